@@ -261,6 +261,33 @@ func (src *IBMPowerVSCluster) ConvertTo(dstRaw conversion.Hub) error {
 	if !reflect.DeepEqual(initialization, infrav1.IBMPowerVSClusterInitializationStatus{}) {
 		dst.Status.Initialization = initialization
 	}
+	if ok {
+		dst.Spec.DNS = restored.Spec.DNS
+		dst.Status.DNS = restored.Status.DNS
+		dst.Spec.VPCEndpointGateways = restored.Spec.VPCEndpointGateways
+		dst.Status.VPCEndpointGateways = restored.Status.VPCEndpointGateways
+		dst.Spec.ResourceNameTemplate = restored.Spec.ResourceNameTemplate
+		dst.Spec.ResourceVerification = restored.Spec.ResourceVerification
+		dst.Spec.Tags = restored.Spec.Tags
+		dst.Status.Tags = restored.Status.Tags
+		dst.Spec.DHCPNetworks = restored.Spec.DHCPNetworks
+		dst.Status.DHCPNetworks = restored.Status.DHCPNetworks
+		dst.Status.WorkspaceQuota = restored.Status.WorkspaceQuota
+		dst.Status.ZoneCapabilities = restored.Status.ZoneCapabilities
+		dst.Status.LastResourceVerificationTime = restored.Status.LastResourceVerificationTime
+		restoreVPCLoadBalancers(dst.Spec.LoadBalancers, restored.Spec.LoadBalancers)
+		restoreVPCLoadBalancerStatuses(dst.Status.LoadBalancers, restored.Status.LoadBalancers)
+		if dst.Spec.TransitGateway != nil && restored.Spec.TransitGateway != nil {
+			dst.Spec.TransitGateway.Connections = restored.Spec.TransitGateway.Connections
+			dst.Spec.TransitGateway.PowerVSConnection = restored.Spec.TransitGateway.PowerVSConnection
+			dst.Spec.TransitGateway.VPCConnection = restored.Spec.TransitGateway.VPCConnection
+			dst.Spec.TransitGateway.GenerateRouteReport = restored.Spec.TransitGateway.GenerateRouteReport
+		}
+		if dst.Status.TransitGateway != nil && restored.Status.TransitGateway != nil {
+			dst.Status.TransitGateway.Connections = restored.Status.TransitGateway.Connections
+			dst.Status.TransitGateway.RouteReport = restored.Status.TransitGateway.RouteReport
+		}
+	}
 	return nil
 }
 
@@ -276,13 +303,44 @@ func (dst *IBMPowerVSCluster) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *IBMPowerVSClusterTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1.IBMPowerVSClusterTemplate)
 
-	return Convert_v1beta2_IBMPowerVSClusterTemplate_To_v1beta3_IBMPowerVSClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta2_IBMPowerVSClusterTemplate_To_v1beta3_IBMPowerVSClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.IBMPowerVSClusterTemplate{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		dst.Spec.Template.Spec.DNS = restored.Spec.Template.Spec.DNS
+		dst.Spec.Template.Spec.VPCEndpointGateways = restored.Spec.Template.Spec.VPCEndpointGateways
+		dst.Spec.Template.Spec.ResourceNameTemplate = restored.Spec.Template.Spec.ResourceNameTemplate
+		dst.Spec.Template.Spec.ResourceVerification = restored.Spec.Template.Spec.ResourceVerification
+		dst.Spec.Template.Spec.Tags = restored.Spec.Template.Spec.Tags
+		dst.Spec.Template.Spec.DHCPNetworks = restored.Spec.Template.Spec.DHCPNetworks
+		restoreVPCLoadBalancers(dst.Spec.Template.Spec.LoadBalancers, restored.Spec.Template.Spec.LoadBalancers)
+		if dst.Spec.Template.Spec.TransitGateway != nil && restored.Spec.Template.Spec.TransitGateway != nil {
+			dst.Spec.Template.Spec.TransitGateway.Connections = restored.Spec.Template.Spec.TransitGateway.Connections
+			dst.Spec.Template.Spec.TransitGateway.PowerVSConnection = restored.Spec.Template.Spec.TransitGateway.PowerVSConnection
+			dst.Spec.Template.Spec.TransitGateway.VPCConnection = restored.Spec.Template.Spec.TransitGateway.VPCConnection
+			dst.Spec.Template.Spec.TransitGateway.GenerateRouteReport = restored.Spec.Template.Spec.TransitGateway.GenerateRouteReport
+		}
+	}
+
+	return nil
 }
 
 func (dst *IBMPowerVSClusterTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.IBMPowerVSClusterTemplate)
 
-	return Convert_v1beta3_IBMPowerVSClusterTemplate_To_v1beta2_IBMPowerVSClusterTemplate(src, dst, nil)
+	if err := Convert_v1beta3_IBMPowerVSClusterTemplate_To_v1beta2_IBMPowerVSClusterTemplate(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 func (src *IBMPowerVSMachine) ConvertTo(dstRaw conversion.Hub) error {
@@ -304,6 +362,15 @@ func (src *IBMPowerVSMachine) ConvertTo(dstRaw conversion.Hub) error {
 		dst.Status.Initialization = initialization
 	}
 
+	if ok {
+		dst.Spec.Tags = restored.Spec.Tags
+		dst.Status.Tags = restored.Status.Tags
+		dst.Spec.DHCPNetwork = restored.Spec.DHCPNetwork
+		dst.Spec.IPAddressPool = restored.Spec.IPAddressPool
+		dst.Spec.BootVolume = restored.Spec.BootVolume
+		dst.Status.BootVolume = restored.Status.BootVolume
+	}
+
 	return nil
 }
 
@@ -336,6 +403,10 @@ func (src *IBMPowerVSMachineTemplate) ConvertTo(dstRaw conversion.Hub) error {
 	}
 
 	if ok {
+		dst.Spec.Template.Spec.Tags = restored.Spec.Template.Spec.Tags
+		dst.Spec.Template.Spec.DHCPNetwork = restored.Spec.Template.Spec.DHCPNetwork
+		dst.Spec.Template.Spec.IPAddressPool = restored.Spec.Template.Spec.IPAddressPool
+		dst.Spec.Template.Spec.BootVolume = restored.Spec.Template.Spec.BootVolume
 		dst.Status = restored.Status
 	}
 
@@ -359,13 +430,45 @@ func (dst *IBMPowerVSMachineTemplate) ConvertFrom(srcRaw conversion.Hub) error {
 func (src *IBMPowerVSImage) ConvertTo(dstRaw conversion.Hub) error {
 	dst := dstRaw.(*infrav1.IBMPowerVSImage)
 
-	return Convert_v1beta2_IBMPowerVSImage_To_v1beta3_IBMPowerVSImage(src, dst, nil)
+	if err := Convert_v1beta2_IBMPowerVSImage_To_v1beta3_IBMPowerVSImage(src, dst, nil); err != nil {
+		return err
+	}
+
+	// Manually restore data.
+	restored := &infrav1.IBMPowerVSImage{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		dst.Spec.Targets = restored.Spec.Targets
+		dst.Spec.ClusterSelector = restored.Spec.ClusterSelector
+		dst.Spec.StockImage = restored.Spec.StockImage
+		dst.Spec.Retention = restored.Spec.Retention
+		dst.Spec.ExpectedChecksum = restored.Spec.ExpectedChecksum
+		dst.Spec.Polling = restored.Spec.Polling
+		dst.Spec.RetryPolicy = restored.Spec.RetryPolicy
+		dst.Status.Targets = restored.Status.Targets
+		dst.Status.Source = restored.Status.Source
+		dst.Status.Checksum = restored.Status.Checksum
+		dst.Status.Usage = restored.Status.Usage
+		dst.Status.Import = restored.Status.Import
+		dst.Status.Attempts = restored.Status.Attempts
+		dst.Status.Adopted = restored.Status.Adopted
+	}
+
+	return nil
 }
 
 func (dst *IBMPowerVSImage) ConvertFrom(srcRaw conversion.Hub) error {
 	src := srcRaw.(*infrav1.IBMPowerVSImage)
 
-	return Convert_v1beta3_IBMPowerVSImage_To_v1beta2_IBMPowerVSImage(src, dst, nil)
+	if err := Convert_v1beta3_IBMPowerVSImage_To_v1beta2_IBMPowerVSImage(src, dst, nil); err != nil {
+		return err
+	}
+
+	return utilconversion.MarshalData(src, dst)
 }
 
 // Convert_v1beta2_IBMPowerVSMachineSpec_To_v1beta3_IBMPowerVSMachineSpec converts v1beta2 IBMPowerVSMachineSpec to v1beta3.
@@ -490,3 +593,60 @@ func Convert_v1beta3_IBMPowerVSImageSpec_To_v1beta2_IBMPowerVSImageSpec(in *infr
 
 	return nil
 }
+
+// Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec converts v1beta3 VPCLoadBalancerSpec to v1beta2.
+func Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(in *infrav1.VPCLoadBalancerSpec, out *VPCLoadBalancerSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(in, out, s)
+}
+
+// Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec converts v1beta3 AdditionalListenerSpec to v1beta2.
+func Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(in *infrav1.AdditionalListenerSpec, out *AdditionalListenerSpec, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(in, out, s)
+}
+
+// Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus converts v1beta3 VPCLoadBalancerStatus to v1beta2.
+func Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(in *infrav1.VPCLoadBalancerStatus, out *VPCLoadBalancerStatus, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(in, out, s)
+}
+
+// Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway converts v1beta3 TransitGateway to v1beta2.
+func Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(in *infrav1.TransitGateway, out *TransitGateway, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(in, out, s)
+}
+
+// Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus converts v1beta3 TransitGatewayStatus to v1beta2.
+func Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(in *infrav1.TransitGatewayStatus, out *TransitGatewayStatus, s apimachineryconversion.Scope) error {
+	return autoConvert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(in, out, s)
+}
+
+// restoreVPCLoadBalancers restores the load balancer fields which do not exist in v1beta2.
+func restoreVPCLoadBalancers(dst, restored []infrav1.VPCLoadBalancerSpec) {
+	for i := range dst {
+		if i >= len(restored) || dst[i].Name != restored[i].Name {
+			continue
+		}
+		dst[i].Profile = restored[i].Profile
+		for j := range dst[i].AdditionalListeners {
+			if j >= len(restored[i].AdditionalListeners) || dst[i].AdditionalListeners[j].Port != restored[i].AdditionalListeners[j].Port {
+				continue
+			}
+			dst[i].AdditionalListeners[j].CertificateCRN = restored[i].AdditionalListeners[j].CertificateCRN
+			dst[i].AdditionalListeners[j].HTTPSRedirect = restored[i].AdditionalListeners[j].HTTPSRedirect
+			dst[i].AdditionalListeners[j].IdleConnectionTimeout = restored[i].AdditionalListeners[j].IdleConnectionTimeout
+		}
+		dst[i].PruneListeners = restored[i].PruneListeners
+	}
+}
+
+// restoreVPCLoadBalancerStatuses restores the load balancer status fields which do not exist in v1beta2.
+func restoreVPCLoadBalancerStatuses(dst, restored map[string]infrav1.VPCLoadBalancerStatus) {
+	for name, status := range dst {
+		restoredStatus, ok := restored[name]
+		if !ok {
+			continue
+		}
+		status.Listeners = restoredStatus.Listeners
+		status.Pools = restoredStatus.Pools
+		dst[name] = status
+	}
+}
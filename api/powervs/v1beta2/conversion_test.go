@@ -87,6 +87,10 @@ func hubIBMPowerVSClusterStatus(in *infrav1.IBMPowerVSClusterStatus, c randfill.
 			in.Deprecated = nil
 		}
 	}
+	// Drop zero times which do not survive the JSON round trip.
+	if in.LastResourceVerificationTime != nil && in.LastResourceVerificationTime.IsZero() {
+		in.LastResourceVerificationTime = nil
+	}
 }
 
 func spokeIBMPowerVSClusterStatus(in *IBMPowerVSClusterStatus, c randfill.Continue) {
@@ -207,12 +211,21 @@ func hubIBMPowerVSMachineTemplateResource(in *infrav1.IBMPowerVSMachineTemplateR
 
 func IBMPowerVSImageFuzzFuncs(_ runtimeserializer.CodecFactory) []interface{} {
 	return []interface{}{
+		hubIBMPowerVSImageSpec,
 		hubIBMPowerVSImageStatus,
 		spokeIBMPowerVSImageStatus,
 		spokeIBMPowerVSImageSpec,
 	}
 }
 
+func hubIBMPowerVSImageSpec(in *infrav1.IBMPowerVSImageSpec, c randfill.Continue) {
+	c.FillNoCustom(in)
+	// Drop empty maps which do not survive the JSON round trip.
+	if in.ClusterSelector != nil && len(in.ClusterSelector.MatchLabels) == 0 {
+		in.ClusterSelector.MatchLabels = nil
+	}
+}
+
 func spokeIBMPowerVSImageSpec(in *IBMPowerVSImageSpec, c randfill.Continue) {
 	c.FillNoCustom(in)
 
@@ -239,6 +252,30 @@ func hubIBMPowerVSImageStatus(in *infrav1.IBMPowerVSImageStatus, c randfill.Cont
 			in.Deprecated = nil
 		}
 	}
+	// Drop zero times which do not survive the JSON round trip.
+	if in.Import != nil {
+		if in.Import.StartTime != nil && in.Import.StartTime.IsZero() {
+			in.Import.StartTime = nil
+		}
+		if in.Import.CompletionTime != nil && in.Import.CompletionTime.IsZero() {
+			in.Import.CompletionTime = nil
+		}
+	}
+	dropZeroImportAttemptTimes(in.Attempts)
+	for i := range in.Targets {
+		dropZeroImportAttemptTimes(in.Targets[i].Attempts)
+	}
+}
+
+func dropZeroImportAttemptTimes(attempts []infrav1.IBMPowerVSImageImportAttempt) {
+	for i := range attempts {
+		if attempts[i].StartTime != nil && attempts[i].StartTime.IsZero() {
+			attempts[i].StartTime = nil
+		}
+		if attempts[i].CompletionTime != nil && attempts[i].CompletionTime.IsZero() {
+			attempts[i].CompletionTime = nil
+		}
+	}
 }
 
 func spokeIBMPowerVSImageStatus(in *IBMPowerVSImageStatus, c randfill.Continue) {
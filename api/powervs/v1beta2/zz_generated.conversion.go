@@ -45,11 +45,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta3.AdditionalListenerSpec)(nil), (*AdditionalListenerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(a.(*v1beta3.AdditionalListenerSpec), b.(*AdditionalListenerSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*CosInstance)(nil), (*v1beta3.CosInstance)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_CosInstance_To_v1beta3_CosInstance(a.(*CosInstance), b.(*v1beta3.CosInstance), scope)
 	}); err != nil {
@@ -270,21 +265,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta3.TransitGateway)(nil), (*TransitGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(a.(*v1beta3.TransitGateway), b.(*TransitGateway), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*TransitGatewayStatus)(nil), (*v1beta3.TransitGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_TransitGatewayStatus_To_v1beta3_TransitGatewayStatus(a.(*TransitGatewayStatus), b.(*v1beta3.TransitGatewayStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta3.TransitGatewayStatus)(nil), (*TransitGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(a.(*v1beta3.TransitGatewayStatus), b.(*TransitGatewayStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VPCEndpoint)(nil), (*v1beta3.VPCEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VPCEndpoint_To_v1beta3_VPCEndpoint(a.(*VPCEndpoint), b.(*v1beta3.VPCEndpoint), scope)
 	}); err != nil {
@@ -320,21 +305,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta3.VPCLoadBalancerSpec)(nil), (*VPCLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(a.(*v1beta3.VPCLoadBalancerSpec), b.(*VPCLoadBalancerSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VPCLoadBalancerStatus)(nil), (*v1beta3.VPCLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VPCLoadBalancerStatus_To_v1beta3_VPCLoadBalancerStatus(a.(*VPCLoadBalancerStatus), b.(*v1beta3.VPCLoadBalancerStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta3.VPCLoadBalancerStatus)(nil), (*VPCLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(a.(*v1beta3.VPCLoadBalancerStatus), b.(*VPCLoadBalancerStatus), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VPCResource)(nil), (*v1beta3.VPCResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VPCResource_To_v1beta3_VPCResource(a.(*VPCResource), b.(*v1beta3.VPCResource), scope)
 	}); err != nil {
@@ -475,6 +450,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta3.AdditionalListenerSpec)(nil), (*AdditionalListenerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(a.(*v1beta3.AdditionalListenerSpec), b.(*AdditionalListenerSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta3.IBMPowerVSClusterSpec)(nil), (*IBMPowerVSClusterSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta3_IBMPowerVSClusterSpec_To_v1beta2_IBMPowerVSClusterSpec(a.(*v1beta3.IBMPowerVSClusterSpec), b.(*IBMPowerVSClusterSpec), scope)
 	}); err != nil {
@@ -510,6 +490,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta3.TransitGatewayStatus)(nil), (*TransitGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(a.(*v1beta3.TransitGatewayStatus), b.(*TransitGatewayStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta3.TransitGateway)(nil), (*TransitGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(a.(*v1beta3.TransitGateway), b.(*TransitGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta3.VPCLoadBalancerSpec)(nil), (*VPCLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(a.(*v1beta3.VPCLoadBalancerSpec), b.(*VPCLoadBalancerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta3.VPCLoadBalancerStatus)(nil), (*VPCLoadBalancerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(a.(*v1beta3.VPCLoadBalancerStatus), b.(*VPCLoadBalancerStatus), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -530,15 +530,13 @@ func autoConvert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpe
 	out.DefaultPoolName = (*string)(unsafe.Pointer(in.DefaultPoolName))
 	out.Port = in.Port
 	out.Protocol = (*VPCLoadBalancerListenerProtocol)(unsafe.Pointer(in.Protocol))
+	// WARNING: in.CertificateCRN requires manual conversion: does not exist in peer-type
+	// WARNING: in.HTTPSRedirect requires manual conversion: does not exist in peer-type
+	// WARNING: in.IdleConnectionTimeout requires manual conversion: does not exist in peer-type
 	out.Selector = in.Selector
 	return nil
 }
 
-// Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec is an autogenerated conversion function.
-func Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(in *v1beta3.AdditionalListenerSpec, out *AdditionalListenerSpec, s conversion.Scope) error {
-	return autoConvert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(in, out, s)
-}
-
 func autoConvert_v1beta2_CosInstance_To_v1beta3_CosInstance(in *CosInstance, out *v1beta3.CosInstance, s conversion.Scope) error {
 	out.Name = in.Name
 	out.BucketName = in.BucketName
@@ -680,8 +678,26 @@ func autoConvert_v1beta2_IBMPowerVSClusterSpec_To_v1beta3_IBMPowerVSClusterSpec(
 	out.VPC = (*v1beta3.VPCResourceReference)(unsafe.Pointer(in.VPC))
 	out.VPCSubnets = *(*[]v1beta3.Subnet)(unsafe.Pointer(&in.VPCSubnets))
 	out.VPCSecurityGroups = *(*[]v1beta3.VPCSecurityGroup)(unsafe.Pointer(&in.VPCSecurityGroups))
-	out.TransitGateway = (*v1beta3.TransitGateway)(unsafe.Pointer(in.TransitGateway))
-	out.LoadBalancers = *(*[]v1beta3.VPCLoadBalancerSpec)(unsafe.Pointer(&in.LoadBalancers))
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(v1beta3.TransitGateway)
+		if err := Convert_v1beta2_TransitGateway_To_v1beta3_TransitGateway(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TransitGateway = nil
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = make([]v1beta3.VPCLoadBalancerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_VPCLoadBalancerSpec_To_v1beta3_VPCLoadBalancerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoadBalancers = nil
+	}
 	out.CosInstance = (*v1beta3.CosInstance)(unsafe.Pointer(in.CosInstance))
 	out.Ignition = (*v1beta3.Ignition)(unsafe.Pointer(in.Ignition))
 	return nil
@@ -695,16 +711,40 @@ func autoConvert_v1beta3_IBMPowerVSClusterSpec_To_v1beta2_IBMPowerVSClusterSpec(
 		return err
 	}
 	out.DHCPServer = (*DHCPServer)(unsafe.Pointer(in.DHCPServer))
+	// WARNING: in.DHCPNetworks requires manual conversion: does not exist in peer-type
 	out.ServiceInstance = (*IBMPowerVSResourceReference)(unsafe.Pointer(in.ServiceInstance))
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.ResourceGroup = (*IBMPowerVSResourceReference)(unsafe.Pointer(in.ResourceGroup))
 	out.VPC = (*VPCResourceReference)(unsafe.Pointer(in.VPC))
 	out.VPCSubnets = *(*[]Subnet)(unsafe.Pointer(&in.VPCSubnets))
 	out.VPCSecurityGroups = *(*[]VPCSecurityGroup)(unsafe.Pointer(&in.VPCSecurityGroups))
-	out.TransitGateway = (*TransitGateway)(unsafe.Pointer(in.TransitGateway))
-	out.LoadBalancers = *(*[]VPCLoadBalancerSpec)(unsafe.Pointer(&in.LoadBalancers))
+	// WARNING: in.VPCEndpointGateways requires manual conversion: does not exist in peer-type
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGateway)
+		if err := Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TransitGateway = nil
+	}
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = make([]VPCLoadBalancerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.LoadBalancers = nil
+	}
 	out.CosInstance = (*CosInstance)(unsafe.Pointer(in.CosInstance))
 	out.Ignition = (*Ignition)(unsafe.Pointer(in.Ignition))
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceNameTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceVerification requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -717,9 +757,29 @@ func autoConvert_v1beta2_IBMPowerVSClusterStatus_To_v1beta3_IBMPowerVSClusterSta
 	out.VPC = (*v1beta3.ResourceReference)(unsafe.Pointer(in.VPC))
 	out.VPCSubnet = *(*map[string]v1beta3.ResourceReference)(unsafe.Pointer(&in.VPCSubnet))
 	out.VPCSecurityGroups = *(*map[string]v1beta3.VPCSecurityGroupStatus)(unsafe.Pointer(&in.VPCSecurityGroups))
-	out.TransitGateway = (*v1beta3.TransitGatewayStatus)(unsafe.Pointer(in.TransitGateway))
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(v1beta3.TransitGatewayStatus)
+		if err := Convert_v1beta2_TransitGatewayStatus_To_v1beta3_TransitGatewayStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TransitGateway = nil
+	}
 	out.COSInstance = (*v1beta3.ResourceReference)(unsafe.Pointer(in.COSInstance))
-	out.LoadBalancers = *(*map[string]v1beta3.VPCLoadBalancerStatus)(unsafe.Pointer(&in.LoadBalancers))
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = make(map[string]v1beta3.VPCLoadBalancerStatus, len(*in))
+		for key, val := range *in {
+			newVal := new(v1beta3.VPCLoadBalancerStatus)
+			if err := Convert_v1beta2_VPCLoadBalancerStatus_To_v1beta3_VPCLoadBalancerStatus(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.LoadBalancers = nil
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -752,12 +812,39 @@ func autoConvert_v1beta3_IBMPowerVSClusterStatus_To_v1beta2_IBMPowerVSClusterSta
 	out.ServiceInstance = (*ResourceReference)(unsafe.Pointer(in.ServiceInstance))
 	out.Network = (*ResourceReference)(unsafe.Pointer(in.Network))
 	out.DHCPServer = (*ResourceReference)(unsafe.Pointer(in.DHCPServer))
+	// WARNING: in.DHCPNetworks requires manual conversion: does not exist in peer-type
 	out.VPC = (*ResourceReference)(unsafe.Pointer(in.VPC))
 	out.VPCSubnet = *(*map[string]ResourceReference)(unsafe.Pointer(&in.VPCSubnet))
 	out.VPCSecurityGroups = *(*map[string]VPCSecurityGroupStatus)(unsafe.Pointer(&in.VPCSecurityGroups))
-	out.TransitGateway = (*TransitGatewayStatus)(unsafe.Pointer(in.TransitGateway))
+	// WARNING: in.VPCEndpointGateways requires manual conversion: does not exist in peer-type
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(TransitGatewayStatus)
+		if err := Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TransitGateway = nil
+	}
 	out.COSInstance = (*ResourceReference)(unsafe.Pointer(in.COSInstance))
-	out.LoadBalancers = *(*map[string]VPCLoadBalancerStatus)(unsafe.Pointer(&in.LoadBalancers))
+	if in.LoadBalancers != nil {
+		in, out := &in.LoadBalancers, &out.LoadBalancers
+		*out = make(map[string]VPCLoadBalancerStatus, len(*in))
+		for key, val := range *in {
+			newVal := new(VPCLoadBalancerStatus)
+			if err := Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(&val, newVal, s); err != nil {
+				return err
+			}
+			(*out)[key] = *newVal
+		}
+	} else {
+		out.LoadBalancers = nil
+	}
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.WorkspaceQuota requires manual conversion: does not exist in peer-type
+	// WARNING: in.ZoneCapabilities requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastResourceVerificationTime requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -976,8 +1063,15 @@ func autoConvert_v1beta3_IBMPowerVSImageSpec_To_v1beta2_IBMPowerVSImageSpec(in *
 	out.Bucket = (*string)(unsafe.Pointer(in.Bucket))
 	out.Object = (*string)(unsafe.Pointer(in.Object))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
+	// WARNING: in.ExpectedChecksum requires manual conversion: does not exist in peer-type
+	// WARNING: in.StockImage requires manual conversion: does not exist in peer-type
 	out.StorageType = in.StorageType
 	out.DeletePolicy = in.DeletePolicy
+	// WARNING: in.Targets requires manual conversion: does not exist in peer-type
+	// WARNING: in.ClusterSelector requires manual conversion: does not exist in peer-type
+	// WARNING: in.RetryPolicy requires manual conversion: does not exist in peer-type
+	// WARNING: in.Polling requires manual conversion: does not exist in peer-type
+	// WARNING: in.Retention requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1017,6 +1111,13 @@ func autoConvert_v1beta3_IBMPowerVSImageStatus_To_v1beta2_IBMPowerVSImageStatus(
 	out.ImageID = in.ImageID
 	out.ImageState = PowerVSImageState(in.ImageState)
 	out.JobID = in.JobID
+	// WARNING: in.Adopted requires manual conversion: does not exist in peer-type
+	// WARNING: in.Targets requires manual conversion: does not exist in peer-type
+	// WARNING: in.Source requires manual conversion: does not exist in peer-type
+	// WARNING: in.Checksum requires manual conversion: does not exist in peer-type
+	// WARNING: in.Import requires manual conversion: does not exist in peer-type
+	// WARNING: in.Attempts requires manual conversion: does not exist in peer-type
+	// WARNING: in.Usage requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -1123,12 +1224,16 @@ func autoConvert_v1beta3_IBMPowerVSMachineSpec_To_v1beta2_IBMPowerVSMachineSpec(
 	out.ProcessorType = PowerVSProcessorType(in.ProcessorType)
 	out.Processors = in.Processors
 	out.MemoryGiB = in.MemoryGiB
+	// WARNING: in.BootVolume requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta3_IBMPowerVSResourceReference_To_v1beta2_IBMPowerVSResourceReference(&in.Network, &out.Network, s); err != nil {
 		return err
 	}
+	// WARNING: in.DHCPNetwork requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPAddressPool requires manual conversion: does not exist in peer-type
 	if err := v1.Convert_string_To_Pointer_string(&in.ProviderID, &out.ProviderID, s); err != nil {
 		return err
 	}
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -1180,6 +1285,8 @@ func autoConvert_v1beta3_IBMPowerVSMachineStatus_To_v1beta2_IBMPowerVSMachineSta
 	out.FailureMessage = (*string)(unsafe.Pointer(in.FailureMessage))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.BootVolume requires manual conversion: does not exist in peer-type
 	// WARNING: in.Deprecated requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -1454,14 +1561,13 @@ func autoConvert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(in *v1beta3.Tr
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.GlobalRouting = (*bool)(unsafe.Pointer(in.GlobalRouting))
+	// WARNING: in.Connections requires manual conversion: does not exist in peer-type
+	// WARNING: in.PowerVSConnection requires manual conversion: does not exist in peer-type
+	// WARNING: in.VPCConnection requires manual conversion: does not exist in peer-type
+	// WARNING: in.GenerateRouteReport requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway is an autogenerated conversion function.
-func Convert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(in *v1beta3.TransitGateway, out *TransitGateway, s conversion.Scope) error {
-	return autoConvert_v1beta3_TransitGateway_To_v1beta2_TransitGateway(in, out, s)
-}
-
 func autoConvert_v1beta2_TransitGatewayStatus_To_v1beta3_TransitGatewayStatus(in *TransitGatewayStatus, out *v1beta3.TransitGatewayStatus, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.ControllerCreated = (*bool)(unsafe.Pointer(in.ControllerCreated))
@@ -1480,14 +1586,11 @@ func autoConvert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(in
 	out.ControllerCreated = (*bool)(unsafe.Pointer(in.ControllerCreated))
 	out.VPCConnection = (*ResourceReference)(unsafe.Pointer(in.VPCConnection))
 	out.PowerVSConnection = (*ResourceReference)(unsafe.Pointer(in.PowerVSConnection))
+	// WARNING: in.Connections requires manual conversion: does not exist in peer-type
+	// WARNING: in.RouteReport requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus is an autogenerated conversion function.
-func Convert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(in *v1beta3.TransitGatewayStatus, out *TransitGatewayStatus, s conversion.Scope) error {
-	return autoConvert_v1beta3_TransitGatewayStatus_To_v1beta2_TransitGatewayStatus(in, out, s)
-}
-
 func autoConvert_v1beta2_VPCEndpoint_To_v1beta3_VPCEndpoint(in *VPCEndpoint, out *v1beta3.VPCEndpoint, s conversion.Scope) error {
 	out.Address = (*string)(unsafe.Pointer(in.Address))
 	out.FIPID = (*string)(unsafe.Pointer(in.FIPID))
@@ -1576,7 +1679,17 @@ func autoConvert_v1beta2_VPCLoadBalancerSpec_To_v1beta3_VPCLoadBalancerSpec(in *
 	out.Name = in.Name
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.Public = (*bool)(unsafe.Pointer(in.Public))
-	out.AdditionalListeners = *(*[]v1beta3.AdditionalListenerSpec)(unsafe.Pointer(&in.AdditionalListeners))
+	if in.AdditionalListeners != nil {
+		in, out := &in.AdditionalListeners, &out.AdditionalListeners
+		*out = make([]v1beta3.AdditionalListenerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_AdditionalListenerSpec_To_v1beta3_AdditionalListenerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalListeners = nil
+	}
 	out.BackendPools = *(*[]v1beta3.VPCLoadBalancerBackendPoolSpec)(unsafe.Pointer(&in.BackendPools))
 	out.SecurityGroups = *(*[]v1beta3.VPCResource)(unsafe.Pointer(&in.SecurityGroups))
 	out.Subnets = *(*[]v1beta3.VPCResource)(unsafe.Pointer(&in.Subnets))
@@ -1592,18 +1705,25 @@ func autoConvert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(in *
 	out.Name = in.Name
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.Public = (*bool)(unsafe.Pointer(in.Public))
-	out.AdditionalListeners = *(*[]AdditionalListenerSpec)(unsafe.Pointer(&in.AdditionalListeners))
+	// WARNING: in.Profile requires manual conversion: does not exist in peer-type
+	if in.AdditionalListeners != nil {
+		in, out := &in.AdditionalListeners, &out.AdditionalListeners
+		*out = make([]AdditionalListenerSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta3_AdditionalListenerSpec_To_v1beta2_AdditionalListenerSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalListeners = nil
+	}
+	// WARNING: in.PruneListeners requires manual conversion: does not exist in peer-type
 	out.BackendPools = *(*[]VPCLoadBalancerBackendPoolSpec)(unsafe.Pointer(&in.BackendPools))
 	out.SecurityGroups = *(*[]VPCResource)(unsafe.Pointer(&in.SecurityGroups))
 	out.Subnets = *(*[]VPCResource)(unsafe.Pointer(&in.Subnets))
 	return nil
 }
 
-// Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec is an autogenerated conversion function.
-func Convert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(in *v1beta3.VPCLoadBalancerSpec, out *VPCLoadBalancerSpec, s conversion.Scope) error {
-	return autoConvert_v1beta3_VPCLoadBalancerSpec_To_v1beta2_VPCLoadBalancerSpec(in, out, s)
-}
-
 func autoConvert_v1beta2_VPCLoadBalancerStatus_To_v1beta3_VPCLoadBalancerStatus(in *VPCLoadBalancerStatus, out *v1beta3.VPCLoadBalancerStatus, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.State = v1beta3.VPCLoadBalancerState(in.State)
@@ -1622,14 +1742,11 @@ func autoConvert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(
 	out.State = VPCLoadBalancerState(in.State)
 	out.Hostname = (*string)(unsafe.Pointer(in.Hostname))
 	out.ControllerCreated = (*bool)(unsafe.Pointer(in.ControllerCreated))
+	// WARNING: in.Listeners requires manual conversion: does not exist in peer-type
+	// WARNING: in.Pools requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus is an autogenerated conversion function.
-func Convert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(in *v1beta3.VPCLoadBalancerStatus, out *VPCLoadBalancerStatus, s conversion.Scope) error {
-	return autoConvert_v1beta3_VPCLoadBalancerStatus_To_v1beta2_VPCLoadBalancerStatus(in, out, s)
-}
-
 func autoConvert_v1beta2_VPCResource_To_v1beta3_VPCResource(in *VPCResource, out *v1beta3.VPCResource, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.Name = (*string)(unsafe.Pointer(in.Name))
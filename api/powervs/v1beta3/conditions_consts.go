@@ -94,6 +94,18 @@ const (
 	// InstanceWaitingForImageReason surfaces when the instance that is controlled
 	// by the IBMPowerVSMachine waiting for the Power VS image to be available in workspace.
 	InstanceWaitingForImageReason = "WaitingForIBMImage"
+
+	// InstanceWaitingForIPAddressReason surfaces when the instance that is controlled
+	// by the IBMPowerVSMachine waiting for the IP address to be allocated from the IPAM pool.
+	InstanceWaitingForIPAddressReason = "WaitingForIPAddress"
+
+	// InstanceWaitingForWorkspaceQuotaReason surfaces when the instance that is controlled
+	// by the IBMPowerVSMachine waiting for capacity in the workspace, as creating it would exceed the workspace quota.
+	InstanceWaitingForWorkspaceQuotaReason = "WaitingForWorkspaceQuota"
+
+	// InstanceUnsupportedByZoneReason surfaces when the instance that is controlled
+	// by the IBMPowerVSMachine requests a system type, storage tier or storage pool not available in the PowerVS zone.
+	InstanceUnsupportedByZoneReason = "UnsupportedByZone"
 )
 
 // IBMPowerVSImage's Ready condition and corresponding reasons.
@@ -113,6 +125,42 @@ const (
 
 	// IBMPowerVSImageImportFailedReason used when the image import is failed.
 	IBMPowerVSImageImportFailedReason = "ImageImportFailed"
+
+	// IBMPowerVSImageImportRetryingReason used when a failed image import is retried.
+	IBMPowerVSImageImportRetryingReason = "ImageImportRetrying"
+
+	// IBMPowerVSImageChecksumMismatchReason used when the checksum of the Cloud Object Storage object does not match the expected checksum.
+	IBMPowerVSImageChecksumMismatchReason = "ChecksumMismatch"
+)
+
+// IBMPowerVSImage's TargetsReady condition and corresponding reasons.
+const (
+	// ImageTargetsReadyCondition reports on the import of the IBMPowerVSImage into the additional Power VS workspaces.
+	ImageTargetsReadyCondition = "TargetsReady"
+
+	// ImageTargetsReadyReason surfaces when the image is active in all the additional Power VS workspaces.
+	ImageTargetsReadyReason = clusterv1.ReadyReason
+
+	// ImageTargetsNotReadyReason surfaces when the image is not yet active in one or more additional Power VS workspaces.
+	ImageTargetsNotReadyReason = clusterv1.NotReadyReason
+
+	// ImageTargetsReconciliationFailedReason used when an error occurs while importing the image into the additional Power VS workspaces.
+	ImageTargetsReconciliationFailedReason = "TargetsReconciliationFailed"
+)
+
+// IBMPowerVSImage's InUse condition and corresponding reasons.
+const (
+	// ImageInUseCondition reports whether the IBMPowerVSImage is referenced by IBMPowerVSMachines or IBMPowerVSMachineTemplates.
+	ImageInUseCondition = "InUse"
+
+	// ImageInUseReason surfaces when the image is referenced by IBMPowerVSMachines or IBMPowerVSMachineTemplates.
+	ImageInUseReason = "InUse"
+
+	// ImageNotInUseReason surfaces when the image is not referenced by any IBMPowerVSMachine or IBMPowerVSMachineTemplate.
+	ImageNotInUseReason = "NotInUse"
+
+	// ImageDeletionBlockedReason surfaces when the deletion of the image is blocked because the image is still in use.
+	ImageDeletionBlockedReason = "DeletionBlocked"
 )
 
 const (
@@ -131,6 +179,11 @@ const (
 	// VPCSecurityGroupReconciliationFailedReason used when an error occurs during VPC reconciliation.
 	VPCSecurityGroupReconciliationFailedReason = "VPCSecurityGroupReconciliationFailed"
 
+	// VPCEndpointGatewayReadyCondition reports on the successful reconciliation of the VPC endpoint gateways.
+	VPCEndpointGatewayReadyCondition = "VPCEndpointGatewayReady"
+	// VPCEndpointGatewayReconciliationFailedReason used when an error occurs during VPC endpoint gateway reconciliation.
+	VPCEndpointGatewayReconciliationFailedReason = "VPCEndpointGatewayReconciliationFailed"
+
 	// VPCReadyCondition reports on the successful reconciliation of a VPC.
 	VPCReadyCondition = "VPCReady"
 	// VPCReconciliationFailedReason used when an error occurs during VPC reconciliation.
@@ -151,6 +204,9 @@ const (
 	// LoadBalancerReconciliationFailedReason used when an error occurs during loadbalancer reconciliation.
 	LoadBalancerReconciliationFailedReason = "LoadBalancerReconciliationFailed"
 
+	// DNSRecordReadyCondition reports on the successful reconciliation of the control plane endpoint DNS record.
+	DNSRecordReadyCondition = "DNSRecordReady"
+
 	// COSInstanceReadyCondition reports on the successful reconciliation of a COS instance.
 	COSInstanceReadyCondition = "COSInstanceCreated"
 	// COSInstanceReconciliationFailedReason used when an error occurs during COS instance reconciliation.
@@ -229,6 +285,15 @@ const (
 	// VPCSecurityGroupDeletingReason surfaces when the VPC security group is being deleted.
 	VPCSecurityGroupDeletingReason = clusterv1.DeletingReason
 
+	// VPCEndpointGatewayReadyReason surfaces when the VPC endpoint gateways are ready.
+	VPCEndpointGatewayReadyReason = clusterv1.ReadyReason
+
+	// VPCEndpointGatewayNotReadyReason surfaces when the VPC endpoint gateways are not ready.
+	VPCEndpointGatewayNotReadyReason = clusterv1.NotReadyReason
+
+	// VPCEndpointGatewayDeletingReason surfaces when the VPC endpoint gateways are being deleted.
+	VPCEndpointGatewayDeletingReason = clusterv1.DeletingReason
+
 	// TransitGatewayReadyReason surfaces when the transit gateway is ready.
 	TransitGatewayReadyReason = clusterv1.ReadyReason
 
@@ -238,6 +303,45 @@ const (
 	// TransitGatewayDeletingReason surfaces when the transit gateway is being deleted.
 	TransitGatewayDeletingReason = clusterv1.DeletingReason
 
+	// TransitGatewayRoutesValidCondition reports whether the routes learned by the transit gateway from its connections overlap each other.
+	TransitGatewayRoutesValidCondition = "TransitGatewayRoutesValid"
+
+	// TransitGatewayRoutesValidReason surfaces when the route report of the transit gateway has no overlapping routes.
+	TransitGatewayRoutesValidReason = "RoutesValid"
+
+	// TransitGatewayRoutesOverlappingReason surfaces when the route report of the transit gateway has overlapping routes.
+	TransitGatewayRoutesOverlappingReason = "RoutesOverlapping"
+
+	// TransitGatewayRouteReportPendingReason surfaces when the route report of the transit gateway is being generated.
+	TransitGatewayRouteReportPendingReason = "RouteReportPending"
+
+	// ResourcesVerifiedCondition reports whether the resources referenced in status still exist and match spec.
+	ResourcesVerifiedCondition = "ResourcesVerified"
+
+	// ResourcesVerifiedReason surfaces when all the resources referenced in status exist and match spec.
+	ResourcesVerifiedReason = "Verified"
+
+	// ResourcesMissingReason surfaces when one or more resources referenced in status no longer exist.
+	ResourcesMissingReason = "ResourcesMissing"
+
+	// ResourcesRecreatingReason surfaces when missing resources created by the controller are being created again.
+	ResourcesRecreatingReason = "ResourcesRecreating"
+
+	// ResourcesDriftedReason surfaces when one or more resources referenced in status no longer match spec.
+	ResourcesDriftedReason = "ResourcesDrifted"
+
+	// ResourcesVerificationFailedReason surfaces when an error occurs while verifying the resources referenced in status.
+	ResourcesVerificationFailedReason = "VerificationFailed"
+
+	// ResourceNameTemplateRenderedCondition reports whether the resource name template renders the names of the resources created by the controller.
+	ResourceNameTemplateRenderedCondition = "ResourceNameTemplateRendered"
+
+	// ResourceNameTemplateRenderedReason surfaces when the resource name template renders the names of all the resources.
+	ResourceNameTemplateRenderedReason = "Rendered"
+
+	// ResourceNameTemplateRenderFailedReason surfaces when the resource name template fails to render, no resource is created until it is fixed.
+	ResourceNameTemplateRenderFailedReason = "RenderFailed"
+
 	// VPCLoadBalancerReadyCondition reports on the successful reconciliation of a VPC LoadBalancer.
 	VPCLoadBalancerReadyCondition = "LoadBalancerReady"
 
@@ -250,6 +354,15 @@ const (
 	// VPCLoadBalancerDeletingReason surfaces when the VPC LoadBalancer is being deleted.
 	VPCLoadBalancerDeletingReason = clusterv1.DeletingReason
 
+	// DNSRecordReadyReason surfaces when the DNS record is ready.
+	DNSRecordReadyReason = clusterv1.ReadyReason
+
+	// DNSRecordNotReadyReason surfaces when the DNS record is not ready.
+	DNSRecordNotReadyReason = clusterv1.NotReadyReason
+
+	// DNSRecordDeletingReason surfaces when the DNS record is being deleted.
+	DNSRecordDeletingReason = clusterv1.DeletingReason
+
 	// COSInstanceReadyReason surfaces when the COS instance is ready.
 	COSInstanceReadyReason = clusterv1.ReadyReason
 
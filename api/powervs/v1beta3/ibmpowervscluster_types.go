@@ -54,6 +54,16 @@ type IBMPowerVSClusterSpec struct {
 	// +optional
 	DHCPServer *DHCPServer `json:"dhcpServer,omitempty"`
 
+	// dhcpNetworks are additional networks backed by DHCP servers in the PowerVS workspace, for example to place the control plane
+	// and the workers on separate networks. a machine selects one of the networks by name with dhcpNetwork.
+	// the network and DHCP server configured with network and dhcpServer remain the network of the machines that select no network.
+	// when a network is removed, the DHCP server created by the controller for it is deleted.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	DHCPNetworks []DHCPNetwork `json:"dhcpNetworks,omitempty"`
+
 	// serviceInstance is the reference to the Power VS server workspace on which the server instance(VM) will be created.
 	// Power VS server workspace is a container for all Power VS instances at a specific geographic region.
 	// serviceInstance can be created via IBM Cloud catalog or CLI.
@@ -106,6 +116,14 @@ type IBMPowerVSClusterSpec struct {
 	// +optional
 	VPCSecurityGroups []VPCSecurityGroup `json:"vpcSecurityGroups,omitempty"`
 
+	// vpcEndpointGateways is a set of Virtual Private Endpoint gateways providing private access to IBM Cloud services from the VPC.
+	// a reserved IP is bound to the endpoint gateway in a VPC subnet of each zone.
+	// when VPCEndpointGateways[].Name is set, system will first check for endpoint gateway with Name in the VPC, if not exist system will create new endpoint gateway.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	VPCEndpointGateways []VPCEndpointGateway `json:"vpcEndpointGateways,omitempty"`
+
 	// transitGateway contains information about IBM Cloud TransitGateway
 	// IBM Cloud TransitGateway helps in establishing network connectivity between IBM Cloud Power VS and VPC infrastructure
 	// more information about TransitGateway can be found here https://www.ibm.com/products/transit-gateway.
@@ -138,6 +156,40 @@ type IBMPowerVSClusterSpec struct {
 	// ignition defined options related to the bootstrapping systems where Ignition is used.
 	// +optional
 	Ignition *Ignition `json:"ignition,omitempty"`
+
+	// dns contains information about an IBM Cloud DNS Services record to create for the control plane endpoint.
+	// when omitted ControlPlaneEndpoint will be set with associated hostname of public loadbalancer.
+	// when specified a record named DNS.RecordName will be created in DNS.Zone pointing to the loadbalancer hostname,
+	// and ControlPlaneEndpoint will be set with the fully qualified name of the record.
+	// if the record already exists it will be updated to point to the loadbalancer, but not deleted along with the cluster.
+	// +optional
+	DNS *DNSRecordSpec `json:"dns,omitempty"`
+
+	// resourceNameTemplate is a Go template used to generate the names of the resources created by the controller,
+	// when no name is set for the resource.
+	// the template is rendered with .ClusterName, .Namespace, .ResourceType and .Zone, and the lower function is available,
+	// e.g. "fin-dev-{{ .ClusterName }}-{{ lower .ResourceType }}".
+	// the zone is appended to the names of the resources created in each zone when the template does not reference .Zone.
+	// the template must reference .ResourceType to keep the names of the resources unique.
+	// no resource is created while the template fails to render, which is reported in the ResourceNameTemplateRendered condition.
+	// resourceNameTemplate cannot be changed once set.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	ResourceNameTemplate *string `json:"resourceNameTemplate,omitempty"`
+
+	// resourceVerification configures the periodic verification of the resources referenced in status.
+	// once the cluster is provisioned, the controller verifies that the resources still exist and match spec,
+	// and reports the missing or drifted resources through the ResourcesVerified condition.
+	// when omitted, the resources are verified every 10 minutes and the missing resources are only reported.
+	// +optional
+	ResourceVerification *ResourceVerification `json:"resourceVerification,omitempty"`
+
+	// tags are user tags, attached in the form key:value to every IBM Cloud resource created for the cluster.
+	// changes to the tags are applied to the resources already created, the tags removed are detached.
+	// the key capibm-cluster-uid is reserved for the tag with the UID of the cluster, attached to the resources along with the user tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // IBMPowerVSClusterStatus defines the observed state of IBMPowerVSCluster.
@@ -166,6 +218,10 @@ type IBMPowerVSClusterStatus struct {
 	// dhcpServer is the reference to the Power VS DHCP server.
 	DHCPServer *ResourceReference `json:"dhcpServer,omitempty"`
 
+	// dhcpNetworks is reference to the Power VS networks and DHCP servers of spec.dhcpNetworks, keyed by name.
+	// +optional
+	DHCPNetworks map[string]DHCPNetworkStatus `json:"dhcpNetworks,omitempty"`
+
 	// vpc is reference to IBM Cloud VPC resources.
 	VPC *ResourceReference `json:"vpc,omitempty"`
 
@@ -175,6 +231,10 @@ type IBMPowerVSClusterStatus struct {
 	// vpcSecurityGroups is reference to IBM Cloud VPC security group.
 	VPCSecurityGroups map[string]VPCSecurityGroupStatus `json:"vpcSecurityGroups,omitempty"`
 
+	// vpcEndpointGateways is reference to IBM Cloud VPC endpoint gateways.
+	// +optional
+	VPCEndpointGateways map[string]VPCEndpointGatewayStatus `json:"vpcEndpointGateways,omitempty"`
+
 	// transitGateway is reference to IBM Cloud TransitGateway.
 	TransitGateway *TransitGatewayStatus `json:"transitGateway,omitempty"`
 
@@ -184,6 +244,30 @@ type IBMPowerVSClusterStatus struct {
 	// loadBalancers reference to IBM Cloud VPC Loadbalancer.
 	LoadBalancers map[string]VPCLoadBalancerStatus `json:"loadBalancers,omitempty"`
 
+	// dns is reference to the IBM Cloud DNS Services record of the control plane endpoint.
+	// +optional
+	DNS *DNSRecordStatus `json:"dns,omitempty"`
+
+	// tags is the status of the user tags attached to the resources created for the cluster.
+	// +optional
+	Tags *TagsStatus `json:"tags,omitempty"`
+
+	// workspaceQuota is the used and available capacity of the PowerVS workspace, read from the workspace usage and limits.
+	// the creation of machines whose processors, memory or boot volume would exceed the available capacity is held until capacity is freed,
+	// the capacity is read again from the workspace before each machine is created.
+	// +optional
+	WorkspaceQuota *WorkspaceQuotaStatus `json:"workspaceQuota,omitempty"`
+
+	// zoneCapabilities are the system types, storage tiers and storage pools available in the PowerVS zone of the workspace.
+	// machines requesting a combination not available in the zone are not created.
+	// +optional
+	ZoneCapabilities *ZoneCapabilitiesStatus `json:"zoneCapabilities,omitempty"`
+
+	// lastResourceVerificationTime is the time the resources referenced in status were last verified.
+	// the resources are verified again once spec.resourceVerification.interval has passed, or on every reconciliation while resources are missing.
+	// +optional
+	LastResourceVerificationTime *metav1.Time `json:"lastResourceVerificationTime,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *IBMPowerVSClusterDeprecatedStatus `json:"deprecated,omitempty"`
@@ -269,6 +353,35 @@ type DHCPServer struct {
 	Snat *bool `json:"snat,omitempty"`
 }
 
+// DHCPNetwork is a network backed by a DHCP server in the PowerVS workspace.
+type DHCPNetwork struct {
+	// name identifies the network within the cluster, machines select the network by this name.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// dhcpServer contains the configuration of the DHCP server of the network.
+	// when dhcpServer.id is set, the existing DHCP server and its network are used.
+	// when dhcpServer.name is omitted, CLUSTER_NAME-<name> will be used as the name of the DHCP server and
+	// the network will be named DHCPSERVER<DHCPServer.Name>_Private.
+	// the PowerVS DHCP service only supports a single DNS server, other DHCP options like DNS search domains and NTP servers are not supported.
+	// +optional
+	DHCPServer *DHCPServer `json:"dhcpServer,omitempty"`
+}
+
+// DHCPNetworkStatus is the reference to a Power VS network backed by a DHCP server.
+type DHCPNetworkStatus struct {
+	// network is the reference to the Power VS network.
+	// +optional
+	Network *ResourceReference `json:"network,omitempty"`
+
+	// dhcpServer is the reference to the Power VS DHCP server.
+	// +optional
+	DHCPServer *ResourceReference `json:"dhcpServer,omitempty"`
+}
+
 // VPCResourceReference is a reference to a specific VPC resource by ID or Name
 // Only one of ID or Name may be specified. Specifying more than one will result in
 // a validation error.
@@ -309,6 +422,84 @@ type TransitGateway struct {
 	// when the field is omitted,  based on PowerVS region (region associated with IBMPowerVSCluster.Spec.Zone) and VPC region(IBMPowerVSCluster.Spec.VPC.Region) system will decide whether to enable globalRouting or not.
 	// +optional
 	GlobalRouting *bool `json:"globalRouting,omitempty"`
+	// connections are the additional connections of the transit gateway, alongside the connections to the PowerVS workspace and the VPC of the cluster.
+	// they can be used to reach the classic infrastructure, an on-premises network over Direct Link or other VPCs.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Connections []TransitGatewayConnection `json:"connections,omitempty"`
+	// powerVSConnection defines the prefix filters of the connection to the PowerVS workspace of the cluster.
+	// when omitted, the prefix filters of the connection are not managed.
+	// +optional
+	PowerVSConnection *TransitGatewayConnectionPrefixFilters `json:"powerVSConnection,omitempty"`
+	// vpcConnection defines the prefix filters of the connection to the VPC of the cluster.
+	// when omitted, the prefix filters of the connection are not managed.
+	// +optional
+	VPCConnection *TransitGatewayConnectionPrefixFilters `json:"vpcConnection,omitempty"`
+	// generateRouteReport indicates whether to generate a route report of the transit gateway once all its connections are attached.
+	// the report is generated again whenever the connections or their prefix filters are changed by the controller,
+	// and the routes overlapping each other are reported in the TransitGatewayRoutesValid condition.
+	// +optional
+	GenerateRouteReport *bool `json:"generateRouteReport,omitempty"`
+}
+
+// TransitGatewayConnectionPrefixFilters defines the prefix filters of a connection of the transit gateway.
+type TransitGatewayConnectionPrefixFilters struct {
+	// prefixFilters are the filters applied to the routes learned from the connection, evaluated in order.
+	// +listType=atomic
+	// +optional
+	PrefixFilters []TransitGatewayPrefixFilter `json:"prefixFilters,omitempty"`
+	// prefixFiltersDefault is the action applied to the routes which do not match any of the prefix filters.
+	// when omitted, the routes are permitted.
+	// +optional
+	PrefixFiltersDefault *TransitGatewayPrefixFilterAction `json:"prefixFiltersDefault,omitempty"`
+}
+
+// TransitGatewayConnection defines an additional connection of the transit gateway.
+type TransitGatewayConnection struct {
+	// name of the connection.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`
+	// +required
+	Name string `json:"name"`
+	// networkType is the type of the network to connect.
+	// +required
+	NetworkType TransitGatewayConnectionNetworkType `json:"networkType"`
+	// networkCRN is the CRN of the network to connect.
+	// it is required for all the network types except classic, which connects the classic infrastructure of the account.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	NetworkCRN *string `json:"networkCRN,omitempty"`
+	// prefixFilters are the filters applied to the routes learned from the connection, evaluated in order.
+	// +listType=atomic
+	// +optional
+	PrefixFilters []TransitGatewayPrefixFilter `json:"prefixFilters,omitempty"`
+	// prefixFiltersDefault is the action applied to the routes which do not match any of the prefix filters.
+	// when omitted, the routes are permitted.
+	// +optional
+	PrefixFiltersDefault *TransitGatewayPrefixFilterAction `json:"prefixFiltersDefault,omitempty"`
+}
+
+// TransitGatewayPrefixFilter defines a filter of the routes learned from a transit gateway connection.
+type TransitGatewayPrefixFilter struct {
+	// action defines whether the matching routes are permitted or denied.
+	// +required
+	Action TransitGatewayPrefixFilterAction `json:"action"`
+	// prefix is the IP prefix in CIDR notation to match.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Prefix string `json:"prefix"`
+	// ge matches the routes with a prefix length greater than or equal to the value.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	Ge *int64 `json:"ge,omitempty"`
+	// le matches the routes with a prefix length less than or equal to the value.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	Le *int64 `json:"le,omitempty"`
 }
 
 // CosInstance represents IBM Cloud COS instance.
@@ -337,6 +528,60 @@ type Ignition struct {
 	Version string `json:"version,omitempty"`
 }
 
+// DNSRecordSpec defines an IBM Cloud DNS Services record for the control plane endpoint.
+type DNSRecordSpec struct {
+	// instanceID is the GUID of the IBM Cloud DNS Services instance.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	InstanceID string `json:"instanceID"`
+
+	// zone is the name of an existing DNS zone in the DNS Services instance, e.g. example.com.
+	// the zone is expected to have the cluster VPC added as a permitted network.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Zone string `json:"zone"`
+
+	// recordName is the name of the record relative to the zone, e.g. api.mycluster.
+	// the fully qualified name of the record will be RECORD_NAME.ZONE.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +required
+	RecordName string `json:"recordName"`
+
+	// ttl is the time to live of the record in seconds.
+	// when omitted the DNS Services default of 900 seconds is used.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=2592000
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+}
+
+// DNSRecordStatus defines the status of the control plane endpoint DNS Services record.
+type DNSRecordStatus struct {
+	// zoneID is the id of the DNS zone containing the record.
+	// +optional
+	ZoneID *string `json:"zoneID,omitempty"`
+
+	// id is the id of the record.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// hostname is the fully qualified name of the record.
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// target is the loadbalancer hostname or IP address the record resolves to.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// controllerCreated indicates whether the record is created by the controller.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+}
+
 // ResourceReference identifies a resource with id.
 type ResourceReference struct {
 	// id represents the id of the resource.
@@ -346,6 +591,49 @@ type ResourceReference struct {
 	ControllerCreated *bool `json:"controllerCreated,omitempty"`
 }
 
+// VPCEndpointGateway defines a Virtual Private Endpoint gateway to an IBM Cloud service.
+type VPCEndpointGateway struct {
+	// name of the endpoint gateway.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`
+	// +required
+	Name string `json:"name"`
+
+	// serviceCRN is the CRN of the IBM Cloud service, or of the Private Path service gateway, targeted by the endpoint gateway.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ServiceCRN string `json:"serviceCRN"`
+}
+
+// VPCEndpointGatewayStatus defines the status of a Virtual Private Endpoint gateway.
+type VPCEndpointGatewayStatus struct {
+	// id represents the id of the resource.
+	ID *string `json:"id,omitempty"`
+	// controllerCreated indicates whether the resource is created by the controller.
+	// +kubebuilder:default=false
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+	// reservedIPs are the addresses reserved for the endpoint gateway in the VPC subnets.
+	// +optional
+	ReservedIPs []string `json:"reservedIPs,omitempty"`
+}
+
+// ResourceVerification configures the periodic verification of the resources referenced in status.
+type ResourceVerification struct {
+	// interval is the time between two verifications of the resources.
+	// +kubebuilder:default="10m"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// missingResourcePolicy defines how the missing resources are handled.
+	// Report reports the missing resources and stops the reconciliation of the cluster.
+	// Recreate clears the status of the missing resources created by the controller, so they are created again,
+	// the missing resources not created by the controller are still reported.
+	// +kubebuilder:default=Report
+	// +optional
+	MissingResourcePolicy MissingResourcePolicy `json:"missingResourcePolicy,omitempty"`
+}
+
 // TransitGatewayStatus defines the status of transit gateway as well as it's connection's status.
 type TransitGatewayStatus struct {
 	// id represents the id of the resource.
@@ -357,6 +645,58 @@ type TransitGatewayStatus struct {
 	VPCConnection *ResourceReference `json:"vpcConnection,omitempty"`
 	// powerVSConnection defines the powervs connection status in transit gateway.
 	PowerVSConnection *ResourceReference `json:"powerVSConnection,omitempty"`
+	// connections defines the status of the additional connections in transit gateway.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Connections []TransitGatewayConnectionStatus `json:"connections,omitempty"`
+	// routeReport defines the status of the latest route report of the transit gateway.
+	// +optional
+	RouteReport *TransitGatewayRouteReportStatus `json:"routeReport,omitempty"`
+}
+
+// TransitGatewayRouteReportStatus defines the status of a route report of the transit gateway.
+type TransitGatewayRouteReportStatus struct {
+	// id represents the id of the route report.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// state of the route report.
+	// +optional
+	State TransitGatewayRouteReportState `json:"state,omitempty"`
+	// overlappingRoutes are the groups of routes learned from different connections which overlap each other.
+	// +listType=atomic
+	// +optional
+	OverlappingRoutes []TransitGatewayOverlappingRoutes `json:"overlappingRoutes,omitempty"`
+}
+
+// TransitGatewayOverlappingRoutes defines a group of routes of the transit gateway which overlap each other.
+type TransitGatewayOverlappingRoutes struct {
+	// routes overlapping each other.
+	// +listType=atomic
+	// +optional
+	Routes []TransitGatewayRoute `json:"routes,omitempty"`
+}
+
+// TransitGatewayRoute defines a route learned from a connection of the transit gateway.
+type TransitGatewayRoute struct {
+	// prefix of the route in CIDR notation.
+	Prefix string `json:"prefix"`
+	// connectionName is the name of the connection the route is learned from.
+	// +optional
+	ConnectionName string `json:"connectionName,omitempty"`
+}
+
+// TransitGatewayConnectionStatus defines the status of an additional transit gateway connection.
+type TransitGatewayConnectionStatus struct {
+	// name of the connection.
+	Name string `json:"name"`
+	// id represents the id of the connection.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// controllerCreated indicates whether the connection is created by the controller.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
 }
 
 // IBMPowerVSClusterDeprecatedStatus groups all the status fields that are deprecated and will be removed in a future version.
@@ -26,6 +26,10 @@ const (
 	// IBMPowerVSImageFinalizer allows IBMPowerVSImageReconciler to clean up resources associated with IBMPowerVSImage before
 	// removing it from the apiserver.
 	IBMPowerVSImageFinalizer = "ibmpowervsimage.infrastructure.cluster.x-k8s.io"
+
+	// IBMPowerVSImageFamilyLabel is the label used to group the IBMPowerVSImages which are versions of the same image,
+	// the retention policy of an image applies to the images of its family.
+	IBMPowerVSImageFamilyLabel = "powervs.cluster.x-k8s.io/image-family"
 )
 
 func init() {
@@ -49,13 +53,32 @@ type IBMPowerVSImageSpec struct {
 	ServiceInstance *IBMPowerVSResourceReference `json:"serviceInstance,omitempty"`
 
 	// bucket is the Cloud Object Storage bucket name; bucket-name[/optional/folder]
-	Bucket *string `json:"bucket"`
+	// required when the image is imported from Cloud Object Storage.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
 
 	// object is the Cloud Object Storage image filename.
-	Object *string `json:"object"`
+	// required when the image is imported from Cloud Object Storage.
+	// +optional
+	Object *string `json:"object,omitempty"`
 
 	// region is the Cloud Object Storage region.
-	Region *string `json:"region"`
+	// required when the image is imported from Cloud Object Storage.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// expectedChecksum is the expected checksum of the Cloud Object Storage object.
+	// when set, the checksum is verified against the metadata of the object before the import job is submitted,
+	// and the image is not imported on mismatch.
+	// +optional
+	ExpectedChecksum *IBMPowerVSImageChecksum `json:"expectedChecksum,omitempty"`
+
+	// stockImage is the reference to a stock image in the catalog of the Power VS zone which is copied into the workspace
+	// instead of importing the image from Cloud Object Storage.
+	// supported stockImage identifier in PowerVSResource are Name and ID.
+	// the copied image keeps the name of the stock image, and bucket, object and region must not be set.
+	// +optional
+	StockImage *IBMPowerVSResourceReference `json:"stockImage,omitempty"`
 
 	// storageType is the type of storage, storage pool with the most available space will be selected.
 	// +kubebuilder:default=tier1
@@ -64,10 +87,218 @@ type IBMPowerVSImageSpec struct {
 	StorageType string `json:"storageType,omitempty"`
 
 	// deletePolicy defines the policy used to identify images to be preserved beyond the lifecycle of associated cluster.
+	// an image which already existed in the workspace and was adopted is never deleted.
 	// +kubebuilder:default=delete
 	// +kubebuilder:validation:Enum=delete;retain
 	// +optional
 	DeletePolicy string `json:"deletePolicy,omitempty"`
+
+	// targets is a list of additional Power VS workspaces the image will be imported into.
+	// the image is imported from the same Cloud Object Storage object or stock image into every target, in addition to the
+	// workspace resolved from serviceInstance or the cluster, and the import of each target is tracked in status.targets.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=32
+	Targets []IBMPowerVSImageTarget `json:"targets,omitempty"`
+
+	// clusterSelector selects the IBMPowerVSClusters in the namespace of the image whose Power VS workspaces
+	// the image will be imported into, in addition to targets.
+	// when omitted no additional workspaces are selected.
+	// +optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// retryPolicy defines how failed import jobs are retried.
+	// each retry deletes the failed import job and any partially imported image before the import job is resubmitted.
+	// when omitted failed import jobs are not retried.
+	// +optional
+	RetryPolicy *IBMPowerVSImageRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// polling defines the intervals used to poll the import job and the image while they are not yet ready.
+	// +optional
+	Polling IBMPowerVSImagePolling `json:"polling,omitempty,omitzero"`
+
+	// retention defines the policy used to rotate the images of the family of the image, identified by the
+	// powervs.cluster.x-k8s.io/image-family label. the policy of the most recently created image of the family applies.
+	// the older IBMPowerVSImages of the family in the same namespace exceeding the policy are deleted by the controller
+	// once they are no longer referenced, and their images in the workspace are deleted according to their deletePolicy.
+	// when omitted the images of the family are not rotated.
+	// +optional
+	Retention *IBMPowerVSImageRetention `json:"retention,omitempty"`
+}
+
+// PowerVSImageChecksumAlgorithm describes the algorithm of a checksum.
+// +kubebuilder:validation:Enum=MD5;SHA256
+type PowerVSImageChecksumAlgorithm string
+
+const (
+	// PowerVSImageChecksumAlgorithmMD5 is the MD5 checksum, verified against the ETag of the object
+	// or the md5 user metadata of objects uploaded in multiple parts.
+	PowerVSImageChecksumAlgorithmMD5 = PowerVSImageChecksumAlgorithm("MD5")
+
+	// PowerVSImageChecksumAlgorithmSHA256 is the SHA256 checksum, verified against the sha256 user metadata of the object.
+	PowerVSImageChecksumAlgorithmSHA256 = PowerVSImageChecksumAlgorithm("SHA256")
+)
+
+// IBMPowerVSImageChecksum is the checksum of a Cloud Object Storage object.
+type IBMPowerVSImageChecksum struct {
+	// algorithm is the algorithm of the checksum.
+	// +required
+	Algorithm PowerVSImageChecksumAlgorithm `json:"algorithm"`
+
+	// value is the hex encoded checksum.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +required
+	Value string `json:"value"`
+}
+
+// IBMPowerVSImageRetryPolicy defines how failed import jobs of an image are retried.
+type IBMPowerVSImageRetryPolicy struct {
+	// maxAttempts is the maximum number of import attempts, including the first one.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +required
+	MaxAttempts int32 `json:"maxAttempts"`
+
+	// backoffSeconds is the time in seconds to wait after the first failed attempt before retrying,
+	// the time is doubled after every failed attempt up to one hour.
+	// when omitted the import is retried after 60 seconds.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	BackoffSeconds *int32 `json:"backoffSeconds,omitempty"`
+}
+
+// IBMPowerVSImagePolling defines the intervals used to poll the import of an image.
+type IBMPowerVSImagePolling struct {
+	// jobIntervalSeconds is the interval in seconds to poll the import job.
+	// when omitted the import job is polled every 120 seconds.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	JobIntervalSeconds *int32 `json:"jobIntervalSeconds,omitempty"`
+
+	// imageIntervalSeconds is the interval in seconds to poll the image until it is active.
+	// when omitted the image is polled every 60 seconds.
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	ImageIntervalSeconds *int32 `json:"imageIntervalSeconds,omitempty"`
+}
+
+// IBMPowerVSImageRetention defines the policy used to rotate the images of an image family.
+type IBMPowerVSImageRetention struct {
+	// keepLastVersions is the number of most recently created images of the family to keep.
+	// older images of the family are deleted once they are no longer referenced by any IBMPowerVSMachine
+	// or IBMPowerVSMachineTemplate.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	KeepLastVersions int32 `json:"keepLastVersions"`
+}
+
+// PowerVSImageSourceType describes the type of the source of an IBM Power VS image.
+// +kubebuilder:validation:Enum=COS;StockImage
+type PowerVSImageSourceType string
+
+const (
+	// PowerVSImageSourceTypeCOS is the image imported from Cloud Object Storage.
+	PowerVSImageSourceTypeCOS = PowerVSImageSourceType("COS")
+
+	// PowerVSImageSourceTypeStockImage is the image copied from the stock image catalog of the Power VS zone.
+	PowerVSImageSourceTypeStockImage = PowerVSImageSourceType("StockImage")
+)
+
+// IBMPowerVSImageSource describes the source of an IBM Power VS image.
+type IBMPowerVSImageSource struct {
+	// type is the type of the image source.
+	// +required
+	Type PowerVSImageSourceType `json:"type"`
+
+	// location identifies the image source, cos://<region>/<bucket>/<object> for an image imported from
+	// Cloud Object Storage and the id of the stock image for an image copied from the stock image catalog.
+	// +optional
+	Location string `json:"location,omitempty"`
+
+	// name is the name of the stock image the image was copied from.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
+// IBMPowerVSImageImportStatus reports the progress of the import job of an image.
+type IBMPowerVSImageImportStatus struct {
+	// progress is the progress of the import job as reported by Power VS.
+	// +optional
+	Progress string `json:"progress,omitempty"`
+
+	// startTime is the time the import job was created.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is the time the import job was observed as completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// checksumVerified is true if the expected checksum was verified against the Cloud Object Storage object
+	// before the import job was submitted.
+	// +optional
+	ChecksumVerified bool `json:"checksumVerified,omitempty"`
+}
+
+// IBMPowerVSImageImportAttempt describes an import attempt of an image.
+type IBMPowerVSImageImportAttempt struct {
+	// attempt is the number of the attempt, starting from 1.
+	// +required
+	Attempt int32 `json:"attempt"`
+
+	// jobID is the id of the import job of the attempt.
+	// +optional
+	JobID string `json:"jobID,omitempty"`
+
+	// state is the state of the import job of the attempt.
+	// +optional
+	State PowerVSImageState `json:"state,omitempty"`
+
+	// message is the message reported by the import job of the attempt.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// startTime is the time the attempt was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// completionTime is the time the attempt was observed as completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// IBMPowerVSImageUsage reports the usage of an IBMPowerVSImage.
+type IBMPowerVSImageUsage struct {
+	// referenceCount is the number of IBMPowerVSMachines and IBMPowerVSMachineTemplates referencing the image.
+	// the IBMPowerVSMachineTemplates of a Cluster being deleted are not counted.
+	// the image is not deleted from the workspace while it is referenced.
+	// +required
+	ReferenceCount int32 `json:"referenceCount"`
+
+	// machines is the number of IBMPowerVSMachines referencing the image.
+	// +optional
+	Machines int32 `json:"machines,omitempty"`
+
+	// machineTemplates is the number of IBMPowerVSMachineTemplates referencing the image.
+	// +optional
+	MachineTemplates int32 `json:"machineTemplates,omitempty"`
+}
+
+// IBMPowerVSImageTarget identifies an additional Power VS workspace the image will be imported into.
+type IBMPowerVSImageTarget struct {
+	// serviceInstance is the reference to the Power VS workspace.
+	// supported serviceInstance identifier in PowerVSResource are Name and ID.
+	// when ServiceInstance.Name is set, zone is used to look up the workspace.
+	// +required
+	ServiceInstance IBMPowerVSResourceReference `json:"serviceInstance"`
+
+	// zone is the name of Power VS zone where the workspace exists.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
 }
 
 // IBMPowerVSImageStatus defines the observed state of IBMPowerVSImage.
@@ -94,17 +325,95 @@ type IBMPowerVSImageStatus struct {
 	// +optional
 	JobID string `json:"jobID,omitempty"`
 
+	// adopted is true when the image already existed in the workspace and was not created by the controller.
+	// an adopted image is not deleted from the workspace.
+	// +optional
+	Adopted bool `json:"adopted,omitempty"`
+
+	// targets is the observed state of the image in each additional Power VS workspace.
+	// +optional
+	// +listType=map
+	// +listMapKey=serviceInstanceID
+	// +kubebuilder:validation:MaxItems=64
+	Targets []IBMPowerVSImageTargetStatus `json:"targets,omitempty"`
+
+	// source is the source the image was imported or copied from.
+	// +optional
+	Source *IBMPowerVSImageSource `json:"source,omitempty"`
+
+	// checksum is the checksum of the source the image was imported or copied from, as reported by Power VS.
+	// +optional
+	Checksum string `json:"checksum,omitempty"`
+
+	// import reports the progress of the import job of the image.
+	// +optional
+	Import *IBMPowerVSImageImportStatus `json:"import,omitempty"`
+
+	// attempts is the history of the import attempts of the image, the most recent attempt is the last one.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=10
+	Attempts []IBMPowerVSImageImportAttempt `json:"attempts,omitempty"`
+
+	// usage reports the IBMPowerVSMachines and IBMPowerVSMachineTemplates referencing the image.
+	// +optional
+	Usage *IBMPowerVSImageUsage `json:"usage,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *IBMPowerVSImageDeprecatedStatus `json:"deprecated,omitempty"`
 }
 
+// IBMPowerVSImageTargetStatus defines the observed state of the image in an additional Power VS workspace.
+type IBMPowerVSImageTargetStatus struct {
+	// serviceInstanceID is the id of the Power VS workspace.
+	// +required
+	ServiceInstanceID string `json:"serviceInstanceID"`
+
+	// zone is the Power VS zone of the workspace.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// imageID is the id of the image imported into the workspace.
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// imageState is the status of the image imported into the workspace.
+	// +optional
+	ImageState PowerVSImageState `json:"imageState,omitempty"`
+
+	// jobID is the job ID of the import operation in the workspace.
+	// +optional
+	JobID string `json:"jobID,omitempty"`
+
+	// adopted is true when the image already existed in the workspace and was not created by the controller.
+	// an adopted image is not deleted from the workspace.
+	// +optional
+	Adopted bool `json:"adopted,omitempty"`
+
+	// ready is true when the image is active in the workspace.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// message is the last message reported by the import job in the workspace.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// attempts is the history of the import attempts in the workspace, the failed import jobs are retried according to spec.retryPolicy.
+	// +optional
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems=10
+	Attempts []IBMPowerVSImageImportAttempt `json:"attempts,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:storageversion
 // +kubebuilder:resource:path=ibmpowervsimages,scope=Namespaced,categories=cluster-api
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.imageState",description="PowerVS image state"
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.ready",description="Image is ready for IBM PowerVS instances"
+// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=".status.import.progress",description="Progress of the image import job"
+// +kubebuilder:printcolumn:name="References",type="integer",JSONPath=".status.usage.referenceCount",description="Number of IBMPowerVSMachines and IBMPowerVSMachineTemplates referencing the image"
 
 // IBMPowerVSImage is the Schema for the ibmpowervsimages API.
 type IBMPowerVSImage struct {
@@ -21,11 +21,18 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 )
 
 // PowerVSProcessorType enum attribute to identify the PowerVS instance processor type.
 type PowerVSProcessorType string
 
+// PowerVSStorageTier enum attribute to identify the storage tier of a PowerVS volume.
+type PowerVSStorageTier string
+
+// PowerVSStorageAffinityPolicy enum attribute to identify the policy used to select the storage pool of a PowerVS volume.
+type PowerVSStorageAffinityPolicy string
+
 const (
 	// IBMPowerVSMachineFinalizer allows IBMPowerVSMachineReconciler to clean up resources associated with IBMPowerVSMachine before
 	// removing it from the apiserver.
@@ -36,6 +43,18 @@ const (
 	PowerVSProcessorTypeShared PowerVSProcessorType = "Shared"
 	// PowerVSProcessorTypeCapped enum property to identify a Capped Power VS processor type.
 	PowerVSProcessorTypeCapped PowerVSProcessorType = "Capped"
+	// PowerVSStorageTierTier0 enum property to identify the tier0 storage tier, with 25 IOPS/GB.
+	PowerVSStorageTierTier0 PowerVSStorageTier = "tier0"
+	// PowerVSStorageTierTier1 enum property to identify the tier1 storage tier, with 10 IOPS/GB.
+	PowerVSStorageTierTier1 PowerVSStorageTier = "tier1"
+	// PowerVSStorageTierTier3 enum property to identify the tier3 storage tier, with 3 IOPS/GB.
+	PowerVSStorageTierTier3 PowerVSStorageTier = "tier3"
+	// PowerVSStorageTierFixedIOPS enum property to identify the fixed IOPS storage tier, with 5000 IOPS regardless of the volume size.
+	PowerVSStorageTierFixedIOPS PowerVSStorageTier = "tier5k"
+	// PowerVSStorageAffinityPolicyAffinity enum property to place a volume in the same storage pool as the referenced volume or instance.
+	PowerVSStorageAffinityPolicyAffinity PowerVSStorageAffinityPolicy = "Affinity"
+	// PowerVSStorageAffinityPolicyAntiAffinity enum property to place a volume in a different storage pool than the referenced volumes or instances.
+	PowerVSStorageAffinityPolicyAntiAffinity PowerVSStorageAffinityPolicy = "AntiAffinity"
 	// DefaultIgnitionVersion represents default Ignition version generated for machine userdata.
 	DefaultIgnitionVersion = "2.3"
 )
@@ -112,15 +131,40 @@ type IBMPowerVSMachineSpec struct {
 	// +optional
 	MemoryGiB int32 `json:"memoryGiB,omitempty"`
 
+	// bootVolume is the storage configuration of the boot volume of the instance.
+	// When omitted, the boot volume is created in the default storage tier of the workspace.
+	// bootVolume is immutable, as the boot volume of an existing instance is not moved to another storage tier or pool.
+	// +optional
+	BootVolume *PowerVSBootVolume `json:"bootVolume,omitempty"`
+
 	// network is the reference to the Network to use for this instance.
 	// supported network identifier in IBMPowerVSResourceReference are Name, ID and RegEx and that can be obtained from IBM Cloud UI or IBM Cloud cli.
 	Network IBMPowerVSResourceReference `json:"network"`
 
+	// dhcpNetwork is the name of the network of IBMPowerVSCluster.spec.dhcpNetworks to use for this instance.
+	// network must not be set along with dhcpNetwork.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=32
+	// +optional
+	DHCPNetwork string `json:"dhcpNetwork,omitempty"`
+
+	// ipAddressPool is the reference to the IPAM pool the address of the instance is allocated from.
+	// when set, an IPAddressClaim is created against the pool and the allocated address is set as the static address of the instance
+	// on its network, so the instance does not depend on a DHCP server for its address.
+	// +optional
+	IPAddressPool *ipamv1.IPPoolReference `json:"ipAddressPool,omitempty"`
+
 	// providerID is the unique identifier as specified by the cloud provider.
 	// +optional
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=512
 	ProviderID string `json:"providerID,omitempty"`
+
+	// tags are user tags, attached in the form key:value to the instance and its volumes,
+	// in addition to the tags of the IBMPowerVSCluster. a tag overrides the cluster tag with the same key.
+	// the key capibm-cluster-uid is reserved for the tag with the UID of the cluster, attached to the resources along with the user tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // IBMPowerVSMachineStatus defines the observed state of IBMPowerVSMachine.
@@ -209,6 +253,14 @@ type IBMPowerVSMachineStatus struct {
 	// zone specifies the Power VS Service instance zone.
 	Zone *string `json:"zone,omitempty"`
 
+	// tags is the status of the user tags attached to the instance and its volumes.
+	// +optional
+	Tags *TagsStatus `json:"tags,omitempty"`
+
+	// bootVolume is the storage tier and storage pool the boot volume of the instance is created in.
+	// +optional
+	BootVolume *PowerVSBootVolumeStatus `json:"bootVolume,omitempty"`
+
 	// deprecated groups all the status fields that are deprecated and will be removed when all the nested field are removed.
 	// +optional
 	Deprecated *IBMPowerVSMachineDeprecatedStatus `json:"deprecated,omitempty"`
@@ -293,6 +345,66 @@ type ImageReference struct {
 	Name string `json:"name,omitempty"`
 }
 
+// PowerVSBootVolume defines the storage configuration of the boot volume of a PowerVS instance.
+type PowerVSBootVolume struct {
+	// storageTier is the storage tier of the boot volume.
+	// It must be set to one of the following values: tier0, tier1, tier3 or tier5k.
+	// tier5k is the fixed IOPS tier, which provides 5000 IOPS regardless of the size of the volume.
+	// The storage tier must be available in the zone of the workspace.
+	// When omitted, the storage tier is selected from the storage pool, or is the default storage tier of the workspace.
+	// +kubebuilder:validation:Enum=tier0;tier1;tier3;tier5k
+	// +optional
+	StorageTier PowerVSStorageTier `json:"storageTier,omitempty"`
+
+	// storagePool is the name of the storage pool the boot volume is created in.
+	// storagePool must not be set along with storageAffinity.
+	// The storage pool must be available in the workspace.
+	// When omitted, the storage pool with the most available space is selected.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	StoragePool string `json:"storagePool,omitempty"`
+
+	// storageAffinity selects the storage pool of the boot volume based on the storage pool of existing volumes or instances.
+	// storageAffinity must not be set along with storagePool.
+	// +optional
+	StorageAffinity *PowerVSStorageAffinity `json:"storageAffinity,omitempty"`
+}
+
+// PowerVSStorageAffinity defines the affinity policy used to select the storage pool of a PowerVS volume.
+type PowerVSStorageAffinity struct {
+	// policy is the affinity policy used to select the storage pool.
+	// It must be set to one of the following values: Affinity or AntiAffinity.
+	// Affinity: the volume is created in the same storage pool as the single volume or instance in volumes or instances.
+	// AntiAffinity: the volume is created in a different storage pool than the volumes and instances in volumes and instances.
+	// +kubebuilder:validation:Enum=Affinity;AntiAffinity
+	// +required
+	Policy PowerVSStorageAffinityPolicy `json:"policy"`
+
+	// volumes are the IDs or names of the volumes the affinity policy is based on.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	// +optional
+	Volumes []string `json:"volumes,omitempty"`
+
+	// instances are the IDs or names of the instances the affinity policy is based on.
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	// +optional
+	Instances []string `json:"instances,omitempty"`
+}
+
+// PowerVSBootVolumeStatus defines the observed storage of the boot volume of a PowerVS instance.
+type PowerVSBootVolumeStatus struct {
+	// storageTier is the storage tier of the boot volume.
+	// +optional
+	StorageTier PowerVSStorageTier `json:"storageTier,omitempty"`
+
+	// storagePool is the name of the storage pool of the boot volume.
+	// +optional
+	StoragePool string `json:"storagePool,omitempty"`
+}
+
 // GetConditions returns the observations of the operational state of the IBMPowerVSMachine resource.
 func (r *IBMPowerVSMachine) GetConditions() []metav1.Condition {
 	return r.Status.Conditions
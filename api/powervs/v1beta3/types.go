@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1beta3
 
-import "github.com/IBM/vpc-go-sdk/vpcv1"
+import (
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
 
 const (
 	// CIDRBlockAny is the CIDRBlock representing any allowable destination/source IP.
@@ -86,6 +90,18 @@ var (
 	ServiceInstanceStateRemoved = ServiceInstanceState("removed")
 )
 
+// MissingResourcePolicy describes how the resources referenced in status that no longer exist are handled.
+// +kubebuilder:validation:Enum=Report;Recreate
+type MissingResourcePolicy string
+
+var (
+	// MissingResourcePolicyReport is the string representing the missing resources being reported.
+	MissingResourcePolicyReport = MissingResourcePolicy("Report")
+
+	// MissingResourcePolicyRecreate is the string representing the missing resources created by the controller being created again.
+	MissingResourcePolicyRecreate = MissingResourcePolicy("Recreate")
+)
+
 // TransitGatewayState describes the state of an IBM Transit Gateway.
 type TransitGatewayState string
 
@@ -120,6 +136,50 @@ var (
 	TransitGatewayConnectionStateDeleting = TransitGatewayConnectionState("deleting")
 )
 
+// TransitGatewayConnectionNetworkType describes the type of network connected to a transit gateway.
+// +kubebuilder:validation:Enum=classic;directlink;vpc;power_virtual_server
+type TransitGatewayConnectionNetworkType string
+
+var (
+	// TransitGatewayConnectionNetworkTypeClassic is the string representing a connection to the classic infrastructure.
+	TransitGatewayConnectionNetworkTypeClassic = TransitGatewayConnectionNetworkType("classic")
+
+	// TransitGatewayConnectionNetworkTypeDirectLink is the string representing a connection to a Direct Link gateway.
+	TransitGatewayConnectionNetworkTypeDirectLink = TransitGatewayConnectionNetworkType("directlink")
+
+	// TransitGatewayConnectionNetworkTypeVPC is the string representing a connection to a VPC.
+	TransitGatewayConnectionNetworkTypeVPC = TransitGatewayConnectionNetworkType("vpc")
+
+	// TransitGatewayConnectionNetworkTypePowerVS is the string representing a connection to a PowerVS workspace.
+	TransitGatewayConnectionNetworkTypePowerVS = TransitGatewayConnectionNetworkType("power_virtual_server")
+)
+
+// TransitGatewayPrefixFilterAction describes whether the routes matching a prefix filter are permitted or denied.
+// +kubebuilder:validation:Enum=permit;deny
+type TransitGatewayPrefixFilterAction string
+
+var (
+	// TransitGatewayPrefixFilterActionPermit is the string representing a prefix filter permitting the routes.
+	TransitGatewayPrefixFilterActionPermit = TransitGatewayPrefixFilterAction("permit")
+
+	// TransitGatewayPrefixFilterActionDeny is the string representing a prefix filter denying the routes.
+	TransitGatewayPrefixFilterActionDeny = TransitGatewayPrefixFilterAction("deny")
+)
+
+// TransitGatewayRouteReportState describes the state of an IBM Transit Gateway route report.
+type TransitGatewayRouteReportState string
+
+var (
+	// TransitGatewayRouteReportStatePending is the string representing a route report which is being generated.
+	TransitGatewayRouteReportStatePending = TransitGatewayRouteReportState("pending")
+
+	// TransitGatewayRouteReportStateComplete is the string representing a route report which is generated.
+	TransitGatewayRouteReportStateComplete = TransitGatewayRouteReportState("complete")
+
+	// TransitGatewayRouteReportStateFailed is the string representing a route report which failed to generate.
+	TransitGatewayRouteReportStateFailed = TransitGatewayRouteReportState("failed")
+)
+
 // VPCLoadBalancerBackendPoolAlgorithm describes the backend pool's load balancing algorithm.
 // +kubebuilder:validation:Enum=least_connections;round_robin;weighted_round_robin
 type VPCLoadBalancerBackendPoolAlgorithm string
@@ -135,6 +195,18 @@ var (
 	VPCLoadBalancerBackendPoolAlgorithmWeightedRoundRobin VPCLoadBalancerBackendPoolAlgorithm = vpcv1.CreateLoadBalancerPoolOptionsAlgorithmWeightedRoundRobinConst
 )
 
+// VPCLoadBalancerProfile describes the profile of a VPC load balancer.
+// +kubebuilder:validation:Enum=application;network-fixed
+type VPCLoadBalancerProfile string
+
+var (
+	// VPCLoadBalancerProfileApplication is the string representing an application load balancer.
+	VPCLoadBalancerProfileApplication = VPCLoadBalancerProfile("application")
+
+	// VPCLoadBalancerProfileNetworkFixed is the string representing a network load balancer with the fixed network profile.
+	VPCLoadBalancerProfileNetworkFixed = VPCLoadBalancerProfile("network-fixed")
+)
+
 // VPCLoadBalancerBackendPoolProtocol describes the protocol for load balancer backend pools.
 // We have unique types in case IBM Cloud Load Balancer Listener and Backend Pool supported algorithms ever diverage.
 // +kubebuilder:validation:Enum=http;https;tcp;udp
@@ -564,3 +636,73 @@ type IBMPowerVSResourceReference struct {
 	// +optional
 	RegEx *string `json:"regex,omitempty"`
 }
+
+// TagsStatus describes the user tags attached to a set of IBM Cloud resources.
+type TagsStatus struct {
+	// applied are the user tags, in the form key:value, last attached to the resources, including the cluster UID tag.
+	// +optional
+	Applied []string `json:"applied,omitempty"`
+
+	// resources are the IDs of the resources the tags were last attached to.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+}
+
+// ZoneCapabilitiesStatus describes the capabilities of the PowerVS zone of the workspace.
+type ZoneCapabilitiesStatus struct {
+	// systemTypes are the system types available in the zone.
+	// the PowerVS datacenter capabilities do not tell which processor types a system type supports,
+	// hence only the system type of a machine is validated against the zone, not its processor type.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	SystemTypes []SystemTypeCapabilities `json:"systemTypes,omitempty"`
+
+	// storageTiers are the storage tiers available in the workspace.
+	// +listType=set
+	// +optional
+	StorageTiers []string `json:"storageTiers,omitempty"`
+
+	// storagePools are the storage pools available in the workspace.
+	// +listType=set
+	// +optional
+	StoragePools []string `json:"storagePools,omitempty"`
+}
+
+// SystemTypeCapabilities describes a system type available in the PowerVS zone.
+type SystemTypeCapabilities struct {
+	// name is the system type, such as s922 or e1080.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
+
+// WorkspaceQuotaStatus describes the used and available capacity of a PowerVS workspace.
+type WorkspaceQuotaStatus struct {
+	// used is the capacity used by all the instances and volumes of the workspace, including the ones of other clusters sharing the workspace.
+	// +optional
+	Used WorkspaceCapacity `json:"used,omitempty,omitzero"`
+
+	// available is the capacity left in the workspace before its quota is exceeded.
+	// +optional
+	Available WorkspaceCapacity `json:"available,omitempty,omitzero"`
+}
+
+// WorkspaceCapacity describes an amount of PowerVS workspace capacity.
+type WorkspaceCapacity struct {
+	// instances is the number of instances.
+	// +optional
+	Instances *int64 `json:"instances,omitempty"`
+
+	// processors is the number of processors.
+	// +optional
+	Processors *resource.Quantity `json:"processors,omitempty"`
+
+	// memory is the amount of memory.
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+
+	// storage is the amount of storage.
+	// +optional
+	Storage *resource.Quantity `json:"storage,omitempty"`
+}
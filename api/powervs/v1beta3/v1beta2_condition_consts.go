@@ -106,6 +106,18 @@ const (
 	// InstanceWaitingForImageV1Beta2Reason surfaces when the instance that is controlled
 	// by the IBMPowerVSMachine waiting for the Power VS image to be available in workspace.
 	InstanceWaitingForImageV1Beta2Reason = "WaitingForIBMImage"
+
+	// InstanceWaitingForIPAddressV1Beta2Reason surfaces when the instance that is controlled
+	// by the IBMPowerVSMachine waiting for the IP address to be allocated from the IPAM pool.
+	InstanceWaitingForIPAddressV1Beta2Reason = "WaitingForIPAddress"
+
+	// InstanceWaitingForWorkspaceQuotaV1Beta2Reason surfaces when the instance that is controlled
+	// by the IBMPowerVSMachine waiting for capacity in the workspace, as creating it would exceed the workspace quota.
+	InstanceWaitingForWorkspaceQuotaV1Beta2Reason = "WaitingForWorkspaceQuota"
+
+	// InstanceUnsupportedByZoneV1Beta2Reason surfaces when the instance that is controlled
+	// by the IBMPowerVSMachine requests a system type, storage tier or storage pool not available in the PowerVS zone.
+	InstanceUnsupportedByZoneV1Beta2Reason = "UnsupportedByZone"
 )
 
 // PowerVS Image related conditions and corresponding reasons.
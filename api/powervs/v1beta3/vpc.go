@@ -39,6 +39,13 @@ type VPCLoadBalancerSpec struct {
 	// +optional
 	Public *bool `json:"public,omitempty"`
 
+	// profile defines the load balancer profile.
+	// application creates an application load balancer. network-fixed is rejected for a PowerVS cluster,
+	// as the PowerVS instances are added to the load balancer pools as IP targets, which a network load balancer does not support.
+	// Defaults to application if not specified.
+	// +optional
+	Profile VPCLoadBalancerProfile `json:"profile,omitempty"`
+
 	// additionalListeners sets the additional listeners for the control plane load balancer.
 	// +listType=map
 	// +listMapKey=port
@@ -46,6 +53,10 @@ type VPCLoadBalancerSpec struct {
 	// ++kubebuilder:validation:UniqueItems=true
 	AdditionalListeners []AdditionalListenerSpec `json:"additionalListeners,omitempty"`
 
+	// pruneListeners deletes the listeners created for additionalListeners, along with their pools, once they are removed from the spec.
+	// +optional
+	PruneListeners *bool `json:"pruneListeners,omitempty"`
+
 	// backendPools defines the load balancer's backend pools.
 	// +optional
 	BackendPools []VPCLoadBalancerBackendPoolSpec `json:"backendPools,omitempty"`
@@ -81,11 +92,47 @@ type AdditionalListenerSpec struct {
 	// +optional
 	Protocol *VPCLoadBalancerListenerProtocol `json:"protocol,omitempty"`
 
+	// certificateCRN defines the CRN of the IBM Cloud Secrets Manager certificate used to terminate TLS on the listener.
+	// Required when the protocol is https. Updating the CRN rotates the certificate of the listener.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CertificateCRN *string `json:"certificateCRN,omitempty"`
+
+	// httpsRedirect redirects the HTTP traffic received on the listener to an HTTPS listener of the same load balancer.
+	// Only supported when the protocol is http.
+	// +optional
+	HTTPSRedirect *VPCLoadBalancerListenerHTTPSRedirect `json:"httpsRedirect,omitempty"`
+
+	// idleConnectionTimeout defines the idle connection timeout of the listener in seconds.
+	// +kubebuilder:validation:Minimum=50
+	// +kubebuilder:validation:Maximum=7200
+	// +optional
+	IdleConnectionTimeout *int64 `json:"idleConnectionTimeout,omitempty"`
+
 	// selector is used to find IBMPowerVSMachines with matching labels.
 	// If the label matches, the machine is then added to the load balancer listener configuration.
 	Selector metav1.LabelSelector `json:"selector,omitempty"`
 }
 
+// VPCLoadBalancerListenerHTTPSRedirect defines the redirection of HTTP traffic to an HTTPS listener.
+type VPCLoadBalancerListenerHTTPSRedirect struct {
+	// listenerPort is the port of the HTTPS listener to redirect the traffic to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +required
+	ListenerPort int64 `json:"listenerPort"`
+
+	// httpStatusCode is the HTTP status code returned for the redirect.
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	// +kubebuilder:default=301
+	// +optional
+	HTTPStatusCode *int64 `json:"httpStatusCode,omitempty"`
+
+	// uri is the redirect relative target URI.
+	// +optional
+	URI *string `json:"uri,omitempty"`
+}
+
 // VPCLoadBalancerBackendPoolSpec defines the desired configuration of a VPC Load Balancer Backend Pool.
 type VPCLoadBalancerBackendPoolSpec struct {
 	// name defines the name of the Backend Pool.
@@ -169,4 +216,32 @@ type VPCLoadBalancerStatus struct {
 	// controllerCreated indicates whether the resource is created by the controller.
 	// +kubebuilder:default=false
 	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+	// listeners are the load balancer listeners reconciled from the additional listeners.
+	// +listType=map
+	// +listMapKey=port
+	// +optional
+	Listeners []VPCLoadBalancerListenerStatus `json:"listeners,omitempty"`
+	// pools are the load balancer pools reconciled from the spec.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Pools []VPCLoadBalancerPoolStatus `json:"pools,omitempty"`
+}
+
+// VPCLoadBalancerListenerStatus defines the status of a VPC load balancer listener.
+type VPCLoadBalancerListenerStatus struct {
+	// port of the listener.
+	Port int64 `json:"port"`
+	// id of the listener.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// VPCLoadBalancerPoolStatus defines the status of a VPC load balancer pool.
+type VPCLoadBalancerPoolStatus struct {
+	// name of the pool.
+	Name string `json:"name"`
+	// id of the pool.
+	// +optional
+	ID *string `json:"id,omitempty"`
 }
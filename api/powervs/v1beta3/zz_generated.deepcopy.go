@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1beta2 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -55,6 +56,21 @@ func (in *AdditionalListenerSpec) DeepCopyInto(out *AdditionalListenerSpec) {
 		*out = new(VPCLoadBalancerListenerProtocol)
 		**out = **in
 	}
+	if in.CertificateCRN != nil {
+		in, out := &in.CertificateCRN, &out.CertificateCRN
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPSRedirect != nil {
+		in, out := &in.HTTPSRedirect, &out.HTTPSRedirect
+		*out = new(VPCLoadBalancerListenerHTTPSRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleConnectionTimeout != nil {
+		in, out := &in.IdleConnectionTimeout, &out.IdleConnectionTimeout
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 }
 
@@ -83,6 +99,51 @@ func (in *CosInstance) DeepCopy() *CosInstance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPNetwork) DeepCopyInto(out *DHCPNetwork) {
+	*out = *in
+	if in.DHCPServer != nil {
+		in, out := &in.DHCPServer, &out.DHCPServer
+		*out = new(DHCPServer)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPNetwork.
+func (in *DHCPNetwork) DeepCopy() *DHCPNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPNetworkStatus) DeepCopyInto(out *DHCPNetworkStatus) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DHCPServer != nil {
+		in, out := &in.DHCPServer, &out.DHCPServer
+		*out = new(ResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPNetworkStatus.
+func (in *DHCPNetworkStatus) DeepCopy() *DHCPNetworkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DHCPNetworkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DHCPServer) DeepCopyInto(out *DHCPServer) {
 	*out = *in
@@ -123,6 +184,66 @@ func (in *DHCPServer) DeepCopy() *DHCPServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordSpec.
+func (in *DNSRecordSpec) DeepCopy() *DNSRecordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordStatus) DeepCopyInto(out *DNSRecordStatus) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatus.
+func (in *DNSRecordStatus) DeepCopy() *DNSRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSCluster) DeepCopyInto(out *IBMPowerVSCluster) {
 	*out = *in
@@ -232,6 +353,13 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 		*out = new(DHCPServer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DHCPNetworks != nil {
+		in, out := &in.DHCPNetworks, &out.DHCPNetworks
+		*out = make([]DHCPNetwork, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ServiceInstance != nil {
 		in, out := &in.ServiceInstance, &out.ServiceInstance
 		*out = new(IBMPowerVSResourceReference)
@@ -266,6 +394,11 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VPCEndpointGateways != nil {
+		in, out := &in.VPCEndpointGateways, &out.VPCEndpointGateways
+		*out = make([]VPCEndpointGateway, len(*in))
+		copy(*out, *in)
+	}
 	if in.TransitGateway != nil {
 		in, out := &in.TransitGateway, &out.TransitGateway
 		*out = new(TransitGateway)
@@ -288,6 +421,28 @@ func (in *IBMPowerVSClusterSpec) DeepCopyInto(out *IBMPowerVSClusterSpec) {
 		*out = new(Ignition)
 		**out = **in
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSRecordSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceNameTemplate != nil {
+		in, out := &in.ResourceNameTemplate, &out.ResourceNameTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceVerification != nil {
+		in, out := &in.ResourceVerification, &out.ResourceVerification
+		*out = new(ResourceVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSClusterSpec.
@@ -331,6 +486,13 @@ func (in *IBMPowerVSClusterStatus) DeepCopyInto(out *IBMPowerVSClusterStatus) {
 		*out = new(ResourceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DHCPNetworks != nil {
+		in, out := &in.DHCPNetworks, &out.DHCPNetworks
+		*out = make(map[string]DHCPNetworkStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.VPC != nil {
 		in, out := &in.VPC, &out.VPC
 		*out = new(ResourceReference)
@@ -350,6 +512,13 @@ func (in *IBMPowerVSClusterStatus) DeepCopyInto(out *IBMPowerVSClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.VPCEndpointGateways != nil {
+		in, out := &in.VPCEndpointGateways, &out.VPCEndpointGateways
+		*out = make(map[string]VPCEndpointGatewayStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	if in.TransitGateway != nil {
 		in, out := &in.TransitGateway, &out.TransitGateway
 		*out = new(TransitGatewayStatus)
@@ -367,6 +536,30 @@ func (in *IBMPowerVSClusterStatus) DeepCopyInto(out *IBMPowerVSClusterStatus) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSRecordStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(TagsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkspaceQuota != nil {
+		in, out := &in.WorkspaceQuota, &out.WorkspaceQuota
+		*out = new(WorkspaceQuotaStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneCapabilities != nil {
+		in, out := &in.ZoneCapabilities, &out.ZoneCapabilities
+		*out = new(ZoneCapabilitiesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastResourceVerificationTime != nil {
+		in, out := &in.LastResourceVerificationTime, &out.LastResourceVerificationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(IBMPowerVSClusterDeprecatedStatus)
@@ -524,6 +717,21 @@ func (in *IBMPowerVSImage) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageChecksum) DeepCopyInto(out *IBMPowerVSImageChecksum) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageChecksum.
+func (in *IBMPowerVSImageChecksum) DeepCopy() *IBMPowerVSImageChecksum {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageChecksum)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSImageDeprecatedStatus) DeepCopyInto(out *IBMPowerVSImageDeprecatedStatus) {
 	*out = *in
@@ -544,6 +752,52 @@ func (in *IBMPowerVSImageDeprecatedStatus) DeepCopy() *IBMPowerVSImageDeprecated
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageImportAttempt) DeepCopyInto(out *IBMPowerVSImageImportAttempt) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageImportAttempt.
+func (in *IBMPowerVSImageImportAttempt) DeepCopy() *IBMPowerVSImageImportAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageImportAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageImportStatus) DeepCopyInto(out *IBMPowerVSImageImportStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageImportStatus.
+func (in *IBMPowerVSImageImportStatus) DeepCopy() *IBMPowerVSImageImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSImageList) DeepCopyInto(out *IBMPowerVSImageList) {
 	*out = *in
@@ -576,6 +830,81 @@ func (in *IBMPowerVSImageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImagePolling) DeepCopyInto(out *IBMPowerVSImagePolling) {
+	*out = *in
+	if in.JobIntervalSeconds != nil {
+		in, out := &in.JobIntervalSeconds, &out.JobIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ImageIntervalSeconds != nil {
+		in, out := &in.ImageIntervalSeconds, &out.ImageIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImagePolling.
+func (in *IBMPowerVSImagePolling) DeepCopy() *IBMPowerVSImagePolling {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImagePolling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageRetention) DeepCopyInto(out *IBMPowerVSImageRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageRetention.
+func (in *IBMPowerVSImageRetention) DeepCopy() *IBMPowerVSImageRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageRetryPolicy) DeepCopyInto(out *IBMPowerVSImageRetryPolicy) {
+	*out = *in
+	if in.BackoffSeconds != nil {
+		in, out := &in.BackoffSeconds, &out.BackoffSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageRetryPolicy.
+func (in *IBMPowerVSImageRetryPolicy) DeepCopy() *IBMPowerVSImageRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageSource) DeepCopyInto(out *IBMPowerVSImageSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageSource.
+func (in *IBMPowerVSImageSource) DeepCopy() *IBMPowerVSImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMPowerVSImageSpec) DeepCopyInto(out *IBMPowerVSImageSpec) {
 	*out = *in
@@ -599,6 +928,39 @@ func (in *IBMPowerVSImageSpec) DeepCopyInto(out *IBMPowerVSImageSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExpectedChecksum != nil {
+		in, out := &in.ExpectedChecksum, &out.ExpectedChecksum
+		*out = new(IBMPowerVSImageChecksum)
+		**out = **in
+	}
+	if in.StockImage != nil {
+		in, out := &in.StockImage, &out.StockImage
+		*out = new(IBMPowerVSResourceReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]IBMPowerVSImageTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(IBMPowerVSImageRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Polling.DeepCopyInto(&out.Polling)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(IBMPowerVSImageRetention)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageSpec.
@@ -621,6 +983,35 @@ func (in *IBMPowerVSImageStatus) DeepCopyInto(out *IBMPowerVSImageStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]IBMPowerVSImageTargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(IBMPowerVSImageSource)
+		**out = **in
+	}
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(IBMPowerVSImageImportStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]IBMPowerVSImageImportAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(IBMPowerVSImageUsage)
+		**out = **in
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(IBMPowerVSImageDeprecatedStatus)
@@ -639,17 +1030,75 @@ func (in *IBMPowerVSImageStatus) DeepCopy() *IBMPowerVSImageStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IBMPowerVSImageV1Beta2DeprecatedStatus) DeepCopyInto(out *IBMPowerVSImageV1Beta2DeprecatedStatus) {
+func (in *IBMPowerVSImageTarget) DeepCopyInto(out *IBMPowerVSImageTarget) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make(v1beta2.Conditions, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-}
-
+	in.ServiceInstance.DeepCopyInto(&out.ServiceInstance)
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageTarget.
+func (in *IBMPowerVSImageTarget) DeepCopy() *IBMPowerVSImageTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageTargetStatus) DeepCopyInto(out *IBMPowerVSImageTargetStatus) {
+	*out = *in
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]IBMPowerVSImageImportAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageTargetStatus.
+func (in *IBMPowerVSImageTargetStatus) DeepCopy() *IBMPowerVSImageTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageUsage) DeepCopyInto(out *IBMPowerVSImageUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageUsage.
+func (in *IBMPowerVSImageUsage) DeepCopy() *IBMPowerVSImageUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMPowerVSImageUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMPowerVSImageV1Beta2DeprecatedStatus) DeepCopyInto(out *IBMPowerVSImageV1Beta2DeprecatedStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(v1beta2.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSImageV1Beta2DeprecatedStatus.
 func (in *IBMPowerVSImageV1Beta2DeprecatedStatus) DeepCopy() *IBMPowerVSImageV1Beta2DeprecatedStatus {
 	if in == nil {
@@ -774,7 +1223,24 @@ func (in *IBMPowerVSMachineSpec) DeepCopyInto(out *IBMPowerVSMachineSpec) {
 	}
 	out.ImageRef = in.ImageRef
 	out.Processors = in.Processors
+	if in.BootVolume != nil {
+		in, out := &in.BootVolume, &out.BootVolume
+		*out = new(PowerVSBootVolume)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Network.DeepCopyInto(&out.Network)
+	if in.IPAddressPool != nil {
+		in, out := &in.IPAddressPool, &out.IPAddressPool
+		*out = new(ipamv1beta2.IPPoolReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMPowerVSMachineSpec.
@@ -823,6 +1289,16 @@ func (in *IBMPowerVSMachineStatus) DeepCopyInto(out *IBMPowerVSMachineStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(TagsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootVolume != nil {
+		in, out := &in.BootVolume, &out.BootVolume
+		*out = new(PowerVSBootVolumeStatus)
+		**out = **in
+	}
 	if in.Deprecated != nil {
 		in, out := &in.Deprecated, &out.Deprecated
 		*out = new(IBMPowerVSMachineDeprecatedStatus)
@@ -1036,6 +1512,66 @@ func (in *ImageReference) DeepCopy() *ImageReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSBootVolume) DeepCopyInto(out *PowerVSBootVolume) {
+	*out = *in
+	if in.StorageAffinity != nil {
+		in, out := &in.StorageAffinity, &out.StorageAffinity
+		*out = new(PowerVSStorageAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSBootVolume.
+func (in *PowerVSBootVolume) DeepCopy() *PowerVSBootVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSBootVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSBootVolumeStatus) DeepCopyInto(out *PowerVSBootVolumeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSBootVolumeStatus.
+func (in *PowerVSBootVolumeStatus) DeepCopy() *PowerVSBootVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSBootVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PowerVSStorageAffinity) DeepCopyInto(out *PowerVSStorageAffinity) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PowerVSStorageAffinity.
+func (in *PowerVSStorageAffinity) DeepCopy() *PowerVSStorageAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(PowerVSStorageAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
 	*out = *in
@@ -1081,6 +1617,26 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceVerification) DeepCopyInto(out *ResourceVerification) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceVerification.
+func (in *ResourceVerification) DeepCopy() *ResourceVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -1116,6 +1672,46 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SystemTypeCapabilities) DeepCopyInto(out *SystemTypeCapabilities) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemTypeCapabilities.
+func (in *SystemTypeCapabilities) DeepCopy() *SystemTypeCapabilities {
+	if in == nil {
+		return nil
+	}
+	out := new(SystemTypeCapabilities)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagsStatus) DeepCopyInto(out *TagsStatus) {
+	*out = *in
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagsStatus.
+func (in *TagsStatus) DeepCopy() *TagsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TagsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGateway) DeepCopyInto(out *TransitGateway) {
 	*out = *in
@@ -1134,6 +1730,28 @@ func (in *TransitGateway) DeepCopyInto(out *TransitGateway) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]TransitGatewayConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PowerVSConnection != nil {
+		in, out := &in.PowerVSConnection, &out.PowerVSConnection
+		*out = new(TransitGatewayConnectionPrefixFilters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCConnection != nil {
+		in, out := &in.VPCConnection, &out.VPCConnection
+		*out = new(TransitGatewayConnectionPrefixFilters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GenerateRouteReport != nil {
+		in, out := &in.GenerateRouteReport, &out.GenerateRouteReport
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGateway.
@@ -1146,6 +1764,177 @@ func (in *TransitGateway) DeepCopy() *TransitGateway {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayConnection) DeepCopyInto(out *TransitGatewayConnection) {
+	*out = *in
+	if in.NetworkCRN != nil {
+		in, out := &in.NetworkCRN, &out.NetworkCRN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixFilters != nil {
+		in, out := &in.PrefixFilters, &out.PrefixFilters
+		*out = make([]TransitGatewayPrefixFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixFiltersDefault != nil {
+		in, out := &in.PrefixFiltersDefault, &out.PrefixFiltersDefault
+		*out = new(TransitGatewayPrefixFilterAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayConnection.
+func (in *TransitGatewayConnection) DeepCopy() *TransitGatewayConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayConnectionPrefixFilters) DeepCopyInto(out *TransitGatewayConnectionPrefixFilters) {
+	*out = *in
+	if in.PrefixFilters != nil {
+		in, out := &in.PrefixFilters, &out.PrefixFilters
+		*out = make([]TransitGatewayPrefixFilter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PrefixFiltersDefault != nil {
+		in, out := &in.PrefixFiltersDefault, &out.PrefixFiltersDefault
+		*out = new(TransitGatewayPrefixFilterAction)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayConnectionPrefixFilters.
+func (in *TransitGatewayConnectionPrefixFilters) DeepCopy() *TransitGatewayConnectionPrefixFilters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayConnectionPrefixFilters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayConnectionStatus) DeepCopyInto(out *TransitGatewayConnectionStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayConnectionStatus.
+func (in *TransitGatewayConnectionStatus) DeepCopy() *TransitGatewayConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayOverlappingRoutes) DeepCopyInto(out *TransitGatewayOverlappingRoutes) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]TransitGatewayRoute, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayOverlappingRoutes.
+func (in *TransitGatewayOverlappingRoutes) DeepCopy() *TransitGatewayOverlappingRoutes {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayOverlappingRoutes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPrefixFilter) DeepCopyInto(out *TransitGatewayPrefixFilter) {
+	*out = *in
+	if in.Ge != nil {
+		in, out := &in.Ge, &out.Ge
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Le != nil {
+		in, out := &in.Le, &out.Le
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPrefixFilter.
+func (in *TransitGatewayPrefixFilter) DeepCopy() *TransitGatewayPrefixFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPrefixFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRoute) DeepCopyInto(out *TransitGatewayRoute) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRoute.
+func (in *TransitGatewayRoute) DeepCopy() *TransitGatewayRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayRouteReportStatus) DeepCopyInto(out *TransitGatewayRouteReportStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OverlappingRoutes != nil {
+		in, out := &in.OverlappingRoutes, &out.OverlappingRoutes
+		*out = make([]TransitGatewayOverlappingRoutes, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteReportStatus.
+func (in *TransitGatewayRouteReportStatus) DeepCopy() *TransitGatewayRouteReportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayRouteReportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
 	*out = *in
@@ -1169,6 +1958,18 @@ func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
 		*out = new(ResourceReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Connections != nil {
+		in, out := &in.Connections, &out.Connections
+		*out = make([]TransitGatewayConnectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RouteReport != nil {
+		in, out := &in.RouteReport, &out.RouteReport
+		*out = new(TransitGatewayRouteReportStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayStatus.
@@ -1211,6 +2012,51 @@ func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointGateway) DeepCopyInto(out *VPCEndpointGateway) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointGateway.
+func (in *VPCEndpointGateway) DeepCopy() *VPCEndpointGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointGatewayStatus) DeepCopyInto(out *VPCEndpointGatewayStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReservedIPs != nil {
+		in, out := &in.ReservedIPs, &out.ReservedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointGatewayStatus.
+func (in *VPCEndpointGatewayStatus) DeepCopy() *VPCEndpointGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLoadBalancerBackendPoolSpec) DeepCopyInto(out *VPCLoadBalancerBackendPoolSpec) {
 	*out = *in
@@ -1257,6 +2103,71 @@ func (in *VPCLoadBalancerHealthMonitorSpec) DeepCopy() *VPCLoadBalancerHealthMon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerListenerHTTPSRedirect) DeepCopyInto(out *VPCLoadBalancerListenerHTTPSRedirect) {
+	*out = *in
+	if in.HTTPStatusCode != nil {
+		in, out := &in.HTTPStatusCode, &out.HTTPStatusCode
+		*out = new(int64)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerListenerHTTPSRedirect.
+func (in *VPCLoadBalancerListenerHTTPSRedirect) DeepCopy() *VPCLoadBalancerListenerHTTPSRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerListenerHTTPSRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerListenerStatus) DeepCopyInto(out *VPCLoadBalancerListenerStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerListenerStatus.
+func (in *VPCLoadBalancerListenerStatus) DeepCopy() *VPCLoadBalancerListenerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerListenerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerPoolStatus) DeepCopyInto(out *VPCLoadBalancerPoolStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerPoolStatus.
+func (in *VPCLoadBalancerPoolStatus) DeepCopy() *VPCLoadBalancerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLoadBalancerSpec) DeepCopyInto(out *VPCLoadBalancerSpec) {
 	*out = *in
@@ -1277,6 +2188,11 @@ func (in *VPCLoadBalancerSpec) DeepCopyInto(out *VPCLoadBalancerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PruneListeners != nil {
+		in, out := &in.PruneListeners, &out.PruneListeners
+		*out = new(bool)
+		**out = **in
+	}
 	if in.BackendPools != nil {
 		in, out := &in.BackendPools, &out.BackendPools
 		*out = make([]VPCLoadBalancerBackendPoolSpec, len(*in))
@@ -1328,6 +2244,20 @@ func (in *VPCLoadBalancerStatus) DeepCopyInto(out *VPCLoadBalancerStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]VPCLoadBalancerListenerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]VPCLoadBalancerPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerStatus.
@@ -1589,3 +2519,85 @@ func (in *VPCSecurityGroupStatus) DeepCopy() *VPCSecurityGroupStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceCapacity) DeepCopyInto(out *WorkspaceCapacity) {
+	*out = *in
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceCapacity.
+func (in *WorkspaceCapacity) DeepCopy() *WorkspaceCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkspaceQuotaStatus) DeepCopyInto(out *WorkspaceQuotaStatus) {
+	*out = *in
+	in.Used.DeepCopyInto(&out.Used)
+	in.Available.DeepCopyInto(&out.Available)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkspaceQuotaStatus.
+func (in *WorkspaceQuotaStatus) DeepCopy() *WorkspaceQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkspaceQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneCapabilitiesStatus) DeepCopyInto(out *ZoneCapabilitiesStatus) {
+	*out = *in
+	if in.SystemTypes != nil {
+		in, out := &in.SystemTypes, &out.SystemTypes
+		*out = make([]SystemTypeCapabilities, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageTiers != nil {
+		in, out := &in.StorageTiers, &out.StorageTiers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StoragePools != nil {
+		in, out := &in.StoragePools, &out.StoragePools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneCapabilitiesStatus.
+func (in *ZoneCapabilitiesStatus) DeepCopy() *ZoneCapabilitiesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneCapabilitiesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
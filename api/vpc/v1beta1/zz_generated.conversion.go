@@ -148,11 +148,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Subnet_To_v1beta1_Subnet(a.(*v1beta2.Subnet), b.(*Subnet), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*v1beta2.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VPC_To_v1beta2_VPC(a.(*VPC), b.(*v1beta2.VPC), scope)
 	}); err != nil {
@@ -233,6 +228,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.Subnet)(nil), (*Subnet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Subnet_To_v1beta1_Subnet(a.(*v1beta2.Subnet), b.(*Subnet), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.VPCLoadBalancerSpec)(nil), (*VPCLoadBalancerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_VPCLoadBalancerSpec_To_v1beta1_VPCLoadBalancerSpec(a.(*v1beta2.VPCLoadBalancerSpec), b.(*VPCLoadBalancerSpec), scope)
 	}); err != nil {
@@ -355,6 +355,10 @@ func autoConvert_v1beta2_IBMVPCClusterSpec_To_v1beta1_IBMVPCClusterSpec(in *v1be
 	}
 	// WARNING: in.Image requires manual conversion: does not exist in peer-type
 	// WARNING: in.Network requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceNameTemplate requires manual conversion: does not exist in peer-type
+	// WARNING: in.ResourceVerification requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -387,6 +391,9 @@ func autoConvert_v1beta2_IBMVPCClusterStatus_To_v1beta1_IBMVPCClusterStatus(in *
 	// WARNING: in.Network requires manual conversion: does not exist in peer-type
 	out.Ready = in.Ready
 	// WARNING: in.ResourceGroup requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.LastResourceVerificationTime requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_Subnet_To_v1beta1_Subnet(&in.Subnet, &out.Subnet, s); err != nil {
 		return err
 	}
@@ -508,6 +515,7 @@ func autoConvert_v1beta2_IBMVPCMachineSpec_To_v1beta1_IBMVPCMachineSpec(in *v1be
 		return err
 	}
 	// WARNING: in.AdditionalVolumes requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -533,6 +541,8 @@ func autoConvert_v1beta2_IBMVPCMachineStatus_To_v1beta1_IBMVPCMachineStatus(in *
 	// WARNING: in.FailureMessage requires manual conversion: does not exist in peer-type
 	out.InstanceStatus = in.InstanceStatus
 	// WARNING: in.LoadBalancerPoolMembers requires manual conversion: does not exist in peer-type
+	// WARNING: in.Tags requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrimaryReservedIP requires manual conversion: does not exist in peer-type
 	// WARNING: in.V1Beta2 requires manual conversion: does not exist in peer-type
 	return nil
 }
@@ -686,6 +696,7 @@ func Convert_v1beta1_NetworkInterface_To_v1beta2_NetworkInterface(in *NetworkInt
 func autoConvert_v1beta2_NetworkInterface_To_v1beta1_NetworkInterface(in *v1beta2.NetworkInterface, out *NetworkInterface, s conversion.Scope) error {
 	// WARNING: in.SecurityGroups requires manual conversion: does not exist in peer-type
 	out.Subnet = in.Subnet
+	// WARNING: in.IPAddressPool requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -707,14 +718,10 @@ func autoConvert_v1beta2_Subnet_To_v1beta1_Subnet(in *v1beta2.Subnet, out *Subne
 	out.Name = (*string)(unsafe.Pointer(in.Name))
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
+	// WARNING: in.Egress requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Subnet_To_v1beta1_Subnet is an autogenerated conversion function.
-func Convert_v1beta2_Subnet_To_v1beta1_Subnet(in *v1beta2.Subnet, out *Subnet, s conversion.Scope) error {
-	return autoConvert_v1beta2_Subnet_To_v1beta1_Subnet(in, out, s)
-}
-
 func autoConvert_v1beta1_VPC_To_v1beta2_VPC(in *VPC, out *v1beta2.VPC, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -775,7 +782,9 @@ func autoConvert_v1beta2_VPCLoadBalancerSpec_To_v1beta1_VPCLoadBalancerSpec(in *
 	out.Name = in.Name
 	// WARNING: in.ID requires manual conversion: does not exist in peer-type
 	// WARNING: in.Public requires manual conversion: does not exist in peer-type
+	// WARNING: in.Profile requires manual conversion: does not exist in peer-type
 	// WARNING: in.AdditionalListeners requires manual conversion: does not exist in peer-type
+	// WARNING: in.PruneListeners requires manual conversion: does not exist in peer-type
 	// WARNING: in.BackendPools requires manual conversion: does not exist in peer-type
 	// WARNING: in.SecurityGroups requires manual conversion: does not exist in peer-type
 	// WARNING: in.Subnets requires manual conversion: does not exist in peer-type
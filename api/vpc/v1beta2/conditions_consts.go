@@ -112,6 +112,8 @@ const (
 	WaitingForClusterInfrastructureReason = "WaitingForClusterInfrastructure"
 	// WaitingForBootstrapDataReason used when machine is waiting for bootstrap data to be ready before proceeding.
 	WaitingForBootstrapDataReason = "WaitingForBootstrapData"
+	// WaitingForIPAddressReason used when machine is waiting for the IP address to be allocated from the IPAM pool.
+	WaitingForIPAddressReason = "WaitingForIPAddress"
 )
 
 const (
@@ -202,11 +204,37 @@ const (
 	// TransitGatewayReconciliationFailedReason used when an error occurs during transit gateway reconciliation.
 	TransitGatewayReconciliationFailedReason = "TransitGatewayReconciliationFailed"
 
+	// VPCEndpointGatewayReadyCondition reports on the successful reconciliation of the VPC endpoint gateways.
+	VPCEndpointGatewayReadyCondition clusterv1beta1.ConditionType = "VPCEndpointGatewayReady"
+	// VPCEndpointGatewayReconciliationFailedReason used when an error occurs during VPC endpoint gateway reconciliation.
+	VPCEndpointGatewayReconciliationFailedReason = "VPCEndpointGatewayReconciliationFailed"
+
+	// ResourcesVerifiedCondition reports whether the resources referenced in the network status still exist and match spec.
+	ResourcesVerifiedCondition clusterv1beta1.ConditionType = "ResourcesVerified"
+	// ResourcesMissingReason used when one or more resources referenced in the network status no longer exist.
+	ResourcesMissingReason = "ResourcesMissing"
+	// ResourcesRecreatingReason used when missing resources created by the controller are being created again.
+	ResourcesRecreatingReason = "ResourcesRecreating"
+	// ResourcesDriftedReason used when one or more resources referenced in the network status no longer match spec.
+	ResourcesDriftedReason = "ResourcesDrifted"
+	// ResourcesVerificationFailedReason used when an error occurs while verifying the resources referenced in the network status.
+	ResourcesVerificationFailedReason = "VerificationFailed"
+
+	// ResourceNameTemplateRenderedCondition reports whether the resource name template renders the names of the resources created by the controller.
+	ResourceNameTemplateRenderedCondition clusterv1beta1.ConditionType = "ResourceNameTemplateRendered"
+	// ResourceNameTemplateRenderFailedReason used when the resource name template fails to render, no resource is created until it is fixed.
+	ResourceNameTemplateRenderFailedReason = "RenderFailed"
+
 	// LoadBalancerReadyCondition reports on the successful reconciliation of a Power VS network.
 	LoadBalancerReadyCondition clusterv1beta1.ConditionType = "LoadBalancerReady"
 	// LoadBalancerReconciliationFailedReason used when an error occurs during loadbalancer reconciliation.
 	LoadBalancerReconciliationFailedReason = "LoadBalancerReconciliationFailed"
 
+	// DNSRecordReadyCondition reports on the successful reconciliation of the control plane endpoint DNS record.
+	DNSRecordReadyCondition clusterv1beta1.ConditionType = "DNSRecordReady"
+	// DNSRecordReconciliationFailedReason used when an error occurs during DNS record reconciliation.
+	DNSRecordReconciliationFailedReason = "DNSRecordReconciliationFailed"
+
 	// COSInstanceReadyCondition reports on the successful reconciliation of a COS instance.
 	COSInstanceReadyCondition clusterv1beta1.ConditionType = "COSInstanceCreated"
 	// COSInstanceReconciliationFailedReason used when an error occurs during COS instance reconciliation.
@@ -326,6 +354,45 @@ const (
 	// TransitGatewayDeletingV1Beta2Reason surfaces when the transit gateway is being deleted.
 	TransitGatewayDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
 
+	// VPCEndpointGatewayReadyV1Beta2Condition reports on the successful reconciliation of the VPC endpoint gateways.
+	VPCEndpointGatewayReadyV1Beta2Condition = "VPCEndpointGatewayReady"
+
+	// VPCEndpointGatewayReadyV1Beta2Reason surfaces when the VPC endpoint gateways are ready.
+	VPCEndpointGatewayReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// VPCEndpointGatewayNotReadyV1Beta2Reason surfaces when the VPC endpoint gateways are not ready.
+	VPCEndpointGatewayNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// VPCEndpointGatewayDeletingV1Beta2Reason surfaces when the VPC endpoint gateways are being deleted.
+	VPCEndpointGatewayDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
+	// ResourcesVerifiedV1Beta2Condition reports whether the resources referenced in the network status still exist and match spec.
+	ResourcesVerifiedV1Beta2Condition = "ResourcesVerified"
+
+	// ResourcesVerifiedV1Beta2Reason surfaces when all the resources referenced in the network status exist and match spec.
+	ResourcesVerifiedV1Beta2Reason = "Verified"
+
+	// ResourcesMissingV1Beta2Reason surfaces when one or more resources referenced in the network status no longer exist.
+	ResourcesMissingV1Beta2Reason = "ResourcesMissing"
+
+	// ResourcesRecreatingV1Beta2Reason surfaces when missing resources created by the controller are being created again.
+	ResourcesRecreatingV1Beta2Reason = "ResourcesRecreating"
+
+	// ResourcesDriftedV1Beta2Reason surfaces when one or more resources referenced in the network status no longer match spec.
+	ResourcesDriftedV1Beta2Reason = "ResourcesDrifted"
+
+	// ResourcesVerificationFailedV1Beta2Reason surfaces when an error occurs while verifying the resources referenced in the network status.
+	ResourcesVerificationFailedV1Beta2Reason = "VerificationFailed"
+
+	// ResourceNameTemplateRenderedV1Beta2Condition reports whether the resource name template renders the names of the resources created by the controller.
+	ResourceNameTemplateRenderedV1Beta2Condition = "ResourceNameTemplateRendered"
+
+	// ResourceNameTemplateRenderedV1Beta2Reason surfaces when the resource name template renders the names of all the resources.
+	ResourceNameTemplateRenderedV1Beta2Reason = "Rendered"
+
+	// ResourceNameTemplateRenderFailedV1Beta2Reason surfaces when the resource name template fails to render, no resource is created until it is fixed.
+	ResourceNameTemplateRenderFailedV1Beta2Reason = "RenderFailed"
+
 	// VPCLoadBalancerReadyV1Beta2Condition reports on the successful reconciliation of a VPC LoadBalancer.
 	VPCLoadBalancerReadyV1Beta2Condition = "LoadBalancerReady"
 
@@ -347,6 +414,18 @@ const (
 	// VPCImageNotReadyV1Beta2Reason surfaces when the VPC custom image is not ready.
 	VPCImageNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
 
+	// DNSRecordReadyV1Beta2Condition reports on the successful reconciliation of the control plane endpoint DNS record.
+	DNSRecordReadyV1Beta2Condition = "DNSRecordReady"
+
+	// DNSRecordReadyV1Beta2Reason surfaces when the DNS record is ready.
+	DNSRecordReadyV1Beta2Reason = clusterv1beta1.ReadyV1Beta2Reason
+
+	// DNSRecordNotReadyV1Beta2Reason surfaces when the DNS record is not ready.
+	DNSRecordNotReadyV1Beta2Reason = clusterv1beta1.NotReadyV1Beta2Reason
+
+	// DNSRecordDeletingV1Beta2Reason surfaces when the DNS record is being deleted.
+	DNSRecordDeletingV1Beta2Reason = clusterv1beta1.DeletingV1Beta2Reason
+
 	// COSInstanceReadyV1Beta2Condition reports on the successful reconciliation of a COS instance.
 	COSInstanceReadyV1Beta2Condition = "COSInstanceReady"
 
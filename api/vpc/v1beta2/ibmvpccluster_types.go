@@ -63,6 +63,88 @@ type IBMVPCClusterSpec struct {
 	// network represents the VPC network to use for the cluster.
 	// +optional
 	Network *VPCNetworkSpec `json:"network,omitempty"`
+
+	// dns represents an IBM Cloud DNS Services record to create for the control plane endpoint.
+	// When specified, a record is created in the zone pointing to the Load Balancer hostname and the control plane endpoint uses the record's fully qualified name.
+	// A record that already exists is updated to point to the Load Balancer, but is not deleted with the cluster.
+	// Only supported along with network, for extended VPC Infrastructure support.
+	// +optional
+	DNS *DNSRecordSpec `json:"dns,omitempty"`
+
+	// resourceNameTemplate is a Go template used to generate the names of the resources created by the controller,
+	// when no name is set for the resource in the network.
+	// The template is rendered with .ClusterName, .Namespace, .ResourceType and .Zone, and the lower function is available,
+	// e.g. "fin-dev-{{ .ClusterName }}-{{ lower .ResourceType }}".
+	// The zone is appended to the names of the resources created in each zone when the template does not reference .Zone.
+	// The template must reference .ResourceType to keep the names of the resources unique.
+	// No resource is created while the template fails to render, which is reported in the ResourceNameTemplateRendered condition.
+	// resourceNameTemplate cannot be changed once set, and is only supported along with network.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +optional
+	ResourceNameTemplate *string `json:"resourceNameTemplate,omitempty"`
+
+	// resourceVerification configures the periodic verification of the resources referenced in the network status.
+	// Once the cluster is ready, the controller verifies that the resources still exist and match spec,
+	// and reports the missing or drifted resources through the ResourcesVerified condition.
+	// When omitted, the resources are verified every 10 minutes and the missing resources are only reported.
+	// Only supported along with network, for extended VPC Infrastructure support.
+	// +optional
+	ResourceVerification *ResourceVerification `json:"resourceVerification,omitempty"`
+
+	// tags are user tags, attached in the form key:value to every IBM Cloud resource created for the cluster.
+	// Changes to the tags are applied to the resources already created, the tags removed are detached.
+	// Only supported along with network, for extended VPC Infrastructure support.
+	// The key capibm-cluster-uid is reserved for the tag with the UID of the cluster, attached to the resources along with the user tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// ResourceVerification configures the periodic verification of the resources referenced in the network status.
+type ResourceVerification struct {
+	// interval is the time between two verifications of the resources.
+	// +kubebuilder:default="10m"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// missingResourcePolicy defines how the missing resources are handled.
+	// Report reports the missing resources and stops the reconciliation of the cluster.
+	// Recreate clears the status of the missing resources created by the controller, so they are created again,
+	// the missing resources not created by the controller are still reported.
+	// Only the Load Balancers, Transit Gateway and endpoint gateways track whether they were created by the controller.
+	// +kubebuilder:default=Report
+	// +optional
+	MissingResourcePolicy MissingResourcePolicy `json:"missingResourcePolicy,omitempty"`
+}
+
+// DNSRecordSpec defines the desired state of an IBM Cloud DNS Services record for the control plane endpoint.
+type DNSRecordSpec struct {
+	// instanceID is the GUID of the IBM Cloud DNS Services instance.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	InstanceID string `json:"instanceID"`
+
+	// zone is the name of an existing DNS zone in the DNS Services instance, e.g. example.com.
+	// The zone is expected to have the cluster's VPC added as a permitted network.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +required
+	Zone string `json:"zone"`
+
+	// recordName is the name of the record relative to the zone, e.g. api.mycluster.
+	// The fully qualified name of the record is recordName.zone.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^([a-z0-9]([-a-z0-9]*[a-z0-9])?)(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +required
+	RecordName string `json:"recordName"`
+
+	// ttl is the time to live of the record in seconds.
+	// When omitted, the DNS Services default of 900 seconds is used.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=2592000
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
 }
 
 // VPCLoadBalancerSpec defines the desired state of an VPC load balancer.
@@ -86,6 +168,13 @@ type VPCLoadBalancerSpec struct {
 	// +optional
 	Public *bool `json:"public,omitempty"`
 
+	// profile defines the load balancer profile.
+	// application creates an application load balancer, network-fixed creates a network load balancer
+	// which preserves the client source IP and is limited to a single subnet and TCP or UDP traffic.
+	// Defaults to application if not specified.
+	// +optional
+	Profile VPCLoadBalancerProfile `json:"profile,omitempty"`
+
 	// AdditionalListeners sets the additional listeners for the control plane load balancer.
 	// +listType=map
 	// +listMapKey=port
@@ -93,6 +182,10 @@ type VPCLoadBalancerSpec struct {
 	// ++kubebuilder:validation:UniqueItems=true
 	AdditionalListeners []AdditionalListenerSpec `json:"additionalListeners,omitempty"`
 
+	// pruneListeners deletes the listeners created for additionalListeners once they are removed from the spec.
+	// +optional
+	PruneListeners *bool `json:"pruneListeners,omitempty"`
+
 	// backendPools defines the load balancer's backend pools.
 	// +optional
 	BackendPools []VPCLoadBalancerBackendPoolSpec `json:"backendPools,omitempty"`
@@ -128,12 +221,48 @@ type AdditionalListenerSpec struct {
 	// +optional
 	Protocol *VPCLoadBalancerListenerProtocol `json:"protocol,omitempty"`
 
+	// certificateCRN defines the CRN of the IBM Cloud Secrets Manager certificate used to terminate TLS on the listener.
+	// Required when the protocol is https. Updating the CRN rotates the certificate of the listener.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	CertificateCRN *string `json:"certificateCRN,omitempty"`
+
+	// httpsRedirect redirects the HTTP traffic received on the listener to an HTTPS listener of the same load balancer.
+	// Only supported when the protocol is http.
+	// +optional
+	HTTPSRedirect *VPCLoadBalancerListenerHTTPSRedirect `json:"httpsRedirect,omitempty"`
+
+	// idleConnectionTimeout defines the idle connection timeout of the listener in seconds.
+	// +kubebuilder:validation:Minimum=50
+	// +kubebuilder:validation:Maximum=7200
+	// +optional
+	IdleConnectionTimeout *int64 `json:"idleConnectionTimeout,omitempty"`
+
 	// The selector is used to find IBMPowerVSMachines with matching labels.
 	// If the label matches, the machine is then added to the load balancer listener configuration.
 	// +kubebuilder:validation:Optional
 	Selector metav1.LabelSelector `json:"selector,omitempty"`
 }
 
+// VPCLoadBalancerListenerHTTPSRedirect defines the redirection of HTTP traffic to an HTTPS listener.
+type VPCLoadBalancerListenerHTTPSRedirect struct {
+	// listenerPort is the port of the HTTPS listener to redirect the traffic to.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +required
+	ListenerPort int64 `json:"listenerPort"`
+
+	// httpStatusCode is the HTTP status code returned for the redirect.
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	// +kubebuilder:default=301
+	// +optional
+	HTTPStatusCode *int64 `json:"httpStatusCode,omitempty"`
+
+	// uri is the redirect relative target URI.
+	// +optional
+	URI *string `json:"uri,omitempty"`
+}
+
 // VPCLoadBalancerBackendPoolSpec defines the desired configuration of a VPC Load Balancer Backend Pool.
 type VPCLoadBalancerBackendPoolSpec struct {
 	// name defines the name of the Backend Pool.
@@ -259,6 +388,100 @@ type VPCNetworkSpec struct {
 	// vpc defines the IBM Cloud VPC for extended VPC Infrastructure support.
 	// +optional
 	VPC *VPCResource `json:"vpc,omitempty"`
+
+	// addressPrefixManagement indicates whether a default address prefix is created in each zone of the VPC, or only the addressPrefixes are used.
+	// Only applies when the VPC is created by the controller.
+	// +kubebuilder:default=auto
+	// +optional
+	AddressPrefixManagement VPCAddressPrefixManagement `json:"addressPrefixManagement,omitempty"`
+
+	// addressPrefixes is a set of address prefixes to create in the VPC, required when addressPrefixManagement is manual.
+	// Subnets without a cidr are allocated a cidr within the address prefixes of their zone.
+	// +listType=map
+	// +listMapKey=cidr
+	// +optional
+	AddressPrefixes []VPCAddressPrefix `json:"addressPrefixes,omitempty"`
+
+	// transitGateway defines the IBM Cloud Transit Gateway connecting the cluster's VPC to other VPCs.
+	// +optional
+	TransitGateway *VPCTransitGateway `json:"transitGateway,omitempty"`
+
+	// egress defines how the subnets reach the internet, unless overridden on a subnet.
+	// when omitted, a public gateway is created by the controller and attached to the subnets in each zone.
+	// +optional
+	Egress *VPCEgress `json:"egress,omitempty"`
+
+	// proxy defines the proxy used by the cluster nodes to reach the internet, injected into the bootstrap data of the machines.
+	// +optional
+	Proxy *VPCProxy `json:"proxy,omitempty"`
+
+	// endpointGateways is a set of Virtual Private Endpoint gateways providing private access to IBM Cloud services from the VPC.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	EndpointGateways []VPCEndpointGateway `json:"endpointGateways,omitempty"`
+}
+
+// VPCAddressPrefix defines an address prefix of the VPC.
+type VPCAddressPrefix struct {
+	// name of the address prefix.
+	// when omitted, a name is generated by IBM Cloud.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// cidr is the IPv4 range of the address prefix.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	CIDR string `json:"cidr"`
+
+	// zone is the availability zone of the address prefix.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Zone string `json:"zone"`
+}
+
+// VPCTransitGateway defines the IBM Cloud Transit Gateway connecting the cluster's VPC to other VPCs.
+type VPCTransitGateway struct {
+	// name of the transit gateway.
+	// when omitted, a name based off the cluster name is used.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// id of an existing transit gateway to use.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// globalRouting indicates whether to set global routing true or not while creating the transit gateway.
+	// when omitted, global routing is enabled only when one of the connected VPCs is in a different region than the cluster.
+	// +optional
+	GlobalRouting *bool `json:"globalRouting,omitempty"`
+
+	// vpcConnections are the connections of the transit gateway to other VPCs, alongside the connection to the cluster's VPC.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	VPCConnections []VPCTransitGatewayConnection `json:"vpcConnections,omitempty"`
+}
+
+// VPCTransitGatewayConnection defines a connection of the transit gateway to another VPC.
+type VPCTransitGatewayConnection struct {
+	// name of the connection.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`
+	// +required
+	Name string `json:"name"`
+
+	// vpcCRN is the CRN of the VPC to connect.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	VPCCRN string `json:"vpcCRN"`
 }
 
 // VPCSecurityGroupStatus defines a vpc security group resource status with its id and respective rule's ids.
@@ -285,6 +508,34 @@ type VPCLoadBalancerStatus struct {
 	// +kubebuilder:default=false
 	// controllerCreated indicates whether the resource is created by the controller.
 	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+	// listeners are the load balancer listeners reconciled from the additional listeners.
+	// +listType=map
+	// +listMapKey=port
+	// +optional
+	Listeners []VPCLoadBalancerListenerStatus `json:"listeners,omitempty"`
+	// pools are the load balancer pools reconciled from the spec.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Pools []VPCLoadBalancerPoolStatus `json:"pools,omitempty"`
+}
+
+// VPCLoadBalancerListenerStatus defines the status of a VPC load balancer listener.
+type VPCLoadBalancerListenerStatus struct {
+	// port of the listener.
+	Port int64 `json:"port"`
+	// id of the listener.
+	// +optional
+	ID *string `json:"id,omitempty"`
+}
+
+// VPCLoadBalancerPoolStatus defines the status of a VPC load balancer pool.
+type VPCLoadBalancerPoolStatus struct {
+	// name of the pool.
+	Name string `json:"name"`
+	// id of the pool.
+	// +optional
+	ID *string `json:"id,omitempty"`
 }
 
 // IBMVPCClusterStatus defines the observed state of IBMVPCCluster.
@@ -310,6 +561,19 @@ type IBMVPCClusterStatus struct {
 	// +optional
 	ResourceGroup *ResourceStatus `json:"resourceGroup,omitempty"`
 
+	// dns is the status of the control plane endpoint's DNS Services record.
+	// +optional
+	DNS *DNSRecordStatus `json:"dns,omitempty"`
+
+	// tags is the status of the user tags attached to the resources created for the cluster.
+	// +optional
+	Tags *TagsStatus `json:"tags,omitempty"`
+
+	// lastResourceVerificationTime is the time the resources referenced in the network status were last verified.
+	// The resources are verified again once spec.resourceVerification.interval has passed, or on every reconciliation while resources are missing.
+	// +optional
+	LastResourceVerificationTime *metav1.Time `json:"lastResourceVerificationTime,omitempty"`
+
 	Subnet      Subnet      `json:"subnet,omitempty"`
 	VPCEndpoint VPCEndpoint `json:"vpcEndpoint,omitempty"`
 
@@ -372,6 +636,81 @@ type VPCNetworkStatus struct {
 	// vpc references the status of the IBM Cloud VPC as part of the extended VPC Infrastructure support.
 	// +optional
 	VPC *ResourceStatus `json:"vpc,omitempty"`
+
+	// addressPrefixes references the VPC address prefixes defined in the Network spec, keyed by cidr.
+	// Once every address prefix in spec is found here, the address prefixes of the VPC are no longer listed.
+	// +optional
+	AddressPrefixes map[string]*ResourceStatus `json:"addressPrefixes,omitempty"`
+
+	// transitGateway references the Transit Gateway connecting the cluster's VPC to other VPCs.
+	// +optional
+	TransitGateway *VPCTransitGatewayStatus `json:"transitGateway,omitempty"`
+
+	// endpointGateways references the Virtual Private Endpoint gateways for the cluster.
+	// The map simplifies lookups.
+	// +optional
+	EndpointGateways map[string]*VPCEndpointGatewayStatus `json:"endpointGateways,omitempty"`
+}
+
+// VPCTransitGatewayStatus provides details on the status of the Transit Gateway connecting the cluster's VPC to other VPCs.
+type VPCTransitGatewayStatus struct {
+	// id is the id of the transit gateway.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// controllerCreated indicates whether the transit gateway was created by the controller.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+
+	// vpcConnection is the status of the connection to the cluster's VPC.
+	// +optional
+	VPCConnection *VPCTransitGatewayConnectionStatus `json:"vpcConnection,omitempty"`
+
+	// vpcConnections is the status of the connections to the other VPCs.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	VPCConnections []VPCTransitGatewayConnectionStatus `json:"vpcConnections,omitempty"`
+}
+
+// VPCTransitGatewayConnectionStatus provides details on the status of a Transit Gateway connection.
+type VPCTransitGatewayConnectionStatus struct {
+	// name of the connection.
+	Name string `json:"name"`
+
+	// id is the id of the connection.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// controllerCreated indicates whether the connection was created by the controller.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+}
+
+// DNSRecordStatus provides details on the status of the control plane endpoint's DNS Services record.
+type DNSRecordStatus struct {
+	// zoneID is the id of the DNS zone containing the record.
+	// +optional
+	ZoneID *string `json:"zoneID,omitempty"`
+
+	// id is the id of the record.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// hostname is the fully qualified name of the record.
+	// +optional
+	Hostname *string `json:"hostname,omitempty"`
+
+	// target is the Load Balancer hostname or IP address the record resolves to.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// controllerCreated indicates whether the record was created by the controller.
+	// +kubebuilder:default=false
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
 }
 
 // VPC holds the VPC information.
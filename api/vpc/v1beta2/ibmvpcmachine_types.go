@@ -86,6 +86,12 @@ type IBMVPCMachineSpec struct {
 	// +kubebuilder:validation:MaxItems=12
 	// +kubebuilder:validation:XValidation:rule="oldSelf.all(x, x in self)",message="Values may only be added"
 	AdditionalVolumes []*VPCVolume `json:"additionalVolumes,omitempty"`
+
+	// tags are user tags, attached in the form key:value to the instance and its volumes,
+	// in addition to the tags of the IBMVPCCluster. A tag overrides the cluster tag with the same key.
+	// The key capibm-cluster-uid is reserved for the tag with the UID of the cluster, attached to the resources along with the user tags.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // IBMVPCResourceReference is a reference to a specific VPC resource by ID or Name
@@ -185,6 +191,15 @@ type IBMVPCMachineStatus struct {
 	// +optional
 	LoadBalancerPoolMembers []VPCLoadBalancerBackendPoolMember `json:"loadBalancerPoolMembers,omitempty"`
 
+	// tags is the status of the user tags attached to the instance and its volumes.
+	// +optional
+	Tags *TagsStatus `json:"tags,omitempty"`
+
+	// primaryReservedIP is the status of the subnet reserved IP created for the address allocated from
+	// spec.primaryNetworkInterface.ipAddressPool.
+	// +optional
+	PrimaryReservedIP *ResourceStatus `json:"primaryReservedIP,omitempty"`
+
 	// V1beta2 groups all the fields that will be added or modified in IBMVPCMachine's status with the V1Beta2 version.
 	// +optional
 	V1Beta2 *IBMVPCMachineV1Beta2Status `json:"v1beta2,omitempty"`
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1beta2
 
-import "github.com/IBM/vpc-go-sdk/vpcv1"
+import (
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+)
 
 const (
 	// CIDRBlockAny is the CIDRBlock representing any allowable destination/source IP.
@@ -44,6 +48,54 @@ var (
 	VPCLoadBalancerBackendPoolAlgorithmWeightedRoundRobin VPCLoadBalancerBackendPoolAlgorithm = vpcv1.CreateLoadBalancerPoolOptionsAlgorithmWeightedRoundRobinConst
 )
 
+// VPCLoadBalancerProfile describes the profile of a VPC load balancer.
+// +kubebuilder:validation:Enum=application;network-fixed
+type VPCLoadBalancerProfile string
+
+var (
+	// VPCLoadBalancerProfileApplication is the string representing an application load balancer.
+	VPCLoadBalancerProfileApplication = VPCLoadBalancerProfile("application")
+
+	// VPCLoadBalancerProfileNetworkFixed is the string representing a network load balancer with the fixed network profile.
+	VPCLoadBalancerProfileNetworkFixed = VPCLoadBalancerProfile("network-fixed")
+)
+
+// VPCAddressPrefixManagement describes how the address prefixes of a VPC are managed.
+// +kubebuilder:validation:Enum=auto;manual
+type VPCAddressPrefixManagement string
+
+var (
+	// VPCAddressPrefixManagementAuto is the string representing a VPC with a default address prefix created in each zone.
+	VPCAddressPrefixManagementAuto = VPCAddressPrefixManagement(vpcv1.CreateVPCOptionsAddressPrefixManagementAutoConst)
+
+	// VPCAddressPrefixManagementManual is the string representing a VPC with only the user defined address prefixes.
+	VPCAddressPrefixManagementManual = VPCAddressPrefixManagement(vpcv1.CreateVPCOptionsAddressPrefixManagementManualConst)
+)
+
+// VPCEgressMode describes how a subnet reaches the internet.
+// +kubebuilder:validation:Enum=PublicGateway;None
+type VPCEgressMode string
+
+var (
+	// VPCEgressModePublicGateway is the string representing egress through a public gateway.
+	VPCEgressModePublicGateway = VPCEgressMode("PublicGateway")
+
+	// VPCEgressModeNone is the string representing no public gateway attached, egress is managed outside of the cluster.
+	VPCEgressModeNone = VPCEgressMode("None")
+)
+
+// MissingResourcePolicy describes how the resources referenced in status that no longer exist are handled.
+// +kubebuilder:validation:Enum=Report;Recreate
+type MissingResourcePolicy string
+
+var (
+	// MissingResourcePolicyReport is the string representing the missing resources being reported.
+	MissingResourcePolicyReport = MissingResourcePolicy("Report")
+
+	// MissingResourcePolicyRecreate is the string representing the missing resources created by the controller being created again.
+	MissingResourcePolicyRecreate = MissingResourcePolicy("Recreate")
+)
+
 // VPCLoadBalancerBackendPoolProtocol describes the protocol for load balancer backend pools.
 // We have unique types in case IBM Cloud Load Balancer Listener and Backend Pool supported algorithms ever diverage.
 // +kubebuilder:validation:Enum=http;https;tcp;udp
@@ -161,6 +213,8 @@ var (
 	ResourceTypePublicGateway = ResourceType("publicGateway")
 	// ResourceTypeCustomImage is a VPC Custom Image.
 	ResourceTypeCustomImage = ResourceType("customImage")
+	// ResourceTypeTransitGateway is an IBM Cloud Transit Gateway.
+	ResourceTypeTransitGateway = ResourceType("transitGateway")
 )
 
 const (
@@ -268,6 +322,12 @@ type NetworkInterface struct {
 
 	// Subnet ID of the network interface.
 	Subnet string `json:"subnet,omitempty"`
+
+	// ipAddressPool is the reference to the IPAM pool the primary IP address of the network interface is allocated from.
+	// when set, an IPAddressClaim is created against the pool and the allocated address is reserved on the subnet
+	// and used as the primary IP of the network interface.
+	// +optional
+	IPAddressPool *ipamv1.IPPoolReference `json:"ipAddressPool,omitempty"`
 }
 
 // VPCLoadBalancerBackendPoolMember represents a VPC Load Balancer Backend Pool Member.
@@ -437,6 +497,74 @@ type Subnet struct {
 	// +kubebuilder:validation:Pattern=`^[-0-9a-z_]+$`
 	ID   *string `json:"id,omitempty"`
 	Zone *string `json:"zone,omitempty"`
+
+	// egress defines how the subnet reaches the internet, overriding the egress of the network.
+	// +optional
+	Egress *VPCEgress `json:"egress,omitempty"`
+}
+
+// VPCEgress defines how a subnet reaches the internet.
+type VPCEgress struct {
+	// mode is the egress mode of the subnet.
+	// PublicGateway attaches a public gateway to the subnet, None attaches no public gateway leaving egress to a NAT or proxy managed outside of the cluster.
+	// +kubebuilder:default=PublicGateway
+	// +optional
+	Mode VPCEgressMode `json:"mode,omitempty"`
+
+	// publicGateway is an existing public gateway, referenced by id or name, to attach instead of the public gateway created by the controller.
+	// only valid when mode is PublicGateway.
+	// +optional
+	PublicGateway *VPCResource `json:"publicGateway,omitempty"`
+}
+
+// VPCProxy defines the proxy used by the cluster nodes to reach the internet.
+type VPCProxy struct {
+	// httpProxy is the proxy url used for http requests.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	HTTPProxy *string `json:"httpProxy,omitempty"`
+
+	// httpsProxy is the proxy url used for https requests.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty"`
+
+	// noProxy is a list of hosts, domains and cidrs which are reached directly rather than through the proxy.
+	// The address prefix cidrs, the subnet cidrs, including the ones allocated by IBM Cloud, and the control plane endpoint are always reached directly.
+	// +optional
+	NoProxy []string `json:"noProxy,omitempty"`
+}
+
+// VPCEndpointGateway defines a Virtual Private Endpoint gateway to an IBM Cloud service.
+type VPCEndpointGateway struct {
+	// name of the endpoint gateway.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern=`^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`
+	// +required
+	Name string `json:"name"`
+
+	// serviceCRN is the CRN of the IBM Cloud service, or of the Private Path service gateway, targeted by the endpoint gateway.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	ServiceCRN string `json:"serviceCRN"`
+}
+
+// VPCEndpointGatewayStatus provides details on the status of a Virtual Private Endpoint gateway.
+type VPCEndpointGatewayStatus struct {
+	// id of the endpoint gateway.
+	ID string `json:"id"`
+
+	// ready indicates whether the endpoint gateway is ready.
+	Ready bool `json:"ready"`
+
+	// controllerCreated indicates whether the endpoint gateway was created by the controller.
+	// +optional
+	ControllerCreated *bool `json:"controllerCreated,omitempty"`
+
+	// reservedIPs are the addresses reserved for the endpoint gateway in the cluster subnets.
+	// +optional
+	ReservedIPs []string `json:"reservedIPs,omitempty"`
 }
 
 // VPCEndpoint describes a VPCEndpoint.
@@ -488,3 +616,14 @@ type VPCResource struct {
 	// +optional
 	Name *string `json:"name,omitempty"`
 }
+
+// TagsStatus describes the user tags attached to a set of IBM Cloud resources.
+type TagsStatus struct {
+	// applied are the user tags, in the form key:value, last attached to the resources, including the cluster UID tag.
+	// +optional
+	Applied []string `json:"applied,omitempty"`
+
+	// resources are the IDs of the resources the tags were last attached to.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+}
@@ -25,6 +25,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1"
+	ipamv1beta2 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -40,6 +41,21 @@ func (in *AdditionalListenerSpec) DeepCopyInto(out *AdditionalListenerSpec) {
 		*out = new(VPCLoadBalancerListenerProtocol)
 		**out = **in
 	}
+	if in.CertificateCRN != nil {
+		in, out := &in.CertificateCRN, &out.CertificateCRN
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPSRedirect != nil {
+		in, out := &in.HTTPSRedirect, &out.HTTPSRedirect
+		*out = new(VPCLoadBalancerListenerHTTPSRedirect)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleConnectionTimeout != nil {
+		in, out := &in.IdleConnectionTimeout, &out.IdleConnectionTimeout
+		*out = new(int64)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 }
 
@@ -53,6 +69,66 @@ func (in *AdditionalListenerSpec) DeepCopy() *AdditionalListenerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordSpec) DeepCopyInto(out *DNSRecordSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordSpec.
+func (in *DNSRecordSpec) DeepCopy() *DNSRecordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSRecordStatus) DeepCopyInto(out *DNSRecordStatus) {
+	*out = *in
+	if in.ZoneID != nil {
+		in, out := &in.ZoneID, &out.ZoneID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSRecordStatus.
+func (in *DNSRecordStatus) DeepCopy() *DNSRecordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSRecordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMCloudCatalogOffering) DeepCopyInto(out *IBMCloudCatalogOffering) {
 	*out = *in
@@ -181,6 +257,28 @@ func (in *IBMVPCClusterSpec) DeepCopyInto(out *IBMVPCClusterSpec) {
 		*out = new(VPCNetworkSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSRecordSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceNameTemplate != nil {
+		in, out := &in.ResourceNameTemplate, &out.ResourceNameTemplate
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceVerification != nil {
+		in, out := &in.ResourceVerification, &out.ResourceVerification
+		*out = new(ResourceVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCClusterSpec.
@@ -212,6 +310,20 @@ func (in *IBMVPCClusterStatus) DeepCopyInto(out *IBMVPCClusterStatus) {
 		*out = new(ResourceStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSRecordStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(TagsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastResourceVerificationTime != nil {
+		in, out := &in.LastResourceVerificationTime, &out.LastResourceVerificationTime
+		*out = (*in).DeepCopy()
+	}
 	in.Subnet.DeepCopyInto(&out.Subnet)
 	in.VPCEndpoint.DeepCopyInto(&out.VPCEndpoint)
 	if in.Conditions != nil {
@@ -468,6 +580,13 @@ func (in *IBMVPCMachineSpec) DeepCopyInto(out *IBMVPCMachineSpec) {
 			}
 		}
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMVPCMachineSpec.
@@ -512,6 +631,16 @@ func (in *IBMVPCMachineStatus) DeepCopyInto(out *IBMVPCMachineStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = new(TagsStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrimaryReservedIP != nil {
+		in, out := &in.PrimaryReservedIP, &out.PrimaryReservedIP
+		*out = new(ResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.V1Beta2 != nil {
 		in, out := &in.V1Beta2, &out.V1Beta2
 		*out = new(IBMVPCMachineV1Beta2Status)
@@ -759,6 +888,11 @@ func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.IPAddressPool != nil {
+		in, out := &in.IPAddressPool, &out.IPAddressPool
+		*out = new(ipamv1beta2.IPPoolReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkInterface.
@@ -791,6 +925,26 @@ func (in *ResourceStatus) DeepCopy() *ResourceStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceVerification) DeepCopyInto(out *ResourceVerification) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceVerification.
+func (in *ResourceVerification) DeepCopy() *ResourceVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Subnet) DeepCopyInto(out *Subnet) {
 	*out = *in
@@ -814,6 +968,11 @@ func (in *Subnet) DeepCopyInto(out *Subnet) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(VPCEgress)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
@@ -826,6 +985,31 @@ func (in *Subnet) DeepCopy() *Subnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagsStatus) DeepCopyInto(out *TagsStatus) {
+	*out = *in
+	if in.Applied != nil {
+		in, out := &in.Applied, &out.Applied
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagsStatus.
+func (in *TagsStatus) DeepCopy() *TagsStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TagsStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPC) DeepCopyInto(out *VPC) {
 	*out = *in
@@ -841,6 +1025,46 @@ func (in *VPC) DeepCopy() *VPC {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCAddressPrefix) DeepCopyInto(out *VPCAddressPrefix) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCAddressPrefix.
+func (in *VPCAddressPrefix) DeepCopy() *VPCAddressPrefix {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCAddressPrefix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEgress) DeepCopyInto(out *VPCEgress) {
+	*out = *in
+	if in.PublicGateway != nil {
+		in, out := &in.PublicGateway, &out.PublicGateway
+		*out = new(VPCResource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEgress.
+func (in *VPCEgress) DeepCopy() *VPCEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
 	*out = *in
@@ -871,6 +1095,46 @@ func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointGateway) DeepCopyInto(out *VPCEndpointGateway) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointGateway.
+func (in *VPCEndpointGateway) DeepCopy() *VPCEndpointGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointGatewayStatus) DeepCopyInto(out *VPCEndpointGatewayStatus) {
+	*out = *in
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReservedIPs != nil {
+		in, out := &in.ReservedIPs, &out.ReservedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointGatewayStatus.
+func (in *VPCEndpointGatewayStatus) DeepCopy() *VPCEndpointGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLoadBalancerBackendPoolMember) DeepCopyInto(out *VPCLoadBalancerBackendPoolMember) {
 	*out = *in
@@ -939,6 +1203,71 @@ func (in *VPCLoadBalancerHealthMonitorSpec) DeepCopy() *VPCLoadBalancerHealthMon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerListenerHTTPSRedirect) DeepCopyInto(out *VPCLoadBalancerListenerHTTPSRedirect) {
+	*out = *in
+	if in.HTTPStatusCode != nil {
+		in, out := &in.HTTPStatusCode, &out.HTTPStatusCode
+		*out = new(int64)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerListenerHTTPSRedirect.
+func (in *VPCLoadBalancerListenerHTTPSRedirect) DeepCopy() *VPCLoadBalancerListenerHTTPSRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerListenerHTTPSRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerListenerStatus) DeepCopyInto(out *VPCLoadBalancerListenerStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerListenerStatus.
+func (in *VPCLoadBalancerListenerStatus) DeepCopy() *VPCLoadBalancerListenerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerListenerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCLoadBalancerPoolStatus) DeepCopyInto(out *VPCLoadBalancerPoolStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerPoolStatus.
+func (in *VPCLoadBalancerPoolStatus) DeepCopy() *VPCLoadBalancerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCLoadBalancerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCLoadBalancerSpec) DeepCopyInto(out *VPCLoadBalancerSpec) {
 	*out = *in
@@ -959,6 +1288,11 @@ func (in *VPCLoadBalancerSpec) DeepCopyInto(out *VPCLoadBalancerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PruneListeners != nil {
+		in, out := &in.PruneListeners, &out.PruneListeners
+		*out = new(bool)
+		**out = **in
+	}
 	if in.BackendPools != nil {
 		in, out := &in.BackendPools, &out.BackendPools
 		*out = make([]VPCLoadBalancerBackendPoolSpec, len(*in))
@@ -1010,6 +1344,20 @@ func (in *VPCLoadBalancerStatus) DeepCopyInto(out *VPCLoadBalancerStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]VPCLoadBalancerListenerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]VPCLoadBalancerPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLoadBalancerStatus.
@@ -1093,6 +1441,33 @@ func (in *VPCNetworkSpec) DeepCopyInto(out *VPCNetworkSpec) {
 		*out = new(VPCResource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AddressPrefixes != nil {
+		in, out := &in.AddressPrefixes, &out.AddressPrefixes
+		*out = make([]VPCAddressPrefix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(VPCTransitGateway)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(VPCEgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(VPCProxy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EndpointGateways != nil {
+		in, out := &in.EndpointGateways, &out.EndpointGateways
+		*out = make([]VPCEndpointGateway, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkSpec.
@@ -1198,6 +1573,43 @@ func (in *VPCNetworkStatus) DeepCopyInto(out *VPCNetworkStatus) {
 		*out = new(ResourceStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AddressPrefixes != nil {
+		in, out := &in.AddressPrefixes, &out.AddressPrefixes
+		*out = make(map[string]*ResourceStatus, len(*in))
+		for key, val := range *in {
+			var outVal *ResourceStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(ResourceStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.TransitGateway != nil {
+		in, out := &in.TransitGateway, &out.TransitGateway
+		*out = new(VPCTransitGatewayStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EndpointGateways != nil {
+		in, out := &in.EndpointGateways, &out.EndpointGateways
+		*out = make(map[string]*VPCEndpointGatewayStatus, len(*in))
+		for key, val := range *in {
+			var outVal *VPCEndpointGatewayStatus
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = new(VPCEndpointGatewayStatus)
+				(*in).DeepCopyInto(*out)
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCNetworkStatus.
@@ -1210,6 +1622,36 @@ func (in *VPCNetworkStatus) DeepCopy() *VPCNetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCProxy) DeepCopyInto(out *VPCProxy) {
+	*out = *in
+	if in.HTTPProxy != nil {
+		in, out := &in.HTTPProxy, &out.HTTPProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTTPSProxy != nil {
+		in, out := &in.HTTPSProxy, &out.HTTPSProxy
+		*out = new(string)
+		**out = **in
+	}
+	if in.NoProxy != nil {
+		in, out := &in.NoProxy, &out.NoProxy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCProxy.
+func (in *VPCProxy) DeepCopy() *VPCProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCResource) DeepCopyInto(out *VPCResource) {
 	*out = *in
@@ -1430,6 +1872,118 @@ func (in *VPCSecurityGroupStatus) DeepCopy() *VPCSecurityGroupStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCTransitGateway) DeepCopyInto(out *VPCTransitGateway) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.GlobalRouting != nil {
+		in, out := &in.GlobalRouting, &out.GlobalRouting
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPCConnections != nil {
+		in, out := &in.VPCConnections, &out.VPCConnections
+		*out = make([]VPCTransitGatewayConnection, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCTransitGateway.
+func (in *VPCTransitGateway) DeepCopy() *VPCTransitGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCTransitGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCTransitGatewayConnection) DeepCopyInto(out *VPCTransitGatewayConnection) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCTransitGatewayConnection.
+func (in *VPCTransitGatewayConnection) DeepCopy() *VPCTransitGatewayConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCTransitGatewayConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCTransitGatewayConnectionStatus) DeepCopyInto(out *VPCTransitGatewayConnectionStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCTransitGatewayConnectionStatus.
+func (in *VPCTransitGatewayConnectionStatus) DeepCopy() *VPCTransitGatewayConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCTransitGatewayConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCTransitGatewayStatus) DeepCopyInto(out *VPCTransitGatewayStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ControllerCreated != nil {
+		in, out := &in.ControllerCreated, &out.ControllerCreated
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPCConnection != nil {
+		in, out := &in.VPCConnection, &out.VPCConnection
+		*out = new(VPCTransitGatewayConnectionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCConnections != nil {
+		in, out := &in.VPCConnections, &out.VPCConnections
+		*out = make([]VPCTransitGatewayConnectionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCTransitGatewayStatus.
+func (in *VPCTransitGatewayStatus) DeepCopy() *VPCTransitGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCTransitGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCVolume) DeepCopyInto(out *VPCVolume) {
 	*out = *in
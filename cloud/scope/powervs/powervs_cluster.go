@@ -20,8 +20,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"math"
 	"reflect"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
@@ -33,12 +37,16 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
@@ -53,6 +61,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
@@ -83,6 +93,8 @@ const (
 	// vpcSubnetIPAddressCount is the total IP Addresses for the subnet.
 	// Support for custom address prefixes will be added at a later time. Currently, we use the ip count for subnet creation.
 	vpcSubnetIPAddressCount int64 = 256
+	// defaultResourceVerificationInterval is the time between two verifications of the resources referenced in status.
+	defaultResourceVerificationInterval = 10 * time.Minute
 )
 
 // ClusterScopeParams defines the input parameters used to create a new ClusterScope.
@@ -105,6 +117,8 @@ type ClientFactory struct {
 	TransitGatewayFactory     func() (transitgateway.TransitGateway, error)
 	ResourceControllerFactory func() (resourcecontroller.ResourceController, error)
 	ResourceManagerFactory    func() (resourcemanager.ResourceManager, error)
+	DNSServicesFactory        func() (dnsservices.DNSServices, error)
+	GlobalTaggingFactory      func() (globaltagging.GlobalTagging, error)
 }
 
 // ClusterScope defines a scope defined around a Power VS Cluster.
@@ -118,6 +132,8 @@ type ClusterScope struct {
 	ResourceClient        resourcecontroller.ResourceController
 	COSClient             cos.Cos
 	ResourceManagerClient resourcemanager.ResourceManager
+	DNSServicesClient     dnsservices.DNSServices
+	GlobalTaggingClient   globaltagging.GlobalTagging
 
 	Cluster           *clusterv1.Cluster
 	IBMPowerVSCluster *infrav1.IBMPowerVSCluster
@@ -228,6 +244,27 @@ func NewPowerVSClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		return nil, fmt.Errorf("failed to create resource manager client: %w", err)
 	}
 
+	// Create DNS Services client only when a DNS record is requested for the control plane endpoint.
+	var dnsClient dnsservices.DNSServices
+	if params.IBMPowerVSCluster.Spec.DNS != nil {
+		dnsClient, err = params.getDNSServicesClient(&dnssvcsv1.DnsSvcsV1Options{
+			Authenticator: auth,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS Services client: %w", err)
+		}
+	}
+
+	// Create Global Tagging client.
+	gtClient, err := params.getGlobalTaggingClient(globaltagging.ServiceOptions{
+		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{
+			Authenticator: auth,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
+	}
+
 	clusterScope := &ClusterScope{
 		Client:                params.Client,
 		patchHelper:           helper,
@@ -239,6 +276,8 @@ func NewPowerVSClusterScope(params ClusterScopeParams) (*ClusterScope, error) {
 		TransitGatewayClient:  tgClient,
 		ResourceClient:        resourceClient,
 		ResourceManagerClient: rmClient,
+		DNSServicesClient:     dnsClient,
+		GlobalTaggingClient:   gtClient,
 	}
 	return clusterScope, nil
 }
@@ -292,6 +331,19 @@ func (params ClusterScopeParams) getTransitGatewayClient(options *tgapiv1.Transi
 	return transitgateway.NewService(options)
 }
 
+func (params ClusterScopeParams) getDNSServicesClient(options *dnssvcsv1.DnsSvcsV1Options) (dnsservices.DNSServices, error) {
+	if params.DNSServicesFactory != nil {
+		return params.DNSServicesFactory()
+	}
+	// Fetch the DNS Services endpoint.
+	dnsServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.DNSServices), params.ServiceEndpoint)
+	if dnsServiceEndpoint != "" {
+		params.Logger.V(3).Info("Overriding the default DNS Services endpoint", "dnsServicesEndpoint", dnsServiceEndpoint)
+		options.URL = dnsServiceEndpoint
+	}
+	return dnsservices.NewService(options)
+}
+
 func (params ClusterScopeParams) getResourceControllerClient(options resourcecontroller.ServiceOptions) (resourcecontroller.ResourceController, error) {
 	if params.ResourceControllerFactory != nil {
 		return params.ResourceControllerFactory()
@@ -318,6 +370,19 @@ func (params ClusterScopeParams) getResourceManagerClient(options *resourcemanag
 	return resourcemanager.NewService(options)
 }
 
+func (params ClusterScopeParams) getGlobalTaggingClient(options globaltagging.ServiceOptions) (globaltagging.GlobalTagging, error) {
+	if params.GlobalTaggingFactory != nil {
+		return params.GlobalTaggingFactory()
+	}
+	// Fetch the global tagging endpoint.
+	gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), params.ServiceEndpoint)
+	if gtEndpoint != "" {
+		options.URL = gtEndpoint
+		params.Logger.V(3).Info("Overriding the default global tagging endpoint", "GlobalTaggingEndpoint", gtEndpoint)
+	}
+	return globaltagging.NewService(options)
+}
+
 // PatchObject persists the cluster configuration and status.
 func (s *ClusterScope) PatchObject() error {
 	return s.patchHelper.Patch(context.TODO(), s.IBMPowerVSCluster)
@@ -383,6 +448,34 @@ func (s *ClusterScope) SetTransitGatewayConnectionStatus(networkType networkConn
 	}
 }
 
+// SetTransitGatewayAdditionalConnectionStatus sets the status of an additional connection of Transit gateway.
+func (s *ClusterScope) SetTransitGatewayAdditionalConnectionStatus(connection infrav1.TransitGatewayConnectionStatus) {
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return
+	}
+	for i := range s.IBMPowerVSCluster.Status.TransitGateway.Connections {
+		if s.IBMPowerVSCluster.Status.TransitGateway.Connections[i].Name == connection.Name {
+			s.IBMPowerVSCluster.Status.TransitGateway.Connections[i] = connection
+			return
+		}
+	}
+	s.IBMPowerVSCluster.Status.TransitGateway.Connections = append(s.IBMPowerVSCluster.Status.TransitGateway.Connections, connection)
+}
+
+// removeTransitGatewayAdditionalConnectionStatus removes the status of an additional connection of Transit gateway.
+func (s *ClusterScope) removeTransitGatewayAdditionalConnectionStatus(name string) {
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return
+	}
+	connections := s.IBMPowerVSCluster.Status.TransitGateway.Connections[:0]
+	for _, connection := range s.IBMPowerVSCluster.Status.TransitGateway.Connections {
+		if connection.Name != name {
+			connections = append(connections, connection)
+		}
+	}
+	s.IBMPowerVSCluster.Status.TransitGateway.Connections = connections
+}
+
 // SetTransitGatewayStatus sets the status of Transit gateway.
 func (s *ClusterScope) SetTransitGatewayStatus(id *string, controllerCreated *bool) {
 	s.IBMPowerVSCluster.Status.TransitGateway = &infrav1.TransitGatewayStatus{
@@ -553,6 +646,26 @@ func (s *ClusterScope) SetVPCSecurityGroupStatus(ctx context.Context, name strin
 	s.IBMPowerVSCluster.Status.VPCSecurityGroups[name] = resource
 }
 
+// SetVPCEndpointGatewayStatus sets the VPC endpoint gateway status.
+func (s *ClusterScope) SetVPCEndpointGatewayStatus(ctx context.Context, name string, endpointGateway *vpcv1.EndpointGateway, controllerCreated bool) {
+	log := ctrl.LoggerFrom(ctx)
+	log.V(3).Info("Setting VPC endpoint gateway status", "name", name, "endpointGatewayID", endpointGateway.ID)
+	if s.IBMPowerVSCluster.Status.VPCEndpointGateways == nil {
+		s.IBMPowerVSCluster.Status.VPCEndpointGateways = make(map[string]infrav1.VPCEndpointGatewayStatus)
+	}
+	reservedIPs := []string{}
+	for _, reservedIP := range endpointGateway.Ips {
+		if reservedIP.Address != nil {
+			reservedIPs = append(reservedIPs, *reservedIP.Address)
+		}
+	}
+	s.IBMPowerVSCluster.Status.VPCEndpointGateways[name] = infrav1.VPCEndpointGatewayStatus{
+		ID:                endpointGateway.ID,
+		ControllerCreated: ptr.To(controllerCreated),
+		ReservedIPs:       reservedIPs,
+	}
+}
+
 // TransitGateway returns the cluster Transit Gateway information.
 func (s *ClusterScope) TransitGateway() *infrav1.TransitGateway {
 	return s.IBMPowerVSCluster.Spec.TransitGateway
@@ -845,6 +958,135 @@ func (s *ClusterScope) createServiceInstance(ctx context.Context) (*resourcecont
 	return serviceInstance, nil
 }
 
+// ReconcileWorkspaceQuota reads the usage and limits of the PowerVS workspace and sets its used and available capacity in status.
+// The usage covers all the resources of the workspace, including the ones of other clusters sharing it.
+func (s *ClusterScope) ReconcileWorkspaceQuota(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	serviceInstanceID := s.GetServiceInstanceID()
+	if serviceInstanceID == "" {
+		return nil
+	}
+	workspace, err := s.IBMPowerVSClient.GetCloudInstance(serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get PowerVS workspace usage and limits: %w", err)
+	}
+	quota := workspaceQuota(workspace)
+	if quota == nil {
+		log.V(3).Info("PowerVS workspace usage or limits are not available", "serviceInstanceID", serviceInstanceID)
+		return nil
+	}
+	s.IBMPowerVSCluster.Status.WorkspaceQuota = quota
+	return nil
+}
+
+// workspaceQuota returns the used and available capacity of the PowerVS workspace, or nil when its usage or limits are not reported.
+func workspaceQuota(workspace *models.CloudInstance) *infrav1.WorkspaceQuotaStatus {
+	if workspace == nil || workspace.Usage == nil || workspace.Limits == nil {
+		return nil
+	}
+	available := func(limit, usage *float64) float64 {
+		return max(ptr.Deref(limit, 0)-ptr.Deref(usage, 0), 0)
+	}
+	return &infrav1.WorkspaceQuotaStatus{
+		Used: workspaceCapacity(
+			ptr.Deref(workspace.Usage.Instances, 0),
+			ptr.Deref(workspace.Usage.Processors, 0),
+			ptr.Deref(workspace.Usage.Memory, 0),
+			ptr.Deref(workspace.Usage.Storage, 0),
+		),
+		Available: workspaceCapacity(
+			available(workspace.Limits.Instances, workspace.Usage.Instances),
+			available(workspace.Limits.Processors, workspace.Usage.Processors),
+			available(workspace.Limits.Memory, workspace.Usage.Memory),
+			available(workspace.Limits.Storage, workspace.Usage.Storage),
+		),
+	}
+}
+
+// workspaceCapacity returns the capacity for the number of instances, processors, memory in GiB and storage in TiB reported by PowerVS.
+func workspaceCapacity(instances, processors, memoryGiB, storageTiB float64) infrav1.WorkspaceCapacity {
+	return infrav1.WorkspaceCapacity{
+		Instances:  ptr.To(int64(instances)),
+		Processors: processorsQuantity(processors),
+		Memory:     gibQuantity(memoryGiB),
+		Storage:    resource.NewQuantity(int64(math.Round(storageTiB*(1<<40))), resource.BinarySI),
+	}
+}
+
+// processorsQuantity returns the quantity of the number of processors, which can be fractional for shared processors.
+func processorsQuantity(processors float64) *resource.Quantity {
+	return resource.NewMilliQuantity(int64(math.Round(processors*1000)), resource.DecimalSI)
+}
+
+// gibQuantity returns the quantity of an amount of memory or storage in GiB.
+func gibQuantity(memoryGiB float64) *resource.Quantity {
+	return resource.NewQuantity(int64(math.Round(memoryGiB*(1<<30))), resource.BinarySI)
+}
+
+// ReconcileZoneCapabilities sets the system types, the storage tiers and the storage pools available
+// in the PowerVS zone of the workspace in status.
+func (s *ClusterScope) ReconcileZoneCapabilities(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	zone := s.Zone()
+	if zone == nil {
+		return fmt.Errorf("PowerVS zone is not set")
+	}
+	datacenter, err := s.IBMPowerVSClient.GetDatacenter(*zone)
+	if err != nil {
+		return fmt.Errorf("failed to get datacenter details: %w", err)
+	}
+	capabilities := &infrav1.ZoneCapabilitiesStatus{}
+	if datacenter.CapabilitiesDetails != nil && datacenter.CapabilitiesDetails.SupportedSystems != nil {
+		capabilities.SystemTypes = systemTypeCapabilities(datacenter.CapabilitiesDetails.SupportedSystems)
+	} else {
+		log.V(3).Info("Supported system types are not available for zone", "zone", *zone)
+	}
+
+	storageTypes, err := s.IBMPowerVSClient.GetAllStorageTypesCapacity()
+	if err != nil {
+		return fmt.Errorf("failed to get storage types capacity: %w", err)
+	}
+	if storageTypes != nil {
+		for _, storageType := range storageTypes.StorageTypesCapacity {
+			if storageType != nil && storageType.StorageType != "" && !slices.Contains(capabilities.StorageTiers, storageType.StorageType) {
+				capabilities.StorageTiers = append(capabilities.StorageTiers, storageType.StorageType)
+			}
+		}
+		slices.Sort(capabilities.StorageTiers)
+	}
+
+	storagePools, err := s.IBMPowerVSClient.GetAllStoragePoolsCapacity()
+	if err != nil {
+		return fmt.Errorf("failed to get storage pools capacity: %w", err)
+	}
+	if storagePools != nil {
+		for _, storagePool := range storagePools.StoragePoolsCapacity {
+			if storagePool != nil && storagePool.PoolName != "" && !slices.Contains(capabilities.StoragePools, storagePool.PoolName) {
+				capabilities.StoragePools = append(capabilities.StoragePools, storagePool.PoolName)
+			}
+		}
+		slices.Sort(capabilities.StoragePools)
+	}
+	s.IBMPowerVSCluster.Status.ZoneCapabilities = capabilities
+	return nil
+}
+
+// systemTypeCapabilities returns the general system types sorted by name, the dedicated systems are the ones
+// available on dedicated hosts. the datacenter capabilities do not tell which processor types a system type supports.
+func systemTypeCapabilities(supportedSystems *models.SupportedSystems) []infrav1.SystemTypeCapabilities {
+	names := slices.Clone(supportedSystems.General)
+	slices.Sort(names)
+	names = slices.Compact(names)
+	systemTypes := make([]infrav1.SystemTypeCapabilities, 0, len(names))
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		systemTypes = append(systemTypes, infrav1.SystemTypeCapabilities{Name: name})
+	}
+	return systemTypes
+}
+
 // ReconcileNetwork reconciles network
 // If only IBMPowerVSCluster.Spec.Network is set, network would be validated and if exists already will get used as cluster’s network or DHCP network would be validated with this name if not exits then a new network will be created via DHCP service.
 // If only IBMPowerVSCluster.Spec.DHCPServer is set, DHCP server would be validated and if exists already, will use DHCP server’s network as cluster network. If not a new DHCP service will be created and it’s network will be used.
@@ -1024,14 +1266,33 @@ func (s *ClusterScope) checkDHCPServerStatus(ctx context.Context, dhcpServer mod
 // createDHCPServer creates the DHCP server.
 func (s *ClusterScope) createDHCPServer(ctx context.Context) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
-	var dhcpServerCreateParams models.DHCPServerCreate
-	dhcpServerDetails := s.DHCPServer()
-	if dhcpServerDetails == nil {
-		dhcpServerDetails = &infrav1.DHCPServer{}
+	dhcpServerCreateParams := newDHCPServerCreateParams(s.GetServiceName(infrav1.ResourceTypeDHCPServer), s.DHCPServer())
+	log.V(3).Info("Creating a new DHCP server with name", "name", dhcpServerCreateParams.Name)
+
+	dhcpServer, err := s.IBMPowerVSClient.CreateDHCPServer(dhcpServerCreateParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create a new DHCP server: %w", err)
+	}
+	if dhcpServer == nil {
+		return nil, fmt.Errorf("created DHCP server is nil")
+	}
+	if dhcpServer.Network == nil {
+		return nil, fmt.Errorf("created DHCP server network is nil")
 	}
 
-	dhcpServerCreateParams.Name = s.GetServiceName(infrav1.ResourceTypeDHCPServer)
-	log.V(3).Info("Creating a new DHCP server with name", "name", dhcpServerCreateParams.Name)
+	log.Info("DHCP Server network details", "details", *dhcpServer.Network)
+	s.SetStatus(ctx, infrav1.ResourceTypeNetwork, infrav1.ResourceReference{ID: dhcpServer.Network.ID, ControllerCreated: ptr.To(true)})
+	return dhcpServer.ID, nil
+}
+
+// newDHCPServerCreateParams returns the parameters to create a DHCP server with the name and the configuration of dhcpServerDetails.
+func newDHCPServerCreateParams(name *string, dhcpServerDetails *infrav1.DHCPServer) *models.DHCPServerCreate {
+	dhcpServerCreateParams := &models.DHCPServerCreate{
+		Name: name,
+	}
+	if dhcpServerDetails == nil {
+		return dhcpServerCreateParams
+	}
 	if dhcpServerDetails.DNSServer != nil {
 		dhcpServerCreateParams.DNSServer = dhcpServerDetails.DNSServer
 	}
@@ -1041,21 +1302,143 @@ func (s *ClusterScope) createDHCPServer(ctx context.Context) (*string, error) {
 	if dhcpServerDetails.Snat != nil {
 		dhcpServerCreateParams.SnatEnabled = dhcpServerDetails.Snat
 	}
+	return dhcpServerCreateParams
+}
+
+// ReconcileDHCPNetworks reconciles the additional networks backed by DHCP servers set in spec.dhcpNetworks.
+// the DHCP server set with dhcpServer.id or the DHCP server whose network has the expected name is used if exists, otherwise a new DHCP server is created.
+// the DHCP servers created by the controller for the networks removed from spec.dhcpNetworks are deleted and the networks are removed from status.
+// returns true once the DHCP servers of all the networks are active.
+func (s *ClusterScope) ReconcileDHCPNetworks(ctx context.Context) (bool, error) {
+	var errs []error
+	active := true
+	names := sets.New[string]()
+	for _, network := range s.IBMPowerVSCluster.Spec.DHCPNetworks {
+		names.Insert(network.Name)
+		networkActive, err := s.reconcileDHCPNetwork(ctx, network)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile DHCP network %s: %w", network.Name, err))
+			continue
+		}
+		active = active && networkActive
+	}
+	for _, name := range slices.Sorted(maps.Keys(s.IBMPowerVSCluster.Status.DHCPNetworks)) {
+		if names.Has(name) {
+			continue
+		}
+		deleted, err := s.deleteDHCPNetworkServer(ctx, name)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if deleted {
+			delete(s.IBMPowerVSCluster.Status.DHCPNetworks, name)
+		}
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return active, nil
+}
+
+// reconcileDHCPNetwork reconciles the DHCP server of the network and sets its network in status once the DHCP server is active.
+func (s *ClusterScope) reconcileDHCPNetwork(ctx context.Context, network infrav1.DHCPNetwork) (bool, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("dhcpNetwork", network.Name)
+	status := s.IBMPowerVSCluster.Status.DHCPNetworks[network.Name]
+	if status.DHCPServer == nil || status.DHCPServer.ID == nil {
+		dhcpServerID, err := s.checkDHCPNetworkServer(ctx, network)
+		if err != nil {
+			return false, fmt.Errorf("failed to check if DHCP server exists: %w", err)
+		}
+		if dhcpServerID != nil {
+			log.V(3).Info("Found DHCP server in cloud", "dhcpServerID", *dhcpServerID)
+			s.setDHCPNetworkStatus(network.Name, infrav1.DHCPNetworkStatus{
+				DHCPServer: &infrav1.ResourceReference{ID: dhcpServerID, ControllerCreated: ptr.To(false)},
+			})
+			return false, nil
+		}
+
+		dhcpServerCreateParams := newDHCPServerCreateParams(ptr.To(s.dhcpNetworkServerName(network)), network.DHCPServer)
+		log.Info("Creating a new DHCP server", "name", dhcpServerCreateParams.Name)
+		dhcpServer, err := s.IBMPowerVSClient.CreateDHCPServer(dhcpServerCreateParams)
+		if err != nil {
+			return false, fmt.Errorf("failed to create a new DHCP server: %w", err)
+		}
+		if dhcpServer == nil || dhcpServer.Network == nil {
+			return false, fmt.Errorf("created DHCP server or its network is nil")
+		}
+		log.Info("Created DHCP Server", "dhcpServerID", *dhcpServer.ID)
+		s.setDHCPNetworkStatus(network.Name, infrav1.DHCPNetworkStatus{
+			DHCPServer: &infrav1.ResourceReference{ID: dhcpServer.ID, ControllerCreated: ptr.To(true)},
+			Network:    &infrav1.ResourceReference{ID: dhcpServer.Network.ID, ControllerCreated: ptr.To(true)},
+		})
+		return false, nil
+	}
 
-	dhcpServer, err := s.IBMPowerVSClient.CreateDHCPServer(&dhcpServerCreateParams)
+	dhcpServer, err := s.IBMPowerVSClient.GetDHCPServer(*status.DHCPServer.ID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create a new DHCP server: %w", err)
+		return false, fmt.Errorf("failed to fetch DHCP server: %w", err)
 	}
 	if dhcpServer == nil {
-		return nil, fmt.Errorf("created DHCP server is nil")
+		return false, fmt.Errorf("dhcp server details is nil for dhcpServerID: %s", *status.DHCPServer.ID)
 	}
-	if dhcpServer.Network == nil {
-		return nil, fmt.Errorf("created DHCP server network is nil")
+	active, err := s.checkDHCPServerStatus(ctx, *dhcpServer)
+	if err != nil || !active {
+		return false, err
 	}
+	if status.Network == nil || status.Network.ID == nil {
+		if dhcpServer.Network == nil || dhcpServer.Network.ID == nil {
+			return false, fmt.Errorf("found DHCP server with ID `%s`, but network is nil", *status.DHCPServer.ID)
+		}
+		status.Network = &infrav1.ResourceReference{ID: dhcpServer.Network.ID, ControllerCreated: status.DHCPServer.ControllerCreated}
+		s.setDHCPNetworkStatus(network.Name, status)
+	}
+	return true, nil
+}
 
-	log.Info("DHCP Server network details", "details", *dhcpServer.Network)
-	s.SetStatus(ctx, infrav1.ResourceTypeNetwork, infrav1.ResourceReference{ID: dhcpServer.Network.ID, ControllerCreated: ptr.To(true)})
-	return dhcpServer.ID, nil
+// checkDHCPNetworkServer checks if the DHCP server of the network exists in cloud with the ID set in spec or with the expected network name.
+func (s *ClusterScope) checkDHCPNetworkServer(ctx context.Context, network infrav1.DHCPNetwork) (*string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if network.DHCPServer != nil && network.DHCPServer.ID != nil {
+		dhcpServer, err := s.IBMPowerVSClient.GetDHCPServer(*network.DHCPServer.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch DHCP server: %w", err)
+		}
+		if dhcpServer == nil {
+			return nil, fmt.Errorf("dhcp server details is nil for dhcpServerID: %s", *network.DHCPServer.ID)
+		}
+		return dhcpServer.ID, nil
+	}
+
+	networkName := dhcpNetworkName(s.dhcpNetworkServerName(network))
+	log.V(3).Info("Checking DHCP server's network list by network name", "name", networkName)
+	dhcpServers, err := s.IBMPowerVSClient.GetAllDHCPServers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch all DHCP servers: %w", err)
+	}
+	for _, dhcpServer := range dhcpServers {
+		if dhcpServer.Network != nil && dhcpServer.Network.Name != nil && *dhcpServer.Network.Name == networkName {
+			return dhcpServer.ID, nil
+		}
+	}
+	return nil, nil
+}
+
+// dhcpNetworkServerName returns the name of the DHCP server of the network.
+func (s *ClusterScope) dhcpNetworkServerName(network infrav1.DHCPNetwork) string {
+	if network.DHCPServer != nil && network.DHCPServer.Name != nil {
+		return *network.DHCPServer.Name
+	}
+	cluster := s.IBMPowerVSCluster
+	return fmt.Sprintf("%s-%s", *generateServiceName(cluster, infrav1.ResourceTypeDHCPServer, cluster.Name), network.Name)
+}
+
+// setDHCPNetworkStatus sets the status of the DHCP network with the name.
+func (s *ClusterScope) setDHCPNetworkStatus(name string, status infrav1.DHCPNetworkStatus) {
+	if s.IBMPowerVSCluster.Status.DHCPNetworks == nil {
+		s.IBMPowerVSCluster.Status.DHCPNetworks = make(map[string]infrav1.DHCPNetworkStatus)
+	}
+	s.IBMPowerVSCluster.Status.DHCPNetworks[name] = status
 }
 
 // ReconcileVPC reconciles VPC.
@@ -1190,8 +1573,12 @@ func (s *ClusterScope) ReconcileVPCSubnets(ctx context.Context) (bool, error) {
 		// if the user did not set any subnet, we try to create subnet in all the zones.
 		log.V(3).Info("VPC subnets details are not set in spec, creating subnets in all zones in the region", "region", *s.VPC().Region)
 		for _, zone := range vpcZones {
+			name, err := s.GetZonalServiceName(infrav1.ResourceTypeSubnet, zone)
+			if err != nil {
+				return false, err
+			}
 			subnet := infrav1.Subnet{
-				Name: ptr.To(fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1.ResourceTypeSubnet), zone)),
+				Name: name,
 				Zone: ptr.To(zone),
 			}
 			subnets = append(subnets, subnet)
@@ -1534,7 +1921,6 @@ func (s *ClusterScope) createVPCSecurityGroup(ctx context.Context, spec infrav1.
 	if err != nil {
 		return nil, fmt.Errorf("error creating VPC security group: %w", err)
 	}
-	// To-Do: Add tags to VPC security group, need to implement the client for "github.com/IBM/platform-services-go-sdk/globaltaggingv1".
 	return securityGroup.ID, nil
 }
 
@@ -1712,68 +2098,209 @@ func (s *ClusterScope) validateVPCSecurityGroup(ctx context.Context, securityGro
 	return securityGroupDet, ruleIDs, nil
 }
 
-// ReconcileTransitGateway reconcile transit gateway.
-func (s *ClusterScope) ReconcileTransitGateway(ctx context.Context) (bool, error) {
+// ReconcileVPCEndpointGateways reconciles VPC endpoint gateways.
+// If an endpoint gateway was just created or is not yet stable, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScope) ReconcileVPCEndpointGateways(ctx context.Context) (bool, error) {
+	vpcID := s.GetVPCID()
+	if vpcID == nil {
+		return false, fmt.Errorf("VPC ID is empty")
+	}
+	requeue := false
+	for _, endpointGateway := range s.IBMPowerVSCluster.Spec.VPCEndpointGateways {
+		requiresRequeue, err := s.reconcileVPCEndpointGateway(ctx, vpcID, endpointGateway)
+		if err != nil {
+			return false, fmt.Errorf("failed to reconcile VPC endpoint gateway '%s': %w", endpointGateway.Name, err)
+		}
+		if requiresRequeue {
+			requeue = true
+		}
+	}
+	return requeue, nil
+}
+
+// reconcileVPCEndpointGateway checks whether the VPC endpoint gateway exists and is stable, creating it if it doesn't exist.
+func (s *ClusterScope) reconcileVPCEndpointGateway(ctx context.Context, vpcID *string, endpointGateway infrav1.VPCEndpointGateway) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
-	if s.GetTransitGatewayID() != nil {
-		log.V(3).Info("Transit gateway ID is set, fetching details", "transitGatewayID", s.GetTransitGatewayID())
-		tg, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
-			ID: s.GetTransitGatewayID(),
+	var endpointGatewayDetails *vpcv1.EndpointGateway
+	controllerCreated := false
+	if status, ok := s.IBMPowerVSCluster.Status.VPCEndpointGateways[endpointGateway.Name]; ok && status.ID != nil {
+		log.V(3).Info("VPC endpoint gateway ID is set, fetching details", "name", endpointGateway.Name, "endpointGatewayID", *status.ID)
+		details, _, err := s.IBMVPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: status.ID,
 		})
 		if err != nil {
-			return false, fmt.Errorf("failed to get transit gateway: %w", err)
+			return false, fmt.Errorf("failed to fetch VPC endpoint gateway: %w", err)
 		}
-		requeue, err := s.checkAndUpdateTransitGateway(ctx, tg)
+		endpointGatewayDetails = details
+		controllerCreated = ptr.Deref(status.ControllerCreated, false)
+	} else {
+		details, err := s.IBMVPCClient.GetEndpointGatewayByName(*vpcID, endpointGateway.Name)
 		if err != nil {
-			return false, fmt.Errorf("failed to check and update transit gateway: %w", err)
+			return false, fmt.Errorf("failed to fetch VPC endpoint gateway by name: %w", err)
 		}
-		return requeue, nil
-	}
-
-	// check transit gateway exist in cloud
-	tg, err := s.isTransitGatewayExists(ctx)
-	if err != nil {
-		return false, fmt.Errorf("failed to check if transit gateway exists: %w", err)
+		endpointGatewayDetails = details
 	}
 
-	// check the status and update the transit gateway's connections if they are not proper
-	if tg != nil {
-		requeue, err := s.checkAndUpdateTransitGateway(ctx, tg)
+	if endpointGatewayDetails == nil {
+		log.Info("Creating VPC endpoint gateway", "name", endpointGateway.Name, "serviceCRN", endpointGateway.ServiceCRN)
+		details, err := s.createVPCEndpointGateway(vpcID, endpointGateway)
 		if err != nil {
-			return false, fmt.Errorf("failed to check and update transit gateway connections: %w", err)
+			return false, fmt.Errorf("failed to create VPC endpoint gateway: %w", err)
 		}
-		return requeue, nil
+		log.Info("Created VPC endpoint gateway", "name", endpointGateway.Name, "endpointGatewayID", *details.ID)
+		s.SetVPCEndpointGatewayStatus(ctx, endpointGateway.Name, details, true)
+		return true, nil
 	}
 
-	// create transit gateway
-	log.Info("Creating transit gateway")
-	if err := s.createTransitGateway(ctx); err != nil {
-		return false, fmt.Errorf("failed to create transit gateway: %w", err)
+	s.SetVPCEndpointGatewayStatus(ctx, endpointGateway.Name, endpointGatewayDetails, controllerCreated)
+	switch ptr.Deref(endpointGatewayDetails.LifecycleState, "") {
+	case vpcv1.EndpointGatewayLifecycleStateStableConst:
+		return false, nil
+	case vpcv1.EndpointGatewayLifecycleStateFailedConst, vpcv1.EndpointGatewayLifecycleStateSuspendedConst:
+		return false, fmt.Errorf("VPC endpoint gateway is in %s state", *endpointGatewayDetails.LifecycleState)
 	}
-
+	log.V(3).Info("VPC endpoint gateway is not yet stable", "name", endpointGateway.Name, "state", ptr.Deref(endpointGatewayDetails.LifecycleState, ""))
 	return true, nil
 }
 
-// isTransitGatewayExists checks transit gateway exist in cloud.
-func (s *ClusterScope) isTransitGatewayExists(ctx context.Context) (*tgapiv1.TransitGateway, error) {
-	log := ctrl.LoggerFrom(ctx)
-	// TODO(karthik-k-n): Support regex
-	var transitGateway *tgapiv1.TransitGateway
-	var err error
+// createVPCEndpointGateway creates a VPC endpoint gateway with a reserved IP in a VPC subnet of each zone.
+func (s *ClusterScope) createVPCEndpointGateway(vpcID *string, endpointGateway infrav1.VPCEndpointGateway) (*vpcv1.EndpointGateway, error) {
+	resourceGroupID := s.GetResourceGroupID()
+	if resourceGroupID == "" {
+		return nil, fmt.Errorf("failed to fetch resource group ID for resource group %v, ID is empty", s.ResourceGroup())
+	}
 
-	if s.IBMPowerVSCluster.Spec.TransitGateway != nil && s.IBMPowerVSCluster.Spec.TransitGateway.ID != nil {
-		transitGateway, _, err = s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
-			ID: s.IBMPowerVSCluster.Spec.TransitGateway.ID,
+	subnetIDs, err := s.getVPCEndpointGatewaySubnetIDs()
+	if err != nil {
+		return nil, err
+	}
+	reservedIPs := make([]vpcv1.EndpointGatewayReservedIPIntf, 0, len(subnetIDs))
+	for _, subnetID := range subnetIDs {
+		reservedIPs = append(reservedIPs, &vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext{
+			AutoDelete: ptr.To(true),
+			Subnet: &vpcv1.SubnetIdentityByID{
+				ID: subnetID,
+			},
 		})
-	} else {
-		transitGateway, err = s.TransitGatewayClient.GetTransitGatewayByName(*s.GetServiceName(infrav1.ResourceTypeTransitGateway))
 	}
 
+	endpointGatewayDetails, _, err := s.IBMVPCClient.CreateEndpointGateway(&vpcv1.CreateEndpointGatewayOptions{
+		Name:   ptr.To(endpointGateway.Name),
+		Target: genutil.GetEndpointGatewayTarget(endpointGateway.ServiceCRN),
+		VPC: &vpcv1.VPCIdentityByID{
+			ID: vpcID,
+		},
+		Ips: reservedIPs,
+		ResourceGroup: &vpcv1.ResourceGroupIdentityByID{
+			ID: &resourceGroupID,
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
+	if endpointGatewayDetails == nil || endpointGatewayDetails.ID == nil {
+		return nil, fmt.Errorf("created VPC endpoint gateway is nil")
+	}
+	return endpointGatewayDetails, nil
+}
 
-	if transitGateway == nil || transitGateway.ID == nil {
+// getVPCEndpointGatewaySubnetIDs returns a VPC subnet id for each zone, as an endpoint gateway can only have a single reserved IP per zone.
+func (s *ClusterScope) getVPCEndpointGatewaySubnetIDs() ([]*string, error) {
+	subnetIDs := s.GetVPCSubnetIDs()
+	if len(subnetIDs) == 0 {
+		return nil, fmt.Errorf("no subnets are present for VPC endpoint gateway creation")
+	}
+	// Sort the subnet ids to keep the selection stable across reconciliations.
+	slices.SortFunc(subnetIDs, func(a, b *string) int {
+		return strings.Compare(ptr.Deref(a, ""), ptr.Deref(b, ""))
+	})
+
+	zones := make(map[string]bool)
+	zoneSubnetIDs := []*string{}
+	for _, subnetID := range subnetIDs {
+		if subnetID == nil {
+			continue
+		}
+		subnet, _, err := s.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: subnetID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch VPC subnet '%s': %w", *subnetID, err)
+		}
+		if subnet == nil || subnet.Zone == nil || subnet.Zone.Name == nil {
+			return nil, fmt.Errorf("failed to fetch zone of VPC subnet '%s'", *subnetID)
+		}
+		if zones[*subnet.Zone.Name] {
+			continue
+		}
+		zones[*subnet.Zone.Name] = true
+		zoneSubnetIDs = append(zoneSubnetIDs, subnetID)
+	}
+	return zoneSubnetIDs, nil
+}
+
+// ReconcileTransitGateway reconcile transit gateway.
+func (s *ClusterScope) ReconcileTransitGateway(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.GetTransitGatewayID() != nil {
+		log.V(3).Info("Transit gateway ID is set, fetching details", "transitGatewayID", s.GetTransitGatewayID())
+		tg, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+			ID: s.GetTransitGatewayID(),
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get transit gateway: %w", err)
+		}
+		requeue, err := s.checkAndUpdateTransitGateway(ctx, tg)
+		if err != nil {
+			return false, fmt.Errorf("failed to check and update transit gateway: %w", err)
+		}
+		return requeue, nil
+	}
+
+	// check transit gateway exist in cloud
+	tg, err := s.isTransitGatewayExists(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if transit gateway exists: %w", err)
+	}
+
+	// check the status and update the transit gateway's connections if they are not proper
+	if tg != nil {
+		requeue, err := s.checkAndUpdateTransitGateway(ctx, tg)
+		if err != nil {
+			return false, fmt.Errorf("failed to check and update transit gateway connections: %w", err)
+		}
+		return requeue, nil
+	}
+
+	// create transit gateway
+	log.Info("Creating transit gateway")
+	if err := s.createTransitGateway(ctx); err != nil {
+		return false, fmt.Errorf("failed to create transit gateway: %w", err)
+	}
+
+	return true, nil
+}
+
+// isTransitGatewayExists checks transit gateway exist in cloud.
+func (s *ClusterScope) isTransitGatewayExists(ctx context.Context) (*tgapiv1.TransitGateway, error) {
+	log := ctrl.LoggerFrom(ctx)
+	// TODO(karthik-k-n): Support regex
+	var transitGateway *tgapiv1.TransitGateway
+	var err error
+
+	if s.IBMPowerVSCluster.Spec.TransitGateway != nil && s.IBMPowerVSCluster.Spec.TransitGateway.ID != nil {
+		transitGateway, _, err = s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+			ID: s.IBMPowerVSCluster.Spec.TransitGateway.ID,
+		})
+	} else {
+		transitGateway, err = s.TransitGatewayClient.GetTransitGatewayByName(*s.GetServiceName(infrav1.ResourceTypeTransitGateway))
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if transitGateway == nil || transitGateway.ID == nil {
 		log.Info("Transit gateway not found in cloud")
 		return nil, nil
 	}
@@ -1853,9 +2380,27 @@ func (s *ClusterScope) checkAndUpdateTransitGatewayConnections(ctx context.Conte
 		return requeue, nil
 	}
 
-	// return when connections are in attached state.
+	// reconcile the prefix filters and the additional connections when connections are in attached state.
 	if powerVSConnStatus && vpcConnStatus {
-		return false, nil
+		requeue, err := s.reconcileTransitGatewayClusterConnectionsPrefixFilters(ctx, transitGateway, tgConnections.Connections)
+		if err != nil {
+			return false, fmt.Errorf("failed to reconcile transit gateway connections prefix filters: %w", err)
+		} else if requeue {
+			return requeue, nil
+		}
+
+		requeue, err = s.reconcileTransitGatewayAdditionalConnections(ctx, transitGateway, tgConnections.Connections)
+		if err != nil {
+			return false, fmt.Errorf("failed to reconcile additional transit gateway connections: %w", err)
+		} else if requeue {
+			return requeue, nil
+		}
+
+		requeue, err = s.reconcileTransitGatewayRouteReport(ctx, transitGateway)
+		if err != nil {
+			return false, fmt.Errorf("failed to reconcile transit gateway route report: %w", err)
+		}
+		return requeue, nil
 	}
 
 	// update the connections when connection not exist
@@ -1942,10 +2487,344 @@ func (s *ClusterScope) createTransitGatewayConnection(ctx context.Context, trans
 		return err
 	}
 	s.SetTransitGatewayConnectionStatus(networkType, &infrav1.ResourceReference{ID: conn.ID, ControllerCreated: ptr.To(true)})
+	s.resetTransitGatewayRouteReport()
+
+	return nil
+}
+
+// reconcileTransitGatewayAdditionalConnections creates the additional connections of the transit gateway which do not exist and checks the state of the existing ones.
+// the additional connections created by the controller which were removed from the spec are deleted.
+// If a connection is created, deleted or not yet attached, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScope) reconcileTransitGatewayAdditionalConnections(ctx context.Context, transitGateway *tgapiv1.TransitGateway, connections []tgapiv1.TransitGatewayConnectionCust) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var specConnections []infrav1.TransitGatewayConnection
+	if s.IBMPowerVSCluster.Spec.TransitGateway != nil {
+		specConnections = s.IBMPowerVSCluster.Spec.TransitGateway.Connections
+	}
+
+	requeue := false
+	desiredConnections := make(map[string]bool)
+	for _, connection := range specConnections {
+		desiredConnections[connection.Name] = true
+		conn := findTransitGatewayConnection(connections, connection)
+		if conn == nil {
+			log.Info("Creating additional transit gateway connection", "connectionName", connection.Name, "networkType", connection.NetworkType)
+			createdConn, _, err := s.TransitGatewayClient.CreateTransitGatewayConnection(buildTransitGatewayConnectionOptions(transitGateway.ID, connection))
+			if err != nil {
+				return false, fmt.Errorf("failed to create transit gateway connection %s: %w", connection.Name, err)
+			}
+			s.SetTransitGatewayAdditionalConnectionStatus(infrav1.TransitGatewayConnectionStatus{Name: connection.Name, ID: createdConn.ID, ControllerCreated: ptr.To(true)})
+			s.resetTransitGatewayRouteReport()
+			requeue = true
+			continue
+		}
+
+		pending, err := s.checkTransitGatewayConnectionStatus(ctx, *conn)
+		if err != nil {
+			return false, fmt.Errorf("failed to check transit gateway connection %s status: %w", connection.Name, err)
+		}
+		requeue = requeue || pending
+
+		// a connection which is not yet in status already existed in the transit gateway.
+		connectionStatus := s.getTransitGatewayAdditionalConnectionStatus(connection.Name)
+		if connectionStatus == nil {
+			s.SetTransitGatewayAdditionalConnectionStatus(infrav1.TransitGatewayConnectionStatus{Name: connection.Name, ID: conn.ID, ControllerCreated: ptr.To(false)})
+		}
+
+		// the prefix filters of a connection which already existed are managed only when they are set in spec.
+		controllerCreated := connectionStatus != nil && ptr.Deref(connectionStatus.ControllerCreated, false)
+		if pending || (!controllerCreated && connection.PrefixFilters == nil && connection.PrefixFiltersDefault == nil) {
+			continue
+		}
+		updated, err := s.reconcileTransitGatewayConnectionPrefixFilters(ctx, transitGateway.ID, *conn, &infrav1.TransitGatewayConnectionPrefixFilters{
+			PrefixFilters:        connection.PrefixFilters,
+			PrefixFiltersDefault: connection.PrefixFiltersDefault,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to reconcile transit gateway connection %s prefix filters: %w", connection.Name, err)
+		}
+		requeue = requeue || updated
+	}
+
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return requeue, nil
+	}
+	for _, connectionStatus := range slices.Clone(s.IBMPowerVSCluster.Status.TransitGateway.Connections) {
+		if desiredConnections[connectionStatus.Name] {
+			continue
+		}
+		if !ptr.Deref(connectionStatus.ControllerCreated, false) {
+			log.Info("Skipping transit gateway connection deletion as resource is not created by controller", "connectionName", connectionStatus.Name)
+			s.removeTransitGatewayAdditionalConnectionStatus(connectionStatus.Name)
+			continue
+		}
+		log.Info("Deleting additional transit gateway connection removed from spec", "connectionName", connectionStatus.Name)
+		deleting, err := s.deleteTransitGatewayConnection(ctx, transitGateway.ID, connectionStatus.ID)
+		if err != nil {
+			return false, err
+		}
+		s.resetTransitGatewayRouteReport()
+		if !deleting {
+			s.removeTransitGatewayAdditionalConnectionStatus(connectionStatus.Name)
+			continue
+		}
+		requeue = true
+	}
+	return requeue, nil
+}
+
+// reconcileTransitGatewayClusterConnectionsPrefixFilters updates the prefix filters of the PowerVS and VPC connections of the transit gateway to match the ones set in spec.
+// If a connection is updated, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScope) reconcileTransitGatewayClusterConnectionsPrefixFilters(ctx context.Context, transitGateway *tgapiv1.TransitGateway, connections []tgapiv1.TransitGatewayConnectionCust) (bool, error) {
+	if s.IBMPowerVSCluster.Spec.TransitGateway == nil || s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return false, nil
+	}
+
+	clusterConnections := []struct {
+		status        *infrav1.ResourceReference
+		prefixFilters *infrav1.TransitGatewayConnectionPrefixFilters
+	}{
+		{status: s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection, prefixFilters: s.IBMPowerVSCluster.Spec.TransitGateway.PowerVSConnection},
+		{status: s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection, prefixFilters: s.IBMPowerVSCluster.Spec.TransitGateway.VPCConnection},
+	}
+	for _, clusterConnection := range clusterConnections {
+		if clusterConnection.prefixFilters == nil || clusterConnection.status == nil || clusterConnection.status.ID == nil {
+			continue
+		}
+		for _, conn := range connections {
+			if ptr.Deref(conn.ID, "") != *clusterConnection.status.ID {
+				continue
+			}
+			updated, err := s.reconcileTransitGatewayConnectionPrefixFilters(ctx, transitGateway.ID, conn, clusterConnection.prefixFilters)
+			if err != nil {
+				return false, fmt.Errorf("failed to reconcile transit gateway connection %s prefix filters: %w", ptr.Deref(conn.Name, ""), err)
+			} else if updated {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// reconcileTransitGatewayConnectionPrefixFilters updates the prefix filters of the transit gateway connection to match the desired ones.
+// filters are evaluated in order, so the existing filters are deleted starting from the last one until they match the beginning of the desired filters,
+// then the missing filters are appended one at a time.
+// If the connection is updated, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScope) reconcileTransitGatewayConnectionPrefixFilters(ctx context.Context, transitGatewayID *string, conn tgapiv1.TransitGatewayConnectionCust, prefixFilters *infrav1.TransitGatewayConnectionPrefixFilters) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	prefixFiltersDefault := string(infrav1.TransitGatewayPrefixFilterActionPermit)
+	if prefixFilters.PrefixFiltersDefault != nil {
+		prefixFiltersDefault = string(*prefixFilters.PrefixFiltersDefault)
+	}
+	if ptr.Deref(conn.PrefixFiltersDefault, string(infrav1.TransitGatewayPrefixFilterActionPermit)) != prefixFiltersDefault {
+		log.Info("Updating transit gateway connection prefix filters default", "connectionName", conn.Name, "prefixFiltersDefault", prefixFiltersDefault)
+		if _, _, err := s.TransitGatewayClient.UpdateTransitGatewayConnection(&tgapiv1.UpdateTransitGatewayConnectionOptions{
+			TransitGatewayID:     transitGatewayID,
+			ID:                   conn.ID,
+			PrefixFiltersDefault: ptr.To(prefixFiltersDefault),
+		}); err != nil {
+			return false, fmt.Errorf("failed to update transit gateway connection prefix filters default: %w", err)
+		}
+		s.resetTransitGatewayRouteReport()
+		return true, nil
+	}
+
+	matched := 0
+	for matched < len(conn.PrefixFilters) && matched < len(prefixFilters.PrefixFilters) && prefixFilterEqual(conn.PrefixFilters[matched], prefixFilters.PrefixFilters[matched]) {
+		matched++
+	}
+
+	if matched < len(conn.PrefixFilters) {
+		prefixFilter := conn.PrefixFilters[len(conn.PrefixFilters)-1]
+		log.Info("Deleting transit gateway connection prefix filter", "connectionName", conn.Name, "prefix", prefixFilter.Prefix)
+		if _, err := s.TransitGatewayClient.DeleteTransitGatewayConnectionPrefixFilter(&tgapiv1.DeleteTransitGatewayConnectionPrefixFilterOptions{
+			TransitGatewayID: transitGatewayID,
+			ID:               conn.ID,
+			FilterID:         prefixFilter.ID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to delete transit gateway connection prefix filter: %w", err)
+		}
+		s.resetTransitGatewayRouteReport()
+		return true, nil
+	}
+
+	if matched < len(prefixFilters.PrefixFilters) {
+		prefixFilter := prefixFilters.PrefixFilters[matched]
+		log.Info("Creating transit gateway connection prefix filter", "connectionName", conn.Name, "prefix", prefixFilter.Prefix)
+		if _, _, err := s.TransitGatewayClient.CreateTransitGatewayConnectionPrefixFilter(&tgapiv1.CreateTransitGatewayConnectionPrefixFilterOptions{
+			TransitGatewayID: transitGatewayID,
+			ID:               conn.ID,
+			Action:           ptr.To(string(prefixFilter.Action)),
+			Prefix:           ptr.To(prefixFilter.Prefix),
+			Ge:               prefixFilter.Ge,
+			Le:               prefixFilter.Le,
+		}); err != nil {
+			return false, fmt.Errorf("failed to create transit gateway connection prefix filter: %w", err)
+		}
+		s.resetTransitGatewayRouteReport()
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// prefixFilterEqual returns true if the prefix filter of the transit gateway connection matches the desired one.
+func prefixFilterEqual(existing tgapiv1.TransitGatewayConnectionPrefixFilterReference, desired infrav1.TransitGatewayPrefixFilter) bool {
+	return ptr.Deref(existing.Action, "") == string(desired.Action) &&
+		ptr.Deref(existing.Prefix, "") == desired.Prefix &&
+		ptr.Equal(existing.Ge, desired.Ge) &&
+		ptr.Equal(existing.Le, desired.Le)
+}
+
+// reconcileTransitGatewayRouteReport generates the route report of the transit gateway when it is requested in spec and records the overlapping routes in status.
+// the report is deleted from the transit gateway once its result is recorded.
+// If the report is being generated, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScope) reconcileTransitGatewayRouteReport(ctx context.Context, transitGateway *tgapiv1.TransitGateway) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return false, nil
+	}
+	if s.IBMPowerVSCluster.Spec.TransitGateway == nil || !ptr.Deref(s.IBMPowerVSCluster.Spec.TransitGateway.GenerateRouteReport, false) {
+		s.resetTransitGatewayRouteReport()
+		return false, nil
+	}
+
+	routeReport := s.IBMPowerVSCluster.Status.TransitGateway.RouteReport
+	if routeReport == nil || routeReport.ID == nil {
+		log.Info("Generating transit gateway route report")
+		report, _, err := s.TransitGatewayClient.CreateTransitGatewayRouteReport(&tgapiv1.CreateTransitGatewayRouteReportOptions{
+			TransitGatewayID: transitGateway.ID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to create transit gateway route report: %w", err)
+		}
+		s.IBMPowerVSCluster.Status.TransitGateway.RouteReport = &infrav1.TransitGatewayRouteReportStatus{
+			ID:    report.ID,
+			State: infrav1.TransitGatewayRouteReportStatePending,
+		}
+		return true, nil
+	}
+
+	if routeReport.State != infrav1.TransitGatewayRouteReportStatePending {
+		return false, nil
+	}
+
+	report, resp, err := s.TransitGatewayClient.GetTransitGatewayRouteReport(&tgapiv1.GetTransitGatewayRouteReportOptions{
+		TransitGatewayID: transitGateway.ID,
+		ID:               routeReport.ID,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+			log.Info("Transit gateway route report not found, generating it again", "routeReportID", routeReport.ID)
+			s.resetTransitGatewayRouteReport()
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get transit gateway route report: %w", err)
+	}
+
+	switch ptr.Deref(report.Status, "") {
+	case string(infrav1.TransitGatewayRouteReportStatePending):
+		log.V(3).Info("Transit gateway route report is in pending state")
+		return true, nil
+	case string(infrav1.TransitGatewayRouteReportStateFailed):
+		s.resetTransitGatewayRouteReport()
+		return false, fmt.Errorf("failed to generate transit gateway route report, current status: %s", *report.Status)
+	}
+
+	routeReport.State = infrav1.TransitGatewayRouteReportStateComplete
+	routeReport.OverlappingRoutes = getTransitGatewayOverlappingRoutes(report)
+	log.Info("Transit gateway route report is generated", "overlappingRoutes", len(routeReport.OverlappingRoutes))
+
+	if _, err := s.TransitGatewayClient.DeleteTransitGatewayRouteReport(&tgapiv1.DeleteTransitGatewayRouteReportOptions{
+		TransitGatewayID: transitGateway.ID,
+		ID:               report.ID,
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete transit gateway route report: %w", err)
+	}
+	return false, nil
+}
+
+// getTransitGatewayOverlappingRoutes returns the overlapping routes of the route report with the name of the connection each route is learned from.
+func getTransitGatewayOverlappingRoutes(report *tgapiv1.RouteReport) []infrav1.TransitGatewayOverlappingRoutes {
+	connectionNames := make(map[string]string)
+	for _, connection := range report.Connections {
+		connectionNames[ptr.Deref(connection.ID, "")] = ptr.Deref(connection.Name, "")
+	}
+
+	var overlappingRoutes []infrav1.TransitGatewayOverlappingRoutes
+	for _, group := range report.OverlappingRoutes {
+		routes := infrav1.TransitGatewayOverlappingRoutes{}
+		for _, route := range group.Routes {
+			routes.Routes = append(routes.Routes, infrav1.TransitGatewayRoute{
+				Prefix:         ptr.Deref(route.Prefix, ""),
+				ConnectionName: connectionNames[ptr.Deref(route.ConnectionID, "")],
+			})
+		}
+		overlappingRoutes = append(overlappingRoutes, routes)
+	}
+	return overlappingRoutes
+}
+
+// resetTransitGatewayRouteReport clears the route report status so that the report is generated again.
+func (s *ClusterScope) resetTransitGatewayRouteReport() {
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return
+	}
+	s.IBMPowerVSCluster.Status.TransitGateway.RouteReport = nil
+}
+
+// getTransitGatewayAdditionalConnectionStatus returns the status of an additional connection of Transit gateway, or nil if it is not set.
+func (s *ClusterScope) getTransitGatewayAdditionalConnectionStatus(name string) *infrav1.TransitGatewayConnectionStatus {
+	if s.IBMPowerVSCluster.Status.TransitGateway == nil {
+		return nil
+	}
+	for i := range s.IBMPowerVSCluster.Status.TransitGateway.Connections {
+		if s.IBMPowerVSCluster.Status.TransitGateway.Connections[i].Name == name {
+			return &s.IBMPowerVSCluster.Status.TransitGateway.Connections[i]
+		}
+	}
+	return nil
+}
 
+// findTransitGatewayConnection returns the transit gateway connection to the network of the additional connection, or nil if it does not exist.
+// a classic connection has no network CRN, so it is matched by name.
+func findTransitGatewayConnection(connections []tgapiv1.TransitGatewayConnectionCust, connection infrav1.TransitGatewayConnection) *tgapiv1.TransitGatewayConnectionCust {
+	for i, conn := range connections {
+		if ptr.Deref(conn.NetworkType, "") != string(connection.NetworkType) {
+			continue
+		}
+		if connection.NetworkCRN != nil && ptr.Deref(conn.NetworkID, "") == *connection.NetworkCRN {
+			return &connections[i]
+		}
+		if connection.NetworkCRN == nil && ptr.Deref(conn.Name, "") == connection.Name {
+			return &connections[i]
+		}
+	}
 	return nil
 }
 
+// buildTransitGatewayConnectionOptions builds the options to create the additional transit gateway connection.
+func buildTransitGatewayConnectionOptions(transitGatewayID *string, connection infrav1.TransitGatewayConnection) *tgapiv1.CreateTransitGatewayConnectionOptions {
+	options := &tgapiv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: transitGatewayID,
+		NetworkType:      ptr.To(string(connection.NetworkType)),
+		NetworkID:        connection.NetworkCRN,
+		Name:             ptr.To(connection.Name),
+	}
+	for _, prefixFilter := range connection.PrefixFilters {
+		options.PrefixFilters = append(options.PrefixFilters, tgapiv1.TransitGatewayConnectionPrefixFilter{
+			Action: ptr.To(string(prefixFilter.Action)),
+			Prefix: ptr.To(prefixFilter.Prefix),
+			Ge:     prefixFilter.Ge,
+			Le:     prefixFilter.Le,
+		})
+	}
+	if connection.PrefixFiltersDefault != nil {
+		options.PrefixFiltersDefault = ptr.To(string(*connection.PrefixFiltersDefault))
+	}
+	return options
+}
+
 // createTransitGatewayConnections creates PowerVS and VPC connections in the transit gateway.
 func (s *ClusterScope) createTransitGatewayConnections(ctx context.Context, tg *tgapiv1.TransitGateway, pvsServiceInstanceCRN, vpcCRN *string) error {
 	if err := s.createTransitGatewayConnection(ctx, tg.ID, ptr.To(getTGPowerVSConnectionName(*tg.Name)), pvsServiceInstanceCRN, powervsNetworkConnectionType); err != nil {
@@ -2043,6 +2922,7 @@ func (s *ClusterScope) ReconcileLoadBalancers(ctx context.Context) (bool, error)
 			loadBalancerID = s.GetLoadBalancerID(loadBalancer.Name)
 		}
 		if loadBalancerID != nil {
+			loadBalancerSpec := loadBalancer
 			log.V(3).Info("Load balancer ID is set, fetching load balancer details", "loadBalancerID", *loadBalancerID)
 			loadBalancer, _, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
 				ID: loadBalancerID,
@@ -2051,16 +2931,25 @@ func (s *ClusterScope) ReconcileLoadBalancers(ctx context.Context) (bool, error)
 				return false, fmt.Errorf("failed to fetch load balancer details: %w", err)
 			}
 
+			// Carry over the listeners and pools recorded by previous reconciliations.
+			previousStatus := s.IBMPowerVSCluster.Status.LoadBalancers[*loadBalancer.Name]
+			loadBalancerStatus := infrav1.VPCLoadBalancerStatus{
+				ID:        loadBalancer.ID,
+				State:     infrav1.VPCLoadBalancerState(*loadBalancer.ProvisioningStatus),
+				Hostname:  loadBalancer.Hostname,
+				Listeners: previousStatus.Listeners,
+				Pools:     previousStatus.Pools,
+			}
+
 			if isReady := s.checkLoadBalancerStatus(ctx, *loadBalancer); !isReady {
 				log.V(3).Info("LoadBalancer is still not Active", "loadBalancerName", *loadBalancer.Name, "state", *loadBalancer.ProvisioningStatus)
 				isAnyLoadBalancerNotReady = true
+			} else if updated, err := s.reconcileLoadBalancerListeners(ctx, loadBalancerSpec, loadBalancer, &loadBalancerStatus); err != nil {
+				return false, fmt.Errorf("failed to reconcile load balancer listeners: %w", err)
+			} else if updated {
+				isAnyLoadBalancerNotReady = true
 			}
 
-			loadBalancerStatus := infrav1.VPCLoadBalancerStatus{
-				ID:       loadBalancer.ID,
-				State:    infrav1.VPCLoadBalancerState(*loadBalancer.ProvisioningStatus),
-				Hostname: loadBalancer.Hostname,
-			}
 			s.SetLoadBalancerStatus(ctx, *loadBalancer.Name, loadBalancerStatus)
 			continue
 		}
@@ -2141,6 +3030,39 @@ func (s *ClusterScope) checkLoadBalancer(ctx context.Context, lb infrav1.VPCLoad
 	}, nil
 }
 
+// getLoadBalancerSubnetIDs returns the ids of the subnets set in the load balancer spec, defaulting to the VPC subnets.
+// Subnets set by name are looked up in the VPC subnet status.
+func (s *ClusterScope) getLoadBalancerSubnetIDs(lb infrav1.VPCLoadBalancerSpec) ([]*string, error) {
+	if len(lb.Subnets) == 0 {
+		subnetIDs := s.GetVPCSubnetIDs()
+		if len(subnetIDs) == 0 {
+			return nil, fmt.Errorf("no subnets are present for load balancer creation")
+		}
+		// Sort the subnet ids to keep the selection stable across reconciliations.
+		slices.SortFunc(subnetIDs, func(a, b *string) int {
+			return strings.Compare(ptr.Deref(a, ""), ptr.Deref(b, ""))
+		})
+		return subnetIDs, nil
+	}
+
+	subnetIDs := make([]*string, 0, len(lb.Subnets))
+	for _, subnet := range lb.Subnets {
+		switch {
+		case subnet.ID != nil:
+			subnetIDs = append(subnetIDs, subnet.ID)
+		case subnet.Name != nil:
+			subnetID := s.GetVPCSubnetID(*subnet.Name)
+			if subnetID == nil {
+				return nil, fmt.Errorf("failed to find VPC subnet %s for load balancer %s", *subnet.Name, lb.Name)
+			}
+			subnetIDs = append(subnetIDs, subnetID)
+		default:
+			return nil, fmt.Errorf("failed to find VPC subnet for load balancer %s, neither id nor name is set", lb.Name)
+		}
+	}
+	return subnetIDs, nil
+}
+
 // createLoadBalancer creates loadBalancer.
 func (s *ClusterScope) createLoadBalancer(ctx context.Context, lb infrav1.VPCLoadBalancerSpec) (*infrav1.VPCLoadBalancerStatus, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -2162,9 +3084,17 @@ func (s *ClusterScope) createLoadBalancer(ctx context.Context, lb infrav1.VPCLoa
 		ID: &resourceGroupID,
 	})
 
-	subnetIDs := s.GetVPCSubnetIDs()
-	if subnetIDs == nil {
-		return nil, fmt.Errorf("no subnets are present for load balancer creation")
+	subnetIDs, err := s.getLoadBalancerSubnetIDs(lb)
+	if err != nil {
+		return nil, err
+	}
+	// Only a network load balancer requires the profile, the API defaults to an application load balancer.
+	// A network load balancer supports a single subnet, so use the first subnet.
+	if lb.Profile == infrav1.VPCLoadBalancerProfileNetworkFixed {
+		options.SetProfile(&vpcv1.LoadBalancerProfileIdentityByName{
+			Name: ptr.To(string(lb.Profile)),
+		})
+		subnetIDs = subnetIDs[:1]
 	}
 	for _, subnetID := range subnetIDs {
 		subnet := &vpcv1.SubnetIdentity{
@@ -2192,23 +3122,9 @@ func (s *ClusterScope) createLoadBalancer(ctx context.Context, lb infrav1.VPCLoa
 		},
 	})
 
-	for _, additionalListeners := range lb.AdditionalListeners {
-		pool := vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext{
-			Algorithm:     core.StringPtr("round_robin"),
-			HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPrototype{Delay: core.Int64Ptr(5), MaxRetries: core.Int64Ptr(2), Timeout: core.Int64Ptr(2), Type: core.StringPtr("tcp")},
-			// Note: Appending port number to the name, it will be referenced to set target port while adding new pool member
-			Name:     ptr.To(fmt.Sprintf("additional-pool-%d", additionalListeners.Port)),
-			Protocol: core.StringPtr("tcp"),
-		}
+	for _, additionalListener := range lb.AdditionalListeners {
+		pool, listener := buildLoadBalancerListener(additionalListener)
 		options.Pools = append(options.Pools, pool)
-
-		listener := vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
-			Protocol: core.StringPtr("tcp"),
-			Port:     core.Int64Ptr(additionalListeners.Port),
-			DefaultPool: &vpcv1.LoadBalancerPoolIdentityByName{
-				Name: ptr.To(fmt.Sprintf("additional-pool-%d", additionalListeners.Port)),
-			},
-		}
 		options.Listeners = append(options.Listeners, listener)
 	}
 
@@ -2226,11 +3142,274 @@ func (s *ClusterScope) createLoadBalancer(ctx context.Context, lb infrav1.VPCLoa
 	}, nil
 }
 
+// buildLoadBalancerListener builds the additional listener and its default pool.
+// The HTTPS redirect is not part of the listener as it references the target listener by ID, it is set once the load balancer is created.
+func buildLoadBalancerListener(additionalListener infrav1.AdditionalListenerSpec) (vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext, vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext) {
+	poolName := additionalListenerPoolName(additionalListener.Port)
+	protocol := infrav1.VPCLoadBalancerListenerProtocolTCP
+	if additionalListener.Protocol != nil {
+		protocol = *additionalListener.Protocol
+	}
+	// HTTP and HTTPS listeners require a HTTP pool, TLS is terminated by the listener.
+	poolProtocol := infrav1.VPCLoadBalancerBackendPoolProtocolTCP
+	switch protocol {
+	case infrav1.VPCLoadBalancerListenerProtocolHTTP, infrav1.VPCLoadBalancerListenerProtocolHTTPS:
+		poolProtocol = infrav1.VPCLoadBalancerBackendPoolProtocolHTTP
+	case infrav1.VPCLoadBalancerListenerProtocolUDP:
+		poolProtocol = infrav1.VPCLoadBalancerBackendPoolProtocolUDP
+	}
+
+	pool := vpcv1.LoadBalancerPoolPrototypeLoadBalancerContext{
+		Algorithm:     core.StringPtr("round_robin"),
+		HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorPrototype{Delay: core.Int64Ptr(5), MaxRetries: core.Int64Ptr(2), Timeout: core.Int64Ptr(2), Type: core.StringPtr("tcp")},
+		Name:          ptr.To(poolName),
+		Protocol:      ptr.To(string(poolProtocol)),
+	}
+	listener := vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
+		Protocol: ptr.To(string(protocol)),
+		Port:     core.Int64Ptr(additionalListener.Port),
+		DefaultPool: &vpcv1.LoadBalancerPoolIdentityByName{
+			Name: ptr.To(poolName),
+		},
+		IdleConnectionTimeout: additionalListener.IdleConnectionTimeout,
+	}
+	if additionalListener.CertificateCRN != nil {
+		listener.CertificateInstance = &vpcv1.CertificateInstanceIdentityByCRN{
+			CRN: additionalListener.CertificateCRN,
+		}
+	}
+	return pool, listener
+}
+
+// reconcileLoadBalancerListeners reconciles the additional listeners of the load balancer and their pools against the spec.
+// Missing listeners and pools are created, the certificate, HTTPS redirect and idle connection timeout of the listeners are updated and,
+// if pruneListeners is set, the listeners and pools created for additional listeners which were removed from the spec are deleted.
+// The listener and pool IDs are recorded in status, which also holds the listeners created by previous reconciliations.
+// As the load balancer is not active while it is updated, at most one change is made per call and true is returned when a change was made.
+func (s *ClusterScope) reconcileLoadBalancerListeners(ctx context.Context, lb infrav1.VPCLoadBalancerSpec, loadBalancer *vpcv1.LoadBalancer, status *infrav1.VPCLoadBalancerStatus) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if len(lb.AdditionalListeners) == 0 && len(status.Listeners) == 0 {
+		return false, nil
+	}
+
+	listeners := make(map[int64]*vpcv1.LoadBalancerListener)
+	for _, listenerReference := range loadBalancer.Listeners {
+		listener, _, err := s.IBMVPCClient.GetLoadBalancerListener(&vpcv1.GetLoadBalancerListenerOptions{
+			LoadBalancerID: loadBalancer.ID,
+			ID:             listenerReference.ID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get load balancer listener %s: %w", *listenerReference.ID, err)
+		}
+		if listener.Port != nil {
+			listeners[*listener.Port] = listener
+		}
+	}
+	pools := make(map[string]*string)
+	for _, poolReference := range loadBalancer.Pools {
+		if poolReference.Name != nil {
+			pools[*poolReference.Name] = poolReference.ID
+		}
+	}
+
+	prune := ptr.Deref(lb.PruneListeners, false)
+	desiredPorts := make(map[int64]bool)
+	desiredPools := make(map[string]bool)
+	for _, additionalListener := range lb.AdditionalListeners {
+		desiredPorts[additionalListener.Port] = true
+		desiredPools[additionalListenerPoolName(additionalListener.Port)] = true
+	}
+	var prunedListeners []infrav1.VPCLoadBalancerListenerStatus
+	var prunedPools []infrav1.VPCLoadBalancerPoolStatus
+	if prune {
+		for _, listenerStatus := range status.Listeners {
+			if listener, ok := listeners[listenerStatus.Port]; ok && !desiredPorts[listenerStatus.Port] {
+				prunedListeners = append(prunedListeners, infrav1.VPCLoadBalancerListenerStatus{Port: listenerStatus.Port, ID: listener.ID})
+			}
+		}
+		for _, poolStatus := range status.Pools {
+			if poolID, ok := pools[poolStatus.Name]; ok && !desiredPools[poolStatus.Name] {
+				prunedPools = append(prunedPools, infrav1.VPCLoadBalancerPoolStatus{Name: poolStatus.Name, ID: poolID})
+			}
+		}
+	}
+
+	// Record the listeners and pools of the spec, along with the ones pending deletion.
+	status.Listeners = nil
+	status.Pools = nil
+	for _, additionalListener := range lb.AdditionalListeners {
+		if listener, ok := listeners[additionalListener.Port]; ok {
+			status.Listeners = append(status.Listeners, infrav1.VPCLoadBalancerListenerStatus{Port: additionalListener.Port, ID: listener.ID})
+		}
+		poolName := additionalListenerPoolName(additionalListener.Port)
+		if poolID, ok := pools[poolName]; ok {
+			status.Pools = append(status.Pools, infrav1.VPCLoadBalancerPoolStatus{Name: poolName, ID: poolID})
+		}
+	}
+	status.Listeners = append(status.Listeners, prunedListeners...)
+	status.Pools = append(status.Pools, prunedPools...)
+
+	for _, additionalListener := range lb.AdditionalListeners {
+		listener, ok := listeners[additionalListener.Port]
+		if !ok {
+			return true, s.createLoadBalancerListener(ctx, loadBalancer, additionalListener, pools)
+		}
+		listenerPatch, err := loadBalancerListenerPatch(additionalListener, listener, listeners)
+		if err != nil {
+			return false, fmt.Errorf("failed to compute patch for load balancer listener with port %d: %w", additionalListener.Port, err)
+		}
+		if listenerPatch == nil {
+			continue
+		}
+		log.Info("Updating load balancer listener", "loadBalancerName", *loadBalancer.Name, "port", additionalListener.Port)
+		if _, _, err := s.IBMVPCClient.UpdateLoadBalancerListener(&vpcv1.UpdateLoadBalancerListenerOptions{
+			LoadBalancerID:            loadBalancer.ID,
+			ID:                        listener.ID,
+			LoadBalancerListenerPatch: listenerPatch,
+		}); err != nil {
+			return false, fmt.Errorf("failed to update load balancer listener with port %d: %w", additionalListener.Port, err)
+		}
+		return true, nil
+	}
+
+	// Delete the listeners before their pools, as a pool cannot be deleted while it is the default pool of a listener.
+	for _, listenerStatus := range prunedListeners {
+		log.Info("Deleting load balancer listener", "loadBalancerName", *loadBalancer.Name, "port", listenerStatus.Port)
+		if _, err := s.IBMVPCClient.DeleteLoadBalancerListener(&vpcv1.DeleteLoadBalancerListenerOptions{
+			LoadBalancerID: loadBalancer.ID,
+			ID:             listenerStatus.ID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to delete load balancer listener with port %d: %w", listenerStatus.Port, err)
+		}
+		return true, nil
+	}
+	for _, poolStatus := range prunedPools {
+		log.Info("Deleting load balancer pool", "loadBalancerName", *loadBalancer.Name, "poolName", poolStatus.Name)
+		if _, err := s.IBMVPCClient.DeleteLoadBalancerPool(&vpcv1.DeleteLoadBalancerPoolOptions{
+			LoadBalancerID: loadBalancer.ID,
+			ID:             poolStatus.ID,
+		}); err != nil {
+			return false, fmt.Errorf("failed to delete load balancer pool %s: %w", poolStatus.Name, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// createLoadBalancerListener creates the additional listener, or its pool first if the pool does not exist.
+func (s *ClusterScope) createLoadBalancerListener(ctx context.Context, loadBalancer *vpcv1.LoadBalancer, additionalListener infrav1.AdditionalListenerSpec, pools map[string]*string) error {
+	log := ctrl.LoggerFrom(ctx)
+	pool, listener := buildLoadBalancerListener(additionalListener)
+	poolID, ok := pools[*pool.Name]
+	if !ok {
+		log.Info("Creating load balancer pool", "loadBalancerName", *loadBalancer.Name, "poolName", *pool.Name)
+		if _, _, err := s.IBMVPCClient.CreateLoadBalancerPool(&vpcv1.CreateLoadBalancerPoolOptions{
+			LoadBalancerID: loadBalancer.ID,
+			Algorithm:      pool.Algorithm,
+			HealthMonitor:  pool.HealthMonitor,
+			Name:           pool.Name,
+			Protocol:       pool.Protocol,
+		}); err != nil {
+			return fmt.Errorf("failed to create load balancer pool %s: %w", *pool.Name, err)
+		}
+		return nil
+	}
+
+	log.Info("Creating load balancer listener", "loadBalancerName", *loadBalancer.Name, "port", additionalListener.Port)
+	if _, _, err := s.IBMVPCClient.CreateLoadBalancerListener(&vpcv1.CreateLoadBalancerListenerOptions{
+		LoadBalancerID: loadBalancer.ID,
+		Port:           listener.Port,
+		Protocol:       listener.Protocol,
+		DefaultPool: &vpcv1.LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID{
+			ID: poolID,
+		},
+		CertificateInstance:   listener.CertificateInstance,
+		IdleConnectionTimeout: listener.IdleConnectionTimeout,
+	}); err != nil {
+		return fmt.Errorf("failed to create load balancer listener with port %d: %w", additionalListener.Port, err)
+	}
+	return nil
+}
+
+// additionalListenerPoolName returns the name of the default pool of the additional listener.
+func additionalListenerPoolName(port int64) string {
+	// Note: Appending port number to the name, it will be referenced to set target port while adding new pool member
+	return fmt.Sprintf("additional-pool-%d", port)
+}
+
+// loadBalancerListenerPatch returns the patch to apply to the listener to match the additional listener, or nil if the listener is up to date.
+func loadBalancerListenerPatch(additionalListener infrav1.AdditionalListenerSpec, listener *vpcv1.LoadBalancerListener, listeners map[int64]*vpcv1.LoadBalancerListener) (map[string]interface{}, error) {
+	listenerPatch := &vpcv1.LoadBalancerListenerPatch{}
+	changed := false
+	if additionalListener.CertificateCRN != nil && (listener.CertificateInstance == nil || ptr.Deref(listener.CertificateInstance.CRN, "") != *additionalListener.CertificateCRN) {
+		listenerPatch.CertificateInstance = &vpcv1.CertificateInstanceIdentityByCRN{
+			CRN: additionalListener.CertificateCRN,
+		}
+		changed = true
+	}
+	if additionalListener.IdleConnectionTimeout != nil && ptr.Deref(listener.IdleConnectionTimeout, 0) != *additionalListener.IdleConnectionTimeout {
+		listenerPatch.IdleConnectionTimeout = additionalListener.IdleConnectionTimeout
+		changed = true
+	}
+	removeRedirect := false
+	if redirect := additionalListener.HTTPSRedirect; redirect != nil {
+		target, ok := listeners[redirect.ListenerPort]
+		if !ok {
+			return nil, fmt.Errorf("redirect listener with port %d not found", redirect.ListenerPort)
+		}
+		statusCode := ptr.Deref(redirect.HTTPStatusCode, 301)
+		current := listener.HTTPSRedirect
+		if current == nil || current.Listener == nil || ptr.Deref(current.Listener.ID, "") != *target.ID || ptr.Deref(current.HTTPStatusCode, 0) != statusCode || ptr.Deref(current.URI, "") != ptr.Deref(redirect.URI, "") {
+			listenerPatch.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirectPatch{
+				HTTPStatusCode: ptr.To(statusCode),
+				Listener: &vpcv1.LoadBalancerListenerIdentityByID{
+					ID: target.ID,
+				},
+				URI: redirect.URI,
+			}
+			changed = true
+		}
+	} else if listener.HTTPSRedirect != nil {
+		removeRedirect = true
+	}
+	if !changed && !removeRedirect {
+		return nil, nil
+	}
+
+	patch, err := listenerPatch.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	if removeRedirect {
+		patch["https_redirect"] = nil
+	}
+	return patch, nil
+}
+
 // COSInstance returns the COS instance reference.
 func (s *ClusterScope) COSInstance() *infrav1.CosInstance {
 	return s.IBMPowerVSCluster.Spec.CosInstance
 }
 
+// DNSRecordHostName returns the fully qualified name of the control plane endpoint DNS record. If DNS is not set, returns empty string.
+func (s *ClusterScope) DNSRecordHostName() string {
+	if s.IBMPowerVSCluster.Spec.DNS == nil {
+		return ""
+	}
+	return dnsservices.RecordSpec(*s.IBMPowerVSCluster.Spec.DNS).HostName()
+}
+
+// ReconcileDNSRecord reconciles the control plane endpoint DNS record to resolve to target.
+// A CNAME record is used for a hostname and an A record for an IPv4 address.
+func (s *ClusterScope) ReconcileDNSRecord(ctx context.Context, target string) error {
+	if s.IBMPowerVSCluster.Spec.DNS == nil {
+		return nil
+	}
+	status, err := dnsservices.ReconcileRecord(ctx, s.DNSServicesClient, dnsservices.RecordSpec(*s.IBMPowerVSCluster.Spec.DNS), (*dnsservices.RecordStatus)(s.IBMPowerVSCluster.Status.DNS), target)
+	s.IBMPowerVSCluster.Status.DNS = (*infrav1.DNSRecordStatus)(status)
+	return err
+}
+
 // ReconcileCOSInstance reconcile COS bucket.
 func (s *ClusterScope) ReconcileCOSInstance(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx)
@@ -2463,49 +3642,126 @@ func (s *ClusterScope) fetchPowerVSServiceInstanceCRN() (*string, error) {
 // TODO(karthik-k-n): Decide on proper naming format for services.
 
 // GetServiceName returns name of given service type from spec or generate a name for it.
-func (s *ClusterScope) GetServiceName(resourceType infrav1.ResourceType) *string { //nolint:gocyclo
+func (s *ClusterScope) GetServiceName(resourceType infrav1.ResourceType) *string {
+	return getServiceName(s.IBMPowerVSCluster, resourceType)
+}
+
+// GetZonalServiceName returns the name of a resource created in each zone of the VPC region.
+// an error is returned when the resource name template fails to render, rather than falling back to the default name.
+func (s *ClusterScope) GetZonalServiceName(resourceType infrav1.ResourceType, zone string) (*string, error) {
+	if s.IBMPowerVSCluster.Spec.ResourceNameTemplate == nil {
+		return ptr.To(fmt.Sprintf("%s-%s", *s.GetServiceName(resourceType), zone)), nil
+	}
+	name, err := genutil.RenderZonalResourceName(*s.IBMPowerVSCluster.Spec.ResourceNameTemplate, resourceNameTemplateData(s.IBMPowerVSCluster, resourceType, zone))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the name of the %s in zone %s: %w", resourceType, zone, err)
+	}
+	return &name, nil
+}
+
+// CheckResourceNameTemplate renders the resource name template of the cluster for every resource type named by the controller.
+// it is called before any resource is reconciled, so resources are never created under the default names when the template fails to render.
+func (s *ClusterScope) CheckResourceNameTemplate() error {
+	if s.IBMPowerVSCluster.Spec.ResourceNameTemplate == nil {
+		return nil
+	}
+	for _, resourceType := range []infrav1.ResourceType{
+		infrav1.ResourceTypeServiceInstance,
+		infrav1.ResourceTypeDHCPServer,
+		infrav1.ResourceTypeVPC,
+		infrav1.ResourceTypeSubnet,
+		infrav1.ResourceTypeTransitGateway,
+		infrav1.ResourceTypeLoadBalancer,
+		infrav1.ResourceTypeCOSInstance,
+		infrav1.ResourceTypeCOSBucket,
+	} {
+		if _, err := genutil.RenderResourceName(*s.IBMPowerVSCluster.Spec.ResourceNameTemplate, resourceNameTemplateData(s.IBMPowerVSCluster, resourceType, "")); err != nil {
+			return fmt.Errorf("failed to generate the name of the %s: %w", resourceType, err)
+		}
+	}
+	return nil
+}
+
+// getServiceName returns the name of a resource of the cluster, the name set in the spec is preferred over the generated one.
+func getServiceName(cluster *infrav1.IBMPowerVSCluster, resourceType infrav1.ResourceType) *string { //nolint:gocyclo
 	switch resourceType {
 	case infrav1.ResourceTypeServiceInstance:
-		if s.ServiceInstance() == nil || s.ServiceInstance().Name == nil {
-			return ptr.To(fmt.Sprintf("%s-serviceInstance", s.InfraCluster()))
+		if cluster.Spec.ServiceInstance == nil || cluster.Spec.ServiceInstance.Name == nil {
+			return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-serviceInstance", cluster.Name))
 		}
-		return s.ServiceInstance().Name
+		return cluster.Spec.ServiceInstance.Name
 	case infrav1.ResourceTypeDHCPServer:
-		if s.DHCPServer() != nil && s.DHCPServer().Name != nil {
-			return s.DHCPServer().Name
+		if cluster.Spec.DHCPServer != nil && cluster.Spec.DHCPServer.Name != nil {
+			return cluster.Spec.DHCPServer.Name
 		}
-		if s.Network() != nil && s.Network().Name != nil {
-			return s.Network().Name
+		if cluster.Spec.Network.Name != nil {
+			return cluster.Spec.Network.Name
 		}
-		return ptr.To(s.InfraCluster())
+		return generateServiceName(cluster, resourceType, cluster.Name)
 	case infrav1.ResourceTypeVPC:
-		if s.VPC() == nil || s.VPC().Name == nil {
-			return ptr.To(fmt.Sprintf("%s-vpc", s.InfraCluster()))
+		if cluster.Spec.VPC == nil || cluster.Spec.VPC.Name == nil {
+			return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-vpc", cluster.Name))
 		}
-		return s.VPC().Name
+		return cluster.Spec.VPC.Name
 	case infrav1.ResourceTypeTransitGateway:
-		if s.TransitGateway() == nil || s.TransitGateway().Name == nil {
-			return ptr.To(fmt.Sprintf("%s-transitgateway", s.InfraCluster()))
+		if cluster.Spec.TransitGateway == nil || cluster.Spec.TransitGateway.Name == nil {
+			return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-transitgateway", cluster.Name))
 		}
-		return s.TransitGateway().Name
+		return cluster.Spec.TransitGateway.Name
 	case infrav1.ResourceTypeCOSInstance:
-		if s.COSInstance() == nil || s.COSInstance().Name == "" {
-			return ptr.To(fmt.Sprintf("%s-cosinstance", s.InfraCluster()))
+		if cluster.Spec.CosInstance == nil || cluster.Spec.CosInstance.Name == "" {
+			return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-cosinstance", cluster.Name))
 		}
-		return &s.COSInstance().Name
+		return &cluster.Spec.CosInstance.Name
 	case infrav1.ResourceTypeCOSBucket:
-		if s.COSInstance() == nil || s.COSInstance().BucketName == "" {
-			return ptr.To(fmt.Sprintf("%s-cosbucket", s.InfraCluster()))
+		if cluster.Spec.CosInstance == nil || cluster.Spec.CosInstance.BucketName == "" {
+			return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-cosbucket", cluster.Name))
 		}
-		return &s.COSInstance().BucketName
+		return &cluster.Spec.CosInstance.BucketName
 	case infrav1.ResourceTypeSubnet:
-		return ptr.To(fmt.Sprintf("%s-vpcsubnet", s.InfraCluster()))
+		return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-vpcsubnet", cluster.Name))
 	case infrav1.ResourceTypeLoadBalancer:
-		return ptr.To(fmt.Sprintf("%s-loadbalancer", s.InfraCluster()))
+		return generateServiceName(cluster, resourceType, fmt.Sprintf("%s-loadbalancer", cluster.Name))
 	}
 	return nil
 }
 
+// generateServiceName returns the name rendered from the resource name template of the cluster, or the default name when no template is set.
+// the controller stops reconciling the cluster when CheckResourceNameTemplate fails, so the default name is never used to create resources
+// for a template failing to render.
+func generateServiceName(cluster *infrav1.IBMPowerVSCluster, resourceType infrav1.ResourceType, defaultName string) *string {
+	if cluster.Spec.ResourceNameTemplate == nil {
+		return &defaultName
+	}
+	name, err := genutil.RenderResourceName(*cluster.Spec.ResourceNameTemplate, resourceNameTemplateData(cluster, resourceType, ""))
+	if err != nil {
+		return &defaultName
+	}
+	return &name
+}
+
+// resourceNameTemplateData returns the data the resource name template of the cluster is rendered with.
+func resourceNameTemplateData(cluster *infrav1.IBMPowerVSCluster, resourceType infrav1.ResourceType, zone string) genutil.ResourceNameTemplateData {
+	return genutil.ResourceNameTemplateData{
+		ClusterName:  cluster.Name,
+		Namespace:    cluster.Namespace,
+		ResourceType: string(resourceType),
+		Zone:         zone,
+	}
+}
+
+// DeleteDNSRecord deletes the control plane endpoint DNS record, if it is created by the controller.
+func (s *ClusterScope) DeleteDNSRecord(ctx context.Context) error {
+	if s.IBMPowerVSCluster.Spec.DNS == nil {
+		return nil
+	}
+	if err := dnsservices.DeleteRecord(ctx, s.DNSServicesClient, s.IBMPowerVSCluster.Spec.DNS.InstanceID, (*dnsservices.RecordStatus)(s.IBMPowerVSCluster.Status.DNS)); err != nil {
+		return err
+	}
+	s.IBMPowerVSCluster.Status.DNS = nil
+	return nil
+}
+
 // DeleteLoadBalancer deletes loadBalancer.
 func (s *ClusterScope) DeleteLoadBalancer(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -2579,6 +3835,50 @@ func (s *ClusterScope) DeleteVPCSecurityGroups(ctx context.Context) error {
 	return nil
 }
 
+// DeleteVPCEndpointGateways deletes the VPC endpoint gateways created by the controller.
+func (s *ClusterScope) DeleteVPCEndpointGateways(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var errs []error
+	requeue := false
+	for name, endpointGateway := range s.IBMPowerVSCluster.Status.VPCEndpointGateways {
+		if endpointGateway.ID == nil || endpointGateway.ControllerCreated == nil || !*endpointGateway.ControllerCreated {
+			log.Info("Skipping VPC endpoint gateway deletion as resource is not created by controller", "name", name)
+			continue
+		}
+
+		endpointGatewayDetails, resp, err := s.IBMVPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: endpointGateway.ID,
+		})
+		if err != nil {
+			if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+				log.Info("VPC endpoint gateway successfully deleted", "name", name)
+				delete(s.IBMPowerVSCluster.Status.VPCEndpointGateways, name)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("failed to fetch VPC endpoint gateway '%s': %w", name, err))
+			continue
+		}
+
+		if endpointGatewayDetails != nil && endpointGatewayDetails.LifecycleState != nil && *endpointGatewayDetails.LifecycleState == vpcv1.EndpointGatewayLifecycleStateDeletingConst {
+			requeue = true
+			continue
+		}
+
+		log.V(3).Info("Deleting VPC endpoint gateway", "name", name, "endpointGatewayID", *endpointGateway.ID)
+		if _, err := s.IBMVPCClient.DeleteEndpointGateway(&vpcv1.DeleteEndpointGatewayOptions{
+			ID: endpointGateway.ID,
+		}); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete VPC endpoint gateway '%s': %w", name, err))
+			continue
+		}
+		requeue = true
+	}
+	if len(errs) > 0 {
+		return false, kerrors.NewAggregate(errs)
+	}
+	return requeue, nil
+}
+
 // DeleteVPCSubnet deletes VPC subnet.
 func (s *ClusterScope) DeleteVPCSubnet(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -2708,35 +4008,23 @@ func (s *ClusterScope) DeleteTransitGateway(ctx context.Context) (bool, error) {
 
 func (s *ClusterScope) deleteTransitGatewayConnections(ctx context.Context, tg *tgapiv1.TransitGateway) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
-	deleteConnection := func(connID *string) (bool, error) {
-		conn, resp, err := s.TransitGatewayClient.GetTransitGatewayConnection(&tgapiv1.GetTransitGatewayConnectionOptions{
-			TransitGatewayID: tg.ID,
-			ID:               connID,
-		})
-		if resp.StatusCode == ResourceNotFoundCode {
-			log.V(3).Info("Connection deleted in transit gateway", "connectionID", *connID)
-			return false, nil
+	for _, connection := range s.IBMPowerVSCluster.Status.TransitGateway.Connections {
+		if !ptr.Deref(connection.ControllerCreated, false) {
+			continue
 		}
+		log.V(3).Info("Deleting additional connection in Transit gateway", "connectionName", connection.Name)
+		requeue, err := s.deleteTransitGatewayConnection(ctx, tg.ID, connection.ID)
 		if err != nil {
-			return false, fmt.Errorf("failed to get transit gateway powervs connection: %w", err)
-		}
-		if conn.Status != nil && *conn.Status == string(infrav1.TransitGatewayConnectionStateDeleting) {
-			log.V(3).Info("Transit gateway connection is in deleting state")
-			return true, nil
+			return false, err
 		}
-
-		if _, err = s.TransitGatewayClient.DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{
-			ID:               connID,
-			TransitGatewayID: tg.ID,
-		}); err != nil {
-			return false, fmt.Errorf("failed to delete transit gateway connection: %w", err)
+		if requeue {
+			return requeue, nil
 		}
-
-		return true, nil
 	}
+
 	if *s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated {
 		log.V(3).Info("Deleting PowerVS connection in Transit gateway")
-		requeue, err := deleteConnection(s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID)
+		requeue, err := s.deleteTransitGatewayConnection(ctx, tg.ID, s.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID)
 		if err != nil {
 			return false, err
 		}
@@ -2747,7 +4035,7 @@ func (s *ClusterScope) deleteTransitGatewayConnections(ctx context.Context, tg *
 
 	if *s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated {
 		log.V(3).Info("Deleting VPC connection in Transit gateway")
-		requeue, err := deleteConnection(s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID)
+		requeue, err := s.deleteTransitGatewayConnection(ctx, tg.ID, s.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID)
 		if err != nil {
 			return false, err
 		}
@@ -2759,6 +4047,36 @@ func (s *ClusterScope) deleteTransitGatewayConnections(ctx context.Context, tg *
 	return false, nil
 }
 
+// deleteTransitGatewayConnection deletes the transit gateway connection.
+// If the connection is being deleted, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScope) deleteTransitGatewayConnection(ctx context.Context, transitGatewayID, connID *string) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	conn, resp, err := s.TransitGatewayClient.GetTransitGatewayConnection(&tgapiv1.GetTransitGatewayConnectionOptions{
+		TransitGatewayID: transitGatewayID,
+		ID:               connID,
+	})
+	if resp != nil && resp.StatusCode == ResourceNotFoundCode {
+		log.V(3).Info("Connection deleted in transit gateway", "connectionID", *connID)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get transit gateway powervs connection: %w", err)
+	}
+	if conn.Status != nil && *conn.Status == string(infrav1.TransitGatewayConnectionStateDeleting) {
+		log.V(3).Info("Transit gateway connection is in deleting state")
+		return true, nil
+	}
+
+	if _, err = s.TransitGatewayClient.DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{
+		ID:               connID,
+		TransitGatewayID: transitGatewayID,
+	}); err != nil {
+		return false, fmt.Errorf("failed to delete transit gateway connection: %w", err)
+	}
+
+	return true, nil
+}
+
 // DeleteDHCPServer deletes DHCP server.
 func (s *ClusterScope) DeleteDHCPServer(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx)
@@ -2790,6 +4108,49 @@ func (s *ClusterScope) DeleteDHCPServer(ctx context.Context) error {
 	return nil
 }
 
+// DeleteDHCPNetworks deletes the DHCP servers of spec.dhcpNetworks created by the controller.
+func (s *ClusterScope) DeleteDHCPNetworks(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if len(s.IBMPowerVSCluster.Status.DHCPNetworks) == 0 {
+		return nil
+	}
+	if s.isResourceCreatedByController(infrav1.ResourceTypeServiceInstance) {
+		log.Info("Skipping deletion of the DHCP servers of the DHCP networks as PowerVS service instance is created by controller, will directly delete the PowerVS service instance since it will delete the DHCP servers internally")
+		return nil
+	}
+
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.IBMPowerVSCluster.Status.DHCPNetworks)) {
+		if _, err := s.deleteDHCPNetworkServer(ctx, name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// deleteDHCPNetworkServer deletes the DHCP server of the DHCP network in status if it is created by the controller.
+// returns true once the DHCP server is gone or when it is not created by the controller.
+func (s *ClusterScope) deleteDHCPNetworkServer(ctx context.Context, name string) (bool, error) {
+	log := ctrl.LoggerFrom(ctx).WithValues("dhcpNetwork", name)
+	dhcpServer := s.IBMPowerVSCluster.Status.DHCPNetworks[name].DHCPServer
+	if dhcpServer == nil || dhcpServer.ID == nil || !ptr.Deref(dhcpServer.ControllerCreated, false) {
+		log.Info("Skipping DHCP server deletion as resource is not created by controller")
+		return true, nil
+	}
+	if _, err := s.IBMPowerVSClient.GetDHCPServer(*dhcpServer.ID); err != nil {
+		if strings.Contains(err.Error(), string(DHCPServerNotFound)) {
+			log.Info("DHCP server successfully deleted")
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to fetch DHCP server of DHCP network %s: %w", name, err)
+	}
+	log.Info("Deleting DHCP server", "dhcpServerID", *dhcpServer.ID)
+	if err := s.IBMPowerVSClient.DeleteDHCPServer(*dhcpServer.ID); err != nil {
+		return false, fmt.Errorf("failed to delete DHCP server of DHCP network %s: %w", name, err)
+	}
+	return false, nil
+}
+
 // DeleteServiceInstance deletes service instance.
 func (s *ClusterScope) DeleteServiceInstance(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -2861,6 +4222,488 @@ func (s *ClusterScope) DeleteCOSInstance(ctx context.Context) error {
 	return nil
 }
 
+// ResourceVerificationInterval returns the time between two verifications of the resources referenced in status.
+func (s *ClusterScope) ResourceVerificationInterval() time.Duration {
+	verification := s.IBMPowerVSCluster.Spec.ResourceVerification
+	if verification == nil || verification.Interval == nil || verification.Interval.Duration <= 0 {
+		return defaultResourceVerificationInterval
+	}
+	return verification.Interval.Duration
+}
+
+// VerifyResources verifies that the resources referenced in status still exist in cloud and match spec.
+// Depending on the missing resource policy, the status of the missing resources created by the controller is cleared
+// so that they are created again by the reconciliation, other missing resources are reported.
+func (s *ClusterScope) VerifyResources(ctx context.Context) (*ResourceVerificationResult, error) {
+	result := &ResourceVerificationResult{}
+	var errs []error
+	for _, verify := range []func(context.Context, *ResourceVerificationResult) error{
+		s.verifyServiceInstance,
+		s.verifyVPC,
+		s.verifyVPCSubnets,
+		s.verifyVPCSecurityGroups,
+		s.verifyVPCEndpointGateways,
+		s.verifyTransitGateway,
+		s.verifyLoadBalancers,
+		s.verifyCOSInstance,
+	} {
+		if err := verify(ctx, result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, kerrors.NewAggregate(errs)
+	}
+	return result, nil
+}
+
+// missingResource records a resource referenced in status that no longer exists in cloud.
+// It returns true when the status of the resource is to be cleared to create it again.
+func (s *ClusterScope) missingResource(ctx context.Context, result *ResourceVerificationResult, resource string, controllerCreated *bool) bool {
+	log := ctrl.LoggerFrom(ctx)
+	verification := s.IBMPowerVSCluster.Spec.ResourceVerification
+	if verification != nil && verification.MissingResourcePolicy == infrav1.MissingResourcePolicyRecreate && ptr.Deref(controllerCreated, false) {
+		log.Info("Resource created by the controller no longer exists, clearing its status to create it again", "resource", resource)
+		result.Recreating = append(result.Recreating, resource)
+		return true
+	}
+	log.Info("Resource referenced in status no longer exists", "resource", resource)
+	result.Missing = append(result.Missing, resource)
+	return false
+}
+
+// driftedResource records a resource whose name no longer matches the expected name.
+func driftedResource(result *ResourceVerificationResult, resource string, name *string, expectedName string) {
+	if name == nil || *name == expectedName {
+		return
+	}
+	result.Drifted = append(result.Drifted, fmt.Sprintf("%s is named %s instead of %s", resource, *name, expectedName))
+}
+
+// verifyServiceInstance verifies the PowerVS workspace referenced in status.
+func (s *ClusterScope) verifyServiceInstance(ctx context.Context, result *ResourceVerificationResult) error {
+	serviceInstanceID := s.GetServiceInstanceID()
+	if serviceInstanceID == "" {
+		return nil
+	}
+	resource := fmt.Sprintf("PowerVS workspace %s", serviceInstanceID)
+	serviceInstance, resp, err := s.ResourceClient.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{
+		ID: &serviceInstanceID,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return fmt.Errorf("failed to fetch PowerVS service instance: %w", err)
+	}
+	if serviceInstance == nil || serviceInstance.State == nil || *serviceInstance.State == string(infrav1.ServiceInstanceStateRemoved) || *serviceInstance.State == "pending_reclamation" {
+		if s.missingResource(ctx, result, resource, s.IBMPowerVSCluster.Status.ServiceInstance.ControllerCreated) {
+			// the network and DHCP server are removed along with the workspace.
+			s.IBMPowerVSCluster.Status.ServiceInstance = nil
+			s.IBMPowerVSCluster.Status.Network = nil
+			s.IBMPowerVSCluster.Status.DHCPServer = nil
+		}
+		return nil
+	}
+	if s.ServiceInstance() == nil || s.ServiceInstance().ID == nil {
+		driftedResource(result, resource, serviceInstance.Name, *s.GetServiceName(infrav1.ResourceTypeServiceInstance))
+	}
+	return nil
+}
+
+// verifyVPC verifies the VPC referenced in status.
+func (s *ClusterScope) verifyVPC(ctx context.Context, result *ResourceVerificationResult) error {
+	vpcID := s.GetVPCID()
+	if vpcID == nil {
+		return nil
+	}
+	resource := fmt.Sprintf("VPC %s", *vpcID)
+	vpcDetails, resp, err := s.IBMVPCClient.GetVPC(&vpcv1.GetVPCOptions{
+		ID: vpcID,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return fmt.Errorf("failed to fetch VPC: %w", err)
+	}
+	if vpcDetails == nil {
+		if s.missingResource(ctx, result, resource, s.IBMPowerVSCluster.Status.VPC.ControllerCreated) {
+			s.IBMPowerVSCluster.Status.VPC = nil
+		}
+		return nil
+	}
+	if s.VPC() == nil || s.VPC().ID == nil {
+		driftedResource(result, resource, vpcDetails.Name, *s.GetServiceName(infrav1.ResourceTypeVPC))
+	}
+	return nil
+}
+
+// verifyVPCSubnets verifies the VPC subnets referenced in status.
+func (s *ClusterScope) verifyVPCSubnets(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.IBMPowerVSCluster.Status.VPCSubnet)) {
+		subnet := s.IBMPowerVSCluster.Status.VPCSubnet[name]
+		if subnet.ID == nil {
+			continue
+		}
+		resource := fmt.Sprintf("VPC subnet %s", name)
+		subnetDetails, resp, err := s.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: subnet.ID,
+		})
+		if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+			errs = append(errs, fmt.Errorf("failed to fetch VPC subnet '%s': %w", name, err))
+			continue
+		}
+		if subnetDetails == nil {
+			if s.missingResource(ctx, result, resource, subnet.ControllerCreated) {
+				delete(s.IBMPowerVSCluster.Status.VPCSubnet, name)
+			}
+			continue
+		}
+		driftedResource(result, resource, subnetDetails.Name, name)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// verifyVPCSecurityGroups verifies the VPC security groups referenced in status.
+func (s *ClusterScope) verifyVPCSecurityGroups(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.IBMPowerVSCluster.Status.VPCSecurityGroups)) {
+		securityGroup := s.IBMPowerVSCluster.Status.VPCSecurityGroups[name]
+		if securityGroup.ID == nil {
+			continue
+		}
+		resource := fmt.Sprintf("VPC security group %s", name)
+		securityGroupDetails, resp, err := s.IBMVPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{
+			ID: securityGroup.ID,
+		})
+		if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+			errs = append(errs, fmt.Errorf("failed to fetch VPC security group '%s': %w", name, err))
+			continue
+		}
+		if securityGroupDetails == nil {
+			if s.missingResource(ctx, result, resource, securityGroup.ControllerCreated) {
+				delete(s.IBMPowerVSCluster.Status.VPCSecurityGroups, name)
+			}
+			continue
+		}
+		driftedResource(result, resource, securityGroupDetails.Name, name)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// verifyVPCEndpointGateways verifies the VPC endpoint gateways referenced in status.
+func (s *ClusterScope) verifyVPCEndpointGateways(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.IBMPowerVSCluster.Status.VPCEndpointGateways)) {
+		endpointGateway := s.IBMPowerVSCluster.Status.VPCEndpointGateways[name]
+		if endpointGateway.ID == nil {
+			continue
+		}
+		resource := fmt.Sprintf("VPC endpoint gateway %s", name)
+		endpointGatewayDetails, resp, err := s.IBMVPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: endpointGateway.ID,
+		})
+		if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+			errs = append(errs, fmt.Errorf("failed to fetch VPC endpoint gateway '%s': %w", name, err))
+			continue
+		}
+		if endpointGatewayDetails == nil {
+			if s.missingResource(ctx, result, resource, endpointGateway.ControllerCreated) {
+				delete(s.IBMPowerVSCluster.Status.VPCEndpointGateways, name)
+			}
+			continue
+		}
+		driftedResource(result, resource, endpointGatewayDetails.Name, name)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// verifyTransitGateway verifies the transit gateway referenced in status.
+func (s *ClusterScope) verifyTransitGateway(ctx context.Context, result *ResourceVerificationResult) error {
+	transitGatewayID := s.GetTransitGatewayID()
+	if transitGatewayID == nil {
+		return nil
+	}
+	resource := fmt.Sprintf("transit gateway %s", *transitGatewayID)
+	transitGateway, resp, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: transitGatewayID,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return fmt.Errorf("failed to fetch transit gateway: %w", err)
+	}
+	if transitGateway == nil {
+		if s.missingResource(ctx, result, resource, s.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated) {
+			s.IBMPowerVSCluster.Status.TransitGateway = nil
+		}
+		return nil
+	}
+	if s.TransitGateway() == nil || s.TransitGateway().ID == nil {
+		driftedResource(result, resource, transitGateway.Name, *s.GetServiceName(infrav1.ResourceTypeTransitGateway))
+	}
+	return nil
+}
+
+// verifyLoadBalancers verifies the load balancers referenced in status.
+func (s *ClusterScope) verifyLoadBalancers(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.IBMPowerVSCluster.Status.LoadBalancers)) {
+		loadBalancer := s.IBMPowerVSCluster.Status.LoadBalancers[name]
+		if loadBalancer.ID == nil {
+			continue
+		}
+		resource := fmt.Sprintf("load balancer %s", name)
+		loadBalancerDetails, resp, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+			ID: loadBalancer.ID,
+		})
+		if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+			errs = append(errs, fmt.Errorf("failed to fetch load balancer '%s': %w", name, err))
+			continue
+		}
+		if loadBalancerDetails == nil {
+			if s.missingResource(ctx, result, resource, loadBalancer.ControllerCreated) {
+				delete(s.IBMPowerVSCluster.Status.LoadBalancers, name)
+			}
+			continue
+		}
+		driftedResource(result, resource, loadBalancerDetails.Name, name)
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// verifyCOSInstance verifies the COS instance referenced in status.
+func (s *ClusterScope) verifyCOSInstance(ctx context.Context, result *ResourceVerificationResult) error {
+	cosInstanceStatus := s.IBMPowerVSCluster.Status.COSInstance
+	if cosInstanceStatus == nil || cosInstanceStatus.ID == nil {
+		return nil
+	}
+	resource := fmt.Sprintf("COS instance %s", *cosInstanceStatus.ID)
+	cosInstance, resp, err := s.ResourceClient.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{
+		ID: cosInstanceStatus.ID,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return fmt.Errorf("failed to fetch COS service instance: %w", err)
+	}
+	if cosInstance == nil || cosInstance.State == nil || *cosInstance.State == string(infrav1.ServiceInstanceStateRemoved) || *cosInstance.State == "pending_reclamation" {
+		if s.missingResource(ctx, result, resource, cosInstanceStatus.ControllerCreated) {
+			s.IBMPowerVSCluster.Status.COSInstance = nil
+		}
+		return nil
+	}
+	driftedResource(result, resource, cosInstance.Name, *s.GetServiceName(infrav1.ResourceTypeCOSInstance))
+	return nil
+}
+
+// taggedResource is a resource created for the cluster the user tags are attached to.
+type taggedResource struct {
+	id string
+	// getCRN returns the CRN of the resource, nil when the resource no longer exists.
+	getCRN func() (*string, error)
+}
+
+// ReconcileTags attaches the user tags of the cluster and the cluster UID tag to the resources created for the cluster and detaches the tags removed from spec.
+// the tags are only attached again when the tags in spec or the resources in status change.
+func (s *ClusterScope) ReconcileTags(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	tags := genutil.ClusterUserTags(s.IBMPowerVSCluster.UID, s.IBMPowerVSCluster.Spec.Tags)
+	resources := s.taggedResources()
+	resourceIDs := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, resource.id)
+	}
+	slices.Sort(resourceIDs)
+
+	tagsStatus := ptr.Deref(s.IBMPowerVSCluster.Status.Tags, infrav1.TagsStatus{})
+	if slices.Equal(tags, tagsStatus.Applied) && (len(tags) == 0 || slices.Equal(resourceIDs, tagsStatus.Resources)) {
+		return nil
+	}
+
+	var resourceCRNs []string
+	for _, resource := range resources {
+		crn, err := resource.getCRN()
+		if err != nil {
+			return err
+		}
+		if crn != nil {
+			resourceCRNs = append(resourceCRNs, *crn)
+		}
+	}
+	removedTags := slices.DeleteFunc(slices.Clone(tagsStatus.Applied), func(tag string) bool {
+		return slices.Contains(tags, tag)
+	})
+	log.Info("Reconciling user tags of the cluster resources", "tags", tags, "removedTags", removedTags, "resources", len(resourceCRNs))
+	if err := s.GlobalTaggingClient.DetachUserTags(removedTags, resourceCRNs); err != nil {
+		return fmt.Errorf("failed to detach user tags from the cluster resources: %w", err)
+	}
+	if err := s.GlobalTaggingClient.AttachUserTags(tags, resourceCRNs); err != nil {
+		return fmt.Errorf("failed to attach user tags to the cluster resources: %w", err)
+	}
+
+	if len(tags) == 0 {
+		s.IBMPowerVSCluster.Status.Tags = nil
+		return nil
+	}
+	s.IBMPowerVSCluster.Status.Tags = &infrav1.TagsStatus{
+		Applied:   tags,
+		Resources: resourceIDs,
+	}
+	return nil
+}
+
+// taggedResources returns the resources referenced in status created by the controller.
+func (s *ClusterScope) taggedResources() []taggedResource {
+	var resources []taggedResource
+	add := func(id *string, controllerCreated *bool, getCRN func(id string) (*string, error)) {
+		if id == nil || !ptr.Deref(controllerCreated, false) {
+			return
+		}
+		resources = append(resources, taggedResource{id: *id, getCRN: func() (*string, error) {
+			return getCRN(*id)
+		}})
+	}
+
+	status := s.IBMPowerVSCluster.Status
+	if status.ServiceInstance != nil {
+		add(status.ServiceInstance.ID, status.ServiceInstance.ControllerCreated, s.resourceInstanceCRN)
+	}
+	// DHCP servers have no CRN, the user tags are attached to the network created along with the DHCP server.
+	if status.Network != nil {
+		add(status.Network.ID, status.Network.ControllerCreated, s.networkCRN)
+	}
+	for _, dhcpNetwork := range status.DHCPNetworks {
+		if dhcpNetwork.Network != nil {
+			add(dhcpNetwork.Network.ID, dhcpNetwork.Network.ControllerCreated, s.networkCRN)
+		}
+	}
+	if status.VPC != nil {
+		add(status.VPC.ID, status.VPC.ControllerCreated, s.vpcCRN)
+	}
+	for _, subnet := range status.VPCSubnet {
+		add(subnet.ID, subnet.ControllerCreated, s.vpcSubnetCRN)
+	}
+	for _, securityGroup := range status.VPCSecurityGroups {
+		add(securityGroup.ID, securityGroup.ControllerCreated, s.vpcSecurityGroupCRN)
+	}
+	for _, endpointGateway := range status.VPCEndpointGateways {
+		add(endpointGateway.ID, endpointGateway.ControllerCreated, s.vpcEndpointGatewayCRN)
+	}
+	for _, loadBalancer := range status.LoadBalancers {
+		add(loadBalancer.ID, loadBalancer.ControllerCreated, s.loadBalancerCRN)
+	}
+	if status.TransitGateway != nil {
+		add(status.TransitGateway.ID, status.TransitGateway.ControllerCreated, s.transitGatewayCRN)
+	}
+	if status.COSInstance != nil {
+		add(status.COSInstance.ID, status.COSInstance.ControllerCreated, s.resourceInstanceCRN)
+	}
+	return resources
+}
+
+// resourceInstanceCRN returns the CRN of a resource instance like the PowerVS workspace or the COS instance.
+func (s *ClusterScope) resourceInstanceCRN(id string) (*string, error) {
+	instance, resp, err := s.ResourceClient.GetResourceInstance(&resourcecontrollerv2.GetResourceInstanceOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch resource instance %s: %w", id, err)
+	}
+	if instance == nil {
+		return nil, nil
+	}
+	return instance.CRN, nil
+}
+
+// networkCRN returns the CRN of a PowerVS network.
+func (s *ClusterScope) networkCRN(id string) (*string, error) {
+	network, err := s.IBMPowerVSClient.GetNetworkByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch network %s: %w", id, err)
+	}
+	if network == nil || network.Crn == "" {
+		return nil, nil
+	}
+	return ptr.To(string(network.Crn)), nil
+}
+
+// vpcCRN returns the CRN of a VPC.
+func (s *ClusterScope) vpcCRN(id string) (*string, error) {
+	vpcDetails, resp, err := s.IBMVPCClient.GetVPC(&vpcv1.GetVPCOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch VPC %s: %w", id, err)
+	}
+	if vpcDetails == nil {
+		return nil, nil
+	}
+	return vpcDetails.CRN, nil
+}
+
+// vpcSubnetCRN returns the CRN of a VPC subnet.
+func (s *ClusterScope) vpcSubnetCRN(id string) (*string, error) {
+	subnet, resp, err := s.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch VPC subnet %s: %w", id, err)
+	}
+	if subnet == nil {
+		return nil, nil
+	}
+	return subnet.CRN, nil
+}
+
+// vpcSecurityGroupCRN returns the CRN of a VPC security group.
+func (s *ClusterScope) vpcSecurityGroupCRN(id string) (*string, error) {
+	securityGroup, resp, err := s.IBMVPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch VPC security group %s: %w", id, err)
+	}
+	if securityGroup == nil {
+		return nil, nil
+	}
+	return securityGroup.CRN, nil
+}
+
+// vpcEndpointGatewayCRN returns the CRN of a VPC endpoint gateway.
+func (s *ClusterScope) vpcEndpointGatewayCRN(id string) (*string, error) {
+	endpointGateway, resp, err := s.IBMVPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch VPC endpoint gateway %s: %w", id, err)
+	}
+	if endpointGateway == nil {
+		return nil, nil
+	}
+	return endpointGateway.CRN, nil
+}
+
+// loadBalancerCRN returns the CRN of a VPC load balancer.
+func (s *ClusterScope) loadBalancerCRN(id string) (*string, error) {
+	loadBalancer, resp, err := s.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch load balancer %s: %w", id, err)
+	}
+	if loadBalancer == nil {
+		return nil, nil
+	}
+	return loadBalancer.CRN, nil
+}
+
+// transitGatewayCRN returns the CRN of a transit gateway.
+func (s *ClusterScope) transitGatewayCRN(id string) (*string, error) {
+	transitGateway, resp, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: &id,
+	})
+	if err != nil && (resp == nil || resp.StatusCode != ResourceNotFoundCode) {
+		return nil, fmt.Errorf("failed to fetch transit gateway %s: %w", id, err)
+	}
+	if transitGateway == nil {
+		return nil, nil
+	}
+	return transitGateway.Crn, nil
+}
+
 // resourceCreatedByController helps to identify resource created by controller or not.
 func (s *ClusterScope) isResourceCreatedByController(resourceType infrav1.ResourceType) bool { //nolint:gocyclo
 	switch resourceType {
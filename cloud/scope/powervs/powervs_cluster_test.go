@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -28,11 +29,13 @@ import (
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
 	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -42,6 +45,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/pointer"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
 	mockcos "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	mockdns "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices/mock"
+	mockgt "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	mockP "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
@@ -1768,6 +1773,450 @@ func TestCreateLoadbalancer(t *testing.T) {
 		g.Expect(loadBalancerStatus.ControllerCreated).To(Equal(ptr.To(true)))
 		g.Expect(loadBalancerStatus.Hostname).To(Equal(ptr.To("test-lb-hostname")))
 	})
+
+	t.Run("When createLoadBalancer creates network load balancer with a single subnet", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVpc,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-gid"),
+					},
+					VPCSubnets: []infrav1.Subnet{
+						{
+							Name: ptr.To("test-subnet-1"),
+						},
+						{
+							Name: ptr.To("test-subnet-2"),
+						},
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"test-subnet-1": {
+							ID: ptr.To("test-subnet-id-1"),
+						},
+						"test-subnet-2": {
+							ID: ptr.To("test-subnet-id-2"),
+						},
+					},
+				},
+			},
+			Cluster: &clusterv1.Cluster{},
+		}
+
+		lb := infrav1.VPCLoadBalancerSpec{
+			Name:    testLBName,
+			Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+		}
+
+		mockVpc.EXPECT().CreateLoadBalancer(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+			profile, ok := options.Profile.(*vpcv1.LoadBalancerProfileIdentityByName)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(profile.Name).To(Equal(ptr.To("network-fixed")))
+			g.Expect(options.Subnets).To(Equal([]vpcv1.SubnetIdentityIntf{&vpcv1.SubnetIdentity{ID: ptr.To("test-subnet-id-1")}}))
+			return &vpcv1.LoadBalancer{
+				ID:                 ptr.To("test-lb-id"),
+				ProvisioningStatus: ptr.To("create_pending"),
+			}, nil, nil
+		})
+
+		loadBalancerStatus, err := clusterScope.createLoadBalancer(ctx, lb)
+		g.Expect(err).To(BeNil())
+		g.Expect(loadBalancerStatus.ID).To(Equal(ptr.To("test-lb-id")))
+	})
+
+	t.Run("When createLoadBalancer uses the subnets set in load balancer spec", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVpc,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-gid"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"test-subnet-1": {
+							ID: ptr.To("test-subnet-id-1"),
+						},
+						"test-subnet-2": {
+							ID: ptr.To("test-subnet-id-2"),
+						},
+					},
+				},
+			},
+			Cluster: &clusterv1.Cluster{},
+		}
+
+		lb := infrav1.VPCLoadBalancerSpec{
+			Name: testLBName,
+			Subnets: []infrav1.VPCResource{
+				{Name: ptr.To("test-subnet-2")},
+				{ID: ptr.To("test-subnet-id-3")},
+			},
+		}
+
+		mockVpc.EXPECT().CreateLoadBalancer(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error) {
+			g.Expect(options.Subnets).To(Equal([]vpcv1.SubnetIdentityIntf{
+				&vpcv1.SubnetIdentity{ID: ptr.To("test-subnet-id-2")},
+				&vpcv1.SubnetIdentity{ID: ptr.To("test-subnet-id-3")},
+			}))
+			return &vpcv1.LoadBalancer{
+				ID:                 ptr.To("test-lb-id"),
+				ProvisioningStatus: ptr.To("create_pending"),
+			}, nil, nil
+		})
+
+		loadBalancerStatus, err := clusterScope.createLoadBalancer(ctx, lb)
+		g.Expect(err).To(BeNil())
+		g.Expect(loadBalancerStatus.ID).To(Equal(ptr.To("test-lb-id")))
+	})
+
+	t.Run("When createLoadBalancer cannot find the subnet set in load balancer spec", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVpc,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-gid"),
+					},
+				},
+			},
+			Cluster: &clusterv1.Cluster{},
+		}
+
+		lb := infrav1.VPCLoadBalancerSpec{
+			Name:    testLBName,
+			Subnets: []infrav1.VPCResource{{Name: ptr.To("test-subnet-1")}},
+		}
+
+		loadBalancerStatus, err := clusterScope.createLoadBalancer(ctx, lb)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(loadBalancerStatus).To(BeNil())
+	})
+
+	t.Run("When createLoadBalancer is called with no VPC subnets in status", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVpc,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-gid"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSubnet: map[string]infrav1.ResourceReference{},
+				},
+			},
+			Cluster: &clusterv1.Cluster{},
+		}
+
+		lb := infrav1.VPCLoadBalancerSpec{
+			Name:    testLBName,
+			Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+		}
+
+		loadBalancerStatus, err := clusterScope.createLoadBalancer(ctx, lb)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(loadBalancerStatus).To(BeNil())
+	})
+}
+
+func TestReconcileLoadBalancerListeners(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	loadBalancer := &vpcv1.LoadBalancer{
+		ID:   ptr.To("test-lb-id"),
+		Name: ptr.To(testLBName),
+		Listeners: []vpcv1.LoadBalancerListenerReference{
+			{ID: ptr.To("http-listener-id")},
+			{ID: ptr.To("https-listener-id")},
+		},
+	}
+	httpListener := &vpcv1.LoadBalancerListener{
+		ID:       ptr.To("http-listener-id"),
+		Port:     ptr.To(int64(80)),
+		Protocol: ptr.To("http"),
+	}
+	httpsListener := &vpcv1.LoadBalancerListener{
+		ID:       ptr.To("https-listener-id"),
+		Port:     ptr.To(int64(443)),
+		Protocol: ptr.To("https"),
+		CertificateInstance: &vpcv1.CertificateInstanceReference{
+			CRN: ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:old-cert"),
+		},
+	}
+	lbSpec := infrav1.VPCLoadBalancerSpec{
+		Name: testLBName,
+		AdditionalListeners: []infrav1.AdditionalListenerSpec{
+			{
+				Port:     80,
+				Protocol: &infrav1.VPCLoadBalancerListenerProtocolHTTP,
+				HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{
+					ListenerPort: 443,
+				},
+			},
+			{
+				Port:           443,
+				Protocol:       &infrav1.VPCLoadBalancerListenerProtocolHTTPS,
+				CertificateCRN: ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:old-cert"),
+			},
+		},
+	}
+
+	t.Run("When no additional listeners are set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, infrav1.VPCLoadBalancerSpec{Name: testLBName}, loadBalancer, &infrav1.VPCLoadBalancerStatus{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+
+	t.Run("When HTTPS redirect is not set on the listener", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().UpdateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(options.ID).To(Equal(ptr.To("http-listener-id")))
+			g.Expect(options.LoadBalancerListenerPatch).To(HaveKey("https_redirect"))
+			return httpListener, nil, nil
+		})
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, lbSpec, loadBalancer, &infrav1.VPCLoadBalancerStatus{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+
+	t.Run("When certificate CRN is changed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		redirectedListener := *httpListener
+		redirectedListener.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirect{
+			HTTPStatusCode: ptr.To(int64(301)),
+			Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+		}
+		rotatedSpec := *lbSpec.DeepCopy()
+		rotatedSpec.AdditionalListeners[1].CertificateCRN = ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:new-cert")
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(&redirectedListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().UpdateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(options.ID).To(Equal(ptr.To("https-listener-id")))
+			g.Expect(options.LoadBalancerListenerPatch).To(HaveKeyWithValue("certificate_instance", HaveKeyWithValue("crn", ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:new-cert"))))
+			return httpsListener, nil, nil
+		})
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, rotatedSpec, loadBalancer, &infrav1.VPCLoadBalancerStatus{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+
+	t.Run("When listeners are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		redirectedListener := *httpListener
+		redirectedListener.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirect{
+			HTTPStatusCode: ptr.To(int64(301)),
+			Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+		}
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(&redirectedListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, lbSpec, loadBalancer, &infrav1.VPCLoadBalancerStatus{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+
+	t.Run("When UpdateLoadBalancerListener returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().UpdateLoadBalancerListener(gomock.Any()).Return(nil, nil, errors.New("failed to update listener"))
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, lbSpec, loadBalancer, &infrav1.VPCLoadBalancerStatus{})
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+
+	t.Run("When the pool of a new additional listener does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		redirectedListener := *httpListener
+		redirectedListener.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirect{
+			HTTPStatusCode: ptr.To(int64(301)),
+			Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+		}
+		newSpec := *lbSpec.DeepCopy()
+		newSpec.AdditionalListeners = append(newSpec.AdditionalListeners, infrav1.AdditionalListenerSpec{Port: 8080})
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(&redirectedListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().CreateLoadBalancerPool(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+			g.Expect(options.Name).To(Equal(ptr.To("additional-pool-8080")))
+			g.Expect(options.Protocol).To(Equal(ptr.To("tcp")))
+			return &vpcv1.LoadBalancerPool{}, nil, nil
+		})
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, newSpec, loadBalancer, &infrav1.VPCLoadBalancerStatus{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+
+	t.Run("When a new additional listener does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		redirectedListener := *httpListener
+		redirectedListener.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirect{
+			HTTPStatusCode: ptr.To(int64(301)),
+			Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+		}
+		newSpec := *lbSpec.DeepCopy()
+		newSpec.AdditionalListeners = append(newSpec.AdditionalListeners, infrav1.AdditionalListenerSpec{Port: 8080})
+		lbWithPool := *loadBalancer
+		lbWithPool.Pools = []vpcv1.LoadBalancerPoolReference{{ID: ptr.To("pool-8080-id"), Name: ptr.To("additional-pool-8080")}}
+		status := &infrav1.VPCLoadBalancerStatus{}
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(&redirectedListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().CreateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(options.Port).To(Equal(ptr.To(int64(8080))))
+			g.Expect(options.DefaultPool).To(Equal(&vpcv1.LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID{ID: ptr.To("pool-8080-id")}))
+			return &vpcv1.LoadBalancerListener{}, nil, nil
+		})
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, newSpec, &lbWithPool, status)
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+		g.Expect(status.Listeners).To(ConsistOf(
+			infrav1.VPCLoadBalancerListenerStatus{Port: 80, ID: ptr.To("http-listener-id")},
+			infrav1.VPCLoadBalancerListenerStatus{Port: 443, ID: ptr.To("https-listener-id")},
+		))
+		g.Expect(status.Pools).To(ConsistOf(infrav1.VPCLoadBalancerPoolStatus{Name: "additional-pool-8080", ID: ptr.To("pool-8080-id")}))
+	})
+
+	t.Run("When an additional listener is removed and pruneListeners is set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		redirectedListener := *httpListener
+		redirectedListener.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirect{
+			HTTPStatusCode: ptr.To(int64(301)),
+			Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+		}
+		removedListener := &vpcv1.LoadBalancerListener{
+			ID:       ptr.To("removed-listener-id"),
+			Port:     ptr.To(int64(8080)),
+			Protocol: ptr.To("tcp"),
+		}
+		lbWithRemovedListener := *loadBalancer
+		lbWithRemovedListener.Listeners = append(lbWithRemovedListener.Listeners, vpcv1.LoadBalancerListenerReference{ID: ptr.To("removed-listener-id")})
+		pruneSpec := *lbSpec.DeepCopy()
+		pruneSpec.PruneListeners = ptr.To(true)
+		status := &infrav1.VPCLoadBalancerStatus{
+			Listeners: []infrav1.VPCLoadBalancerListenerStatus{{Port: 8080, ID: ptr.To("removed-listener-id")}},
+		}
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(&redirectedListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(removedListener, nil, nil)
+		mockVpc.EXPECT().DeleteLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.DeleteLoadBalancerListenerOptions) (*core.DetailedResponse, error) {
+			g.Expect(options.ID).To(Equal(ptr.To("removed-listener-id")))
+			return nil, nil
+		})
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, pruneSpec, &lbWithRemovedListener, status)
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+		g.Expect(status.Listeners).To(ContainElement(infrav1.VPCLoadBalancerListenerStatus{Port: 8080, ID: ptr.To("removed-listener-id")}))
+	})
+
+	t.Run("When an additional listener is removed and pruneListeners is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		redirectedListener := *httpListener
+		redirectedListener.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirect{
+			HTTPStatusCode: ptr.To(int64(301)),
+			Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+		}
+		removedListener := &vpcv1.LoadBalancerListener{
+			ID:       ptr.To("removed-listener-id"),
+			Port:     ptr.To(int64(8080)),
+			Protocol: ptr.To("tcp"),
+		}
+		lbWithRemovedListener := *loadBalancer
+		lbWithRemovedListener.Listeners = append(lbWithRemovedListener.Listeners, vpcv1.LoadBalancerListenerReference{ID: ptr.To("removed-listener-id")})
+		status := &infrav1.VPCLoadBalancerStatus{
+			Listeners: []infrav1.VPCLoadBalancerListenerStatus{{Port: 8080, ID: ptr.To("removed-listener-id")}},
+		}
+
+		clusterScope := ClusterScope{IBMVPCClient: mockVpc}
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(&redirectedListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(httpsListener, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(removedListener, nil, nil)
+
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, lbSpec, &lbWithRemovedListener, status)
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+		g.Expect(status.Listeners).ToNot(ContainElement(infrav1.VPCLoadBalancerListenerStatus{Port: 8080, ID: ptr.To("removed-listener-id")}))
+	})
 }
 
 func TestCheckLoadBalancerPort(t *testing.T) {
@@ -2886,6 +3335,31 @@ func TestGetServiceName(t *testing.T) {
 			},
 			expectedName: ptr.To("ClusterName-loadbalancer"),
 		},
+		{
+			name:         "Resource type is cos bucket and resource name template is set",
+			resourceType: infrav1.ResourceTypeCOSBucket,
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "dev"},
+					Spec:       infrav1.IBMPowerVSClusterSpec{ResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ .ClusterName }}-{{ lower .ResourceType }}")},
+				},
+			},
+			expectedName: ptr.To("fin-dev-capi-cluster-cosbucket"),
+		},
+		{
+			name:         "Resource type is vpc, VPC name is set and resource name template is set",
+			resourceType: infrav1.ResourceTypeVPC,
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "dev"},
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						VPC:                  &infrav1.VPCResourceReference{Name: ptr.To("VPCName")},
+						ResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ .ClusterName }}-{{ lower .ResourceType }}"),
+					},
+				},
+			},
+			expectedName: ptr.To("VPCName"),
+		},
 		{
 			name: "Resource type is invalid",
 			clusterScope: ClusterScope{
@@ -2903,11 +3377,97 @@ func TestGetServiceName(t *testing.T) {
 	}
 }
 
-func TestGetVPCByName(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
+func TestGetZonalServiceName(t *testing.T) {
+	testCases := []struct {
+		name         string
+		nameTemplate *string
+		expectedName string
+		expectedErr  string
+	}{
+		{
+			name:         "Resource name template is not set",
+			expectedName: "capi-cluster-vpcsubnet-us-south-1",
+		},
+		{
+			name:         "Resource name template references the zone",
+			nameTemplate: ptr.To("fin-{{ .ClusterName }}-{{ .Zone }}-{{ lower .ResourceType }}"),
+			expectedName: "fin-capi-cluster-us-south-1-subnet",
+		},
+		{
+			name:         "Resource name template does not reference the zone",
+			nameTemplate: ptr.To("fin-{{ .ClusterName }}-{{ lower .ResourceType }}"),
+			expectedName: "fin-capi-cluster-subnet-us-south-1",
+		},
+		{
+			name:         "Resource name template fails to render",
+			nameTemplate: ptr.To("fin-{{ index .Zone 20 }}-{{ lower .ResourceType }}"),
+			expectedErr:  "failed to generate the name of the subnet in zone us-south-1: failed to render resource name template",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+					Spec:       infrav1.IBMPowerVSClusterSpec{ResourceNameTemplate: tc.nameTemplate},
+				},
+			}
+			name, err := clusterScope.GetZonalServiceName(infrav1.ResourceTypeSubnet, "us-south-1")
+			if tc.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+				g.Expect(name).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(*name).To(Equal(tc.expectedName))
+		})
+	}
+}
+
+func TestCheckResourceNameTemplate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		nameTemplate *string
+		expectedErr  string
+	}{
+		{
+			name: "Resource name template is not set",
+		},
+		{
+			name:         "Resource name template renders for every resource type",
+			nameTemplate: ptr.To("fin-{{ .ClusterName }}-{{ lower .ResourceType }}"),
+		},
+		{
+			name:         "Resource name template fails to render",
+			nameTemplate: ptr.To("fin-{{ index .ClusterName 20 }}-{{ lower .ResourceType }}"),
+			expectedErr:  "failed to generate the name of the serviceInstance: failed to render resource name template",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+					Spec:       infrav1.IBMPowerVSClusterSpec{ResourceNameTemplate: tc.nameTemplate},
+				},
+			}
+			err := clusterScope.CheckResourceNameTemplate()
+			if tc.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
+
+func TestGetVPCByName(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
 
 	setup := func(t *testing.T) {
 		t.Helper()
@@ -3542,471 +4102,462 @@ func TestReconcileNetwork(t *testing.T) {
 	})
 }
 
-func TestReconcileVPCSubnets(t *testing.T) {
+func TestReconcileWorkspaceQuota(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
 	)
+
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When VPCSubnets are set in spec", func(t *testing.T) {
+
+	newClusterScope := func() *ClusterScope {
+		return &ClusterScope{
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{Status: infrav1.IBMPowerVSClusterStatus{
+				ServiceInstance: &infrav1.ResourceReference{ID: ptr.To("serviceInstanceID")},
+			}},
+		}
+	}
+
+	t.Run("When service instance ID is not set in status", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC:        &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets: []infrav1.Subnet{{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}, {ID: ptr.To("subnet2ID"), Name: ptr.To("subnet2Name")}}},
-			},
+			IBMPowerVSClient:  mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
 		}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}
-		subnet1Options := &vpcv1.GetSubnetOptions{ID: subnet1Details.ID}
-		mockVPC.EXPECT().GetSubnet(subnet1Options).Return(subnet1Details, nil, nil)
-		subnet2Details := &vpcv1.Subnet{ID: ptr.To("subnet2ID"), Name: ptr.To("subnet2Name")}
-		subnet2Options := &vpcv1.GetSubnetOptions{ID: subnet2Details.ID}
-		mockVPC.EXPECT().GetSubnet(subnet2Options).Return(subnet2Details, nil, nil)
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).To(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet2Details.Name].ID).To(Equal(subnet2Details.ID))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet2Details.Name].ControllerCreated).To(BeNil())
+		g.Expect(clusterScope.ReconcileWorkspaceQuota(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.WorkspaceQuota).To(BeNil())
 	})
+	t.Run("When getting the workspace returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When more VPCSubnets are set in the spec than the available VPC zones with zone explicitly mentioned for few subnets", func(t *testing.T) {
+		clusterScope := newClusterScope()
+		mockPowerVS.EXPECT().GetCloudInstance("serviceInstanceID").Return(nil, errors.New("error getting cloud instance"))
+		g.Expect(clusterScope.ReconcileWorkspaceQuota(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.WorkspaceQuota).To(BeNil())
+	})
+	t.Run("When the workspace usage and limits are available", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		var subnetZone *string
 
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets: []infrav1.Subnet{
-						{Name: ptr.To("subnet1Name"), Zone: ptr.To("eu-de-2")},
-						{Name: ptr.To("subnet2Name")},
-						{Name: ptr.To("subnet3Name")},
-						{Name: ptr.To("subnet4Name")},
-						{Name: ptr.To("subnet5Name")},
-					}},
+		clusterScope := newClusterScope()
+		mockPowerVS.EXPECT().GetCloudInstance("serviceInstanceID").Return(&models.CloudInstance{
+			Usage: &models.CloudInstanceUsageLimits{
+				Instances:  ptr.To(3.0),
+				Processors: ptr.To(1.5),
+				Memory:     ptr.To(48.0),
+				Storage:    ptr.To(0.5),
 			},
-		}
+			Limits: &models.CloudInstanceUsageLimits{
+				Instances:  ptr.To(10.0),
+				Processors: ptr.To(2.0),
+				Memory:     ptr.To(64.0),
+				Storage:    ptr.To(0.25),
+			},
+		}, nil)
+		g.Expect(clusterScope.ReconcileWorkspaceQuota(ctx)).To(Succeed())
+		quota := clusterScope.IBMPowerVSCluster.Status.WorkspaceQuota
+		g.Expect(quota).ToNot(BeNil())
+		g.Expect(*quota.Used.Instances).To(Equal(int64(3)))
+		g.Expect(quota.Used.Processors.Cmp(resource.MustParse("1.5"))).To(BeZero())
+		g.Expect(quota.Used.Memory.Cmp(resource.MustParse("48Gi"))).To(BeZero())
+		g.Expect(quota.Used.Storage.Cmp(resource.MustParse("512Gi"))).To(BeZero())
+		g.Expect(*quota.Available.Instances).To(Equal(int64(7)))
+		g.Expect(quota.Available.Processors.Cmp(resource.MustParse("500m"))).To(BeZero())
+		g.Expect(quota.Available.Memory.Cmp(resource.MustParse("16Gi"))).To(BeZero())
+		g.Expect(quota.Available.Storage.IsZero()).To(BeTrue())
+	})
+}
 
-		vpcZones, err := regionUtil.VPCZonesForVPCRegion(*clusterScope.IBMPowerVSCluster.Spec.VPC.Region)
-		g.Expect(err).To(BeNil())
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets))
-		for i := 0; i < len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
-			subnet1Options := &vpcv1.CreateSubnetOptions{}
-			if clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Zone != nil {
-				subnetZone = clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Zone
-			} else {
-				subnetZone = &vpcZones[i%len(vpcZones)]
-			}
-			subnet1Options.SetSubnetPrototype(&vpcv1.SubnetPrototype{
-				IPVersion:             ptr.To("ipv4"),
-				TotalIpv4AddressCount: ptr.To(vpcSubnetIPAddressCount),
-				Name:                  clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Name,
-				VPC: &vpcv1.VPCIdentity{
-					ID: clusterScope.IBMPowerVSCluster.Status.VPC.ID,
-				},
-				Zone: &vpcv1.ZoneIdentity{
-					Name: subnetZone,
-				},
-				ResourceGroup: &vpcv1.ResourceGroupIdentity{
-					ID: clusterScope.IBMPowerVSCluster.Spec.ResourceGroup.ID,
-				},
-			})
-			subnetDetails := &vpcv1.Subnet{ID: ptr.To(fmt.Sprintf("subnet%dID", i+1)), Name: ptr.To(fmt.Sprintf("subnet%dName", i+1))}
-			mockVPC.EXPECT().CreateSubnet(subnet1Options).Return(subnetDetails, nil, nil)
-		}
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-		for i := 1; i <= len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
-			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ID).To(Equal(fmt.Sprintf("subnet%dID", i)))
-			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ControllerCreated).To(BeTrue())
+func TestReconcileZoneCapabilities(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	newClusterScope := func() *ClusterScope {
+		return &ClusterScope{
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{Spec: infrav1.IBMPowerVSClusterSpec{
+				Zone: ptr.To("dal10"),
+			}},
 		}
-	})
+	}
 
-	t.Run("When VPCSubnets are set in the spec along with the zones", func(t *testing.T) {
+	t.Run("When zone is not set in spec", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets: []infrav1.Subnet{
-						{Name: ptr.To("subnet1Name"), Zone: ptr.To("eu-de-1")},
-						{Name: ptr.To("subnet2Name"), Zone: ptr.To("eu-de-2")},
-						{Name: ptr.To("subnet3Name"), Zone: ptr.To("eu-de-3")},
-					}},
-			},
+			IBMPowerVSClient:  mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
 		}
+		g.Expect(clusterScope.ReconcileZoneCapabilities(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ZoneCapabilities).To(BeNil())
+	})
+	t.Run("When getting the datacenter returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets))
-		for i := 0; i < len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
-			subnet1Options := &vpcv1.CreateSubnetOptions{}
-			subnet1Options.SetSubnetPrototype(&vpcv1.SubnetPrototype{
-				IPVersion:             ptr.To("ipv4"),
-				TotalIpv4AddressCount: ptr.To(vpcSubnetIPAddressCount),
-				Name:                  clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Name,
-				VPC: &vpcv1.VPCIdentity{
-					ID: clusterScope.IBMPowerVSCluster.Status.VPC.ID,
-				},
-				Zone: &vpcv1.ZoneIdentity{
-					Name: clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Zone,
-				},
-				ResourceGroup: &vpcv1.ResourceGroupIdentity{
-					ID: clusterScope.IBMPowerVSCluster.Spec.ResourceGroup.ID,
-				},
-			})
-			subnetDetails := &vpcv1.Subnet{ID: ptr.To(fmt.Sprintf("subnet%dID", i+1)), Name: ptr.To(fmt.Sprintf("subnet%dName", i+1))}
-			mockVPC.EXPECT().CreateSubnet(subnet1Options).Return(subnetDetails, nil, nil)
-		}
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-		for i := 1; i <= len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
-			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ID).To(Equal(fmt.Sprintf("subnet%dID", i)))
-			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ControllerCreated).To(BeTrue())
-		}
+		clusterScope := newClusterScope()
+		mockPowerVS.EXPECT().GetDatacenter("dal10").Return(nil, errors.New("error getting datacenter"))
+		g.Expect(clusterScope.ReconcileZoneCapabilities(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ZoneCapabilities).To(BeNil())
 	})
-	t.Run("When VPCSubnets are not set in spec and subnet doesnot exist in cloud", func(t *testing.T) {
+	t.Run("When getting the storage types capacity returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")}},
-			},
-		}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(3)
-		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil).Times(3)
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-		g.Expect(len(clusterScope.IBMPowerVSCluster.Status.VPCSubnet)).To(Equal(3))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeTrue())
+		clusterScope := newClusterScope()
+		mockPowerVS.EXPECT().GetDatacenter("dal10").Return(&models.Datacenter{}, nil)
+		mockPowerVS.EXPECT().GetAllStorageTypesCapacity().Return(nil, errors.New("error getting storage types capacity"))
+		g.Expect(clusterScope.ReconcileZoneCapabilities(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ZoneCapabilities).To(BeNil())
 	})
+	t.Run("When getting the storage pools capacity returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When VPCSubnets are set in spec but zone is not specified", func(t *testing.T) {
+		clusterScope := newClusterScope()
+		mockPowerVS.EXPECT().GetDatacenter("dal10").Return(&models.Datacenter{}, nil)
+		mockPowerVS.EXPECT().GetAllStorageTypesCapacity().Return(&models.StorageTypesCapacity{}, nil)
+		mockPowerVS.EXPECT().GetAllStoragePoolsCapacity().Return(nil, errors.New("error getting storage pools capacity"))
+		g.Expect(clusterScope.ReconcileZoneCapabilities(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ZoneCapabilities).To(BeNil())
+	})
+	t.Run("When the zone capabilities are available", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
+		clusterScope := newClusterScope()
+		mockPowerVS.EXPECT().GetDatacenter("dal10").Return(&models.Datacenter{
+			CapabilitiesDetails: &models.CapabilitiesDetails{
+				SupportedSystems: &models.SupportedSystems{
+					General:   []string{"s922", "e980"},
+					Dedicated: []string{"e1080", "s922"},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets:    []infrav1.Subnet{{Name: ptr.To("subnet1Name")}}},
 			},
-		}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil)
-
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeTrue())
+		}, nil)
+		mockPowerVS.EXPECT().GetAllStorageTypesCapacity().Return(&models.StorageTypesCapacity{
+			StorageTypesCapacity: []*models.StorageTypeCapacity{{StorageType: "tier3"}, {StorageType: "tier1"}},
+		}, nil)
+		mockPowerVS.EXPECT().GetAllStoragePoolsCapacity().Return(&models.StoragePoolsCapacity{
+			StoragePoolsCapacity: []*models.StoragePoolCapacity{{PoolName: "Tier3-Flash-1"}, {PoolName: "Tier1-Flash-1"}},
+		}, nil)
+		g.Expect(clusterScope.ReconcileZoneCapabilities(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.ZoneCapabilities).To(Equal(&infrav1.ZoneCapabilitiesStatus{
+			SystemTypes: []infrav1.SystemTypeCapabilities{
+				{Name: "e980"},
+				{Name: "s922"},
+			},
+			StorageTiers: []string{"tier1", "tier3"},
+			StoragePools: []string{"Tier1-Flash-1", "Tier3-Flash-1"},
+		}))
+	})
+}
+
+func TestReconcileDHCPNetworks(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When no DHCP networks are set in spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMPowerVSClient:  mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+		}
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeTrue())
+		g.Expect(active).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(BeNil())
 	})
-	t.Run("When VPCZonesForVPCRegion returns error on providing invalid region", func(t *testing.T) {
+	t.Run("When DHCP server ID is set in spec and exists in IBM cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC: &infrav1.VPCResourceReference{Region: ptr.To("aa-dde")}},
-			},
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{Spec: infrav1.IBMPowerVSClusterSpec{
+				DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage", DHCPServer: &infrav1.DHCPServer{ID: ptr.To("dhcpID")}}},
+			}},
 		}
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID")}, nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(Equal(map[string]infrav1.DHCPNetworkStatus{
+			"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(false)}},
+		}))
 	})
-	t.Run("When no vpcZones exists for a region", func(t *testing.T) {
+	t.Run("When DHCP server with the expected network name exists in IBM cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
+		netName := dhcpNetworkName("clusterName-storage")
+		dhcpServers := models.DHCPServers{&models.DHCPServer{ID: ptr.To("dhcpID"), Network: &models.DHCPServerNetwork{ID: ptr.To("netID"), Name: ptr.To(netName)}}}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "clusterName"},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC: &infrav1.VPCResourceReference{Region: ptr.To("")}},
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage", DHCPServer: &infrav1.DHCPServer{Name: ptr.To("clusterName-storage")}}},
+				},
 			},
 		}
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockPowerVS.EXPECT().GetAllDHCPServers().Return(dhcpServers, nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.DHCPNetworks["storage"].DHCPServer.ID).To(Equal("dhcpID"))
 	})
-	t.Run("When subnetID and subnetName is nil and vpc subnet exists in cloud", func(t *testing.T) {
+	t.Run("When DHCP server does not exist and is created", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
+		dhcpServer := &models.DHCPServer{ID: ptr.To("dhcpID"), Network: &models.DHCPServerNetwork{ID: ptr.To("netID")}}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				ObjectMeta: metav1.ObjectMeta{Name: "clusterName"},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC:        &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets: []infrav1.Subnet{{Zone: ptr.To("eu-de-1")}},
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage", DHCPServer: &infrav1.DHCPServer{Cidr: ptr.To("192.168.10.0/24")}}},
 				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSubnet: map[string]infrav1.ResourceReference{
-						"subnet1Name": {ID: ptr.To("subnet1ID"), ControllerCreated: ptr.To(true)},
-					}},
 			},
 		}
-		vpcSubnet1Name := fmt.Sprintf("%s-vpcsubnet-0", clusterScope.IBMPowerVSCluster.ObjectMeta.Name)
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: &vpcSubnet1Name}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(subnet1Details, nil)
-
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockPowerVS.EXPECT().GetAllDHCPServers().Return(nil, nil)
+		mockPowerVS.EXPECT().CreateDHCPServer(gomock.Any()).DoAndReturn(func(params *models.DHCPServerCreate) (*models.DHCPServer, error) {
+			g.Expect(*params.Name).To(Equal("clusterName-storage"))
+			g.Expect(*params.Cidr).To(Equal("192.168.10.0/24"))
+			return dhcpServer, nil
+		})
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeFalse())
+		g.Expect(active).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(Equal(map[string]infrav1.DHCPNetworkStatus{
+			"storage": {
+				DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)},
+				Network:    &infrav1.ResourceReference{ID: ptr.To("netID"), ControllerCreated: ptr.To(true)},
+			},
+		}))
 	})
-
-	t.Run("When GetSubnet returns error", func(t *testing.T) {
+	t.Run("When CreateDHCPServer returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				ObjectMeta: metav1.ObjectMeta{Name: "clusterName"},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets: []infrav1.Subnet{
-						{
-							ID:   ptr.To("subnet1ID"),
-							Name: ptr.To("subnet1Name"),
-						},
-					}},
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage"}},
+				},
 			},
 		}
-
-		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, fmt.Errorf("GetSubnet returns error"))
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockPowerVS.EXPECT().GetAllDHCPServers().Return(nil, nil)
+		mockPowerVS.EXPECT().CreateDHCPServer(gomock.Any()).Return(nil, fmt.Errorf("error creating dhcp server"))
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to reconcile DHCP network storage")))
+		g.Expect(active).To(BeFalse())
 	})
-
-	t.Run("When GetSubnet returns empty subnet", func(t *testing.T) {
+	t.Run("When DHCP server in status is in build state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-					VPCSubnets: []infrav1.Subnet{
-						{
-							ID:   ptr.To("subnet1ID"),
-							Name: ptr.To("subnet1Name"),
-						},
-					}},
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage"}},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
+					},
+				},
 			},
 		}
-
-		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, nil)
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1.DHCPServerStateBuild))}, nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeFalse())
 	})
-
-	t.Run("When GetVPCSubnetByName in checkVPCSubnet returns error", func(t *testing.T) {
+	t.Run("When DHCP server in status is active", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage"}},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(false)}},
+					},
 				},
 			},
 		}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, fmt.Errorf("GetVPCSubnetByName returns error"))
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1.DHCPServerStateActive)), Network: &models.DHCPServerNetwork{ID: ptr.To("netID")}}
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(dhcpServer, nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks["storage"].Network).To(Equal(&infrav1.ResourceReference{ID: ptr.To("netID"), ControllerCreated: ptr.To(false)}))
 	})
-
-	t.Run("When createVPCSubnet returns error as Resourcegroup is not set", func(t *testing.T) {
+	t.Run("When DHCP server in status is in error state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage"}},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
+					},
 				},
 			},
 		}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1.DHCPServerStateError))}, nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(active).To(BeFalse())
 	})
-}
-
-func TestSetVPCSubnetStatus(t *testing.T) {
-	testCases := []struct {
-		name         string
-		subnetName   string
-		resource     infrav1.ResourceReference
-		clusterScope ClusterScope
-	}{
-		{
-			name:       "VPC subnet status is nil",
-			subnetName: "subnet1Name",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resource: infrav1.ResourceReference{ID: ptr.To("ID1")},
-		},
-		{
-			name:       "VPC subnet status is not nil",
-			subnetName: "subnet1Name",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Status: infrav1.IBMPowerVSClusterStatus{
-						VPCSubnet: map[string]infrav1.ResourceReference{
-							"subnet1Name": {
-								ControllerCreated: ptr.To(true),
-							},
-						},
-					},
-				},
-			},
-			resource: infrav1.ResourceReference{ID: ptr.To("ID1"), ControllerCreated: ptr.To(true)},
-		},
-	}
-
-	for _, tc := range testCases {
-		g := NewWithT(t)
-		t.Run(tc.name, func(_ *testing.T) {
-			tc.clusterScope.SetVPCSubnetStatus(ctx, tc.subnetName, tc.resource)
-			g.Expect(tc.clusterScope.IBMPowerVSCluster.Status.VPCSubnet[tc.subnetName].ID).To(Equal(tc.resource.ID))
-			g.Expect(tc.clusterScope.IBMPowerVSCluster.Status.VPCSubnet[tc.subnetName].ControllerCreated).To(Equal(tc.resource.ControllerCreated))
-		})
-	}
-}
-
-func TestCheckVPCSubnet(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	t.Run("When GetVPCSubnetByName returns nil as vpc subnet does not exist in cloud", func(t *testing.T) {
+	t.Run("When DHCP network is removed from spec and its DHCP server is created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
+					},
+				},
+			},
 		}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		vpcSubnetID, err := clusterScope.checkVPCSubnet(ctx, "subnet1Name")
-		g.Expect(vpcSubnetID).To(Equal(""))
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID")}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("dhcpID").Return(nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(HaveKey("storage"))
+
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(nil, fmt.Errorf("%s", DHCPServerNotFound))
+		active, err = clusterScope.ReconcileDHCPNetworks(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(BeEmpty())
 	})
-	t.Run("When GetVPCSubnetByName returns error", func(t *testing.T) {
+	t.Run("When DHCP network is removed from spec and its DHCP server is not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					DHCPNetworks: []infrav1.DHCPNetwork{{Name: "storage"}},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {
+							DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(false)},
+							Network:    &infrav1.ResourceReference{ID: ptr.To("netID"), ControllerCreated: ptr.To(false)},
+						},
+						"removed": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("removedDHCPID"), ControllerCreated: ptr.To(false)}},
+					},
+				},
+			},
 		}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, fmt.Errorf("GetVPCSubnetByName returns error"))
-		vpcSubnetID, err := clusterScope.checkVPCSubnet(ctx, "subnet1Name")
-		g.Expect(vpcSubnetID).To(Equal(""))
-		g.Expect(err).ToNot(BeNil())
+		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpID"), Status: ptr.To(string(infrav1.DHCPServerStateActive)), Network: &models.DHCPServerNetwork{ID: ptr.To("netID")}}
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(dhcpServer, nil)
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(active).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(HaveLen(1))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(HaveKey("storage"))
 	})
-	t.Run("When GetVPCSubnetByName returns success", func(t *testing.T) {
+	t.Run("When DHCP network is removed from spec and deletion of its DHCP server fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			IBMPowerVSClient: mockPowerVS,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
+					},
+				},
+			},
 		}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(subnet1Details, nil)
-		vpcSubnetID, err := clusterScope.checkVPCSubnet(ctx, "subnet1Name")
-		g.Expect(vpcSubnetID).To(Equal(*subnet1Details.ID))
-		g.Expect(err).To(BeNil())
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID")}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("dhcpID").Return(fmt.Errorf("error deleting dhcp server"))
+		active, err := clusterScope.ReconcileDHCPNetworks(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to delete DHCP server of DHCP network storage")))
+		g.Expect(active).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DHCPNetworks).To(HaveKey("storage"))
 	})
 }
 
-func TestCreateVPCSubnet(t *testing.T) {
+func TestReconcileVPCSubnets(t *testing.T) {
 	var (
 		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -4019,7 +4570,7 @@ func TestCreateVPCSubnet(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When zone is set and createVPCSubnet returns success", func(t *testing.T) {
+	t.Run("When VPCSubnets are set in spec", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -4027,60 +4578,141 @@ func TestCreateVPCSubnet(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}},
+					VPC:        &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					VPCSubnets: []infrav1.Subnet{{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}, {ID: ptr.To("subnet2ID"), Name: ptr.To("subnet2Name")}}},
 			},
 		}
-		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
-
-		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil)
-		subnetID, err := clusterScope.createVPCSubnet(subnet)
-		g.Expect(subnetID).To(Equal(subnet1Details.ID))
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}
+		subnet1Options := &vpcv1.GetSubnetOptions{ID: subnet1Details.ID}
+		mockVPC.EXPECT().GetSubnet(subnet1Options).Return(subnet1Details, nil, nil)
+		subnet2Details := &vpcv1.Subnet{ID: ptr.To("subnet2ID"), Name: ptr.To("subnet2Name")}
+		subnet2Options := &vpcv1.GetSubnetOptions{ID: subnet2Details.ID}
+		mockVPC.EXPECT().GetSubnet(subnet2Options).Return(subnet2Details, nil, nil)
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet2Details.Name].ID).To(Equal(subnet2Details.ID))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet2Details.Name].ControllerCreated).To(BeNil())
 	})
-	t.Run("When zone is not set and createVPCSubnet returns success", func(t *testing.T) {
+
+	t.Run("When more VPCSubnets are set in the spec than the available VPC zones with zone explicitly mentioned for few subnets", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		var subnetZone *string
 
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
+				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
 					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
 					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}},
+					VPCSubnets: []infrav1.Subnet{
+						{Name: ptr.To("subnet1Name"), Zone: ptr.To("eu-de-2")},
+						{Name: ptr.To("subnet2Name")},
+						{Name: ptr.To("subnet3Name")},
+						{Name: ptr.To("subnet4Name")},
+						{Name: ptr.To("subnet5Name")},
+					}},
 			},
 		}
-		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
-		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
 
-		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil)
-		subnetID, err := clusterScope.createVPCSubnet(subnet)
-		g.Expect(subnetID).To(Equal(subnet1Details.ID))
+		vpcZones, err := regionUtil.VPCZonesForVPCRegion(*clusterScope.IBMPowerVSCluster.Spec.VPC.Region)
+		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets))
+		for i := 0; i < len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
+			subnet1Options := &vpcv1.CreateSubnetOptions{}
+			if clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Zone != nil {
+				subnetZone = clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Zone
+			} else {
+				subnetZone = &vpcZones[i%len(vpcZones)]
+			}
+			subnet1Options.SetSubnetPrototype(&vpcv1.SubnetPrototype{
+				IPVersion:             ptr.To("ipv4"),
+				TotalIpv4AddressCount: ptr.To(vpcSubnetIPAddressCount),
+				Name:                  clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Name,
+				VPC: &vpcv1.VPCIdentity{
+					ID: clusterScope.IBMPowerVSCluster.Status.VPC.ID,
+				},
+				Zone: &vpcv1.ZoneIdentity{
+					Name: subnetZone,
+				},
+				ResourceGroup: &vpcv1.ResourceGroupIdentity{
+					ID: clusterScope.IBMPowerVSCluster.Spec.ResourceGroup.ID,
+				},
+			})
+			subnetDetails := &vpcv1.Subnet{ID: ptr.To(fmt.Sprintf("subnet%dID", i+1)), Name: ptr.To(fmt.Sprintf("subnet%dName", i+1))}
+			mockVPC.EXPECT().CreateSubnet(subnet1Options).Return(subnetDetails, nil, nil)
+		}
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeTrue())
 		g.Expect(err).To(BeNil())
+		for i := 1; i <= len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
+			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ID).To(Equal(fmt.Sprintf("subnet%dID", i)))
+			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ControllerCreated).To(BeTrue())
+		}
 	})
 
-	t.Run("When resourceGroupID is not set", func(t *testing.T) {
+	t.Run("When VPCSubnets are set in the spec along with the zones", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			IBMVPCClient:      mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{Spec: infrav1.IBMPowerVSClusterSpec{}},
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					VPCSubnets: []infrav1.Subnet{
+						{Name: ptr.To("subnet1Name"), Zone: ptr.To("eu-de-1")},
+						{Name: ptr.To("subnet2Name"), Zone: ptr.To("eu-de-2")},
+						{Name: ptr.To("subnet3Name"), Zone: ptr.To("eu-de-3")},
+					}},
+			},
 		}
-		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
-		subnetID, err := clusterScope.createVPCSubnet(subnet)
-		g.Expect(subnetID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
-	})
 
-	t.Run("When vpc is not set in Status and GetVPCID returns error", func(t *testing.T) {
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets))
+		for i := 0; i < len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
+			subnet1Options := &vpcv1.CreateSubnetOptions{}
+			subnet1Options.SetSubnetPrototype(&vpcv1.SubnetPrototype{
+				IPVersion:             ptr.To("ipv4"),
+				TotalIpv4AddressCount: ptr.To(vpcSubnetIPAddressCount),
+				Name:                  clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Name,
+				VPC: &vpcv1.VPCIdentity{
+					ID: clusterScope.IBMPowerVSCluster.Status.VPC.ID,
+				},
+				Zone: &vpcv1.ZoneIdentity{
+					Name: clusterScope.IBMPowerVSCluster.Spec.VPCSubnets[i].Zone,
+				},
+				ResourceGroup: &vpcv1.ResourceGroupIdentity{
+					ID: clusterScope.IBMPowerVSCluster.Spec.ResourceGroup.ID,
+				},
+			})
+			subnetDetails := &vpcv1.Subnet{ID: ptr.To(fmt.Sprintf("subnet%dID", i+1)), Name: ptr.To(fmt.Sprintf("subnet%dName", i+1))}
+			mockVPC.EXPECT().CreateSubnet(subnet1Options).Return(subnetDetails, nil, nil)
+		}
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+		for i := 1; i <= len(clusterScope.IBMPowerVSCluster.Spec.VPCSubnets); i++ {
+			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ID).To(Equal(fmt.Sprintf("subnet%dID", i)))
+			g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[fmt.Sprintf("subnet%dName", i)].ControllerCreated).To(BeTrue())
+		}
+	})
+	t.Run("When VPCSubnets are not set in spec and subnet doesnot exist in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -4088,15 +4720,27 @@ func TestCreateVPCSubnet(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")}}},
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")}},
+			},
 		}
-		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
-		subnetID, err := clusterScope.createVPCSubnet(subnet)
-		g.Expect(subnetID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil).Times(3)
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil).Times(3)
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+		g.Expect(len(clusterScope.IBMPowerVSCluster.Status.VPCSubnet)).To(Equal(3))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeTrue())
 	})
 
-	t.Run("When CreateSubnet returns error", func(t *testing.T) {
+	t.Run("When VPCSubnets are set in spec but zone is not specified", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -4104,16 +4748,27 @@ func TestCreateVPCSubnet(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec:   infrav1.IBMPowerVSClusterSpec{ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")}},
-				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}}},
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					VPCSubnets:    []infrav1.Subnet{{Name: ptr.To("subnet1Name")}}},
+			},
 		}
-		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
-		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(nil, nil, fmt.Errorf("error creating subnet"))
-		subnetID, err := clusterScope.createVPCSubnet(subnet)
-		g.Expect(subnetID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil)
+
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeTrue())
 	})
-	t.Run("When CreateSubnet returns empty subnet", func(t *testing.T) {
+	t.Run("When VPCZonesForVPCRegion returns error on providing invalid region", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -4121,352 +4776,377 @@ func TestCreateVPCSubnet(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec:   infrav1.IBMPowerVSClusterSpec{ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")}},
-				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}}},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC: &infrav1.VPCResourceReference{Region: ptr.To("aa-dde")}},
+			},
 		}
-		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
-		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(nil, nil, nil)
-		subnetID, err := clusterScope.createVPCSubnet(subnet)
-		g.Expect(subnetID).To(BeNil())
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-}
-func TestPowerVSDeleteLoadBalancer(t *testing.T) {
-	var (
-		mockVpc  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVpc = mock.NewMockVpc(mockCtrl)
-	}
+	t.Run("When no vpcZones exists for a region", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	powervsClusterScope := func() *ClusterScope {
-		return &ClusterScope{
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					LoadBalancers: map[string]infrav1.VPCLoadBalancerStatus{
-						"lb": {
-							ID:                ptr.To("lb-id"),
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC: &infrav1.VPCResourceReference{Region: ptr.To("")}},
 			},
 		}
-	}
-
-	t.Run("When load balancer is not found", func(*testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(BeNil())
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
 		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When DeleteLoadBalancer returns error", func(*testing.T) {
+	t.Run("When subnetID and subnetName is nil and vpc subnet exists in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
-			ID:                 ptr.To("lb-id"),
-			Name:               ptr.To("lb"),
-			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
-		}, nil, nil)
-		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete load balancer"))
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(Not(BeNil()))
-		g.Expect(requeue).To(BeFalse())
-	})
 
-	t.Run("When load balancer deletion is in pending state", func(*testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
-			ID:                 ptr.To("lb-id"),
-			Name:               ptr.To("lb"),
-			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateDeletePending)),
-		}, nil, nil)
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC:        &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					VPCSubnets: []infrav1.Subnet{{Zone: ptr.To("eu-de-1")}},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"subnet1Name": {ID: ptr.To("subnet1ID"), ControllerCreated: ptr.To(true)},
+					}},
+			},
+		}
+		vpcSubnet1Name := fmt.Sprintf("%s-vpcsubnet-0", clusterScope.IBMPowerVSCluster.ObjectMeta.Name)
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: &vpcSubnet1Name}
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(subnet1Details, nil)
+
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ID).To(Equal(subnet1Details.ID))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCSubnet[*subnet1Details.Name].ControllerCreated).To(BeFalse())
 	})
 
-	t.Run("When GetLoadBalancer returns error", func(*testing.T) {
+	t.Run("When GetSubnet returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, nil, errors.New("failed to get loadbalancer"))
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(Not(BeNil()))
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					VPCSubnets: []infrav1.Subnet{
+						{
+							ID:   ptr.To("subnet1ID"),
+							Name: ptr.To("subnet1Name"),
+						},
+					}},
+			},
+		}
+
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, fmt.Errorf("GetSubnet returns error"))
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
 		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When DeleteLoadBalancer successfully deletes load balancer in cloud", func(*testing.T) {
+	t.Run("When GetSubnet returns empty subnet", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
-			ID:                 ptr.To("lb-id"),
-			Name:               ptr.To("lb"),
-			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
-		}, nil, nil)
-		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, nil)
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
-	})
 
-	t.Run("When load balancer is not created by controller", func(*testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{
-			"lb": {
-				ID:                ptr.To("lb-id"),
-				ControllerCreated: ptr.To(false),
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+					VPCSubnets: []infrav1.Subnet{
+						{
+							ID:   ptr.To("subnet1ID"),
+							Name: ptr.To("subnet1Name"),
+						},
+					}},
 			},
 		}
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(BeNil())
+
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, nil)
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
 		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When one load balancer is not created by controller", func(*testing.T) {
+	t.Run("When GetVPCSubnetByName in checkVPCSubnet returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{
-			testLB1: {
-				ID:                ptr.To("lb-id"),
-				ControllerCreated: ptr.To(true),
-			},
-			testLB2: {
-				ID:                ptr.To("lb-id"),
-				ControllerCreated: ptr.To(false),
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+				},
 			},
 		}
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
-			ID:                 ptr.To("lb-id"),
-			Name:               ptr.To("lb"),
-			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
-		}, nil, nil)
-		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, nil)
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, fmt.Errorf("GetVPCSubnetByName returns error"))
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When deleting multiple load balancer", func(*testing.T) {
+	t.Run("When createVPCSubnet returns error as Resourcegroup is not set", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{
-			testLB1: {
-				ID:                ptr.To("lb-id"),
-				ControllerCreated: ptr.To(true),
-			},
-			testLB2: {
-				ID:                ptr.To("lb-id"),
-				ControllerCreated: ptr.To(true),
-			},
-			"lb3": {
-				ID:                ptr.To("lb-id"),
-				ControllerCreated: ptr.To(true),
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: testClusterName},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPC: &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+				},
 			},
 		}
-		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
-			ID:                 ptr.To("lb-id"),
-			Name:               ptr.To("lb"),
-			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
-		}, nil, nil).Times(3)
-		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, nil).Times(3)
-		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.ReconcileVPCSubnets(ctx)
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
 	})
 }
 
-func TestDeleteVPCSecurityGroups(t *testing.T) {
+func TestSetVPCSubnetStatus(t *testing.T) {
+	testCases := []struct {
+		name         string
+		subnetName   string
+		resource     infrav1.ResourceReference
+		clusterScope ClusterScope
+	}{
+		{
+			name:       "VPC subnet status is nil",
+			subnetName: "subnet1Name",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resource: infrav1.ResourceReference{ID: ptr.To("ID1")},
+		},
+		{
+			name:       "VPC subnet status is not nil",
+			subnetName: "subnet1Name",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						VPCSubnet: map[string]infrav1.ResourceReference{
+							"subnet1Name": {
+								ControllerCreated: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			resource: infrav1.ResourceReference{ID: ptr.To("ID1"), ControllerCreated: ptr.To(true)},
+		},
+	}
+
+	for _, tc := range testCases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			tc.clusterScope.SetVPCSubnetStatus(ctx, tc.subnetName, tc.resource)
+			g.Expect(tc.clusterScope.IBMPowerVSCluster.Status.VPCSubnet[tc.subnetName].ID).To(Equal(tc.resource.ID))
+			g.Expect(tc.clusterScope.IBMPowerVSCluster.Status.VPCSubnet[tc.subnetName].ControllerCreated).To(Equal(tc.resource.ControllerCreated))
+		})
+	}
+}
+
+func TestCheckVPCSubnet(t *testing.T) {
 	var (
-		mockVpc  *mock.MockVpc
+		mockVPC  *mock.MockVpc
 		mockCtrl *gomock.Controller
 	)
-
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockVpc = mock.NewMockVpc(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
 	}
-
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	powervsClusterScope := func() *ClusterScope {
-		return &ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: map[string]infrav1.VPCSecurityGroupStatus{
-						"sc": {
-							ID:                ptr.To("sc-id"),
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-		}
-	}
-
-	t.Run("When security group is not found", func(*testing.T) {
+	t.Run("When GetVPCSubnetByName returns nil as vpc subnet does not exist in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+		}
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		vpcSubnetID, err := clusterScope.checkVPCSubnet(ctx, "subnet1Name")
+		g.Expect(vpcSubnetID).To(Equal(""))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When DeleteSecurityGroup returns error", func(*testing.T) {
+	t.Run("When GetVPCSubnetByName returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
-			ID:   ptr.To("sc-id"),
-			Name: ptr.To("sc"),
-		}, nil, nil)
-		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete security group"))
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
-		g.Expect(err).To(Not(BeNil()))
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+		}
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, fmt.Errorf("GetVPCSubnetByName returns error"))
+		vpcSubnetID, err := clusterScope.checkVPCSubnet(ctx, "subnet1Name")
+		g.Expect(vpcSubnetID).To(Equal(""))
+		g.Expect(err).ToNot(BeNil())
 	})
-
-	t.Run("When GetSecurityGroup returns error", func(*testing.T) {
+	t.Run("When GetVPCSubnetByName returns success", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
-		g.Expect(err).To(Not(BeNil()))
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+		}
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("subnet1Name")}
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(subnet1Details, nil)
+		vpcSubnetID, err := clusterScope.checkVPCSubnet(ctx, "subnet1Name")
+		g.Expect(vpcSubnetID).To(Equal(*subnet1Details.ID))
+		g.Expect(err).To(BeNil())
 	})
+}
 
-	t.Run("When DeleteSecurityGroup successfully deletes security group in cloud", func(*testing.T) {
+func TestCreateVPCSubnet(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When zone is set and createVPCSubnet returns success", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
-			ID:   ptr.To("sc-id"),
-			Name: ptr.To("sc"),
-		}, nil, nil)
-		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, nil)
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}},
+			},
+		}
+		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
+
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil)
+		subnetID, err := clusterScope.createVPCSubnet(subnet)
+		g.Expect(subnetID).To(Equal(subnet1Details.ID))
 		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When deleting multiple SecurityGroup", func(*testing.T) {
+	t.Run("When zone is not set and createVPCSubnet returns success", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPCSecurityGroups = map[string]infrav1.VPCSecurityGroupStatus{
-			"sc1": {
-				ID:                ptr.To("sc-id"),
-				ControllerCreated: ptr.To(true),
-			},
-			"sc2": {
-				ID:                ptr.To("sc-id"),
-				ControllerCreated: ptr.To(true),
-			},
-			"sc3": {
-				ID:                ptr.To("sc-id"),
-				ControllerCreated: ptr.To(true),
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("eu-de")},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}},
 			},
 		}
-		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
-			ID:   ptr.To("sc-id"),
-			Name: ptr.To("sc"),
-		}, nil, nil).Times(3)
-		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, nil).Times(3)
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
+		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
+		subnet1Details := &vpcv1.Subnet{ID: ptr.To("subnet1ID"), Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
+
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(subnet1Details, nil, nil)
+		subnetID, err := clusterScope.createVPCSubnet(subnet)
+		g.Expect(subnetID).To(Equal(subnet1Details.ID))
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When one security group is not created by controller", func(*testing.T) {
+	t.Run("When resourceGroupID is not set", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPCSecurityGroups = map[string]infrav1.VPCSecurityGroupStatus{
-			"sc1": {
-				ID:                ptr.To("sc-id"),
-				ControllerCreated: ptr.To(false),
-			},
-			"sc2": {
-				ID:                ptr.To("sc-id"),
-				ControllerCreated: ptr.To(true),
-			},
+
+		clusterScope := ClusterScope{
+			IBMVPCClient:      mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{Spec: infrav1.IBMPowerVSClusterSpec{}},
 		}
-		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
-			ID:   ptr.To("sc-id"),
-			Name: ptr.To("sc"),
-		}, nil, nil)
-		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, nil)
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
-		g.Expect(err).To(BeNil())
+		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1")}
+		subnetID, err := clusterScope.createVPCSubnet(subnet)
+		g.Expect(subnetID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When security group is not created by controller", func(*testing.T) {
+	t.Run("When vpc is not set in Status and GetVPCID returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPCSecurityGroups = map[string]infrav1.VPCSecurityGroupStatus{
-			"sc": {
-				ID:                ptr.To("sc-id"),
-				ControllerCreated: ptr.To(false),
-			},
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")}}},
 		}
-		clusterScope.IBMVPCClient = mockVpc
-		err := clusterScope.DeleteVPCSecurityGroups(ctx)
-		g.Expect(err).To(BeNil())
+		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
+		subnetID, err := clusterScope.createVPCSubnet(subnet)
+		g.Expect(subnetID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-}
 
-func TestDeleteVPCSubnet(t *testing.T) {
+	t.Run("When CreateSubnet returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec:   infrav1.IBMPowerVSClusterSpec{ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")}},
+				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}}},
+		}
+		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(nil, nil, fmt.Errorf("error creating subnet"))
+		subnetID, err := clusterScope.createVPCSubnet(subnet)
+		g.Expect(subnetID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When CreateSubnet returns empty subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec:   infrav1.IBMPowerVSClusterSpec{ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")}},
+				Status: infrav1.IBMPowerVSClusterStatus{VPC: &infrav1.ResourceReference{ID: ptr.To("vpcID")}}},
+		}
+		subnet := infrav1.Subnet{Name: ptr.To("ClusterName-vpcsubnet-eu-de-1"), Zone: ptr.To("eu-de-1")}
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).Return(nil, nil, nil)
+		subnetID, err := clusterScope.createVPCSubnet(subnet)
+		g.Expect(subnetID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+func TestPowerVSDeleteLoadBalancer(t *testing.T) {
 	var (
 		mockVpc  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -4485,9 +5165,12 @@ func TestDeleteVPCSubnet(t *testing.T) {
 		return &ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSubnet: map[string]infrav1.ResourceReference{
-						"subent1": {
-							ID:                ptr.To("subent1"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
+					LoadBalancers: map[string]infrav1.VPCLoadBalancerStatus{
+						"lb": {
+							ID:                ptr.To("lb-id"),
 							ControllerCreated: ptr.To(true),
 						},
 					},
@@ -4496,136 +5179,156 @@ func TestDeleteVPCSubnet(t *testing.T) {
 		}
 	}
 
-	t.Run("When VPC Subnet is not found", func(*testing.T) {
+	t.Run("When load balancer is not found", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When DeleteSubnet returns error", func(*testing.T) {
+	t.Run("When DeleteLoadBalancer returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnet1"), Status: ptr.To("active")}, nil, nil)
-		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete subnet"))
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:                 ptr.To("lb-id"),
+			Name:               ptr.To("lb"),
+			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete load balancer"))
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(Not(BeNil()))
 		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When subnet deletion is in pending state", func(*testing.T) {
+	t.Run("When load balancer deletion is in pending state", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnet1"), Status: ptr.To(string(infrav1.VPCSubnetStateDeleting))}, nil, nil)
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:                 ptr.To("lb-id"),
+			Name:               ptr.To("lb"),
+			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateDeletePending)),
+		}, nil, nil)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When GetSubnet returns error", func(*testing.T) {
+	t.Run("When GetLoadBalancer returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, nil, errors.New("failed to get loadbalancer"))
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(Not(BeNil()))
 		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When DeleteVPCSubnet successfully deletes subnet in cloud", func(*testing.T) {
+	t.Run("When DeleteLoadBalancer successfully deletes load balancer in cloud", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnet1"), Status: ptr.To("active")}, nil, nil)
-		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:                 ptr.To("lb-id"),
+			Name:               ptr.To("lb"),
+			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, nil)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When deleting multiple subnet", func(*testing.T) {
+	t.Run("When load balancer is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
-			"subent1": {
-				ID:                ptr.To("subentid"),
-				ControllerCreated: ptr.To(true),
-			},
-			"subent2": {
-				ID:                ptr.To("subentid"),
-				ControllerCreated: ptr.To(true),
-			},
-			"subent3": {
-				ID:                ptr.To("subentid"),
-				ControllerCreated: ptr.To(true),
+		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{
+			"lb": {
+				ID:                ptr.To("lb-id"),
+				ControllerCreated: ptr.To(false),
 			},
 		}
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnetid"), Status: ptr.To("active")}, nil, nil).Times(3)
-		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, nil).Times(3)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When one subnet is not created by controller", func(*testing.T) {
+	t.Run("When one load balancer is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
-			"subent1": {
-				ID:                ptr.To("subentid"),
-				ControllerCreated: ptr.To(false),
-			},
-			"subent2": {
-				ID:                ptr.To("subentid"),
+		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{
+			testLB1: {
+				ID:                ptr.To("lb-id"),
 				ControllerCreated: ptr.To(true),
 			},
+			testLB2: {
+				ID:                ptr.To("lb-id"),
+				ControllerCreated: ptr.To(false),
+			},
 		}
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:                 ptr.To("lb-id"),
+			Name:               ptr.To("lb"),
+			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, nil)
 		clusterScope.IBMVPCClient = mockVpc
-		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnetid"), Status: ptr.To("active")}, nil, nil)
-		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, nil)
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When subnet is not created by controller", func(*testing.T) {
+	t.Run("When deleting multiple load balancer", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
-			"subent1": {
-				ID:                ptr.To("subent1"),
-				ControllerCreated: ptr.To(false),
+		clusterScope.IBMPowerVSCluster.Status.LoadBalancers = map[string]infrav1.VPCLoadBalancerStatus{
+			testLB1: {
+				ID:                ptr.To("lb-id"),
+				ControllerCreated: ptr.To(true),
+			},
+			testLB2: {
+				ID:                ptr.To("lb-id"),
+				ControllerCreated: ptr.To(true),
+			},
+			"lb3": {
+				ID:                ptr.To("lb-id"),
+				ControllerCreated: ptr.To(true),
 			},
 		}
+		mockVpc.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:                 ptr.To("lb-id"),
+			Name:               ptr.To("lb"),
+			ProvisioningStatus: ptr.To(string(infrav1.VPCLoadBalancerStateActive)),
+		}, nil, nil).Times(3)
+		mockVpc.EXPECT().DeleteLoadBalancer(gomock.Any()).Return(&core.DetailedResponse{}, nil).Times(3)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		requeue, err := clusterScope.DeleteLoadBalancer(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
+		g.Expect(requeue).To(BeTrue())
 	})
 }
 
-func TestPowerVSDeleteVPC(t *testing.T) {
+func TestDeleteVPCSecurityGroups(t *testing.T) {
 	var (
 		mockVpc  *mock.MockVpc
 		mockCtrl *gomock.Controller
@@ -4644,115 +5347,150 @@ func TestPowerVSDeleteVPC(t *testing.T) {
 		return &ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID:                ptr.To("vpcid"),
-						ControllerCreated: ptr.To(true),
+					VPCSecurityGroups: map[string]infrav1.VPCSecurityGroupStatus{
+						"sc": {
+							ID:                ptr.To("sc-id"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
 		}
 	}
 
-	t.Run("When VPC is not found", func(*testing.T) {
+	t.Run("When security group is not found", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
 	})
-
-	t.Run("When VPC ID is nil", func(*testing.T) {
+	t.Run("When DeleteSecurityGroup returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPC.ID = nil
+		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
+			ID:   ptr.To("sc-id"),
+			Name: ptr.To("sc"),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete security group"))
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
+		g.Expect(err).To(Not(BeNil()))
 	})
-	t.Run("When DeleteVPC returns error", func(*testing.T) {
+
+	t.Run("When GetSecurityGroup returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpcid"), Status: ptr.To("active")}, nil, nil)
-		mockVpc.EXPECT().DeleteVPC(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete vpc"))
+		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
 		g.Expect(err).To(Not(BeNil()))
-		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When vpc deletion is in pending state", func(*testing.T) {
+	t.Run("When DeleteSecurityGroup successfully deletes security group in cloud", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpcid"), Status: ptr.To(string(infrav1.VPCStateDeleting))}, nil, nil)
+		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
+			ID:   ptr.To("sc-id"),
+			Name: ptr.To("sc"),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, nil)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When GetVPC returns error", func(*testing.T) {
+	t.Run("When deleting multiple SecurityGroup", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		clusterScope.IBMPowerVSCluster.Status.VPCSecurityGroups = map[string]infrav1.VPCSecurityGroupStatus{
+			"sc1": {
+				ID:                ptr.To("sc-id"),
+				ControllerCreated: ptr.To(true),
+			},
+			"sc2": {
+				ID:                ptr.To("sc-id"),
+				ControllerCreated: ptr.To(true),
+			},
+			"sc3": {
+				ID:                ptr.To("sc-id"),
+				ControllerCreated: ptr.To(true),
+			},
+		}
+		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
+			ID:   ptr.To("sc-id"),
+			Name: ptr.To("sc"),
+		}, nil, nil).Times(3)
+		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, nil).Times(3)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
-		g.Expect(err).To(Not(BeNil()))
-		g.Expect(requeue).To(BeFalse())
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When DeleteVPC successfully deletes VPC in cloud", func(*testing.T) {
+	t.Run("When one security group is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpcid"), Status: ptr.To("active")}, nil, nil)
-		mockVpc.EXPECT().DeleteVPC(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		clusterScope.IBMPowerVSCluster.Status.VPCSecurityGroups = map[string]infrav1.VPCSecurityGroupStatus{
+			"sc1": {
+				ID:                ptr.To("sc-id"),
+				ControllerCreated: ptr.To(false),
+			},
+			"sc2": {
+				ID:                ptr.To("sc-id"),
+				ControllerCreated: ptr.To(true),
+			},
+		}
+		mockVpc.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{
+			ID:   ptr.To("sc-id"),
+			Name: ptr.To("sc"),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteSecurityGroup(gomock.Any()).Return(&core.DetailedResponse{}, nil)
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When VPC is not created by controller", func(*testing.T) {
+	t.Run("When security group is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.VPC = &infrav1.ResourceReference{
-			ID:                ptr.To("vpcid"),
-			ControllerCreated: ptr.To(false),
+		clusterScope.IBMPowerVSCluster.Status.VPCSecurityGroups = map[string]infrav1.VPCSecurityGroupStatus{
+			"sc": {
+				ID:                ptr.To("sc-id"),
+				ControllerCreated: ptr.To(false),
+			},
 		}
 		clusterScope.IBMVPCClient = mockVpc
-		requeue, err := clusterScope.DeleteVPC(ctx)
+		err := clusterScope.DeleteVPCSecurityGroups(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
 	})
 }
 
-func TestDeleteTransitGateway(t *testing.T) {
+func TestDeleteVPCEndpointGateways(t *testing.T) {
 	var (
+		mockVpc  *mock.MockVpc
 		mockCtrl *gomock.Controller
-		mockTG   *tgmock.MockTransitGateway
 	)
+
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
+		mockVpc = mock.NewMockVpc(mockCtrl)
 	}
 
 	teardown := func() {
@@ -4762,16 +5500,10 @@ func TestDeleteTransitGateway(t *testing.T) {
 		return &ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID:                ptr.To("transitgatewayID"),
-						ControllerCreated: ptr.To(true),
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-							ID:                ptr.To("connectionID"),
-						},
-						VPCConnection: &infrav1.ResourceReference{
+					VPCEndpointGateways: map[string]infrav1.VPCEndpointGatewayStatus{
+						"vpe-cos": {
+							ID:                ptr.To("vpe-id"),
 							ControllerCreated: ptr.To(true),
-							ID:                ptr.To("connectionID"),
 						},
 					},
 				},
@@ -4779,740 +5511,742 @@ func TestDeleteTransitGateway(t *testing.T) {
 		}
 	}
 
-	t.Run("When transit gateway is nil", func(*testing.T) {
+	t.Run("When endpoint gateway is not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status = infrav1.IBMPowerVSClusterStatus{}
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways["vpe-cos"] = infrav1.VPCEndpointGatewayStatus{
+			ID:                ptr.To("vpe-id"),
+			ControllerCreated: ptr.To(false),
+		}
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When DeleteTransitGateway returns error", func(*testing.T) {
+
+	t.Run("When endpoint gateway is not found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
 		clusterScope := powervsClusterScope()
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
-		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, nil).Times(2)
-		mockTG.EXPECT().DeleteTransitGateway(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete transit gateway"))
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
-		g.Expect(err).To(Not(BeNil()))
+		mockVpc.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways).ToNot(HaveKey("vpe-cos"))
 	})
 
-	t.Run("When transit gateway is not found", func(*testing.T) {
+	t.Run("When GetEndpointGateway returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
 		clusterScope := powervsClusterScope()
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
-		g.Expect(err).To(BeNil())
+		mockVpc.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get endpoint gateway"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When GetTransitGateway returns error", func(*testing.T) {
+	t.Run("When endpoint gateway is in deleting state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
 		clusterScope := powervsClusterScope()
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, errors.New("failed to get transit gateway"))
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
-		g.Expect(err).To(Not(BeNil()))
-		g.Expect(requeue).To(BeFalse())
+		mockVpc.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateDeletingConst),
+		}, nil, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When TransitGateway deletion is in pending state", func(*testing.T) {
+	t.Run("When DeleteEndpointGateway returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateDeletePending))}
 		clusterScope := powervsClusterScope()
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		mockVpc.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteEndpointGateway(gomock.Any()).Return(nil, errors.New("failed to delete endpoint gateway"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When DeleteTransitGateway successfully deletes transit gateway in cloud", func(*testing.T) {
+	t.Run("When DeleteEndpointGateway successfully deletes endpoint gateway", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
 		clusterScope := powervsClusterScope()
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
-		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, nil).Times(2)
-		mockTG.EXPECT().DeleteTransitGateway(gomock.Any()).Return(&core.DetailedResponse{}, nil)
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		mockVpc.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst),
+		}, nil, nil)
+		mockVpc.EXPECT().DeleteEndpointGateway(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeTrue())
 	})
+}
 
-	t.Run("When deleteTransitGatewayConnections returns error", func(*testing.T) {
+func TestDeleteVPCSubnet(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	powervsClusterScope := func() *ClusterScope {
+		return &ClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"subent1": {
+							ID:                ptr.To("subent1"),
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When VPC Subnet is not found", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When DeleteSubnet returns error", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnet1"), Status: ptr.To("active")}, nil, nil)
+		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete subnet"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When subnet deletion is in pending state", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnet1"), Status: ptr.To(string(infrav1.VPCSubnetStateDeleting))}, nil, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
 
+	t.Run("When GetSubnet returns error", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
-		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{}, errors.New("failed to get transit gateway connections"))
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
 		g.Expect(err).To(Not(BeNil()))
 		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When deleteTransitGatewayConnections returns requeue as true", func(*testing.T) {
+	t.Run("When DeleteVPCSubnet successfully deletes subnet in cloud", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnet1"), Status: ptr.To("active")}, nil, nil)
+		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
 
+	t.Run("When deleting multiple subnet", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 		clusterScope := powervsClusterScope()
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateDeleting))}
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
-		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{}, nil)
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
+			"subent1": {
+				ID:                ptr.To("subentid"),
+				ControllerCreated: ptr.To(true),
+			},
+			"subent2": {
+				ID:                ptr.To("subentid"),
+				ControllerCreated: ptr.To(true),
+			},
+			"subent3": {
+				ID:                ptr.To("subentid"),
+				ControllerCreated: ptr.To(true),
+			},
+		}
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnetid"), Status: ptr.To("active")}, nil, nil).Times(3)
+		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, nil).Times(3)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When transit gateway is not created by controller", func(*testing.T) {
+	t.Run("When one subnet is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		tgw := &tgapiv1.TransitGateway{
-			Name:   ptr.To("transitGateway"),
-			ID:     ptr.To("transitGatewayID"),
-			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
 		clusterScope := powervsClusterScope()
-		clusterScope.IBMPowerVSCluster.Status.TransitGateway = &infrav1.TransitGatewayStatus{
-			ID:                ptr.To("transitgatewayID"),
-			ControllerCreated: ptr.To(false),
-			PowerVSConnection: &infrav1.ResourceReference{
+		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
+			"subent1": {
+				ID:                ptr.To("subentid"),
 				ControllerCreated: ptr.To(false),
-				ID:                ptr.To("connectionID"),
 			},
-			VPCConnection: &infrav1.ResourceReference{
+			"subent2": {
+				ID:                ptr.To("subentid"),
+				ControllerCreated: ptr.To(true),
+			},
+		}
+		clusterScope.IBMVPCClient = mockVpc
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("subnetid"), Status: ptr.To("active")}, nil, nil)
+		mockVpc.EXPECT().DeleteSubnet(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+
+	t.Run("When subnet is not created by controller", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
+			"subent1": {
+				ID:                ptr.To("subent1"),
 				ControllerCreated: ptr.To(false),
-				ID:                ptr.To("connectionID"),
 			},
 		}
-		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
-		clusterScope.TransitGatewayClient = mockTG
-		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPCSubnet(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
 }
-func TestIsResourceCreatedByController(t *testing.T) {
-	testCases := []struct {
-		name           string
-		resourceType   infrav1.ResourceType
-		clusterScope   ClusterScope
-		expectedResult bool
-	}{
-		{
-			name: "When resourceType is VPC and VPC status is nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resourceType:   infrav1.ResourceTypeVPC,
-			expectedResult: false,
-		},
-		{
-			name: "When resourceType is VPC and VPC status is not nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Status: infrav1.IBMPowerVSClusterStatus{
-						VPC: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			resourceType:   infrav1.ResourceTypeVPC,
-			expectedResult: true,
-		},
-		{
-			name: "When resourceType is ServiceInstance and ServiceInstance status is nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resourceType:   infrav1.ResourceTypeServiceInstance,
-			expectedResult: false,
-		},
-		{
-			name: "When resourceType is ServiceInstance and ServiceInstance status is not nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Status: infrav1.IBMPowerVSClusterStatus{
-						ServiceInstance: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
+
+func TestPowerVSDeleteVPC(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	powervsClusterScope := func() *ClusterScope {
+		return &ClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID:                ptr.To("vpcid"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			resourceType:   infrav1.ResourceTypeServiceInstance,
-			expectedResult: true,
-		},
-		{
-			name: "When resourceType is TransitGateway and TransitGateway status is nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resourceType:   infrav1.ResourceTypeTransitGateway,
-			expectedResult: false,
-		},
-		{
-			name: "When resourceType is TransitGateway and TransitGateway status is not nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Status: infrav1.IBMPowerVSClusterStatus{
-						TransitGateway: &infrav1.TransitGatewayStatus{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			resourceType:   infrav1.ResourceTypeTransitGateway,
-			expectedResult: true,
-		},
-		{
-			name: "When resourceType is DHCPServer and DHCPServer status is nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resourceType:   infrav1.ResourceTypeDHCPServer,
-			expectedResult: false,
-		},
-		{
-			name: "When resourceType is DHCPServer and DHCPServer status is not nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Status: infrav1.IBMPowerVSClusterStatus{
-						DHCPServer: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			resourceType:   infrav1.ResourceTypeDHCPServer,
-			expectedResult: true,
-		},
-		{
-			name: "When resourceType is COSInstance and COSInstance status is nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resourceType:   infrav1.ResourceTypeCOSInstance,
-			expectedResult: false,
-		},
-		{
-			name: "When resourceType is COSInstance and COSInstance status is not nil",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Status: infrav1.IBMPowerVSClusterStatus{
-						COSInstance: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
-					},
-				},
-			},
-			resourceType:   infrav1.ResourceTypeCOSInstance,
-			expectedResult: true,
-		},
-		{
-			name: "When resourceType is not valid",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
-			},
-			resourceType:   infrav1.ResourceTypePublicGateway,
-			expectedResult: false,
-		},
-	}
-
-	for _, tc := range testCases {
-		g := NewWithT(t)
-		t.Run(tc.name, func(_ *testing.T) {
-			isResourceCreated := tc.clusterScope.isResourceCreatedByController(tc.resourceType)
-			g.Expect(isResourceCreated).To(Equal(tc.expectedResult))
-		})
+		}
 	}
-}
 
-func TestDeleteCOSInstance(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCtrl               *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	t.Run("When COS instance resource is not created by controller", func(t *testing.T) {
+	t.Run("When VPC is not found", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
-		err := clusterScope.DeleteCOSInstance(ctx)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When COS instance ID is nil", func(t *testing.T) {
+
+	t.Run("When VPC ID is nil", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-			Status: infrav1.IBMPowerVSClusterStatus{
-				COSInstance: &infrav1.ResourceReference{
-					ControllerCreated: ptr.To(true),
-				},
-			},
-		}}
-		err := clusterScope.DeleteCOSInstance(ctx)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPC.ID = nil
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When COS instance state is pending_reclamation", func(t *testing.T) {
+	t.Run("When DeleteVPC returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					COSInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("cosInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		cosInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("cosInstanceID"), State: ptr.To("pending_reclamation")}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(cosInstance, nil, nil)
-		err := clusterScope.DeleteCOSInstance(ctx)
-		g.Expect(err).To(BeNil())
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpcid"), Status: ptr.To("active")}, nil, nil)
+		mockVpc.EXPECT().DeleteVPC(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete vpc"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When COS instance is not found", func(t *testing.T) {
+
+	t.Run("When vpc deletion is in pending state", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					COSInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("cosInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, fmt.Errorf("error getting resource instance"))
-		err := clusterScope.DeleteCOSInstance(ctx)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpcid"), Status: ptr.To(string(infrav1.VPCStateDeleting))}, nil, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-	t.Run("When GetResourceInstance returns error", func(t *testing.T) {
+
+	t.Run("When GetVPC returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					COSInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("cosInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error getting resource instance"))
-		err := clusterScope.DeleteCOSInstance(ctx)
-		g.Expect(err.Error()).To(Equal("failed to fetch COS service instance: error getting resource instance"))
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When COS instance state is active and DeleteResourceInstance succeeds", func(t *testing.T) {
+
+	t.Run("When DeleteVPC successfully deletes VPC in cloud", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					COSInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("cosInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		cosInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("cosInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(cosInstance, nil, nil)
-		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, nil)
-		err := clusterScope.DeleteCOSInstance(ctx)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpcid"), Status: ptr.To("active")}, nil, nil)
+		mockVpc.EXPECT().DeleteVPC(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When COS instance state is active and DeleteResourceInstance returns error", func(t *testing.T) {
+	t.Run("When VPC is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					COSInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("cosInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPC = &infrav1.ResourceReference{
+			ID:                ptr.To("vpcid"),
+			ControllerCreated: ptr.To(false),
 		}
-		cosInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("cosInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(cosInstance, nil, nil)
-		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, fmt.Errorf("error deleting resource instance"))
-		err := clusterScope.DeleteCOSInstance(ctx)
-		g.Expect(err).To(Equal(fmt.Errorf("error deleting resource instance")))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.DeleteVPC(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
 }
 
-func TestDeleteServiceInstance(t *testing.T) {
+func TestDeleteTransitGateway(t *testing.T) {
 	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCtrl               *gomock.Controller
+		mockCtrl *gomock.Controller
+		mockTG   *tgmock.MockTransitGateway
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
 	}
+
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When PowerVS service instance resource is not created by controller", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
-		requeue, err := clusterScope.DeleteServiceInstance(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
-	})
-	t.Run("When PowerVS service instance ID is nil", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-			Status: infrav1.IBMPowerVSClusterStatus{
-				ServiceInstance: &infrav1.ResourceReference{
-					ControllerCreated: ptr.To(true),
-				},
-			},
-		}}
-		requeue, err := clusterScope.DeleteServiceInstance(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeFalse())
-	})
-	t.Run("When PowerVS service instance is in removed state", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
+	powervsClusterScope := func() *ClusterScope {
+		return &ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("serviceInstanceID"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID:                ptr.To("transitgatewayID"),
 						ControllerCreated: ptr.To(true),
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+							ID:                ptr.To("connectionID"),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+							ID:                ptr.To("connectionID"),
+						},
 					},
 				},
 			},
-			ResourceClient: mockResourceController,
 		}
-		serviceInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("serviceInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateRemoved))}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(serviceInstance, nil, nil)
-		requeue, err := clusterScope.DeleteServiceInstance(ctx)
+	}
+
+	t.Run("When transit gateway is nil", func(*testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status = infrav1.IBMPowerVSClusterStatus{}
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When GetResourceInstance returns error", func(t *testing.T) {
+	t.Run("When DeleteTransitGateway returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("serviceInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error getting resource instance"))
-		requeue, err := clusterScope.DeleteServiceInstance(ctx)
-		g.Expect(err.Error()).To(Equal("failed to fetch PowerVS service instance: error getting resource instance"))
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		clusterScope := powervsClusterScope()
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, nil).Times(2)
+		mockTG.EXPECT().DeleteTransitGateway(gomock.Any()).Return(&core.DetailedResponse{}, errors.New("failed to delete transit gateway"))
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(Not(BeNil()))
 		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When PowerVS service instance state is active and DeleteResourceInstance succeeds", func(t *testing.T) {
+
+	t.Run("When transit gateway is not found", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("serviceInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		serviceInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("serviceInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(serviceInstance, nil, nil)
-		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.DeleteServiceInstance(ctx)
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		clusterScope := powervsClusterScope()
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When PowerVS instance state is active and DeleteResourceInstance returns error", func(t *testing.T) {
+	t.Run("When GetTransitGateway returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID:                ptr.To("serviceInstanceID"),
-						ControllerCreated: ptr.To(true),
-					},
-				},
-			},
-			ResourceClient: mockResourceController,
-		}
-		serviceInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("serviceInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(serviceInstance, nil, nil)
-		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, fmt.Errorf("error deleting resource instance"))
-		requeue, err := clusterScope.DeleteServiceInstance(ctx)
-		g.Expect(err).To(MatchError(ContainSubstring("error deleting resource instance")))
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		clusterScope := powervsClusterScope()
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, errors.New("failed to get transit gateway"))
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(Not(BeNil()))
 		g.Expect(requeue).To(BeFalse())
 	})
-}
 
-func TestDeleteDHCPServer(t *testing.T) {
-	var (
-		mockPowerVS *mockP.MockPowerVS
-		mockCtrl    *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	t.Run("When DHCP Server resource is not created by controller", func(t *testing.T) {
+	t.Run("When TransitGateway deletion is in pending state", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
-		err := clusterScope.DeleteDHCPServer(ctx)
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateDeletePending))}
+		clusterScope := powervsClusterScope()
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-	t.Run("When PowerVS service instance is created by controller", func(t *testing.T) {
+
+	t.Run("When DeleteTransitGateway successfully deletes transit gateway in cloud", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-			Status: infrav1.IBMPowerVSClusterStatus{
-				DHCPServer: &infrav1.ResourceReference{
-					ControllerCreated: ptr.To(true),
-				},
-				ServiceInstance: &infrav1.ResourceReference{
-					ControllerCreated: ptr.To(true),
-				},
-			},
-		}}
-		err := clusterScope.DeleteDHCPServer(ctx)
+		clusterScope := powervsClusterScope()
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, nil).Times(2)
+		mockTG.EXPECT().DeleteTransitGateway(gomock.Any()).Return(&core.DetailedResponse{}, nil)
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When DHCP server ID is nil", func(t *testing.T) {
+	t.Run("When deleteTransitGatewayConnections returns error", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-			Status: infrav1.IBMPowerVSClusterStatus{
-				DHCPServer: &infrav1.ResourceReference{
-					ControllerCreated: ptr.To(true),
-				},
-				ServiceInstance: &infrav1.ResourceReference{},
-			},
-		}}
-		err := clusterScope.DeleteDHCPServer(ctx)
-		g.Expect(err).To(BeNil())
+		clusterScope := powervsClusterScope()
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{}, errors.New("failed to get transit gateway connections"))
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When the DHCP server is not found", func(t *testing.T) {
+
+	t.Run("When deleteTransitGatewayConnections returns requeue as true", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					DHCPServer: &infrav1.ResourceReference{
-						ID:                ptr.To("dhcpServerID"),
-						ControllerCreated: ptr.To(true),
-					},
-					ServiceInstance: &infrav1.ResourceReference{},
-				},
-			},
-			IBMPowerVSClient: mockPowerVS,
-		}
-		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(nil, fmt.Errorf("dhcp server does not exist"))
-		err := clusterScope.DeleteDHCPServer(ctx)
+		clusterScope := powervsClusterScope()
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateDeleting))}
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		mockTG.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{}, nil)
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-	t.Run("When GetDHCPServer returns error", func(t *testing.T) {
+
+	t.Run("When transit gateway is not created by controller", func(*testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					DHCPServer: &infrav1.ResourceReference{
-						ID:                ptr.To("dhcpServerID"),
-						ControllerCreated: ptr.To(true),
-					},
-					ServiceInstance: &infrav1.ResourceReference{},
-				},
-			},
-			IBMPowerVSClient: mockPowerVS,
-		}
-		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(nil, fmt.Errorf("error getting dhcp server"))
-		err := clusterScope.DeleteDHCPServer(ctx)
-		g.Expect(err).To(MatchError(ContainSubstring("error getting dhcp server")))
-	})
-	t.Run("When DeleteDHCPServer returns error", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					DHCPServer: &infrav1.ResourceReference{
-						ID:                ptr.To("dhcpServerID"),
-						ControllerCreated: ptr.To(true),
-					},
-					ServiceInstance: &infrav1.ResourceReference{},
-				},
+		tgw := &tgapiv1.TransitGateway{
+			Name:   ptr.To("transitGateway"),
+			ID:     ptr.To("transitGatewayID"),
+			Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.TransitGateway = &infrav1.TransitGatewayStatus{
+			ID:                ptr.To("transitgatewayID"),
+			ControllerCreated: ptr.To(false),
+			PowerVSConnection: &infrav1.ResourceReference{
+				ControllerCreated: ptr.To(false),
+				ID:                ptr.To("connectionID"),
+			},
+			VPCConnection: &infrav1.ResourceReference{
+				ControllerCreated: ptr.To(false),
+				ID:                ptr.To("connectionID"),
 			},
-			IBMPowerVSClient: mockPowerVS,
 		}
-		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpServerID")}
-		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(dhcpServer, nil)
-		mockPowerVS.EXPECT().DeleteDHCPServer(gomock.Any()).Return(fmt.Errorf("error deleting dhcp server"))
-		err := clusterScope.DeleteDHCPServer(ctx)
-		g.Expect(err.Error()).To(Equal("failed to delete DHCP server: error deleting dhcp server"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(tgw, nil, nil)
+		clusterScope.TransitGatewayClient = mockTG
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When DHCP server deletion is successful", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-
-		clusterScope := ClusterScope{
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					DHCPServer: &infrav1.ResourceReference{
-						ID:                ptr.To("dhcpServerID"),
-						ControllerCreated: ptr.To(true),
+}
+func TestIsResourceCreatedByController(t *testing.T) {
+	testCases := []struct {
+		name           string
+		resourceType   infrav1.ResourceType
+		clusterScope   ClusterScope
+		expectedResult bool
+	}{
+		{
+			name: "When resourceType is VPC and VPC status is nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resourceType:   infrav1.ResourceTypeVPC,
+			expectedResult: false,
+		},
+		{
+			name: "When resourceType is VPC and VPC status is not nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						VPC: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
-					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
-			IBMPowerVSClient: mockPowerVS,
-		}
-		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpServerID")}
-		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(dhcpServer, nil)
-		mockPowerVS.EXPECT().DeleteDHCPServer(gomock.Any()).Return(nil)
-		err := clusterScope.DeleteDHCPServer(ctx)
-		g.Expect(err).To(BeNil())
-	})
+			resourceType:   infrav1.ResourceTypeVPC,
+			expectedResult: true,
+		},
+		{
+			name: "When resourceType is ServiceInstance and ServiceInstance status is nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resourceType:   infrav1.ResourceTypeServiceInstance,
+			expectedResult: false,
+		},
+		{
+			name: "When resourceType is ServiceInstance and ServiceInstance status is not nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						ServiceInstance: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			resourceType:   infrav1.ResourceTypeServiceInstance,
+			expectedResult: true,
+		},
+		{
+			name: "When resourceType is TransitGateway and TransitGateway status is nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resourceType:   infrav1.ResourceTypeTransitGateway,
+			expectedResult: false,
+		},
+		{
+			name: "When resourceType is TransitGateway and TransitGateway status is not nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						TransitGateway: &infrav1.TransitGatewayStatus{
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			resourceType:   infrav1.ResourceTypeTransitGateway,
+			expectedResult: true,
+		},
+		{
+			name: "When resourceType is DHCPServer and DHCPServer status is nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resourceType:   infrav1.ResourceTypeDHCPServer,
+			expectedResult: false,
+		},
+		{
+			name: "When resourceType is DHCPServer and DHCPServer status is not nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						DHCPServer: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			resourceType:   infrav1.ResourceTypeDHCPServer,
+			expectedResult: true,
+		},
+		{
+			name: "When resourceType is COSInstance and COSInstance status is nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resourceType:   infrav1.ResourceTypeCOSInstance,
+			expectedResult: false,
+		},
+		{
+			name: "When resourceType is COSInstance and COSInstance status is not nil",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						COSInstance: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
+					},
+				},
+			},
+			resourceType:   infrav1.ResourceTypeCOSInstance,
+			expectedResult: true,
+		},
+		{
+			name: "When resourceType is not valid",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+			},
+			resourceType:   infrav1.ResourceTypePublicGateway,
+			expectedResult: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			isResourceCreated := tc.clusterScope.isResourceCreatedByController(tc.resourceType)
+			g.Expect(isResourceCreated).To(Equal(tc.expectedResult))
+		})
+	}
 }
 
-func TestDeleteTransitGatewayConnections(t *testing.T) {
+func TestDeleteCOSInstance(t *testing.T) {
 	var (
-		mockTransitGateway *tgmock.MockTransitGateway
-		mockCtrl           *gomock.Controller
+		mockResourceController *mockRC.MockResourceController
+		mockCtrl               *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When PowerVS connection of transit gateway is in deleting state", func(t *testing.T) {
+	t.Run("When COS instance resource is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
+		err := clusterScope.DeleteCOSInstance(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When COS instance ID is nil", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				COSInstance: &infrav1.ResourceReference{
+					ControllerCreated: ptr.To(true),
+				},
+			},
+		}}
+		err := clusterScope.DeleteCOSInstance(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When COS instance state is pending_reclamation", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5520,24 +6254,20 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
+					COSInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("cosInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateDeleting))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		cosInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("cosInstanceID"), State: ptr.To("pending_reclamation")}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(cosInstance, nil, nil)
+		err := clusterScope.DeleteCOSInstance(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
 	})
-
-	t.Run("When DeleteTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
+	t.Run("When COS instance is not found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5545,25 +6275,39 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
+					COSInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("cosInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, fmt.Errorf("error deleting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err.Error()).To(Equal("failed to delete transit gateway connection: error deleting transit gateway connection"))
-		g.Expect(requeue).To(BeFalse())
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, fmt.Errorf("error getting resource instance"))
+		err := clusterScope.DeleteCOSInstance(ctx)
+		g.Expect(err).To(BeNil())
 	})
+	t.Run("When GetResourceInstance returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	t.Run("When DeleteTransitGatewayConnection for PowerVS connection succeeds", func(t *testing.T) {
+		clusterScope := ClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					COSInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("cosInstanceID"),
+						ControllerCreated: ptr.To(true),
+					},
+				},
+			},
+			ResourceClient: mockResourceController,
+		}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error getting resource instance"))
+		err := clusterScope.DeleteCOSInstance(ctx)
+		g.Expect(err.Error()).To(Equal("failed to fetch COS service instance: error getting resource instance"))
+	})
+	t.Run("When COS instance state is active and DeleteResourceInstance succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5571,25 +6315,22 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(true),
-						},
+					COSInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("cosInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		cosInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("cosInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(cosInstance, nil, nil)
+		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, nil)
+		err := clusterScope.DeleteCOSInstance(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When GetTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
+	t.Run("When COS instance state is active and DeleteResourceInstance returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5597,23 +6338,62 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("powerVStgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					COSInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("cosInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 400}, fmt.Errorf("error getting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
+		cosInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("cosInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(cosInstance, nil, nil)
+		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, fmt.Errorf("error deleting resource instance"))
+		err := clusterScope.DeleteCOSInstance(ctx)
+		g.Expect(err).To(Equal(fmt.Errorf("error deleting resource instance")))
+	})
+}
+
+func TestDeleteServiceInstance(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockCtrl               *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When PowerVS service instance resource is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
+		requeue, err := clusterScope.DeleteServiceInstance(ctx)
+		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When PowerVS connection is not found and VPC connection of transit gateway is deleted successfully", func(t *testing.T) {
+	t.Run("When PowerVS service instance ID is nil", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				ServiceInstance: &infrav1.ResourceReference{
+					ControllerCreated: ptr.To(true),
+				},
+			},
+		}}
+		requeue, err := clusterScope.DeleteServiceInstance(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When PowerVS service instance is in removed state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5621,32 +6401,21 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("powerVStgID"),
-							ControllerCreated: ptr.To(true),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					ServiceInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("serviceInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		powerVSTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("powerVStgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(powerVSTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		serviceInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("serviceInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateRemoved))}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(serviceInstance, nil, nil)
+		requeue, err := clusterScope.DeleteServiceInstance(ctx)
 		g.Expect(err).To(BeNil())
-		g.Expect(requeue).To(BeTrue())
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When GetTransitGatewayConnection for VPC connection returns error", func(t *testing.T) {
+	t.Run("When GetResourceInstance returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5654,28 +6423,20 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(false),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					ServiceInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("serviceInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: 500}, fmt.Errorf("error getting transit gateway connection"))
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error getting resource instance"))
+		requeue, err := clusterScope.DeleteServiceInstance(ctx)
+		g.Expect(err.Error()).To(Equal("failed to fetch PowerVS service instance: error getting resource instance"))
 		g.Expect(requeue).To(BeFalse())
 	})
-
-	t.Run("When DeleteTransitGatewayConnection for VPC connection succeeds", func(t *testing.T) {
+	t.Run("When PowerVS service instance state is active and DeleteResourceInstance succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5683,30 +6444,23 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(false),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					ServiceInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("serviceInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
-		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		serviceInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("serviceInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(serviceInstance, nil, nil)
+		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.DeleteServiceInstance(ctx)
 		g.Expect(err).To(BeNil())
 		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When VPC connection of transit gateway is not found", func(t *testing.T) {
+	t.Run("When PowerVS instance state is active and DeleteResourceInstance returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -5714,649 +6468,547 @@ func TestDeleteTransitGatewayConnections(t *testing.T) {
 		clusterScope := ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						PowerVSConnection: &infrav1.ResourceReference{
-							ControllerCreated: ptr.To(false),
-						},
-						VPCConnection: &infrav1.ResourceReference{
-							ID:                ptr.To("vpctgID"),
-							ControllerCreated: ptr.To(true),
-						},
+					ServiceInstance: &infrav1.ResourceReference{
+						ID:                ptr.To("serviceInstanceID"),
+						ControllerCreated: ptr.To(true),
 					},
 				},
 			},
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 		}
-		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
-		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
-		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
-		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
-		g.Expect(err).To(BeNil())
+		serviceInstance := &resourcecontrollerv2.ResourceInstance{ID: ptr.To("serviceInstanceID"), State: ptr.To(string(infrav1.ServiceInstanceStateActive))}
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(serviceInstance, nil, nil)
+		mockResourceController.EXPECT().DeleteResourceInstance(gomock.Any()).Return(nil, fmt.Errorf("error deleting resource instance"))
+		requeue, err := clusterScope.DeleteServiceInstance(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("error deleting resource instance")))
 		g.Expect(requeue).To(BeFalse())
 	})
 }
-func TestReconcileCOSInstance(t *testing.T) {
+
+func TestDeleteDHCPServer(t *testing.T) {
 	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCOSController      *mockcos.MockCos
-		mockCtrl               *gomock.Controller
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockCOSController = mockcos.NewMockCos(mockCtrl)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When fetch for COS service instance fails", func(t *testing.T) {
+	t.Run("When DHCP Server resource is not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
-
-		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: testRegion,
-					},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
-			},
-		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, fmt.Errorf("error fetching instance by name"))
 
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
+		err := clusterScope.DeleteDHCPServer(ctx)
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When COS service instance is found in IBM Cloud and cluster status is updated", func(t *testing.T) {
+	t.Run("When PowerVS service instance is created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				DHCPServer: &infrav1.ResourceReference{
+					ControllerCreated: ptr.To(true),
+				},
+				ServiceInstance: &infrav1.ResourceReference{
+					ControllerCreated: ptr.To(true),
+				},
+			},
+		}}
+		err := clusterScope.DeleteDHCPServer(ctx)
 		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
+	})
+
+	t.Run("When DHCP server ID is nil", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				DHCPServer: &infrav1.ResourceReference{
+					ControllerCreated: ptr.To(true),
+				},
+				ServiceInstance: &infrav1.ResourceReference{},
+			},
+		}}
+		err := clusterScope.DeleteDHCPServer(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When the DHCP server is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: testRegion,
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-					Zone: ptr.To("test-zone"),
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPServer: &infrav1.ResourceReference{
+						ID:                ptr.To("dhcpServerID"),
+						ControllerCreated: ptr.To(true),
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
-			GUID:  ptr.To("test-cos-instance-guid"),
-		}, nil)
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
+		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(nil, fmt.Errorf("dhcp server does not exist"))
+		err := clusterScope.DeleteDHCPServer(ctx)
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud", func(t *testing.T) {
+	t.Run("When GetDHCPServer returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: testRegion,
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-					Zone: ptr.To("test-zone"),
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPServer: &infrav1.ResourceReference{
+						ID:                ptr.To("dhcpServerID"),
+						ControllerCreated: ptr.To(true),
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
+		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(nil, fmt.Errorf("error getting dhcp server"))
+		err := clusterScope.DeleteDHCPServer(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("error getting dhcp server")))
 	})
-
-	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud but fails during the creation", func(t *testing.T) {
+	t.Run("When DeleteDHCPServer returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: testRegion,
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-					Zone: ptr.To("test-zone"),
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPServer: &infrav1.ResourceReference{
+						ID:                ptr.To("dhcpServerID"),
+						ControllerCreated: ptr.To(true),
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to create COS service instance"))
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
+		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpServerID")}
+		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(dhcpServer, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer(gomock.Any()).Return(fmt.Errorf("error deleting dhcp server"))
+		err := clusterScope.DeleteDHCPServer(ctx)
+		g.Expect(err.Error()).To(Equal("failed to delete DHCP server: error deleting dhcp server"))
 	})
-
-	t.Run("When fetch for API_KEY fails", func(t *testing.T) {
+	t.Run("When DHCP server deletion is successful", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: testRegion,
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPServer: &infrav1.ResourceReference{
+						ID:                ptr.To("dhcpServerID"),
+						ControllerCreated: ptr.To(true),
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
+		dhcpServer := &models.DHCPServerDetail{ID: ptr.To("dhcpServerID")}
+		mockPowerVS.EXPECT().GetDHCPServer(gomock.Any()).Return(dhcpServer, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer(gomock.Any()).Return(nil)
+		err := clusterScope.DeleteDHCPServer(ctx)
+		g.Expect(err).To(BeNil())
+	})
+}
 
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
-			GUID:  ptr.To("test-cos-instance-guid"),
-		}, nil)
+func TestDeleteDHCPNetworks(t *testing.T) {
+	var (
+		mockPowerVS *mockP.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockPowerVS = mockP.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When no DHCP networks are set in status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		err := clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
+		err := clusterScope.DeleteDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When COS bucket region is failed to be determined", func(t *testing.T) {
+	t.Run("When PowerVS service instance is created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+					"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
+				},
+				ServiceInstance: &infrav1.ResourceReference{
+					ControllerCreated: ptr.To(true),
+				},
+			},
+		}}
+		err := clusterScope.DeleteDHCPNetworks(ctx)
 		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
+	})
+	t.Run("When only the DHCP servers created by controller are deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage":  {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
+						"existing": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("existingID"), ControllerCreated: ptr.To(false)}},
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(bool(true))))
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(&models.DHCPServerDetail{ID: ptr.To("dhcpID")}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("dhcpID").Return(nil)
+		err := clusterScope.DeleteDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("When checkCOSBucket fails to determine whether bucket exist in cloud due to an unexpected error", func(t *testing.T) {
+	t.Run("When the DHCP server is not found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-bucket-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcpID"), ControllerCreated: ptr.To(true)}},
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, fmt.Errorf("failed to get bucket by name"))
-
-		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
-			return mockCOSController, nil
-		}
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
-	})
-	t.Run("When create COS bucket fails", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
-
-		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-bucket-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
-					},
-				},
-			},
-		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create bucket"))
-
-		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
-			return mockCOSController, nil
-		}
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
-	})
-
-	t.Run("When create COS bucket succeeds", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
-		g.Expect(err).To(BeNil())
-		defer os.Unsetenv("IBMCLOUD_APIKEY")
+		mockPowerVS.EXPECT().GetDHCPServer("dhcpID").Return(nil, fmt.Errorf("dhcp server does not exist"))
+		err := clusterScope.DeleteDHCPNetworks(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When DeleteDHCPServer returns error for one of the DHCP networks", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					CosInstance: &infrav1.CosInstance{
-						BucketRegion: "test-bucket-region",
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resource-group-id"),
-					},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+						"backup":  {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("backupID"), ControllerCreated: ptr.To(true)}},
+						"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("storageID"), ControllerCreated: ptr.To(true)}},
 					},
+					ServiceInstance: &infrav1.ResourceReference{},
 				},
 			},
+			IBMPowerVSClient: mockPowerVS,
 		}
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID:   ptr.To("test-resource-instance-id"),
-			GUID: ptr.To("test-resource-instance-guid"),
-			Name: ptr.To("test-resource-instance-name"),
-		}, nil, nil)
-
-		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil)
-
-		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
-			return mockCOSController, nil
-		}
-
-		err = clusterScope.ReconcileCOSInstance(ctx)
-		g.Expect(err).To(BeNil())
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
+		mockPowerVS.EXPECT().GetDHCPServer("backupID").Return(&models.DHCPServerDetail{ID: ptr.To("backupID")}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("backupID").Return(fmt.Errorf("error deleting dhcp server"))
+		mockPowerVS.EXPECT().GetDHCPServer("storageID").Return(&models.DHCPServerDetail{ID: ptr.To("storageID")}, nil)
+		mockPowerVS.EXPECT().DeleteDHCPServer("storageID").Return(nil)
+		err := clusterScope.DeleteDHCPNetworks(ctx)
+		g.Expect(err).To(MatchError("failed to delete DHCP server of DHCP network backup: error deleting dhcp server"))
 	})
 }
 
-func TestCheckCOSServiceInstance(t *testing.T) {
+func TestDeleteTransitGatewayConnections(t *testing.T) {
 	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCtrl               *gomock.Controller
+		mockTransitGateway *tgmock.MockTransitGateway
+		mockCtrl           *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When fetching of cos service instance returns error", func(t *testing.T) {
+	t.Run("When additional connection created by controller is deleted first", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
+						Connections: []infrav1.TransitGatewayConnectionStatus{
+							{Name: "on-prem", ID: ptr.To("dl-connID"), ControllerCreated: ptr.To(true)},
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, fmt.Errorf("error listing COS instances"))
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).DoAndReturn(func(options *tgapiv1.DeleteTransitGatewayConnectionOptions) (*core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("dl-connID"))
+			return nil, nil
+		})
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When COS service instance is not found in IBM Cloud", func(t *testing.T) {
+	t.Run("When PowerVS connection of transit gateway is in deleting state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance).To(BeNil())
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateDeleting))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When COS service instance exists but state is not active", func(t *testing.T) {
+	t.Run("When DeleteTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To("failed"),
-		}, nil)
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(err).To(MatchError(fmt.Errorf("COS service instance is not in active state, current state: %s", "failed")))
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, fmt.Errorf("error deleting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err.Error()).To(Equal("failed to delete transit gateway connection: error deleting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
 	})
-	t.Run("When COS service instance exists and state is active", func(t *testing.T) {
+
+	t.Run("When DeleteTransitGatewayConnection for PowerVS connection succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
-			Name:  ptr.To("test-cos-resource-name"),
-			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
-		}, nil)
-
-		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
-		g.Expect(cosResourceInstance.Name).To(Equal(ptr.To("test-cos-resource-name")))
-		g.Expect(cosResourceInstance.State).To(Equal(ptr.To(string(infrav1.ServiceInstanceStateActive))))
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-}
-
-func TestCreateCOSBucket(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCOSController      *mockcos.MockCos
-		mockCtrl               *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-		mockCOSController = mockcos.NewMockCos(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
 
-	t.Run("When COS bucket creation fails in cloud", func(t *testing.T) {
+	t.Run("When GetTransitGatewayConnection for PowerVS connection returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			COSClient:      mockCOSController,
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("powerVStgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create COS bucket"))
-		err := clusterScope.createCOSBucket()
-		g.Expect(err).ToNot(BeNil())
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 400}, fmt.Errorf("error getting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
 	})
-
-	t.Run("When COS bucket already exists and is owned by the user", func(t *testing.T) {
+	t.Run("When PowerVS connection is not found and VPC connection of transit gateway is deleted successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			COSClient:      mockCOSController,
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("powerVStgID"),
+							ControllerCreated: ptr.To(true),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "Bucket already owned by user", nil))
-
-		err := clusterScope.createCOSBucket()
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		powerVSTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("powerVStgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(powerVSTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
-
-	t.Run("When COS bucket already exists but is owned by someone else", func(t *testing.T) {
+	t.Run("When GetTransitGatewayConnection for VPC connection returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			COSClient:      mockCOSController,
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(false),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyExists, "Bucket already exists", nil))
-		err := clusterScope.createCOSBucket()
-		g.Expect(err).To(BeNil())
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: 500}, fmt.Errorf("error getting transit gateway connection"))
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err.Error()).To(Equal("failed to get transit gateway powervs connection: error getting transit gateway connection"))
+		g.Expect(requeue).To(BeFalse())
 	})
 
-	t.Run("When an unexpected error occurs during COS bucket creation", func(t *testing.T) {
+	t.Run("When DeleteTransitGatewayConnection for VPC connection succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			COSClient:      mockCOSController,
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(false),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New("UnexpectedError", "An unexpected error occurred", nil))
-
-		err := clusterScope.createCOSBucket()
-		g.Expect(err).ToNot(BeNil())
-		g.Expect(err.Error()).To(ContainSubstring("failed to create COS bucket"))
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		tgResponse := &tgapiv1.TransitGatewayConnectionCust{Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(tgResponse, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
 	})
 
-	t.Run("When COS bucket is created successfully", func(t *testing.T) {
+	t.Run("When VPC connection of transit gateway is not found", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			COSClient:      mockCOSController,
-			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("test-serviceinstance-id"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						PowerVSConnection: &infrav1.ResourceReference{
+							ControllerCreated: ptr.To(false),
+						},
+						VPCConnection: &infrav1.ResourceReference{
+							ID:                ptr.To("vpctgID"),
+							ControllerCreated: ptr.To(true),
+						},
 					},
 				},
 			},
+			TransitGatewayClient: mockTransitGateway,
 		}
-
-		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(&s3.CreateBucketOutput{}, nil)
-
-		err := clusterScope.createCOSBucket()
+		tg := &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID")}
+		vpcTGOptions := &tgapiv1.GetTransitGatewayConnectionOptions{TransitGatewayID: tg.ID, ID: ptr.To("vpctgID")}
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(vpcTGOptions).Return(nil, &core.DetailedResponse{StatusCode: ResourceNotFoundCode}, nil)
+		requeue, err := clusterScope.deleteTransitGatewayConnections(ctx, tg)
 		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
 	})
 }
-
-func TestCheckCOSBucket(t *testing.T) {
+func TestReconcileCOSInstance(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
 		mockCOSController      *mockcos.MockCos
@@ -6365,21 +7017,29 @@ func TestCheckCOSBucket(t *testing.T) {
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 		mockCOSController = mockcos.NewMockCos(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When checking if COS bucket exists in cloud", func(t *testing.T) {
+
+	t.Run("When fetch for COS service instance fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			COSClient:      mockCOSController,
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: testRegion,
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6388,81 +7048,33 @@ func TestCheckCOSBucket(t *testing.T) {
 			},
 		}
 
-		testScenarios := []struct {
-			name         string
-			mockError    error
-			bucketExists bool
-			expectErr    bool
-		}{
-			{
-				name:         "NoSuchBucket error",
-				mockError:    awserr.New(s3.ErrCodeNoSuchBucket, "NoSuchBucket", nil),
-				bucketExists: false,
-				expectErr:    false,
-			},
-			{
-				name:         "Forbidden error",
-				mockError:    awserr.New("Forbidden", "Forbidden", nil),
-				bucketExists: false,
-				expectErr:    false,
-			},
-			{
-				name:         "NotFound error",
-				mockError:    awserr.New("NotFound", "NotFound", nil),
-				bucketExists: false,
-				expectErr:    false,
-			},
-			{
-				name:         "Other aws error",
-				mockError:    awserr.New("OtherAWSError", "OtherAWSError", nil),
-				bucketExists: false,
-				expectErr:    true,
-			},
-			{
-				name:         "Bucket exists",
-				mockError:    nil,
-				bucketExists: true,
-				expectErr:    false,
-			},
-		}
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, fmt.Errorf("error fetching instance by name"))
 
-		for _, scenario := range testScenarios {
-			t.Run(scenario.name, func(_ *testing.T) {
-				mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, scenario.mockError)
-				exists, err := clusterScope.checkCOSBucket()
-				g.Expect(exists).To(Equal(scenario.bucketExists))
-				if scenario.expectErr {
-					g.Expect(err).ToNot(BeNil())
-				} else {
-					g.Expect(err).To(BeNil())
-				}
-			})
-		}
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
 	})
-}
-
-func TestCreateCOSServiceInstance(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockCtrl               *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
 
-	t.Run("When creating COS resource instance fails due to missing resource group id", func(t *testing.T) {
+	t.Run("When COS service instance is found in IBM Cloud and cluster status is updated", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: testRegion,
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+					Zone: ptr.To("test-zone"),
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
 						ID: ptr.To("test-serviceinstance-id"),
@@ -6471,23 +7083,37 @@ func TestCreateCOSServiceInstance(t *testing.T) {
 			},
 		}
 
-		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
-		g.Expect(cosResourceInstance).To(BeNil())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+			GUID:  ptr.To("test-cos-instance-guid"),
+		}, nil)
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
 	})
 
-	t.Run("When creating COS resource instance fails", func(t *testing.T) {
+	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: testRegion,
+					},
 					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resourcegroup-id"),
+						ID: ptr.To("test-resource-group-id"),
 					},
+					Zone: ptr.To("test-zone"),
 				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
@@ -6497,25 +7123,38 @@ func TestCreateCOSServiceInstance(t *testing.T) {
 			},
 		}
 
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error creating resource instance"))
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
 
-		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
-		g.Expect(cosResourceInstance).To(BeNil())
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
 
-	t.Run("When COS resource instance creation is successful", func(t *testing.T) {
+	t.Run("When COS service instance is not found in IBM Cloud and hence creates COS instance in cloud but fails during the creation", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
 			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: testRegion,
+					},
 					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("test-resourcegroup-id"),
+						ID: ptr.To("test-resource-group-id"),
 					},
+					Zone: ptr.To("test-zone"),
 				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
@@ -6525,491 +7164,651 @@ func TestCreateCOSServiceInstance(t *testing.T) {
 			},
 		}
 
-		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
-			ID: ptr.To("new-resource-instance-id"),
-		}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to create COS service instance"))
 
-		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
-		g.Expect(err).To(BeNil())
-		g.Expect(cosResourceInstance.ID).To(Equal(ptr.To("new-resource-instance-id")))
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance).To(BeNil())
 	})
-}
-
-func TestReconcileTransitGateway(t *testing.T) {
-	var (
-		mockResourceController *mockRC.MockResourceController
-		mockVPC                *mock.MockVpc
-		mockTransitGateway     *tgmock.MockTransitGateway
-		mockCtrl               *gomock.Controller
-	)
 
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	t.Run("when TransitGatewayID is set in status and returns error getting TransitGateway", func(t *testing.T) {
+	t.Run("When fetch for API_KEY fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: testRegion,
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID: ptr.To("transitGatewayID"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+			GUID:  ptr.To("test-cos-instance-guid"),
+		}, nil)
+
+		err := clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-cos-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(false)))
 	})
 
-	t.Run("When TransitGateway ID is set in status and already exists but returns error when getting TransitGateway connections", func(t *testing.T) {
+	t.Run("When COS bucket region is failed to be determined", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID: ptr.To("transitGatewayID"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(nil, nil, errors.New("failed to get transitGateway connections"))
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
+
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(bool(true))))
 	})
 
-	t.Run("When TransitGatewayID is set in status and TransitGateway not in available state", func(t *testing.T) {
+	t.Run("When checkCOSBucket fails to determine whether bucket exist in cloud due to an unexpected error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-bucket-region",
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					TransitGateway: &infrav1.TransitGatewayStatus{
-						ID: ptr.To("transitGatewayID"),
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
+
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
+
+		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, fmt.Errorf("failed to get bucket by name"))
+
+		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
+			return mockCOSController, nil
+		}
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
-	t.Run("When TransitGatewayID is set in spec already exists in cloud and is in available state", func(t *testing.T) {
+	t.Run("When create COS bucket fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
+
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					TransitGateway: &infrav1.TransitGateway{
-						ID: ptr.To("transitGatewayID"),
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-bucket-region",
 					},
-					VPC: &infrav1.VPCResourceReference{
-						ID: ptr.To("vpcID"),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
 					},
-					ServiceInstance: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("serviceInstanceID"),
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeFalse())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
+
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
+
+		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create bucket"))
+
+		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
+			return mockCOSController, nil
+		}
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
-	t.Run("When TransitGatewayID is set in spec and returns error while getting TransitGateway details", func(t *testing.T) {
+
+	t.Run("When create COS bucket succeeds", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
+		err := os.Setenv("IBMCLOUD_APIKEY", "test-api-key")
+		g.Expect(err).To(BeNil())
+		defer os.Unsetenv("IBMCLOUD_APIKEY")
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					TransitGateway: &infrav1.TransitGateway{
-						ID: ptr.To("transitGatewayID"),
+					CosInstance: &infrav1.CosInstance{
+						BucketRegion: "test-bucket-region",
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resource-group-id"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
 
-		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID:   ptr.To("test-resource-instance-id"),
+			GUID: ptr.To("test-resource-instance-guid"),
+			Name: ptr.To("test-resource-instance-name"),
+		}, nil, nil)
+
+		mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeNoSuchBucket, "bucket does not exist", nil))
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, nil)
+
+		cos.NewServiceFunc = func(_ cos.ServiceOptions, _, _ string) (cos.Cos, error) {
+			return mockCOSController, nil
+		}
+
+		err = clusterScope.ReconcileCOSInstance(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ID).To(Equal(ptr.To("test-resource-instance-guid")))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.COSInstance.ControllerCreated).To(Equal(ptr.To(true)))
 	})
+}
 
-	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in failed state", func(t *testing.T) {
+func TestCheckCOSServiceInstance(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockCtrl               *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When fetching of cos service instance returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), ID: ptr.To("transitGatewayID"), Status: ptr.To(string(infrav1.TransitGatewayStateFailed))}, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, fmt.Errorf("error listing COS instances"))
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in pending state", func(t *testing.T) {
+	t.Run("When COS service instance is not found in IBM Cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{},
-			},
-		}
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeTrue())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(nil, nil)
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("Creates TransitGateway and transitGatewayConnections successfully", func(t *testing.T) {
+	t.Run("When COS service instance exists but state is not active", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
+			ResourceClient: mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+						ID: ptr.To("test-serviceinstance-id"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To("failed"),
+		}, nil)
 
-	t.Run("When PowerVS service Instance and VPC details are not set in status and fails to create transit gateway", func(t *testing.T) {
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(err).To(MatchError(fmt.Errorf("COS service instance is not in active state, current state: %s", "failed")))
+	})
+	t.Run("When COS service instance exists and state is active", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
-			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
-			IBMPowerVSCluster:    &infrav1.IBMPowerVSCluster{},
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
 		}
 
-		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
-		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockResourceController.EXPECT().GetResourceInstanceByFilter(gomock.AssignableToTypeOf(resourcecontroller.InstanceFilter{})).Return(&resourcecontrollerv2.ResourceInstance{
+			Name:  ptr.To("test-cos-resource-name"),
+			State: ptr.To(string(infrav1.ServiceInstanceStateActive)),
+		}, nil)
+
+		cosResourceInstance, err := clusterScope.checkCOSServiceInstance(ctx)
+		g.Expect(cosResourceInstance.Name).To(Equal(ptr.To("test-cos-resource-name")))
+		g.Expect(cosResourceInstance.State).To(Equal(ptr.To(string(infrav1.ServiceInstanceStateActive))))
+		g.Expect(err).To(BeNil())
 	})
 }
 
-func TestCheckAndUpdateTransitGatewayConnections(t *testing.T) {
+func TestCreateCOSBucket(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
-		mockVPC                *mock.MockVpc
-		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCOSController      *mockcos.MockCos
 		mockCtrl               *gomock.Controller
 	)
-
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
-		mockVPC = mock.NewMockVpc(mockCtrl)
 		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockCOSController = mockcos.NewMockCos(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("Returns error when getting VPC details", func(t *testing.T) {
+
+	t.Run("When COS bucket creation fails in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		clusterScope := ClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, fmt.Errorf("failed to create COS bucket"))
+		err := clusterScope.createCOSBucket()
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("Returns error when getting PowerVS service Instance details", func(t *testing.T) {
+	t.Run("When COS bucket already exists and is owned by the user", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get serviceInstance"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		clusterScope := ClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
+
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyOwnedByYou, "Bucket already owned by user", nil))
+
+		err := clusterScope.createCOSBucket()
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When TransitGateway connections doesn't exist and creates connections", func(t *testing.T) {
+	t.Run("When COS bucket already exists but is owned by someone else", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
+		clusterScope := ClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New(s3.ErrCodeBucketAlreadyExists, "Bucket already exists", nil))
+		err := clusterScope.createCOSBucket()
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When TransitGateway connections doesn't exist and return error while creating PowerVSConnection", func(t *testing.T) {
+	t.Run("When an unexpected error occurs during COS bucket creation", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("error while creating connections"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		clusterScope := ClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
+
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(nil, awserr.New("UnexpectedError", "An unexpected error occurred", nil))
+
+		err := clusterScope.createCOSBucket()
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("failed to create COS bucket"))
 	})
 
-	t.Run("When TransitGateway connections exist and both are in attached state already", func(t *testing.T) {
+	t.Run("When COS bucket is created successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeFalse())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeFalse())
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).To(BeNil())
-	})
+		clusterScope := ClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-	t.Run("WHen PowerVSConnection exist and is in pending state", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		mockCOSController.EXPECT().CreateBucket(gomock.Any()).Return(&s3.CreateBucketOutput{}, nil)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		err := clusterScope.createCOSBucket()
 		g.Expect(err).To(BeNil())
 	})
+}
 
-	t.Run("When VPCConnection exist and is in pending state", func(t *testing.T) {
+func TestCheckCOSBucket(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockCOSController      *mockcos.MockCos
+		mockCtrl               *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+		mockCOSController = mockcos.NewMockCos(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When checking if COS bucket exists in cloud", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStatePending))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
+		clusterScope := ClusterScope{
+			COSClient:      mockCOSController,
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-	t.Run("When VPCConnection status exist and is in failed state", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		testScenarios := []struct {
+			name         string
+			mockError    error
+			bucketExists bool
+			expectErr    bool
+		}{
+			{
+				name:         "NoSuchBucket error",
+				mockError:    awserr.New(s3.ErrCodeNoSuchBucket, "NoSuchBucket", nil),
+				bucketExists: false,
+				expectErr:    false,
+			},
+			{
+				name:         "Forbidden error",
+				mockError:    awserr.New("Forbidden", "Forbidden", nil),
+				bucketExists: false,
+				expectErr:    false,
+			},
+			{
+				name:         "NotFound error",
+				mockError:    awserr.New("NotFound", "NotFound", nil),
+				bucketExists: false,
+				expectErr:    false,
+			},
+			{
+				name:         "Other aws error",
+				mockError:    awserr.New("OtherAWSError", "OtherAWSError", nil),
+				bucketExists: false,
+				expectErr:    true,
+			},
+			{
+				name:         "Bucket exists",
+				mockError:    nil,
+				bucketExists: true,
+				expectErr:    false,
+			},
+		}
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		for _, scenario := range testScenarios {
+			t.Run(scenario.name, func(_ *testing.T) {
+				mockCOSController.EXPECT().GetBucketByName(gomock.Any()).Return(nil, scenario.mockError)
+				exists, err := clusterScope.checkCOSBucket()
+				g.Expect(exists).To(Equal(scenario.bucketExists))
+				if scenario.expectErr {
+					g.Expect(err).ToNot(BeNil())
+				} else {
+					g.Expect(err).To(BeNil())
+				}
+			})
+		}
 	})
+}
 
-	t.Run("When PowerVSConnection status exist and is in failed state", func(t *testing.T) {
+func TestCreateCOSServiceInstance(t *testing.T) {
+	var (
+		mockResourceController *mockRC.MockResourceController
+		mockCtrl               *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When creating COS resource instance fails due to missing resource group id", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		clusterScope := ClusterScope{
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
+
+		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When PowerVSConnection doesn't exist and creates it", func(t *testing.T) {
+	t.Run("When creating COS resource instance fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
+		clusterScope := ClusterScope{
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resourcegroup-id"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-	t.Run("When PowerVSConnection doesn't exist and returns error while creating it", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(nil, nil, fmt.Errorf("error creating resource instance"))
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
+		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
+		g.Expect(cosResourceInstance).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When VPCConnection doesn't exist and creates it", func(t *testing.T) {
+	t.Run("When COS resource instance creation is successful", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
-		g.Expect(requeue).To(BeTrue())
-		g.Expect(err).To(BeNil())
-	})
+		clusterScope := ClusterScope{
+			ResourceClient: mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("test-resourcegroup-id"),
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("test-serviceinstance-id"),
+					},
+				},
+			},
+		}
 
-	t.Run("When VPCConnection doesn't exist and returns error while creating it", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		mockResourceController.EXPECT().CreateResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{
+			ID: ptr.To("new-resource-instance-id"),
+		}, nil, nil)
 
-		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
-		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
-		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
-		g.Expect(requeue).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		cosResourceInstance, err := clusterScope.createCOSServiceInstance()
+		g.Expect(err).To(BeNil())
+		g.Expect(cosResourceInstance.ID).To(Equal(ptr.To("new-resource-instance-id")))
 	})
 }
 
-func TestCreateTransitGateway(t *testing.T) {
+func TestReconcileTransitGateway(t *testing.T) {
 	var (
 		mockResourceController *mockRC.MockResourceController
 		mockVPC                *mock.MockVpc
@@ -7027,235 +7826,170 @@ func TestCreateTransitGateway(t *testing.T) {
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("when PowerVS serviceInstance ID and VPC ID is not set in Status", func(t *testing.T) {
+	t.Run("when TransitGatewayID is set in status and returns error getting TransitGateway", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
+					},
 				},
 			},
 		}
 
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("Fails to get TransitGateway location and routing", func(t *testing.T) {
+	t.Run("When TransitGateway ID is set in status and already exists but returns error when getting TransitGateway connections", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("zone-ID"),
-					VPC:           &infrav1.VPCResourceReference{},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
 		}
 
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(nil, nil, errors.New("failed to get transitGateway connections"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("Return error while creating TransitGateway", func(t *testing.T) {
+	t.Run("When TransitGatewayID is set in status and TransitGateway not in available state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
 				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway"))
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("Creates TransitGateway but return error when getting VPC details", func(t *testing.T) {
+	t.Run("When TransitGatewayID is set in spec already exists in cloud and is in available state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
 			IBMVPCClient:         mockVPC,
 			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
+					TransitGateway: &infrav1.TransitGateway{
+						ID: ptr.To("transitGatewayID"),
 					},
-					VPC: &infrav1.ResourceReference{
+					VPC: &infrav1.VPCResourceReference{
 						ID: ptr.To("vpcID"),
 					},
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To("pending")}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
-		err := clusterScope.createTransitGateway(ctx)
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-
-	t.Run("Creates TransitGateway but return error while getting PowerVS details", func(t *testing.T) {
+	t.Run("When TransitGatewayID is set in spec and returns error while getting TransitGateway details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
+					TransitGateway: &infrav1.TransitGateway{
+						ID: ptr.To("transitGatewayID"),
 					},
 				},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get power vs instance"))
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		mockTransitGateway.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get transit gateway"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When PowerVSConnection creation is completed but fails to create VPCConnection", func(t *testing.T) {
+	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in failed state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
-					},
-				},
+				Spec: infrav1.IBMPowerVSClusterSpec{},
 			},
 		}
 
-		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
-		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
-		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
-		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway connection"))
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
-		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("transitGatewayName"), ID: ptr.To("transitGatewayID"), Status: ptr.To(string(infrav1.TransitGatewayStateFailed))}, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When local routing is configured but global routing is required", func(t *testing.T) {
+	t.Run("When TransitGatewayID is not set in spec and fetching using name returns with transit gateway in pending state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 
 		clusterScope := ClusterScope{
 			TransitGatewayClient: mockTransitGateway,
-			IBMVPCClient:         mockVPC,
-			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					TransitGateway: &infrav1.TransitGateway{
-						GlobalRouting: ptr.To(false),
-					},
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("us-east-1"),
-					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					ServiceInstance: &infrav1.ResourceReference{
-						ID: ptr.To("serviceInstanceID"),
-					},
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("vpcID"),
-					},
-				},
+				Spec: infrav1.IBMPowerVSClusterSpec{},
 			},
 		}
 
-		err := clusterScope.createTransitGateway(ctx)
-		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStatePending))}, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When global routing is set to true", func(t *testing.T) {
+	t.Run("Creates TransitGateway and transitGatewayConnections successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -7266,11 +8000,8 @@ func TestCreateTransitGateway(t *testing.T) {
 			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					TransitGateway: &infrav1.TransitGateway{
-						GlobalRouting: ptr.To(true),
-					},
 					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
-					Zone:          ptr.To("zone-ID"),
+					Zone:          ptr.To("us-east-1"),
 					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
 				},
 				Status: infrav1.IBMPowerVSClusterStatus{
@@ -7284,1121 +8015,871 @@ func TestCreateTransitGateway(t *testing.T) {
 			},
 		}
 
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
 		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
 		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
 		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
 		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
 		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
-		err := clusterScope.createTransitGateway(ctx)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
 		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-}
 
-func makePowerVSClusterScope(mockTransitGateway *tgmock.MockTransitGateway, mockVPC *mock.MockVpc, mockResourceController *mockRC.MockResourceController) ClusterScope {
-	clusterScope := ClusterScope{
-		TransitGatewayClient: mockTransitGateway,
-		IBMVPCClient:         mockVPC,
-		ResourceClient:       mockResourceController,
-		IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-			Status: infrav1.IBMPowerVSClusterStatus{
-				TransitGateway: &infrav1.TransitGatewayStatus{
-					ID: ptr.To("transitGatewayID"),
-				},
-				ServiceInstance: &infrav1.ResourceReference{
-					ID: ptr.To("serviceInstanceID"),
-				},
-				VPC: &infrav1.ResourceReference{
-					ID: ptr.To("vpcID"),
-				},
-			},
-		},
-	}
+	t.Run("When PowerVS service Instance and VPC details are not set in status and fails to create transit gateway", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-	return clusterScope
+		clusterScope := ClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster:    &infrav1.IBMPowerVSCluster{},
+		}
+
+		mockTransitGateway.EXPECT().GetTransitGatewayByName(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
 }
 
-func TestReconcileVPCSecurityGroups(t *testing.T) {
+func TestCheckAndUpdateTransitGatewayConnections(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
+		mockResourceController *mockRC.MockResourceController
+		mockVPC                *mock.MockVpc
+		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCtrl               *gomock.Controller
 	)
-	securityGroupID := "securityGroupID"
-	securityGroupName := "securityGroupName"
 
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
 		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When SecurityGroup ID is set and returns error while getting SecurityGroup", func(t *testing.T) {
+	t.Run("Returns error when getting VPC details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						ID: ptr.To("securityGroupID"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set and returns error while creating SecurityGroup", func(t *testing.T) {
+
+	t.Run("Returns error when getting PowerVS service Instance details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create security group"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get serviceInstance"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When SecurityGroup Name is set and creates SecurityGroup successfully", func(t *testing.T) {
+	t.Run("When TransitGateway connections doesn't exist and creates connections", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).To(BeNil())
-	})
-	t.Run("When SecurityGroup Name is set and SecurityGroup already exists", func(t *testing.T) {
-		g := NewWithT(t)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When TransitGateway connections doesn't exist and return error while creating PowerVSConnection", func(t *testing.T) {
+		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil)
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("error while creating connections"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When TransitGateway connections exist and both are in attached state already", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeFalse())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeFalse())
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup ID is set and SecurityGroup already exists", func(t *testing.T) {
+	t.Run("WHen PowerVSConnection exist and is in pending state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						ID: ptr.To("securityGroupID"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil, nil)
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set and GetSecurityGroup returns error", func(t *testing.T) {
+
+	t.Run("When VPCConnection exist and is in pending state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
-		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: vpcSecurityGroupStatus,
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
-		g.Expect(err).ToNot(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStatePending))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroup Name is set  and returns error while getting SecurityGroupRules", func(t *testing.T) {
+
+	t.Run("When VPCConnection status exist and is in failed state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
-		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: &securityGroupID, RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: vpcSecurityGroupStatus,
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID"), Name: ptr.To("securityGroupName")}, nil, nil)
-		mockVPC.EXPECT().GetSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to get security group rule"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
 
-	t.Run("When SecurityGroup is created successfully but returns error while creating SecurityGroupRules", func(t *testing.T) {
+	t.Run("When PowerVSConnection status exist and is in failed state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create security group rule"))
-		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateFailed))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-}
-
-func TestValidateVPCSecurityGroup(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
 
-	t.Run("When SecurityGroup by name exists and SecurityGroupRule matches", func(t *testing.T) {
+	t.Run("When PowerVSConnection doesn't exist and creates it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
-			},
-			ID: ptr.To("ruleID"),
-		}
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup by id exists and SecurityGroupRule matches", func(t *testing.T) {
+	t.Run("When PowerVSConnection doesn't exist and returns error while creating it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
-			},
-			ID: ptr.To("ruleID"),
-		}
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("When VPCConnection doesn't exist and creates it", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
+		g.Expect(requeue).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup by name doesn't exist and return error", func(t *testing.T) {
+	t.Run("When VPCConnection doesn't exist and returns error while creating it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name: ptr.To("securityGroupNamw"),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get SecurityGroup"))
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway connection"))
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-
-	t.Run("When SecurityGroup by id doesn't exist and return error", func(t *testing.T) {
+	t.Run("When additional connection doesn't exist and creates it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID: ptr.To("securityGroupID"),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{
+			Connections: []infrav1.TransitGatewayConnection{
+				{
+					Name:        "on-prem",
+					NetworkType: infrav1.TransitGatewayConnectionNetworkTypeDirectLink,
+					NetworkCRN:  ptr.To("dl-crn"),
+					PrefixFilters: []infrav1.TransitGatewayPrefixFilter{
+						{Action: infrav1.TransitGatewayPrefixFilterActionPermit, Prefix: "10.0.0.0/8"},
 					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					PrefixFiltersDefault: &infrav1.TransitGatewayPrefixFilterActionDeny,
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get SecurityGroup"))
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("on-prem"))
+			g.Expect(*options.NetworkType).To(Equal("directlink"))
+			g.Expect(*options.NetworkID).To(Equal("dl-crn"))
+			g.Expect(options.PrefixFilters).To(HaveLen(1))
+			g.Expect(*options.PrefixFiltersDefault).To(Equal("deny"))
+			return &tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("dl-connID")}, nil, nil
+		})
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.Connections).To(ConsistOf(infrav1.TransitGatewayConnectionStatus{Name: "on-prem", ID: ptr.To("dl-connID"), ControllerCreated: ptr.To(true)}))
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup by name exists but is not attached to VPC", func(t *testing.T) {
+	t.Run("When additional connection exists and is in attached state", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name: ptr.To("securityGroupName"),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{
+			Connections: []infrav1.TransitGatewayConnection{
+				{Name: "classic", NetworkType: infrav1.TransitGatewayConnectionNetworkTypeClassic},
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID")}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeEmpty())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("classic"), ID: ptr.To("classic-connID"), NetworkType: ptr.To("classic"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.Connections).To(ConsistOf(infrav1.TransitGatewayConnectionStatus{Name: "classic", ID: ptr.To("classic-connID"), ControllerCreated: ptr.To(false)}))
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup by name exists but VPC id not matching", func(t *testing.T) {
+	t.Run("When additional connection created by controller is removed from spec and deletes it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name: ptr.To("securityGroupName"),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Status.TransitGateway.Connections = []infrav1.TransitGatewayConnectionStatus{
+			{Name: "shared-services", ID: ptr.To("shared-connID"), ControllerCreated: ptr.To(true)},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().GetTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("shared-connID"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))}, &core.DetailedResponse{StatusCode: 200}, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.Connections).To(HaveLen(1))
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroup by id exists but VPC id not matching", func(t *testing.T) {
+	t.Run("When VPCConnection prefix filter set in spec doesn't exist and creates it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID: ptr.To("securityGroupID"),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{
+			VPCConnection: &infrav1.TransitGatewayConnectionPrefixFilters{
+				PrefixFilters: []infrav1.TransitGatewayPrefixFilter{
+					{Action: infrav1.TransitGatewayPrefixFilterActionPermit, Prefix: "10.240.0.0/16", Le: ptr.To(int64(24))},
 				},
 			},
 		}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnectionPrefixFilter(gomock.Any()).DoAndReturn(func(options *tgapiv1.CreateTransitGatewayConnectionPrefixFilterOptions) (*tgapiv1.PrefixFilterCust, *core.DetailedResponse, error) {
+			g.Expect(options.ID).To(Equal(ptr.To("vpc-connID")))
+			g.Expect(options.Action).To(Equal(ptr.To("permit")))
+			g.Expect(options.Prefix).To(Equal(ptr.To("10.240.0.0/16")))
+			g.Expect(options.Le).To(Equal(ptr.To(int64(24))))
+			return &tgapiv1.PrefixFilterCust{ID: ptr.To("filterID")}, nil, nil
+		})
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When it returns error while validating SecurityGroupRules", func(t *testing.T) {
+	t.Run("When additional connection created by controller has a prefix filter not set in spec and deletes it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					CIDRSubnetName: ptr.To("CIDRSubnetName"),
-					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{
+			Connections: []infrav1.TransitGatewayConnection{
+				{Name: "classic", NetworkType: infrav1.TransitGatewayConnectionNetworkTypeClassic},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
+		clusterScope.IBMPowerVSCluster.Status.TransitGateway.Connections = []infrav1.TransitGatewayConnectionStatus{
+			{Name: "classic", ID: ptr.To("classic-connID"), ControllerCreated: ptr.To(true)},
 		}
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("classic"), ID: ptr.To("classic-connID"), NetworkType: ptr.To("classic"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached)),
+			PrefixFilters: []tgapiv1.TransitGatewayConnectionPrefixFilterReference{{ID: ptr.To("filterID"), Action: ptr.To("deny"), Prefix: ptr.To("10.0.0.0/8")}},
+		})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayConnectionPrefixFilter(gomock.Any()).DoAndReturn(func(options *tgapiv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (*core.DetailedResponse, error) {
+			g.Expect(options.ID).To(Equal(ptr.To("classic-connID")))
+			g.Expect(options.FilterID).To(Equal(ptr.To("filterID")))
+			return nil, nil
+		})
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroupRule doesn't exist and creates it", func(t *testing.T) {
+	t.Run("When route report is requested and connections are attached, generates it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.1.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{GenerateRouteReport: ptr.To(true)}
 
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Protocol: ptr.To("tcp"), ID: ptr.To("ruleID")}, nil, nil)
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayRouteReport(gomock.Any()).Return(&tgapiv1.RouteReport{ID: ptr.To("reportID"), Status: ptr.To("pending")}, nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.RouteReport).To(Equal(&infrav1.TransitGatewayRouteReportStatus{ID: ptr.To("reportID"), State: infrav1.TransitGatewayRouteReportStatePending}))
+		g.Expect(requeue).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
 
-	t.Run("When SecurityGroupRule doesn't match and return error", func(t *testing.T) {
+	t.Run("When route report is complete, records the overlapping routes and deletes it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
+		clusterScope := makePowerVSClusterScope(mockTransitGateway, mockVPC, mockResourceController)
+		clusterScope.IBMPowerVSCluster.Spec.TransitGateway = &infrav1.TransitGateway{GenerateRouteReport: ptr.To(true)}
+		clusterScope.IBMPowerVSCluster.Status.TransitGateway.RouteReport = &infrav1.TransitGatewayRouteReportStatus{ID: ptr.To("reportID"), State: infrav1.TransitGatewayRouteReportStatePending}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
-		vpcSecurityGroupStatus["securityGroupName"] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{}, ControllerCreated: ptr.To(false)}
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPCSecurityGroups: vpcSecurityGroupStatus,
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
+		conn := append([]tgapiv1.TransitGatewayConnectionCust{}, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("vpc"), ID: ptr.To("vpc-connID"), NetworkType: ptr.To("vpc"), NetworkID: ptr.To("vpc-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		conn = append(conn, tgapiv1.TransitGatewayConnectionCust{Name: ptr.To("pvs"), ID: ptr.To("pvs-connID"), NetworkType: ptr.To("power_virtual_server"), NetworkID: ptr.To("pvs-crn"), Status: ptr.To(string(infrav1.TransitGatewayConnectionStateAttached))})
+		mockTransitGateway.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{Connections: conn}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("pvs-crn")}, nil, nil)
+		mockTransitGateway.EXPECT().GetTransitGatewayRouteReport(gomock.Any()).Return(&tgapiv1.RouteReport{
+			ID:     ptr.To("reportID"),
+			Status: ptr.To("complete"),
+			Connections: []tgapiv1.RouteReportConnection{
+				{ID: ptr.To("vpc-connID"), Name: ptr.To("vpc")},
+				{ID: ptr.To("pvs-connID"), Name: ptr.To("pvs")},
+			},
+			OverlappingRoutes: []tgapiv1.RouteReportOverlappingRouteGroup{
+				{Routes: []tgapiv1.RouteReportOverlappingRoute{
+					{ConnectionID: ptr.To("vpc-connID"), Prefix: ptr.To("10.240.0.0/24")},
+					{ConnectionID: ptr.To("pvs-connID"), Prefix: ptr.To("10.240.0.0/16")},
+				}},
 			},
-		}
-
-		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
-		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		}, nil, nil)
+		mockTransitGateway.EXPECT().DeleteTransitGatewayRouteReport(gomock.Any()).Return(nil, nil)
+		requeue, err := clusterScope.checkAndUpdateTransitGatewayConnections(ctx, &tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName")})
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.RouteReport.State).To(Equal(infrav1.TransitGatewayRouteReportStateComplete))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway.RouteReport.OverlappingRoutes).To(Equal([]infrav1.TransitGatewayOverlappingRoutes{
+			{Routes: []infrav1.TransitGatewayRoute{
+				{Prefix: "10.240.0.0/24", ConnectionName: "vpc"},
+				{Prefix: "10.240.0.0/16", ConnectionName: "pvs"},
+			}},
+		}))
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(err).To(BeNil())
 	})
 }
 
-func TestValidateVPCSecurityGroupRule(t *testing.T) {
+func TestCreateTransitGateway(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
+		mockResourceController *mockRC.MockResourceController
+		mockVPC                *mock.MockVpc
+		mockTransitGateway     *tgmock.MockTransitGateway
+		mockCtrl               *gomock.Controller
 	)
+
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
+		mockTransitGateway = tgmock.NewMockTransitGateway(mockCtrl)
 		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockResourceController = mockRC.NewMockResourceController(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
+	t.Run("when PowerVS serviceInstance ID and VPC ID is not set in Status", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
+
+		clusterScope := ClusterScope{
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
 				},
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
-			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Fails to get TransitGateway location and routing", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("zone-ID"),
+					VPC:           &infrav1.VPCResourceReference{},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
+
+	t.Run("Return error while creating TransitGateway", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp returns error", func(t *testing.T) {
+
+	t.Run("Creates TransitGateway but return error when getting VPC details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID:      ptr.To("ruleID"),
-			PortMax: ptr.To(int64(65535)),
-			PortMin: ptr.To(int64(1)),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To("pending")}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
+
+	t.Run("Creates TransitGateway but return error while getting PowerVS details", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
-			},
-			ID: ptr.To("ruleID"),
-		}
 
-		vpcSecurityGroupRules := make([]vpcv1.SecurityGroupRuleIntf, 0, 1)
-		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(nil, nil, errors.New("failed to get power vs instance"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
+
+	t.Run("When PowerVSConnection creation is completed but fails to create VPCConnection", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				Address: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
 
-		vpcSecurityGroupRules := make([]vpcv1.SecurityGroupRuleIntf, 0, 1)
-		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create transit Gateway connection"))
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll returns error", func(t *testing.T) {
+
+	t.Run("When local routing is configured but global routing is required", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					TransitGateway: &infrav1.TransitGateway{
+						GlobalRouting: ptr.To(false),
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("us-east-1"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).To(BeNil())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
+
+	t.Run("When global routing is set to true", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				ICMPCode: ptr.To(int64(12)),
-				ICMPType: ptr.To(int64(3)),
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CRN: ptr.To("crn"),
-			},
-			ID:   ptr.To("ruleID"),
-			Code: ptr.To(int64(12)),
-			Type: ptr.To(int64(3)),
-		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+			TransitGatewayClient: mockTransitGateway,
+			IBMVPCClient:         mockVPC,
+			ResourceClient:       mockResourceController,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					TransitGateway: &infrav1.TransitGateway{
+						GlobalRouting: ptr.To(true),
+					},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("resourceGroupID")},
+					Zone:          ptr.To("zone-ID"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("region")},
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
+					ServiceInstance: &infrav1.ResourceReference{
+						ID: ptr.To("serviceInstanceID"),
+					},
 					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+						ID: ptr.To("vpcID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("crn")}, nil)
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
-		g.Expect(match).To(BeTrue())
+		mockTransitGateway.EXPECT().CreateTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("transitGatewayID"), Name: ptr.To("transitGatewayName"), Status: ptr.To(string(infrav1.TransitGatewayStateAvailable))}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{CRN: ptr.To("crn")}, nil, nil)
+		mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{CRN: ptr.To("crn")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("pvs-connID")}, nil, nil)
+		mockTransitGateway.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-connID")}, nil, nil)
+		err := clusterScope.createTransitGateway(ctx)
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ID).To(BeEquivalentTo("transitGatewayID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ID).To(BeEquivalentTo("pvs-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.PowerVSConnection.ControllerCreated).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ID).To(BeEquivalentTo("vpc-connID"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.TransitGateway.VPCConnection.ControllerCreated).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
+}
+
+func makePowerVSClusterScope(mockTransitGateway *tgmock.MockTransitGateway, mockVPC *mock.MockVpc, mockResourceController *mockRC.MockResourceController) ClusterScope {
+	clusterScope := ClusterScope{
+		TransitGatewayClient: mockTransitGateway,
+		IBMVPCClient:         mockVPC,
+		ResourceClient:       mockResourceController,
+		IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+			Status: infrav1.IBMPowerVSClusterStatus{
+				TransitGateway: &infrav1.TransitGatewayStatus{
+					ID: ptr.To("transitGatewayID"),
+				},
+				ServiceInstance: &infrav1.ResourceReference{
+					ID: ptr.To("serviceInstanceID"),
+				},
+				VPC: &infrav1.ResourceReference{
+					ID: ptr.To("vpcID"),
+				},
+			},
+		},
+	}
+
+	return clusterScope
+}
+
+func TestReconcileVPCSecurityGroups(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	securityGroupID := "securityGroupID"
+	securityGroupName := "securityGroupName"
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroup ID is set and returns error while getting SecurityGroup", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				ICMPCode: ptr.To(int64(12)),
-				ICMPType: ptr.To(int64(3)),
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CRN: ptr.To("crn"),
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						ID: ptr.To("securityGroupID"),
+					}),
+				},
 			},
-			ID:   ptr.To("ruleID"),
-			Code: ptr.To(int64(12)),
-			Type: ptr.To(int64(3)),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroup Name is set and returns error while creating SecurityGroup", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("CRN")}, nil)
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create security group"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp returns error", func(t *testing.T) {
+
+	t.Run("When SecurityGroup Name is set and creates SecurityGroup successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				ICMPCode: ptr.To(int64(12)),
-				ICMPType: ptr.To(int64(3)),
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
-			Direction: ptr.To("outbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CRN: ptr.To("crn"),
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+				},
 			},
-			ID:   ptr.To("ruleID"),
-			Code: ptr.To(int64(12)),
-			Type: ptr.To(int64(3)),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroup Name is set and SecurityGroup already exists", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8408,60 +8889,22 @@ func TestValidateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get securityGroup"))
-		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil)
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).To(BeNil())
 	})
-}
-
-func TestValidateVPCSecurityGroupRules(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
 
-	t.Run("When SecurityGroupRule of Direction Inbound matches", func(t *testing.T) {
+	t.Run("When SecurityGroup ID is set and SecurityGroup already exists", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("inbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("0.0.0.0/0"),
-			},
-			ID: ptr.To("ruleID"),
-		}
-
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8471,90 +8914,81 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						ID: ptr.To("securityGroupID"),
+					}),
 				},
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(match).To(BeTrue())
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: &securityGroupName, ID: &securityGroupID, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}, nil, nil)
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound doesn't match", func(t *testing.T) {
+	t.Run("When SecurityGroup Name is set and GetSecurityGroup returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("inbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
+		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
+		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSecurityGroups: vpcSecurityGroupStatus,
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+				},
 			},
-			ID: ptr.To("ruleID"),
 		}
 
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get security group"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroup Name is set  and returns error while getting SecurityGroupRules", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
+		vpcSecurityGroupStatus[securityGroupName] = infrav1.VPCSecurityGroupStatus{ID: &securityGroupID, RuleIDs: []*string{ptr.To("ruleID")}, ControllerCreated: ptr.To(true)}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("securityGroupID"),
-					},
+					VPCSecurityGroups: vpcSecurityGroupStatus,
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
 				},
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID"), Name: ptr.To("securityGroupName")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to get security group rule"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
+
+	t.Run("When SecurityGroup is created successfully but returns error while creating SecurityGroupRules", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
 			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
-		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
-			Direction: ptr.To("inbound"),
-			Protocol:  ptr.To("tcp"),
-			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
-			},
-			ID: ptr.To("ruleID"),
-		}
-		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
 
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
@@ -8565,18 +8999,37 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(match).To(BeFalse())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create security group rule"))
+		err := clusterScope.ReconcileVPCSecurityGroups(ctx)
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Outbound matches", func(t *testing.T) {
+}
+
+func TestValidateVPCSecurityGroup(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroup by name exists and SecurityGroupRule matches", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -8597,10 +9050,9 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 			},
 			ID: ptr.To("ruleID"),
 		}
-
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			ID:    ptr.To("securityGroupID"),
+			Name:  ptr.To("securityGroupName"),
 			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
 		}
 		clusterScope := ClusterScope{
@@ -8617,12 +9069,15 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
 		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(match).To(BeTrue())
+		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Outbound doesn't match", func(t *testing.T) {
+
+	t.Run("When SecurityGroup by id exists and SecurityGroupRule matches", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -8639,11 +9094,10 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 			Direction: ptr.To("outbound"),
 			Protocol:  ptr.To("tcp"),
 			Remote: &vpcv1.SecurityGroupRuleRemote{
-				CIDRBlock: ptr.To("192.168.1.1/24"),
+				CIDRBlock: ptr.To("0.0.0.0/0"),
 			},
 			ID: ptr.To("ruleID"),
 		}
-
 		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 		vpcSecurityGroup := infrav1.VPCSecurityGroup{
 			ID:    ptr.To("securityGroupID"),
@@ -8663,42 +9117,21 @@ func TestValidateVPCSecurityGroupRules(t *testing.T) {
 			},
 		}
 
-		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(match).To(BeFalse())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(sg).To(BeEquivalentTo(securityGroupDetails))
 		g.Expect(err).To(BeNil())
 	})
-}
 
-func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
-	t.Run("When it matches the remoteType Address", func(t *testing.T) {
+	t.Run("When SecurityGroup by name doesn't exist and return error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name: ptr.To("securityGroupNamw"),
 		}
-
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8708,34 +9141,25 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.0.1/24")}, remote)
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get SecurityGroup"))
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType Address", func(t *testing.T) {
+
+	t.Run("When SecurityGroup by id doesn't exist and return error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID: ptr.To("securityGroupID"),
 		}
-
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8745,69 +9169,54 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to get SecurityGroup"))
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it matches the remoteType Any", func(t *testing.T) {
+
+	t.Run("When SecurityGroup by name exists but is not attached to VPC", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name: ptr.To("securityGroupName"),
 		}
-
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
 				Status: infrav1.IBMPowerVSClusterStatus{
 					VPC: &infrav1.ResourceReference{
 						ID: ptr.To("VPCID"),
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
-				},
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("0.0.0.0/0")}, remote)
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID")}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeEmpty())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType Any", func(t *testing.T) {
+
+	t.Run("When SecurityGroup by name exists but VPC id not matching", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name: ptr.To("securityGroupName"),
 		}
-
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8817,34 +9226,26 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it matches the remoteType CIDR", func(t *testing.T) {
+
+	t.Run("When SecurityGroup by id exists but VPC id not matching", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID: ptr.To("securityGroupID"),
 		}
-
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8854,35 +9255,52 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("sgID"), VPC: &vpcv1.VPCReference{ID: ptr.To("vpcID")}}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType CIDR", func(t *testing.T) {
+
+	t.Run("When it returns error while validating SecurityGroupRules", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					CIDRSubnetName: ptr.To("CIDRSubnetName"),
+					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
 		}
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
 
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8892,35 +9310,38 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
-
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.0.1/24")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(securityGroupDetails, nil, nil)
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When the remoteType CIDR and it returns error", func(t *testing.T) {
+
+	t.Run("When SecurityGroupRule doesn't exist and creates it", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.1.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
 
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -8930,73 +9351,120 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
-		g.Expect(match).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Protocol: ptr.To("tcp"), ID: ptr.To("ruleID")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).To(BeNil())
 	})
-	t.Run("When it matches the remoteType SG", func(t *testing.T) {
+
+	t.Run("When SecurityGroupRule doesn't match and return error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroupStatus := make(map[string]infrav1.VPCSecurityGroupStatus)
+		vpcSecurityGroupStatus["securityGroupName"] = infrav1.VPCSecurityGroupStatus{ID: ptr.To("securityGroupID"), RuleIDs: []*string{}, ControllerCreated: ptr.To(false)}
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Status: infrav1.IBMPowerVSClusterStatus{
+					VPCSecurityGroups: vpcSecurityGroupStatus,
 					VPC: &infrav1.ResourceReference{
 						ID: ptr.To("VPCID"),
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("crn")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
-		g.Expect(match).To(BeTrue())
-		g.Expect(err).To(BeNil())
+		securityGroupDetails := &vpcv1.SecurityGroup{Name: ptr.To("securityGroupName"), ID: ptr.To("securityGroupID"), Rules: vpcSecurityGroupRules, VPC: &vpcv1.VPCReference{ID: ptr.To("VPCID")}}
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(securityGroupDetails, nil)
+		sg, ruleIDs, err := clusterScope.validateVPCSecurityGroup(ctx, vpcSecurityGroup)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("When it doesn't match the remoteType SG", func(t *testing.T) {
+}
+
+func TestValidateVPCSecurityGroupRule(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
 			},
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
 		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9006,35 +9474,49 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("CRN")}, nil)
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
-		g.Expect(match).To(BeFalse())
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		g.Expect(match).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("When the remoteType SG and it returns error", func(t *testing.T) {
+	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
 			},
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
 		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9044,46 +9526,76 @@ func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
-		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
 		g.Expect(match).To(BeFalse())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(err).To(BeNil())
 	})
-}
-
-func TestCreateVPCSecurityGroupRule(t *testing.T) {
-	var (
-		mockVPC  *mock.MockVpc
-		mockCtrl *gomock.Controller
-	)
-	securityGroupID := "securityGroupID"
-	var portMax int64 = 65535
-	var portMin int64 = 1
-	var protocol = "tcp"
-	setup := func(t *testing.T) {
-		t.Helper()
-		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
-	}
-	teardown := func() {
-		mockCtrl.Finish()
-	}
+	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolTcpudp returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID:      ptr.To("ruleID"),
+			PortMax: ptr.To(int64(65535)),
+			PortMin: ptr.To(int64(1)),
+		}
 
-	t.Run("Creates SecurityGroupRule of remoteType Address successfully", func(t *testing.T) {
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			Address:    ptr.To("192.168.0.1/24"),
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
@@ -9092,7 +9604,21 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
+		vpcSecurityGroupRules := make([]vpcv1.SecurityGroupRuleIntf, 0, 1)
+		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9102,26 +9628,23 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		g.Expect(match).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("Creates SecurityGroupRule of remoteType CIDR successfully", func(t *testing.T) {
+	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			CIDRSubnetName: ptr.To("CIDRSubnetName"),
-			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
@@ -9130,7 +9653,21 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				Address: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
+		vpcSecurityGroupRules := make([]vpcv1.SecurityGroupRuleIntf, 0, 1)
+		vpcSecurityGroupRules = append(vpcSecurityGroupRules, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9140,21 +9677,17 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("SecurityGroupRule of remoteType CIDR returns error when getting VPC subnet", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolAll returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -9169,7 +9702,20 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9179,34 +9725,50 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
 		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
 		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).ToNot(BeNil())
 	})
-	t.Run("Creates SecurityGroupRule of remoteType Any successfully", func(t *testing.T) {
+	t.Run("When it matches SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
 		remote := infrav1.VPCSecurityGroupRuleRemote{
-			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
 			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
 			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				ICMPCode: ptr.To(int64(12)),
+				ICMPType: ptr.To(int64(3)),
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CRN: ptr.To("crn"),
 			},
+			ID:   ptr.To("ruleID"),
+			Code: ptr.To(int64(12)),
+			Type: ptr.To(int64(3)),
 		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9216,20 +9778,18 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("crn")}, nil)
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(*ruleID).To(BeEquivalentTo("ruleID"))
+		g.Expect(match).To(BeTrue())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("Creates SecurityGroupRule of remoteType SG successfully", func(t *testing.T) {
+	t.Run("When it doesn't match SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -9238,13 +9798,30 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				ICMPCode: ptr.To(int64(12)),
+				ICMPType: ptr.To(int64(3)),
 			},
 		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CRN: ptr.To("crn"),
+			},
+			ID:   ptr.To("ruleID"),
+			Code: ptr.To(int64(12)),
+			Type: ptr.To(int64(3)),
+		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9254,21 +9831,18 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{CRN: ptr.To("crn"), Name: ptr.To("securityGroupName")}, nil)
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("crn"), CRN: ptr.To("CRN")}, nil)
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
 		g.Expect(err).To(BeNil())
 	})
-	t.Run("SecurityGroupRule of remoteType SG returns error while getting securityGroup", func(t *testing.T) {
+	t.Run("When SecurityGroupRule of protocolType SecurityGroupRuleSecurityGroupRuleProtocolIcmp returns error", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
@@ -9277,13 +9851,30 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
 		}
 		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
 				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
 				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				ICMPCode: ptr.To(int64(12)),
+				ICMPType: ptr.To(int64(3)),
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CRN: ptr.To("crn"),
 			},
+			ID:   ptr.To("ruleID"),
+			Code: ptr.To(int64(12)),
+			Type: ptr.To(int64(3)),
 		}
 
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
 		clusterScope := ClusterScope{
 			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
@@ -9293,501 +9884,2103 @@ func TestCreateVPCSecurityGroupRule(t *testing.T) {
 					},
 				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
 				},
 			},
 		}
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get securityGroup"))
+		ruleID, match, err := clusterScope.validateSecurityGroupRule(vpcSecurityGroupRules, rules.Direction, rules.Destination, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestValidateVPCSecurityGroupRules(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroupRule of Direction Inbound matches", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("inbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Inbound doesn't match", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("inbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("securityGroupID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("inbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Outbound matches", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("0.0.0.0/0"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Outbound doesn't match", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroupRule := vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{
+			Direction: ptr.To("outbound"),
+			Protocol:  ptr.To("tcp"),
+			Remote: &vpcv1.SecurityGroupRuleRemote{
+				CIDRBlock: ptr.To("192.168.1.1/24"),
+			},
+			ID: ptr.To("ruleID"),
+		}
+
+		vpcSecurityGroupRules := append([]vpcv1.SecurityGroupRuleIntf{}, &vpcSecurityGroupRule)
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			ID:    ptr.To("securityGroupID"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		ruleIDs, match, err := clusterScope.validateVPCSecurityGroupRules(vpcSecurityGroupRules, vpcSecurityGroup.Rules)
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+}
+
+func TestValidateVPCSecurityGroupRuleRemote(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When it matches the remoteType Address", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.0.1/24")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match the remoteType Address", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{Address: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it matches the remoteType Any", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("0.0.0.0/0")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match the remoteType Any", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it matches the remoteType CIDR", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match the remoteType CIDR", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.0.1/24")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When the remoteType CIDR and it returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CIDRBlock: ptr.To("192.168.1.1/24")}, remote)
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When it matches the remoteType SG", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("crn")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
+		g.Expect(match).To(BeTrue())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When it doesn't match the remoteType SG", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("192.168.1.1/24"), CRN: ptr.To("CRN")}, nil)
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When the remoteType SG and it returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
+		match, err := clusterScope.validateVPCSecurityGroupRuleRemote(&vpcv1.SecurityGroupRuleRemote{CRN: ptr.To("crn")}, remote)
+		g.Expect(match).To(BeFalse())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestCreateVPCSecurityGroupRule(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	securityGroupID := "securityGroupID"
+	var portMax int64 = 65535
+	var portMin int64 = 1
+	var protocol = "tcp"
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("Creates SecurityGroupRule of remoteType Address successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			Address:    ptr.To("192.168.0.1/24"),
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("Creates SecurityGroupRule of remoteType CIDR successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("192.168.1.1/24")}, nil)
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("SecurityGroupRule of remoteType CIDR returns error when getting VPC subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			CIDRSubnetName: ptr.To("CIDRSubnetName"),
+			RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, errors.New("failed to get VPC subnet"))
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("Creates SecurityGroupRule of remoteType Any successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAny,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("outbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("Creates SecurityGroupRule of remoteType SG successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(&vpcv1.SecurityGroup{CRN: ptr.To("crn"), Name: ptr.To("securityGroupName")}, nil)
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolIcmp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeEquivalentTo(ptr.To("ruleID")))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("SecurityGroupRule of remoteType SG returns error while getting securityGroup", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, errors.New("failed to get security group"))
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("SecurityGroupRule of remoteType SG returns error when SecurityGroup doesn't exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		remote := infrav1.VPCSecurityGroupRuleRemote{
+			SecurityGroupName: ptr.To("securityGroupName"),
+			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
+		}
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name:  ptr.To("securityGroupName"),
+						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+					}),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
+		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
+		g.Expect(ruleID).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestCreateVPCSecurityGroupRules(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	t.Run("When SecurityGroupRule of Direction Outbound created successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Outbound returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					CIDRSubnetName: ptr.To("CIDRSubnetName"),
+					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Inbound created successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
+					MinimumPort: 1,
+				},
+			},
+		}
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
+			Source: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					CIDRSubnetName: ptr.To("CIDRSubnetName"),
+					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("VPCID"),
+					},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				},
+			},
+		}
+
+		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
+		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
+		g.Expect(ruleIDs).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestCreateVPCSecurityGroupRulesAndSetStatus(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When SecurityGroupRule is created successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
+		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When CreateSecurityGroupRule returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		rules := infrav1.VPCSecurityGroupRule{
+			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
+			Destination: &infrav1.VPCSecurityGroupRulePrototype{
+				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
+					Address:    ptr.To("192.168.0.1/24"),
+					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
+				}),
+				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
+			},
+		}
+		vpcSecurityGroup := infrav1.VPCSecurityGroup{
+			Name:  ptr.To("securityGroupName"),
+			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		}
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create securityGroupRules"))
+		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestCreateVPCSecurityGroup(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	t.Run("When SecurityGroup is created successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
+		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
+		g.Expect(*sg).To(BeEquivalentTo("securityGroupID"))
+		g.Expect(err).To(BeNil())
+	})
+	t.Run("When SecurityGroup returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := ClusterScope{
+			IBMVPCClient: mockVPC,
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
+						Name: ptr.To("securityGroupName"),
+					}),
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resourceGroupID"),
+					},
+				},
+			},
+		}
+
+		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create SecurityGroup"))
+		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
+		g.Expect(sg).To(BeNil())
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
+func TestReconcileVPCEndpointGateways(t *testing.T) {
+	var (
+		mockVpc  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+	}
+
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	powervsClusterScope := func() *ClusterScope {
+		return &ClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("resource-group-id"),
+					},
+					VPCEndpointGateways: []infrav1.VPCEndpointGateway{
+						{
+							Name:       "vpe-cos",
+							ServiceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.us-south.cloud-object-storage.appdomain.cloud",
+						},
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID: ptr.To("vpc-id"),
+					},
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"subnet-1": {ID: ptr.To("subnet-id-1")},
+						"subnet-2": {ID: ptr.To("subnet-id-2")},
+						"subnet-3": {ID: ptr.To("subnet-id-3")},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When VPC ID is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPC = nil
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When GetEndpointGatewayByName returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, errors.New("failed to list endpoint gateways"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When endpoint gateway exists in cloud and is stable", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst),
+			Ips:            []vpcv1.ReservedIPReference{{Address: ptr.To("10.240.0.5")}},
+		}, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways["vpe-cos"]).To(Equal(infrav1.VPCEndpointGatewayStatus{
+			ID:                ptr.To("vpe-id"),
+			ControllerCreated: ptr.To(false),
+			ReservedIPs:       []string{"10.240.0.5"},
+		}))
+	})
+
+	t.Run("When endpoint gateway ID is set in status and endpoint gateway is pending", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways = map[string]infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockVpc.EXPECT().GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStatePendingConst),
+		}, nil, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways["vpe-cos"].ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("When endpoint gateway is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways = map[string]infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: ptr.To("vpe-id"), ControllerCreated: ptr.To(true)},
+		}
+		mockVpc.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateFailedConst),
+		}, nil, nil)
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+
+	t.Run("When endpoint gateway is created with a reserved IP in a subnet of each zone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, nil)
+		mockVpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil)
+		mockVpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil)
+		mockVpc.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-3")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, nil, nil)
+		mockVpc.EXPECT().CreateEndpointGateway(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("vpe-cos"))
+			g.Expect(*options.VPC.(*vpcv1.VPCIdentityByID).ID).To(Equal("vpc-id"))
+			g.Expect(*options.ResourceGroup.(*vpcv1.ResourceGroupIdentityByID).ID).To(Equal("resource-group-id"))
+			g.Expect(*options.Target.(*vpcv1.EndpointGatewayTargetPrototype).ResourceType).To(Equal(vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderCloudServiceConst))
+			g.Expect(options.Ips).To(HaveLen(2))
+			g.Expect(*options.Ips[0].(*vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext).Subnet.(*vpcv1.SubnetIdentityByID).ID).To(Equal("subnet-id-1"))
+			g.Expect(*options.Ips[1].(*vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext).Subnet.(*vpcv1.SubnetIdentityByID).ID).To(Equal("subnet-id-3"))
+			return &vpcv1.EndpointGateway{
+				ID:             ptr.To("vpe-id"),
+				LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStatePendingConst),
+			}, nil, nil
+		})
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways["vpe-cos"].ID).To(Equal("vpe-id"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways["vpe-cos"].ControllerCreated).To(BeTrue())
+	})
+
+	t.Run("When CreateEndpointGateway returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope()
+		mockVpc.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, nil)
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil).Times(3)
+		mockVpc.EXPECT().CreateEndpointGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create endpoint gateway"))
+		clusterScope.IBMVPCClient = mockVpc
+		requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestClusterScope_BucketRegion(t *testing.T) {
+	testRegion := region
+	vpcRegion := "us-east"
+
+	testcases := []struct {
+		name                 string
+		expectedBucketRegion string
+		clusterScope         ClusterScope
+	}{
+		{
+			name:                 "Returns bucket region from COS instance when set",
+			expectedBucketRegion: testRegion,
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						CosInstance: &infrav1.CosInstance{
+							BucketRegion: testRegion,
+						},
+						VPC: &infrav1.VPCResourceReference{
+							Region: ptr.To(vpcRegion),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:                 "Returns VPC region when COS bucket region is not set",
+			expectedBucketRegion: vpcRegion,
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						CosInstance: &infrav1.CosInstance{},
+						VPC: &infrav1.VPCResourceReference{
+							Region: ptr.To(vpcRegion),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:                 "Returns VPC region when COS instance is nil",
+			expectedBucketRegion: vpcRegion,
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						VPC: &infrav1.VPCResourceReference{
+							Region: ptr.To(vpcRegion),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:                 "Returns empty string when both COS bucket region and VPC region are not set",
+			expectedBucketRegion: "",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						CosInstance: &infrav1.CosInstance{},
+						VPC:         &infrav1.VPCResourceReference{},
+					},
+				},
+			},
+		},
+		{
+			name:                 "Returns empty string when COS instance is nil and VPC region is not set",
+			expectedBucketRegion: "",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						VPC: &infrav1.VPCResourceReference{},
+					},
+				},
+			},
+		},
+		{
+			name:                 "Returns empty string when both COS instance and VPC are nil",
+			expectedBucketRegion: "",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{},
+				},
+			},
+		},
+		{
+			name:                 "Prioritizes COS bucket region over VPC region",
+			expectedBucketRegion: testRegion,
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						CosInstance: &infrav1.CosInstance{
+							BucketRegion: testRegion,
+						},
+						VPC: &infrav1.VPCResourceReference{
+							Region: ptr.To(vpcRegion),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:                 "Returns empty string when COS bucket region is empty and VPC is nil",
+			expectedBucketRegion: "",
+			clusterScope: ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Spec: infrav1.IBMPowerVSClusterSpec{
+						CosInstance: &infrav1.CosInstance{
+							BucketRegion: "",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		g := NewWithT(t)
+		t.Run(tc.name, func(_ *testing.T) {
+			region := tc.clusterScope.bucketRegion()
+			g.Expect(region).To(Equal(tc.expectedBucketRegion))
+		})
+	}
+}
+
+func TestReconcileDNSRecord(t *testing.T) {
+	var (
+		mockDNS  *mockdns.MockDNSServices
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = mockdns.NewMockDNSServices(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func() *ClusterScope {
+		return &ClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com",
+						RecordName: "api.capi",
+					},
+				},
+			},
+			DNSServicesClient: mockDNS,
+		}
+	}
+	lbHostName := "abcd-us-south.lb.appdomain.cloud"
+
+	t.Run("When DNS is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScope{IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{}}
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS zone is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com").Return(nil, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).ToNot(Succeed())
+	})
+	t.Run("When DNS record does not exist it creates a CNAME record", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.Type).To(Equal(dnssvcsv1.ResourceRecord_Type_Cname))
+			g.Expect(*options.Name).To(Equal("api.capi.example.com"))
+			g.Expect(options.Rdata).To(Equal(&dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: ptr.To(lbHostName)}))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To("record-id")}, nil, nil
+		})
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS).To(Equal(&infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("zone-id"),
+			ID:                ptr.To("record-id"),
+			Hostname:          ptr.To("api.capi.example.com"),
+			Target:            ptr.To(lbHostName),
+			ControllerCreated: ptr.To(true),
+		}))
+	})
+	t.Run("When DNS record points to a previous load balancer it is updated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.DNS = &infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("zone-id"),
+			ID:                ptr.To("record-id"),
+			Hostname:          ptr.To("api.capi.example.com"),
+			Target:            ptr.To("old.lb.appdomain.cloud"),
+			ControllerCreated: ptr.To(true),
+		}
+		record := &dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_Cname),
+			Rdata: map[string]interface{}{"cname": "old.lb.appdomain.cloud"},
+		}
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(record, nil)
+		mockDNS.EXPECT().UpdateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.RecordID).To(Equal("record-id"))
+			g.Expect(options.Rdata).To(Equal(&dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord{Cname: ptr.To(lbHostName)}))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To("record-id")}, nil, nil
+		})
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).To(Succeed())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.DNS.Target).To(Equal(lbHostName))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.DNS.ControllerCreated).To(BeTrue())
 	})
-	t.Run("SecurityGroupRule of remoteType SG returns error when SecurityGroup doesn't exist", func(t *testing.T) {
+	t.Run("When existing DNS record is up to date it is adopted", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		remote := infrav1.VPCSecurityGroupRuleRemote{
-			SecurityGroupName: ptr.To("securityGroupName"),
-			RemoteType:        infrav1.VPCSecurityGroupRuleRemoteTypeSG,
-		}
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes:  append([]infrav1.VPCSecurityGroupRuleRemote{}, remote),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
+
+		clusterScope := newClusterScope()
+		record := &dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_Cname),
+			Rdata: map[string]interface{}{"cname": lbHostName},
 		}
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(record, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).To(Succeed())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.DNS.ID).To(Equal("record-id"))
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.DNS.ControllerCreated).To(BeFalse())
+	})
+	t.Run("When existing DNS record has a different type", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name:  ptr.To("securityGroupName"),
-						Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-					}),
-				},
-			},
+		clusterScope := newClusterScope()
+		record := &dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_A),
+			Rdata: map[string]interface{}{"ip": "10.0.0.1"},
 		}
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(record, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).ToNot(Succeed())
+	})
+	t.Run("When DNS record name is changed the previously created record is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		mockVPC.EXPECT().GetSecurityGroupByName(gomock.Any()).Return(nil, nil)
-		ruleID, err := clusterScope.createVPCSecurityGroupRule(ctx, &securityGroupID, ptr.To("inbound"), &protocol, &portMin, &portMax, remote)
-		g.Expect(ruleID).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		clusterScope := newClusterScope()
+		clusterScope.IBMPowerVSCluster.Status.DNS = &infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("zone-id"),
+			ID:                ptr.To("old-record-id"),
+			Hostname:          ptr.To("old.capi.example.com"),
+			ControllerCreated: ptr.To(true),
+		}
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(nil, nil)
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).Return(&dnssvcsv1.ResourceRecord{ID: ptr.To("record-id")}, nil, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbHostName)).To(Succeed())
+		g.Expect(*clusterScope.IBMPowerVSCluster.Status.DNS.ID).To(Equal("record-id"))
 	})
 }
 
-func TestCreateVPCSecurityGroupRules(t *testing.T) {
+func TestDeleteDNSRecord(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
+		mockDNS  *mockdns.MockDNSServices
 		mockCtrl *gomock.Controller
 	)
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockDNS = mockdns.NewMockDNSServices(mockCtrl)
 	}
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-
-	t.Run("When SecurityGroupRule of Direction Outbound created successfully", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
-			},
-		}
-
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+	newClusterScope := func(controllerCreated bool) *ClusterScope {
+		return &ClusterScope{
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com",
+						RecordName: "api.capi",
 					},
 				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+				Status: infrav1.IBMPowerVSClusterStatus{
+					DNS: &infrav1.DNSRecordStatus{
+						ZoneID:            ptr.To("zone-id"),
+						ID:                ptr.To("record-id"),
+						Hostname:          ptr.To("api.capi.example.com"),
+						ControllerCreated: ptr.To(controllerCreated),
+					},
 				},
 			},
+			DNSServicesClient: mockDNS,
 		}
+	}
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(err).To(BeNil())
-	})
-	t.Run("When SecurityGroupRule of Direction Outbound returns error", func(t *testing.T) {
+	t.Run("When DNS record is not created by controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					CIDRSubnetName: ptr.To("CIDRSubnetName"),
-					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
 
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		clusterScope := newClusterScope(false)
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS).To(BeNil())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound created successfully", func(t *testing.T) {
+	t.Run("When DNS record is deleted successfully", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-				PortRange: &infrav1.VPCSecurityGroupPortRange{MaximumPort: 65535,
-					MinimumPort: 1,
-				},
-			},
-		}
 
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
-				},
-			},
-		}
+		clusterScope := newClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(&dnssvcsv1.DeleteResourceRecordOptions{
+			InstanceID: ptr.To("dns-instance-id"),
+			DnszoneID:  ptr.To("zone-id"),
+			RecordID:   ptr.To("record-id"),
+		}).Return(nil, nil)
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS record is already deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolTcpudp{Direction: ptr.To("inbound"), ID: ptr.To("ruleID")}, nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeEquivalentTo([]*string{ptr.To("ruleID")}))
-		g.Expect(err).To(BeNil())
+		clusterScope := newClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(&core.DetailedResponse{StatusCode: ResourceNotFoundCode}, errors.New("not found"))
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
 	})
-	t.Run("When SecurityGroupRule of Direction Inbound returns error", func(t *testing.T) {
+	t.Run("When DNS record deletion fails", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionInbound,
-			Source: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					CIDRSubnetName: ptr.To("CIDRSubnetName"),
-					RemoteType:     infrav1.VPCSecurityGroupRuleRemoteTypeCIDR,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
 
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
+		clusterScope := newClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(nil, errors.New("failed to delete"))
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.DNS).ToNot(BeNil())
+	})
+}
+
+func TestResourceVerificationInterval(t *testing.T) {
+	t.Run("When resource verification is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		clusterScope := ClusterScope{
+			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
 		}
+		g.Expect(clusterScope.ResourceVerificationInterval()).To(Equal(10 * time.Minute))
+	})
+
+	t.Run("When resource verification interval is set", func(t *testing.T) {
+		g := NewWithT(t)
 		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Status: infrav1.IBMPowerVSClusterStatus{
-					VPC: &infrav1.ResourceReference{
-						ID: ptr.To("VPCID"),
-					},
-				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, vpcSecurityGroup),
+					ResourceVerification: &infrav1.ResourceVerification{
+						Interval: &metav1.Duration{Duration: 30 * time.Minute},
+					},
 				},
 			},
 		}
-
-		mockVPC.EXPECT().GetVPCSubnetByName(gomock.Any()).Return(nil, nil)
-		ruleIDs, err := clusterScope.createVPCSecurityGroupRules(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"))
-		g.Expect(ruleIDs).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.ResourceVerificationInterval()).To(Equal(30 * time.Minute))
 	})
 }
 
-func TestCreateVPCSecurityGroupRulesAndSetStatus(t *testing.T) {
+func TestVerifyResources(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
+		mockVpc  *mock.MockVpc
+		mockTG   *tgmock.MockTransitGateway
 		mockCtrl *gomock.Controller
 	)
+
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
 	}
+
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When SecurityGroupRule is created successfully", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+	powervsClusterScope := func(policy infrav1.MissingResourcePolicy) *ClusterScope {
+		return &ClusterScope{
+			IBMVPCClient:         mockVpc,
+			TransitGatewayClient: mockTG,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster",
+				},
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
+					TransitGateway: &infrav1.TransitGateway{
+						Name: ptr.To("tg-name"),
+					},
+					ResourceVerification: &infrav1.ResourceVerification{
+						MissingResourcePolicy: policy,
+					},
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID:                ptr.To("vpc-id"),
+						ControllerCreated: ptr.To(true),
+					},
+					TransitGateway: &infrav1.TransitGatewayStatus{
+						ID:                ptr.To("tg-id"),
+						ControllerCreated: ptr.To(false),
 					},
 				},
 			},
 		}
+	}
+
+	t.Run("When all resources exist and match spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyReport)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), Name: ptr.To("cluster-vpc")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("tg-name")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(BeEmpty())
+		g.Expect(result.Recreating).To(BeEmpty())
+		g.Expect(result.Drifted).To(BeEmpty())
+	})
+
+	t.Run("When VPC is missing and missing resources are reported", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyReport)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("tg-name")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(Equal([]string{"VPC vpc-id"}))
+		g.Expect(result.Recreating).To(BeEmpty())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPC).ToNot(BeNil())
+	})
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(&vpcv1.SecurityGroupRuleSecurityGroupRuleProtocolAll{Direction: ptr.To("outbound"), ID: ptr.To("ruleID")}, nil, nil)
-		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
+	t.Run("When VPC created by controller is missing and missing resources are recreated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyRecreate)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("tg-name")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(BeEmpty())
+		g.Expect(result.Recreating).To(Equal([]string{"VPC vpc-id"}))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPC).To(BeNil())
 	})
-	t.Run("When CreateSecurityGroupRule returns error", func(t *testing.T) {
+
+	t.Run("When transit gateway not created by controller is missing and missing resources are recreated", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		rules := infrav1.VPCSecurityGroupRule{
-			Direction: infrav1.VPCSecurityGroupRuleDirectionOutbound,
-			Destination: &infrav1.VPCSecurityGroupRulePrototype{
-				Remotes: append([]infrav1.VPCSecurityGroupRuleRemote{}, infrav1.VPCSecurityGroupRuleRemote{
-					Address:    ptr.To("192.168.0.1/24"),
-					RemoteType: infrav1.VPCSecurityGroupRuleRemoteTypeAddress,
-				}),
-				Protocol: infrav1.VPCSecurityGroupRuleProtocolTCP,
-			},
-		}
-		vpcSecurityGroup := infrav1.VPCSecurityGroup{
-			Name:  ptr.To("securityGroupName"),
-			Rules: append([]*infrav1.VPCSecurityGroupRule{}, &rules),
-		}
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
-					},
-				},
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyRecreate)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), Name: ptr.To("cluster-vpc")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(Equal([]string{"transit gateway tg-id"}))
+		g.Expect(result.Recreating).To(BeEmpty())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.TransitGateway).ToNot(BeNil())
+	})
+
+	t.Run("When transit gateway name does not match spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyReport)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), Name: ptr.To("cluster-vpc")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("renamed-tg")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(BeEmpty())
+		g.Expect(result.Drifted).To(Equal([]string{"transit gateway tg-id is named renamed-tg instead of tg-name"}))
+	})
+
+	t.Run("When VPC subnet created by controller is missing and missing resources are recreated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyRecreate)
+		clusterScope.IBMPowerVSCluster.Status.VPCSubnet = map[string]infrav1.ResourceReference{
+			"subnet-name": {
+				ID:                ptr.To("subnet-id"),
+				ControllerCreated: ptr.To(true),
 			},
 		}
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), Name: ptr.To("cluster-vpc")}, nil, nil)
+		mockVpc.EXPECT().GetSubnet(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: 404}, errors.New("not found"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("tg-name")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Recreating).To(Equal([]string{"VPC subnet subnet-name"}))
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.VPCSubnet).ToNot(HaveKey("subnet-name"))
+	})
 
-		mockVPC.EXPECT().CreateSecurityGroupRule(gomock.Any()).Return(nil, nil, errors.New("failed to create securityGroupRules"))
-		err := clusterScope.createVPCSecurityGroupRulesAndSetStatus(ctx, vpcSecurityGroup.Rules, ptr.To("securityGroupID"), ptr.To("securityGroupName"))
+	t.Run("When GetVPC returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(infrav1.MissingResourcePolicyReport)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get VPC"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("tg-name")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
 		g.Expect(err).ToNot(BeNil())
+		g.Expect(result).To(BeNil())
 	})
 }
 
-func TestCreateVPCSecurityGroup(t *testing.T) {
+func TestReconcileTags(t *testing.T) {
 	var (
-		mockVPC  *mock.MockVpc
+		mockVpc  *mock.MockVpc
+		mockGT   *mockgt.MockGlobalTagging
 		mockCtrl *gomock.Controller
 	)
+
 	setup := func(t *testing.T) {
 		t.Helper()
 		mockCtrl = gomock.NewController(t)
-		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockVpc = mock.NewMockVpc(mockCtrl)
+		mockGT = mockgt.NewMockGlobalTagging(mockCtrl)
 	}
+
 	teardown := func() {
 		mockCtrl.Finish()
 	}
-	t.Run("When SecurityGroup is created successfully", func(t *testing.T) {
-		g := NewWithT(t)
-		setup(t)
-		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
+	powervsClusterScope := func(tags map[string]string, tagsStatus *infrav1.TagsStatus) *ClusterScope {
+		return &ClusterScope{
+			IBMVPCClient:        mockVpc,
+			GlobalTaggingClient: mockGT,
 			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
 				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
+					Tags: tags,
+				},
+				Status: infrav1.IBMPowerVSClusterStatus{
+					VPC: &infrav1.ResourceReference{
+						ID:                ptr.To("vpc-id"),
+						ControllerCreated: ptr.To(true),
+					},
+					VPCSubnet: map[string]infrav1.ResourceReference{
+						"subnet": {
+							ID:                ptr.To("subnet-id"),
+							ControllerCreated: ptr.To(false),
+						},
 					},
+					Tags: tagsStatus,
 				},
 			},
 		}
+	}
 
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{ID: ptr.To("securityGroupID")}, nil, nil)
-		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
-		g.Expect(*sg).To(BeEquivalentTo("securityGroupID"))
+	t.Run("When no tags are set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(nil, nil)
+		err := clusterScope.ReconcileTags(ctx)
 		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Tags).To(BeNil())
 	})
-	t.Run("When SecurityGroup returns error", func(t *testing.T) {
+
+	t.Run("When tags are attached to the resources created by the controller", func(t *testing.T) {
 		g := NewWithT(t)
 		setup(t)
 		t.Cleanup(teardown)
-		clusterScope := ClusterScope{
-			IBMVPCClient: mockVPC,
-			IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-				Spec: infrav1.IBMPowerVSClusterSpec{
-					VPCSecurityGroups: append([]infrav1.VPCSecurityGroup{}, infrav1.VPCSecurityGroup{
-						Name: ptr.To("securityGroupName"),
-					}),
-					ResourceGroup: &infrav1.IBMPowerVSResourceReference{
-						ID: ptr.To("resourceGroupID"),
-					},
-				},
-			},
-		}
+		clusterScope := powervsClusterScope(map[string]string{"owner": "finance", "cost-center": "1234"}, nil)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockGT.EXPECT().DetachUserTags(gomock.Len(0), []string{"vpc-crn"}).Return(nil)
+		mockGT.EXPECT().AttachUserTags([]string{"cost-center:1234", "owner:finance"}, []string{"vpc-crn"}).Return(nil)
+		err := clusterScope.ReconcileTags(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Tags).To(Equal(&infrav1.TagsStatus{
+			Applied:   []string{"cost-center:1234", "owner:finance"},
+			Resources: []string{"vpc-id"},
+		}))
+	})
 
-		mockVPC.EXPECT().CreateSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("failed to create SecurityGroup"))
-		sg, err := clusterScope.createVPCSecurityGroup(ctx, clusterScope.IBMPowerVSCluster.Spec.VPCSecurityGroups[0])
-		g.Expect(sg).To(BeNil())
-		g.Expect(err).ToNot(BeNil())
+	t.Run("When cluster UID tag is attached along with the tags", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(map[string]string{"owner": "finance"}, nil)
+		clusterScope.IBMPowerVSCluster.UID = "cluster-uid"
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockGT.EXPECT().DetachUserTags(gomock.Len(0), []string{"vpc-crn"}).Return(nil)
+		mockGT.EXPECT().AttachUserTags([]string{"capibm-cluster-uid:cluster-uid", "owner:finance"}, []string{"vpc-crn"}).Return(nil)
+		err := clusterScope.ReconcileTags(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Tags).To(Equal(&infrav1.TagsStatus{
+			Applied:   []string{"capibm-cluster-uid:cluster-uid", "owner:finance"},
+			Resources: []string{"vpc-id"},
+		}))
 	})
-}
 
-func TestClusterScope_BucketRegion(t *testing.T) {
-	testRegion := region
-	vpcRegion := "us-east"
+	t.Run("When tags are already attached to the resources", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(map[string]string{"owner": "finance"}, &infrav1.TagsStatus{
+			Applied:   []string{"owner:finance"},
+			Resources: []string{"vpc-id"},
+		})
+		err := clusterScope.ReconcileTags(ctx)
+		g.Expect(err).To(BeNil())
+	})
 
-	testcases := []struct {
-		name                 string
-		expectedBucketRegion string
-		clusterScope         ClusterScope
-	}{
-		{
-			name:                 "Returns bucket region from COS instance when set",
-			expectedBucketRegion: testRegion,
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						CosInstance: &infrav1.CosInstance{
-							BucketRegion: testRegion,
-						},
-						VPC: &infrav1.VPCResourceReference{
-							Region: ptr.To(vpcRegion),
-						},
-					},
-				},
-			},
-		},
-		{
-			name:                 "Returns VPC region when COS bucket region is not set",
-			expectedBucketRegion: vpcRegion,
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						CosInstance: &infrav1.CosInstance{},
-						VPC: &infrav1.VPCResourceReference{
-							Region: ptr.To(vpcRegion),
-						},
-					},
-				},
-			},
-		},
-		{
-			name:                 "Returns VPC region when COS instance is nil",
-			expectedBucketRegion: vpcRegion,
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						VPC: &infrav1.VPCResourceReference{
-							Region: ptr.To(vpcRegion),
-						},
-					},
-				},
-			},
-		},
-		{
-			name:                 "Returns empty string when both COS bucket region and VPC region are not set",
-			expectedBucketRegion: "",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						CosInstance: &infrav1.CosInstance{},
-						VPC:         &infrav1.VPCResourceReference{},
-					},
-				},
-			},
-		},
-		{
-			name:                 "Returns empty string when COS instance is nil and VPC region is not set",
-			expectedBucketRegion: "",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						VPC: &infrav1.VPCResourceReference{},
-					},
-				},
-			},
-		},
-		{
-			name:                 "Returns empty string when both COS instance and VPC are nil",
-			expectedBucketRegion: "",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{},
-				},
-			},
-		},
-		{
-			name:                 "Prioritizes COS bucket region over VPC region",
-			expectedBucketRegion: testRegion,
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						CosInstance: &infrav1.CosInstance{
-							BucketRegion: testRegion,
-						},
-						VPC: &infrav1.VPCResourceReference{
-							Region: ptr.To(vpcRegion),
-						},
-					},
-				},
-			},
-		},
-		{
-			name:                 "Returns empty string when COS bucket region is empty and VPC is nil",
-			expectedBucketRegion: "",
-			clusterScope: ClusterScope{
-				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
-					Spec: infrav1.IBMPowerVSClusterSpec{
-						CosInstance: &infrav1.CosInstance{
-							BucketRegion: "",
-						},
-					},
-				},
-			},
-		},
-	}
+	t.Run("When tags are changed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(map[string]string{"owner": "sales"}, &infrav1.TagsStatus{
+			Applied:   []string{"env:dev", "owner:finance"},
+			Resources: []string{"vpc-id"},
+		})
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockGT.EXPECT().DetachUserTags([]string{"env:dev", "owner:finance"}, []string{"vpc-crn"}).Return(nil)
+		mockGT.EXPECT().AttachUserTags([]string{"owner:sales"}, []string{"vpc-crn"}).Return(nil)
+		err := clusterScope.ReconcileTags(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Tags.Applied).To(Equal([]string{"owner:sales"}))
+	})
 
-	for _, tc := range testcases {
+	t.Run("When all tags are removed", func(t *testing.T) {
 		g := NewWithT(t)
-		t.Run(tc.name, func(_ *testing.T) {
-			region := tc.clusterScope.bucketRegion()
-			g.Expect(region).To(Equal(tc.expectedBucketRegion))
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(nil, &infrav1.TagsStatus{
+			Applied:   []string{"owner:finance"},
+			Resources: []string{"vpc-id"},
 		})
-	}
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockGT.EXPECT().DetachUserTags([]string{"owner:finance"}, []string{"vpc-crn"}).Return(nil)
+		mockGT.EXPECT().AttachUserTags(gomock.Len(0), []string{"vpc-crn"}).Return(nil)
+		err := clusterScope.ReconcileTags(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Tags).To(BeNil())
+	})
+
+	t.Run("When attaching tags fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		clusterScope := powervsClusterScope(map[string]string{"owner": "finance"}, nil)
+		mockVpc.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+		mockGT.EXPECT().DetachUserTags(gomock.Any(), gomock.Any()).Return(nil)
+		mockGT.EXPECT().AttachUserTags(gomock.Any(), gomock.Any()).Return(errors.New("failed to attach tags"))
+		err := clusterScope.ReconcileTags(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(clusterScope.IBMPowerVSCluster.Status.Tags).To(BeNil())
+	})
 }
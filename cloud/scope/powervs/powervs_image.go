@@ -20,16 +20,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
@@ -39,26 +53,48 @@ import (
 // BucketAccess indicates if the bucket has public or private access public access.
 const BucketAccess = "public"
 
+const (
+	// defaultImageJobPollInterval is the default interval to poll the image import job.
+	defaultImageJobPollInterval = 2 * time.Minute
+	// defaultImagePollInterval is the default interval to poll the image until it is active.
+	defaultImagePollInterval = 1 * time.Minute
+	// defaultImageImportBackoff is the default time to wait after the first failed import attempt before retrying.
+	defaultImageImportBackoff = 1 * time.Minute
+	// maxImageImportBackoff is the maximum time to wait after a failed import attempt before retrying.
+	maxImageImportBackoff = 1 * time.Hour
+	// maxImageImportAttempts is the maximum number of import attempts kept in the status.
+	maxImageImportAttempts = 10
+)
+
 var (
 	// ErrServiceInsanceNotInActiveState indicates error if serviceInstance is inactive.
 	ErrServiceInsanceNotInActiveState = errors.New("service instance is not in active state")
+
+	// ErrImageChecksumMismatch indicates error if the Cloud Object Storage object does not match the expected checksum.
+	ErrImageChecksumMismatch = errors.New("checksum of the Cloud Object Storage object does not match the expected checksum")
 )
 
 // ImageScopeParams defines the input parameters used to create a new ImageScope.
 type ImageScopeParams struct {
-	Client          client.Client
-	IBMPowerVSImage *infrav1.IBMPowerVSImage
-	ServiceEndpoint []endpoints.ServiceEndpoint
-	Zone            *string
+	Client            client.Client
+	IBMPowerVSImage   *infrav1.IBMPowerVSImage
+	ServiceEndpoint   []endpoints.ServiceEndpoint
+	Zone              *string
+	IBMPowerVSCluster *infrav1.IBMPowerVSCluster
 }
 
 // ImageScope defines a scope defined around a Power VS Cluster.
 type ImageScope struct {
 	Client            client.Client
 	IBMPowerVSClient  powervs.PowerVS
+	ResourceClient    resourcecontroller.ResourceController
+	COSClient         cos.Cos
 	IBMPowerVSImage   *infrav1.IBMPowerVSImage
 	ServiceEndpoint   []endpoints.ServiceEndpoint
 	serviceInstanceID string
+
+	// targetClients holds the Power VS clients of the additional workspaces the image is imported into, keyed by workspace id.
+	targetClients map[string]powervs.PowerVS
 }
 
 // NewPowerVSImageScope creates a new ImageScope from the supplied parameters.
@@ -77,6 +113,7 @@ func NewPowerVSImageScope(ctx context.Context, params ImageScopeParams) (scope *
 		return nil, err
 	}
 	scope.IBMPowerVSImage = params.IBMPowerVSImage
+	scope.ServiceEndpoint = params.ServiceEndpoint
 
 	// Create Resource Controller client.
 	var serviceOption resourcecontroller.ServiceOptions
@@ -97,6 +134,9 @@ func NewPowerVSImageScope(ctx context.Context, params ImageScopeParams) (scope *
 		serviceInstanceID = *params.IBMPowerVSImage.Spec.ServiceInstance.ID
 	} else {
 		name := fmt.Sprintf("%s-%s", params.IBMPowerVSImage.Spec.ClusterName, "serviceInstance")
+		if params.IBMPowerVSCluster != nil {
+			name = *getServiceName(params.IBMPowerVSCluster, infrav1.ResourceTypeServiceInstance)
+		}
 		if params.IBMPowerVSImage.Spec.ServiceInstance != nil && params.IBMPowerVSImage.Spec.ServiceInstance.Name != nil {
 			name = *params.IBMPowerVSImage.Spec.ServiceInstance.Name
 		}
@@ -121,13 +161,27 @@ func NewPowerVSImageScope(ctx context.Context, params ImageScopeParams) (scope *
 		serviceInstanceID = *serviceInstance.GUID
 	}
 
+	c, _, err := newImagePowerVSClient(ctx, rc, serviceInstanceID, params.ServiceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	scope.IBMPowerVSClient = c
+	scope.ResourceClient = rc
+	scope.serviceInstanceID = serviceInstanceID
+	return scope, nil
+}
+
+// newImagePowerVSClient creates a Power VS client for the workspace with the given id and returns it along with the zone of the workspace.
+func newImagePowerVSClient(ctx context.Context, rc resourcecontroller.ResourceController, serviceInstanceID string, serviceEndpoint []endpoints.ServiceEndpoint) (powervs.PowerVS, string, error) {
+	log := ctrl.LoggerFrom(ctx)
 	res, _, err := rc.GetResourceInstance(
 		&resourcecontrollerv2.GetResourceInstanceOptions{
 			ID: &serviceInstanceID,
 		})
 	if err != nil {
 		err = fmt.Errorf("failed to get resource instance: %w", err)
-		return nil, err
+		return nil, "", err
 	}
 
 	options := powervs.ServiceOptions{
@@ -138,7 +192,7 @@ func NewPowerVSImageScope(ctx context.Context, params ImageScopeParams) (scope *
 	}
 
 	// Fetch the service endpoint.
-	if svcEndpoint := endpoints.FetchPVSEndpoint(endpoints.ConstructRegionFromZone(*res.RegionID), params.ServiceEndpoint); svcEndpoint != "" {
+	if svcEndpoint := endpoints.FetchPVSEndpoint(endpoints.ConstructRegionFromZone(*res.RegionID), serviceEndpoint); svcEndpoint != "" {
 		options.IBMPIOptions.URL = svcEndpoint
 		log.V(3).Info("Overriding the default PowerVS service endpoint", "serviceEndpoint", svcEndpoint)
 	}
@@ -146,18 +200,20 @@ func NewPowerVSImageScope(ctx context.Context, params ImageScopeParams) (scope *
 	c, err := powervs.NewService(options)
 	if err != nil {
 		err = fmt.Errorf("failed to create NewIBMPowerVSClient error %w", err)
-		return nil, err
+		return nil, "", err
 	}
 
 	options.CloudInstanceID = serviceInstanceID
 	c.WithClients(options)
-	scope.IBMPowerVSClient = c
-	scope.serviceInstanceID = serviceInstanceID
-	return scope, nil
+	return c, *res.RegionID, nil
 }
 
 func (i *ImageScope) ensureImageUnique(imageName string) (*models.ImageReference, error) {
-	images, err := i.IBMPowerVSClient.GetAllImage()
+	return findImageByName(i.IBMPowerVSClient, imageName)
+}
+
+func findImageByName(c powervs.PowerVS, imageName string) (*models.ImageReference, error) {
+	images, err := c.GetAllImage()
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +228,12 @@ func (i *ImageScope) ensureImageUnique(imageName string) (*models.ImageReference
 // CreateImageCOSBucket creates a power vs image.
 func (i *ImageScope) CreateImageCOSBucket(ctx context.Context) (*models.ImageReference, *models.JobReference, error) {
 	log := ctrl.LoggerFrom(ctx)
-	imageSpec := i.IBMPowerVSImage.Spec
 	m := i.IBMPowerVSImage.ObjectMeta
+	imageSpec := i.IBMPowerVSImage.Spec
+	i.IBMPowerVSImage.Status.Source = &infrav1.IBMPowerVSImageSource{
+		Type:     infrav1.PowerVSImageSourceTypeCOS,
+		Location: fmt.Sprintf("cos://%s/%s/%s", ptr.Deref(imageSpec.Region, ""), ptr.Deref(imageSpec.Bucket, ""), ptr.Deref(imageSpec.Object, "")),
+	}
 
 	imageReply, err := i.ensureImageUnique(m.Name)
 	if err != nil {
@@ -181,6 +241,7 @@ func (i *ImageScope) CreateImageCOSBucket(ctx context.Context) (*models.ImageRef
 		return nil, nil, err
 	} else if imageReply != nil {
 		log.Info("Image already exists", "imageName", m.Name)
+		i.adoptImage(ctx, imageReply)
 		return imageReply, nil, nil
 	}
 
@@ -191,26 +252,371 @@ func (i *ImageScope) CreateImageCOSBucket(ctx context.Context) (*models.ImageRef
 		}
 	}
 
-	body := &models.CreateCosImageImportJob{
-		ImageName:     &m.Name,
-		BucketName:    imageSpec.Bucket,
-		BucketAccess:  core.StringPtr(BucketAccess),
-		Region:        imageSpec.Region,
-		ImageFilename: imageSpec.Object,
-		StorageType:   imageSpec.StorageType,
+	verified, err := i.verifyChecksum()
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedVerifyImageChecksum", "Failed image checksum verification - %v", err)
+		return nil, nil, err
 	}
 
-	jobRef, err := i.IBMPowerVSClient.CreateCosImage(body)
+	jobRef, err := i.IBMPowerVSClient.CreateCosImage(i.cosImageImportJob())
 	if err != nil {
 		log.Info("Unable to create new import job request")
 		record.Warnf(i.IBMPowerVSImage, "FailedCreateImageImportJob", "Failed image import job creation - %v", err)
 		return nil, nil, err
 	}
 	log.Info("New import job request created")
+	i.IBMPowerVSImage.Status.Import = &infrav1.IBMPowerVSImageImportStatus{
+		StartTime:        ptr.To(metav1.Now()),
+		ChecksumVerified: verified,
+	}
+	i.addImportAttempt(*jobRef.ID)
 	record.Eventf(i.IBMPowerVSImage, "SuccessfulCreateImageImportJob", "Created image import job %q", *jobRef.ID)
 	return nil, jobRef, nil
 }
 
+// adoptImage marks the existing image as adopted when the controller has neither submitted an import job nor recorded an image,
+// so that an image the controller did not create is not deleted from the workspace.
+func (i *ImageScope) adoptImage(ctx context.Context, image *models.ImageReference) {
+	if i.IBMPowerVSImage.Status.JobID != "" || i.IBMPowerVSImage.Status.ImageID != "" || i.IBMPowerVSImage.Status.Adopted {
+		return
+	}
+	ctrl.LoggerFrom(ctx).Info("Adopting image not created by the controller, the image will not be deleted", "imageID", ptr.Deref(image.ImageID, ""))
+	i.IBMPowerVSImage.Status.Adopted = true
+}
+
+// verifyChecksum verifies the expected checksum of the image against the metadata of the Cloud Object Storage object,
+// it returns true if the checksum was verified and false if no checksum is expected.
+func (i *ImageScope) verifyChecksum() (bool, error) {
+	expected := i.IBMPowerVSImage.Spec.ExpectedChecksum
+	if expected == nil {
+		return false, nil
+	}
+
+	c, err := i.getCOSClient()
+	if err != nil {
+		return false, err
+	}
+	bucket, key := i.cosObjectKey()
+	object, err := c.HeadObject(&s3.HeadObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get metadata of object %s in bucket %s: %w", key, bucket, err)
+	}
+
+	actual := objectChecksum(object, expected.Algorithm)
+	if actual == "" {
+		return false, fmt.Errorf("%w: object %s in bucket %s has no %s checksum", ErrImageChecksumMismatch, key, bucket, expected.Algorithm)
+	}
+	if !strings.EqualFold(actual, expected.Value) {
+		return false, fmt.Errorf("%w: expected %s checksum %s, got %s", ErrImageChecksumMismatch, expected.Algorithm, expected.Value, actual)
+	}
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulVerifyImageChecksum", "Verified %s checksum of object %q", expected.Algorithm, key)
+	return true, nil
+}
+
+// objectChecksum returns the checksum of the Cloud Object Storage object using the given algorithm, or an empty string if it is unknown.
+// The MD5 checksum is the ETag of objects uploaded in a single part, other checksums are read from the user metadata of the object.
+func objectChecksum(object *s3.HeadObjectOutput, algorithm infrav1.PowerVSImageChecksumAlgorithm) string {
+	if algorithm == infrav1.PowerVSImageChecksumAlgorithmMD5 {
+		if etag := strings.Trim(ptr.Deref(object.ETag, ""), `"`); etag != "" && !strings.Contains(etag, "-") {
+			return etag
+		}
+	}
+	for k, v := range object.Metadata {
+		if strings.EqualFold(k, string(algorithm)) {
+			return ptr.Deref(v, "")
+		}
+	}
+	return ""
+}
+
+// cosObjectKey returns the name of the bucket and the key of the Cloud Object Storage object of the image.
+func (i *ImageScope) cosObjectKey() (string, string) {
+	bucket, folder, _ := strings.Cut(ptr.Deref(i.IBMPowerVSImage.Spec.Bucket, ""), "/")
+	key := ptr.Deref(i.IBMPowerVSImage.Spec.Object, "")
+	if folder != "" {
+		key = strings.TrimSuffix(folder, "/") + "/" + key
+	}
+	return bucket, key
+}
+
+// getCOSClient returns the Cloud Object Storage client used to access the public bucket of the image, creating it if required.
+func (i *ImageScope) getCOSClient() (cos.Cos, error) {
+	if i.COSClient != nil {
+		return i.COSClient, nil
+	}
+	region := ptr.Deref(i.IBMPowerVSImage.Spec.Region, "")
+	serviceEndpoint := fmt.Sprintf("s3.%s.%s", region, cosURLDomain)
+	// Fetch the COS service endpoint.
+	if cosServiceEndpoint := endpoints.FetchEndpoints(string(endpoints.COS), i.ServiceEndpoint); cosServiceEndpoint != "" {
+		serviceEndpoint = cosServiceEndpoint
+	}
+
+	cosOptions := cos.ServiceOptions{
+		Options: &cosSession.Options{
+			Config: aws.Config{
+				Endpoint: &serviceEndpoint,
+				Region:   &region,
+			},
+		},
+	}
+	// The image is imported from a public bucket, so no credentials are required.
+	c, err := cos.NewAnonymousService(cosOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create COS client: %w", err)
+	}
+	i.COSClient = c
+	return c, nil
+}
+
+// SetImportProgress will set the progress and the timing of the import job.
+func (i *ImageScope) SetImportProgress(job *models.Job) {
+	if i.IBMPowerVSImage.Status.Import == nil {
+		i.IBMPowerVSImage.Status.Import = &infrav1.IBMPowerVSImageImportStatus{}
+	}
+	status := i.IBMPowerVSImage.Status.Import
+	if job.Status.Progress != nil {
+		status.Progress = *job.Status.Progress
+	}
+	if created := time.Time(job.CreateTimestamp); !created.IsZero() {
+		status.StartTime = ptr.To(metav1.NewTime(created))
+	}
+	state := infrav1.PowerVSImageState(ptr.Deref(job.Status.State, ""))
+	if (state == infrav1.PowerVSImageStateCompleted || state == infrav1.PowerVSImageStateFailed) && status.CompletionTime == nil {
+		status.CompletionTime = ptr.To(metav1.Now())
+	}
+}
+
+// addImportAttempt records a new import attempt with the given import job in the status.
+func (i *ImageScope) addImportAttempt(jobID string) {
+	i.IBMPowerVSImage.Status.Attempts = addImportAttempt(i.IBMPowerVSImage.Status.Attempts, jobID)
+}
+
+// SetImportAttempt will update the import attempt of the import job with the state of the job,
+// the attempt is recorded if the import job was submitted before attempts were tracked.
+func (i *ImageScope) SetImportAttempt(job *models.Job) {
+	i.IBMPowerVSImage.Status.Attempts = setImportAttempt(i.IBMPowerVSImage.Status.Attempts, ptr.Deref(job.ID, i.IBMPowerVSImage.Status.JobID), job)
+}
+
+// ShouldRetryImport returns true if the failed import job should be retried according to the retry policy,
+// along with the time left to wait before retrying.
+func (i *ImageScope) ShouldRetryImport() (bool, time.Duration) {
+	return shouldRetryImport(i.IBMPowerVSImage.Spec.RetryPolicy, i.IBMPowerVSImage.Status.Attempts)
+}
+
+// addImportAttempt appends a new import attempt with the given import job to the attempts, keeping the most recent attempts only.
+func addImportAttempt(attempts []infrav1.IBMPowerVSImageImportAttempt, jobID string) []infrav1.IBMPowerVSImageImportAttempt {
+	attempt := int32(1)
+	if len(attempts) > 0 {
+		attempt = attempts[len(attempts)-1].Attempt + 1
+	}
+	attempts = append(attempts, infrav1.IBMPowerVSImageImportAttempt{
+		Attempt:   attempt,
+		JobID:     jobID,
+		State:     infrav1.PowerVSImageStateQueued,
+		StartTime: ptr.To(metav1.Now()),
+	})
+	if len(attempts) > maxImageImportAttempts {
+		attempts = attempts[len(attempts)-maxImageImportAttempts:]
+	}
+	return attempts
+}
+
+// setImportAttempt updates the last import attempt with the state of the import job with the given id,
+// a new attempt is appended if the last attempt is not for the import job.
+func setImportAttempt(attempts []infrav1.IBMPowerVSImageImportAttempt, jobID string, job *models.Job) []infrav1.IBMPowerVSImageImportAttempt {
+	if len(attempts) == 0 || attempts[len(attempts)-1].JobID != jobID {
+		attempts = addImportAttempt(attempts, jobID)
+	}
+	attempt := &attempts[len(attempts)-1]
+	attempt.State = infrav1.PowerVSImageState(ptr.Deref(job.Status.State, ""))
+	attempt.Message = job.Status.Message
+	if (attempt.State == infrav1.PowerVSImageStateCompleted || attempt.State == infrav1.PowerVSImageStateFailed) && attempt.CompletionTime == nil {
+		attempt.CompletionTime = ptr.To(metav1.Now())
+	}
+	return attempts
+}
+
+// shouldRetryImport returns true if the last failed import attempt should be retried according to the retry policy,
+// along with the time left to wait before retrying.
+func shouldRetryImport(policy *infrav1.IBMPowerVSImageRetryPolicy, attempts []infrav1.IBMPowerVSImageImportAttempt) (bool, time.Duration) {
+	if policy == nil || len(attempts) == 0 || attempts[len(attempts)-1].Attempt >= policy.MaxAttempts {
+		return false, 0
+	}
+
+	backoff := defaultImageImportBackoff
+	if policy.BackoffSeconds != nil {
+		backoff = time.Duration(*policy.BackoffSeconds) * time.Second
+	}
+	for n := int32(1); n < attempts[len(attempts)-1].Attempt && backoff < maxImageImportBackoff; n++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, maxImageImportBackoff)
+
+	last := attempts[len(attempts)-1]
+	if last.CompletionTime == nil {
+		return true, backoff
+	}
+	return true, max(time.Until(last.CompletionTime.Add(backoff)), 0)
+}
+
+// ResetImport deletes the failed import job and any partially imported image, so that the import job can be resubmitted.
+func (i *ImageScope) ResetImport(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if i.IBMPowerVSImage.Status.JobID != "" {
+		if err := i.DeleteImportJob(); err != nil {
+			return err
+		}
+	}
+
+	image, err := i.ensureImageUnique(i.IBMPowerVSImage.Name)
+	if err != nil {
+		return err
+	}
+	if image != nil {
+		log.Info("Deleting partially imported image", "imageID", *image.ImageID)
+		if err := i.IBMPowerVSClient.DeleteImage(*image.ImageID); err != nil {
+			record.Warnf(i.IBMPowerVSImage, "FailedDeleteImage", "Failed partially imported image deletion - %v", err)
+			return err
+		}
+		record.Eventf(i.IBMPowerVSImage, "SuccessfulDeleteImage", "Deleted partially imported image %q", *image.ImageID)
+	}
+
+	i.IBMPowerVSImage.Status.JobID = ""
+	i.IBMPowerVSImage.Status.ImageID = ""
+	i.IBMPowerVSImage.Status.ImageState = ""
+	i.IBMPowerVSImage.Status.Import = nil
+	return nil
+}
+
+// JobPollInterval returns the interval used to poll the image import job.
+func (i *ImageScope) JobPollInterval() time.Duration {
+	if seconds := i.IBMPowerVSImage.Spec.Polling.JobIntervalSeconds; seconds != nil {
+		return time.Duration(*seconds) * time.Second
+	}
+	return defaultImageJobPollInterval
+}
+
+// ImagePollInterval returns the interval used to poll the image until it is active.
+func (i *ImageScope) ImagePollInterval() time.Duration {
+	if seconds := i.IBMPowerVSImage.Spec.Polling.ImageIntervalSeconds; seconds != nil {
+		return time.Duration(*seconds) * time.Second
+	}
+	return defaultImagePollInterval
+}
+
+// cosImageImportJob returns the request body used to import the image from Cloud Object Storage.
+func (i *ImageScope) cosImageImportJob() *models.CreateCosImageImportJob {
+	imageSpec := i.IBMPowerVSImage.Spec
+	return &models.CreateCosImageImportJob{
+		ImageName:     &i.IBMPowerVSImage.Name,
+		BucketName:    imageSpec.Bucket,
+		BucketAccess:  core.StringPtr(BucketAccess),
+		Region:        imageSpec.Region,
+		ImageFilename: imageSpec.Object,
+		StorageType:   imageSpec.StorageType,
+	}
+}
+
+// IsStockImage returns true if the image is copied from the stock image catalog of the Power VS zone.
+func (i *ImageScope) IsStockImage() bool {
+	return i.IBMPowerVSImage.Spec.StockImage != nil
+}
+
+// CopyStockImage copies the stock image referenced by Spec.StockImage from the catalog of the Power VS zone into the workspace.
+func (i *ImageScope) CopyStockImage(ctx context.Context) (*models.ImageReference, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ref := i.IBMPowerVSImage.Spec.StockImage
+
+	stockImage, err := findStockImage(i.IBMPowerVSClient, ptr.Deref(ref.ID, ""), ptr.Deref(ref.Name, ""))
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveStockImage", "Failed to retrieve stock image - %v", err)
+		return nil, err
+	}
+	if stockImage == nil {
+		return nil, fmt.Errorf("stock image %s not found in the catalog of the zone", ptr.Deref(ref.ID, ptr.Deref(ref.Name, "")))
+	}
+	i.IBMPowerVSImage.Status.Source = &infrav1.IBMPowerVSImageSource{
+		Type:     infrav1.PowerVSImageSourceTypeStockImage,
+		Location: *stockImage.ImageID,
+		Name:     *stockImage.Name,
+	}
+	if stockImage.Specifications != nil && stockImage.Specifications.SourceChecksum != "" {
+		i.IBMPowerVSImage.Status.Checksum = stockImage.Specifications.SourceChecksum
+	}
+
+	imageReply, err := i.ensureImageUnique(*stockImage.Name)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedRetrieveImage", "Failed to retrieve image %q", *stockImage.Name)
+		return nil, err
+	} else if imageReply != nil {
+		log.Info("Image already exists", "imageName", *stockImage.Name)
+		i.adoptImage(ctx, imageReply)
+		return imageReply, nil
+	}
+
+	image, err := copyStockImage(i.IBMPowerVSClient, stockImage)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedCopyStockImage", "Failed to copy stock image %q - %v", *stockImage.Name, err)
+		return nil, err
+	}
+	log.Info("Stock image copied", "imageName", *stockImage.Name, "imageID", *image.ImageID)
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCopyStockImage", "Copied stock image %q", *stockImage.Name)
+	return image, nil
+}
+
+// findStockImage returns the stock image with the given id, or with the given name when id is empty,
+// from the catalog of the zone of the Power VS client, or nil if there is none.
+func findStockImage(c powervs.PowerVS, id, name string) (*models.ImageReference, error) {
+	images, err := c.GetAllStockImages()
+	if err != nil {
+		return nil, err
+	}
+	for _, img := range images.Images {
+		if (id != "" && *img.ImageID == id) || (id == "" && *img.Name == name) {
+			return img, nil
+		}
+	}
+	return nil, nil
+}
+
+// copyStockImage copies the stock image into the workspace of the Power VS client.
+func copyStockImage(c powervs.PowerVS, stockImage *models.ImageReference) (*models.ImageReference, error) {
+	image, err := c.CreateImage(&models.CreateImage{
+		Source:  ptr.To("root-project"),
+		ImageID: *stockImage.ImageID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.ImageReference{
+		ImageID: image.ImageID,
+		Name:    image.Name,
+		State:   &image.State,
+	}, nil
+}
+
+// workspaceImageName returns the name of the image in the workspaces, which is the name of the stock image
+// for an image copied from the stock image catalog.
+func (i *ImageScope) workspaceImageName() string {
+	if !i.IsStockImage() {
+		return i.IBMPowerVSImage.Name
+	}
+	if i.IBMPowerVSImage.Status.Source != nil && i.IBMPowerVSImage.Status.Source.Name != "" {
+		return i.IBMPowerVSImage.Status.Source.Name
+	}
+	return ptr.Deref(i.IBMPowerVSImage.Spec.StockImage.Name, "")
+}
+
+// SetChecksum will set the checksum of the source of the image.
+func (i *ImageScope) SetChecksum(specifications *models.ImageSpecifications) {
+	if specifications != nil && specifications.SourceChecksum != "" {
+		i.IBMPowerVSImage.Status.Checksum = specifications.SourceChecksum
+	}
+}
+
 // DeleteImage will delete the image.
 func (i *ImageScope) DeleteImage() error {
 	if err := i.IBMPowerVSClient.DeleteImage(i.IBMPowerVSImage.Status.ImageID); err != nil {
@@ -282,3 +688,460 @@ func (i *ImageScope) SetJobID(id string) {
 func (i *ImageScope) GetJobID() string {
 	return i.IBMPowerVSImage.Status.JobID
 }
+
+// GetImageTargetIDs returns the ids of the additional Power VS workspaces the image should be imported into.
+// The workspaces are resolved from Spec.Targets and from the IBMPowerVSClusters matching Spec.ClusterSelector,
+// the workspace the image is primarily imported into is never part of the result.
+func (i *ImageScope) GetImageTargetIDs(ctx context.Context) ([]string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var ids []string
+	addID := func(id string) {
+		if id != "" && id != i.serviceInstanceID && !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	for _, target := range i.IBMPowerVSImage.Spec.Targets {
+		id, err := i.getServiceInstanceID(target.ServiceInstance, target.Zone)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			return nil, fmt.Errorf("target service instance %s is not yet created", ptr.Deref(target.ServiceInstance.Name, ""))
+		}
+		addID(id)
+	}
+
+	if i.IBMPowerVSImage.Spec.ClusterSelector == nil {
+		return ids, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(i.IBMPowerVSImage.Spec.ClusterSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cluster selector: %w", err)
+	}
+	clusters := &infrav1.IBMPowerVSClusterList{}
+	if err := i.Client.List(ctx, clusters, client.InNamespace(i.IBMPowerVSImage.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSClusters: %w", err)
+	}
+	for _, cluster := range clusters.Items {
+		if cluster.Status.ServiceInstance != nil && cluster.Status.ServiceInstance.ID != nil {
+			addID(*cluster.Status.ServiceInstance.ID)
+			continue
+		}
+		ref := infrav1.IBMPowerVSResourceReference{Name: getServiceName(&cluster, infrav1.ResourceTypeServiceInstance)}
+		if cluster.Spec.ServiceInstance != nil && (cluster.Spec.ServiceInstance.ID != nil || cluster.Spec.ServiceInstance.Name != nil) {
+			ref = *cluster.Spec.ServiceInstance
+		}
+		id, err := i.getServiceInstanceID(ref, cluster.Spec.Zone)
+		if err != nil {
+			return nil, err
+		}
+		if id == "" {
+			log.Info("Service instance of selected cluster is not yet created, skipping", "cluster", cluster.Name)
+			continue
+		}
+		addID(id)
+	}
+	return ids, nil
+}
+
+// getServiceInstanceID returns the id of the referenced Power VS workspace, or an empty string if it does not exist.
+func (i *ImageScope) getServiceInstanceID(ref infrav1.IBMPowerVSResourceReference, zone *string) (string, error) {
+	if ref.ID != nil {
+		return *ref.ID, nil
+	}
+	if ref.Name == nil {
+		return "", fmt.Errorf("either id or name of the target service instance must be set")
+	}
+	serviceInstance, err := i.ResourceClient.GetResourceInstanceByFilter(resourcecontroller.InstanceFilter{
+		Name:           *ref.Name,
+		Zone:           zone,
+		ResourceID:     resourcecontroller.PowerVSResourceID,
+		ResourcePlanID: resourcecontroller.PowerVSResourcePlanID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get service instance %s: %w", *ref.Name, err)
+	}
+	if serviceInstance == nil {
+		return "", nil
+	}
+	return *serviceInstance.GUID, nil
+}
+
+// getTargetClient returns the Power VS client of the workspace with the given id, creating it if required.
+func (i *ImageScope) getTargetClient(ctx context.Context, target *infrav1.IBMPowerVSImageTargetStatus) (powervs.PowerVS, error) {
+	if c, ok := i.targetClients[target.ServiceInstanceID]; ok {
+		return c, nil
+	}
+	c, zone, err := newImagePowerVSClient(ctx, i.ResourceClient, target.ServiceInstanceID, i.ServiceEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if i.targetClients == nil {
+		i.targetClients = make(map[string]powervs.PowerVS)
+	}
+	i.targetClients[target.ServiceInstanceID] = c
+	target.Zone = zone
+	return c, nil
+}
+
+// GetImageTarget returns the status of the image in the additional workspace with the given id, or nil if there is none.
+func (i *ImageScope) GetImageTarget(serviceInstanceID string) *infrav1.IBMPowerVSImageTargetStatus {
+	for idx := range i.IBMPowerVSImage.Status.Targets {
+		if i.IBMPowerVSImage.Status.Targets[idx].ServiceInstanceID == serviceInstanceID {
+			return &i.IBMPowerVSImage.Status.Targets[idx]
+		}
+	}
+	return nil
+}
+
+// ReconcileImageTargets imports the image into every additional workspace and removes the image from the workspaces
+// which are no longer targeted. It returns true when the image is active in all the additional workspaces.
+func (i *ImageScope) ReconcileImageTargets(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	ids, err := i.GetImageTargetIDs(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	// Remove the image from the workspaces no longer targeted.
+	var targets []infrav1.IBMPowerVSImageTargetStatus
+	for _, target := range i.IBMPowerVSImage.Status.Targets {
+		if slices.Contains(ids, target.ServiceInstanceID) {
+			targets = append(targets, target)
+			continue
+		}
+		log.Info("Removing image from workspace no longer targeted", "serviceInstanceID", target.ServiceInstanceID)
+		if err := i.deleteImageTarget(ctx, &target); err != nil {
+			return false, err
+		}
+	}
+	i.IBMPowerVSImage.Status.Targets = targets
+
+	ready := true
+	var errs []error
+	for _, id := range ids {
+		target := i.GetImageTarget(id)
+		if target == nil {
+			i.IBMPowerVSImage.Status.Targets = append(i.IBMPowerVSImage.Status.Targets, infrav1.IBMPowerVSImageTargetStatus{ServiceInstanceID: id})
+			target = &i.IBMPowerVSImage.Status.Targets[len(i.IBMPowerVSImage.Status.Targets)-1]
+		}
+		if err := i.reconcileImageTarget(ctx, target); err != nil {
+			errs = append(errs, fmt.Errorf("failed to reconcile image in service instance %s: %w", id, err))
+		}
+		ready = ready && target.Ready
+	}
+	return ready, errors.Join(errs...)
+}
+
+func (i *ImageScope) reconcileImageTarget(ctx context.Context, target *infrav1.IBMPowerVSImageTargetStatus) error {
+	log := ctrl.LoggerFrom(ctx).WithValues("serviceInstanceID", target.ServiceInstanceID)
+	c, err := i.getTargetClient(ctx, target)
+	if err != nil {
+		return err
+	}
+
+	if target.ImageID != "" {
+		image, err := c.GetImage(target.ImageID)
+		if err != nil {
+			return err
+		}
+		target.ImageState = infrav1.PowerVSImageState(image.State)
+		target.Ready = target.ImageState == infrav1.PowerVSImageStateACTIVE
+		return nil
+	}
+
+	target.Ready = false
+	if target.JobID != "" {
+		job, err := c.GetJob(target.JobID)
+		if err != nil {
+			return err
+		}
+		target.ImageState = infrav1.PowerVSImageState(*job.Status.State)
+		target.Message = job.Status.Message
+		target.Attempts = setImportAttempt(target.Attempts, target.JobID, job)
+		switch target.ImageState {
+		case infrav1.PowerVSImageStateCompleted:
+		case infrav1.PowerVSImageStateFailed:
+			retry, backoff := shouldRetryImport(i.IBMPowerVSImage.Spec.RetryPolicy, target.Attempts)
+			if !retry {
+				return fmt.Errorf("failed to import image, message: %s", job.Status.Message)
+			}
+			if backoff > 0 {
+				log.Info("Image import failed, waiting before retrying", "backoff", backoff)
+				return nil
+			}
+			if err := i.resetImageTargetImport(ctx, target, c); err != nil {
+				return fmt.Errorf("failed to reset failed image import: %w", err)
+			}
+			log.Info("Retrying image import")
+		default:
+			log.V(3).Info("Image import job not yet finished", "state", target.ImageState)
+			return nil
+		}
+	}
+
+	imageName := i.workspaceImageName()
+	if imageName == "" {
+		log.V(3).Info("Stock image is not yet resolved, waiting for the image in the primary workspace")
+		return nil
+	}
+	image, err := findImageByName(c, imageName)
+	if err != nil {
+		return err
+	}
+	if image != nil {
+		if target.JobID == "" {
+			log.Info("Adopting image not created by the controller, the image will not be deleted", "imageID", *image.ImageID)
+			target.Adopted = true
+		}
+		target.ImageID = *image.ImageID
+		target.ImageState = infrav1.PowerVSImageState(ptr.Deref(image.State, ""))
+		target.Ready = target.ImageState == infrav1.PowerVSImageStateACTIVE
+		return nil
+	}
+	if target.JobID != "" {
+		// The import job has completed but the image is not yet listed, check again later.
+		return nil
+	}
+
+	if i.IsStockImage() {
+		return i.copyStockImageToTarget(target, c, imageName)
+	}
+
+	lastJob, err := c.GetCosImages(target.ServiceInstanceID)
+	if err != nil && !isImportJobNotFound(err) {
+		return fmt.Errorf("failed to get the last image import job: %w", err)
+	}
+	if lastJob != nil {
+		if *lastJob.Status.State != string(infrav1.PowerVSImageStateCompleted) && *lastJob.Status.State != string(infrav1.PowerVSImageStateFailed) {
+			log.Info("Previous import job not yet finished", "state", *lastJob.Status.State)
+			return nil
+		}
+	}
+
+	jobRef, err := c.CreateCosImage(i.cosImageImportJob())
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedCreateImageImportJob", "Failed image import job creation in service instance %q - %v", target.ServiceInstanceID, err)
+		return err
+	}
+	target.JobID = *jobRef.ID
+	target.ImageState = infrav1.PowerVSImageStateQueued
+	target.Attempts = addImportAttempt(target.Attempts, *jobRef.ID)
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCreateImageImportJob", "Created image import job %q in service instance %q", *jobRef.ID, target.ServiceInstanceID)
+	return nil
+}
+
+// resetImageTargetImport deletes the failed import job and any partially imported image in the target workspace,
+// so that the import job can be resubmitted.
+func (i *ImageScope) resetImageTargetImport(ctx context.Context, target *infrav1.IBMPowerVSImageTargetStatus, c powervs.PowerVS) error {
+	if err := c.DeleteJob(target.JobID); err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedDeleteImageImportJob", "Failed image import job deletion in service instance %q - %v", target.ServiceInstanceID, err)
+		return err
+	}
+	image, err := findImageByName(c, i.workspaceImageName())
+	if err != nil {
+		return err
+	}
+	if image != nil {
+		ctrl.LoggerFrom(ctx).Info("Deleting partially imported image", "serviceInstanceID", target.ServiceInstanceID, "imageID", *image.ImageID)
+		if err := c.DeleteImage(*image.ImageID); err != nil {
+			record.Warnf(i.IBMPowerVSImage, "FailedDeleteImage", "Failed partially imported image deletion in service instance %q - %v", target.ServiceInstanceID, err)
+			return err
+		}
+	}
+	target.JobID = ""
+	target.ImageState = ""
+	target.Message = ""
+	return nil
+}
+
+// isImportJobNotFound returns true if the error reports that there is no image import job in the workspace.
+func isImportJobNotFound(err error) bool {
+	var notFound *p_cloud_images.PcloudV1CloudinstancesCosimagesGetNotFound
+	return errors.As(err, &notFound)
+}
+
+// copyStockImageToTarget copies the stock image with the given name from the catalog of the zone of the target workspace.
+func (i *ImageScope) copyStockImageToTarget(target *infrav1.IBMPowerVSImageTargetStatus, c powervs.PowerVS, imageName string) error {
+	stockImage, err := findStockImage(c, "", imageName)
+	if err != nil {
+		return err
+	}
+	if stockImage == nil {
+		return fmt.Errorf("stock image %s not found in the catalog of zone %s", imageName, target.Zone)
+	}
+	image, err := copyStockImage(c, stockImage)
+	if err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedCopyStockImage", "Failed to copy stock image %q into service instance %q - %v", imageName, target.ServiceInstanceID, err)
+		return err
+	}
+	target.ImageID = *image.ImageID
+	target.ImageState = infrav1.PowerVSImageState(ptr.Deref(image.State, ""))
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulCopyStockImage", "Copied stock image %q into service instance %q", imageName, target.ServiceInstanceID)
+	return nil
+}
+
+// DeleteImageTargets removes the image or the image import job from every additional workspace.
+func (i *ImageScope) DeleteImageTargets(ctx context.Context) error {
+	var errs []error
+	var targets []infrav1.IBMPowerVSImageTargetStatus
+	for _, target := range i.IBMPowerVSImage.Status.Targets {
+		if err := i.deleteImageTarget(ctx, &target); err != nil {
+			errs = append(errs, err)
+			targets = append(targets, target)
+		}
+	}
+	i.IBMPowerVSImage.Status.Targets = targets
+	return errors.Join(errs...)
+}
+
+func (i *ImageScope) deleteImageTarget(ctx context.Context, target *infrav1.IBMPowerVSImageTargetStatus) error {
+	if target.ImageID == "" && target.JobID == "" {
+		return nil
+	}
+	if target.ImageID != "" && (target.Adopted || i.IBMPowerVSImage.Spec.DeletePolicy == string(infrav1.DeletePolicyRetain)) {
+		return nil
+	}
+	c, err := i.getTargetClient(ctx, target)
+	if err != nil {
+		return err
+	}
+	if target.ImageID == "" {
+		if err := c.DeleteJob(target.JobID); err != nil {
+			record.Warnf(i.IBMPowerVSImage, "FailedDeleteImageImportJob", "Failed image import job deletion in service instance %q - %v", target.ServiceInstanceID, err)
+			return err
+		}
+		record.Eventf(i.IBMPowerVSImage, "SuccessfulDeleteImageImportJob", "Deleted image import job %q in service instance %q", target.JobID, target.ServiceInstanceID)
+		return nil
+	}
+	if err := c.DeleteImage(target.ImageID); err != nil {
+		record.Warnf(i.IBMPowerVSImage, "FailedDeleteImage", "Failed image deletion in service instance %q - %v", target.ServiceInstanceID, err)
+		return err
+	}
+	record.Eventf(i.IBMPowerVSImage, "SuccessfulDeleteImage", "Deleted Image %q in service instance %q", target.ImageID, target.ServiceInstanceID)
+	return nil
+}
+
+// GetImageUsage returns the usage of the IBMPowerVSImage by the IBMPowerVSMachines and IBMPowerVSMachineTemplates in its namespace,
+// the IBMPowerVSMachineTemplates of a Cluster being deleted are not counted.
+func GetImageUsage(ctx context.Context, c client.Client, image *infrav1.IBMPowerVSImage) (*infrav1.IBMPowerVSImageUsage, error) {
+	usage := &infrav1.IBMPowerVSImageUsage{}
+
+	machines := &infrav1.IBMPowerVSMachineList{}
+	if err := c.List(ctx, machines, client.InNamespace(image.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSMachines: %w", err)
+	}
+	for _, machine := range machines.Items {
+		if machine.Spec.ImageRef.Name == image.Name {
+			usage.Machines++
+		}
+	}
+
+	templates := &infrav1.IBMPowerVSMachineTemplateList{}
+	if err := c.List(ctx, templates, client.InNamespace(image.Namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSMachineTemplates: %w", err)
+	}
+	for _, template := range templates.Items {
+		if template.Spec.Template.Spec.ImageRef.Name != image.Name || !template.DeletionTimestamp.IsZero() {
+			continue
+		}
+		// The templates are only garbage collected after the Cluster is deleted, so the templates of a Cluster
+		// being deleted must not block the deletion of the image the Cluster is waiting for.
+		deleting, err := isClusterDeleting(ctx, c, &template)
+		if err != nil {
+			return nil, err
+		}
+		if !deleting {
+			usage.MachineTemplates++
+		}
+	}
+
+	usage.ReferenceCount = usage.Machines + usage.MachineTemplates
+	return usage, nil
+}
+
+// isClusterDeleting returns true if the Cluster owning the object, or the Cluster named in its cluster name label,
+// is being deleted or no longer exists.
+func isClusterDeleting(ctx context.Context, c client.Client, obj client.Object) (bool, error) {
+	clusterName := obj.GetLabels()[clusterv1.ClusterNameLabel]
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.Kind == "Cluster" && strings.HasPrefix(ref.APIVersion, clusterv1.GroupVersion.Group+"/") {
+			clusterName = ref.Name
+			break
+		}
+	}
+	if clusterName == "" {
+		return false, nil
+	}
+	cluster := &clusterv1.Cluster{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: obj.GetNamespace(), Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("failed to get Cluster %s: %w", clusterName, err)
+	}
+	return !cluster.DeletionTimestamp.IsZero(), nil
+}
+
+// ReconcileUsage updates the usage of the image in the status and returns true if the image is in use.
+func (i *ImageScope) ReconcileUsage(ctx context.Context) (bool, error) {
+	usage, err := GetImageUsage(ctx, i.Client, i.IBMPowerVSImage)
+	if err != nil {
+		return false, err
+	}
+	i.IBMPowerVSImage.Status.Usage = usage
+	return usage.ReferenceCount > 0, nil
+}
+
+// ReconcileRetention deletes the older images of the family of the image according to the retention policy of the image.
+// The policy is only applied by the most recently created image of the family, images which are in use are never deleted.
+func (i *ImageScope) ReconcileRetention(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	retention := i.IBMPowerVSImage.Spec.Retention
+	family, ok := i.IBMPowerVSImage.Labels[infrav1.IBMPowerVSImageFamilyLabel]
+	if retention == nil || !ok || family == "" {
+		return nil
+	}
+
+	images := &infrav1.IBMPowerVSImageList{}
+	if err := i.Client.List(ctx, images, client.InNamespace(i.IBMPowerVSImage.Namespace), client.MatchingLabels{infrav1.IBMPowerVSImageFamilyLabel: family}); err != nil {
+		return fmt.Errorf("failed to list IBMPowerVSImages of family %s: %w", family, err)
+	}
+	// Sort the images of the family from the most recently created one.
+	slices.SortFunc(images.Items, func(a, b infrav1.IBMPowerVSImage) int {
+		if c := b.CreationTimestamp.Compare(a.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(b.Name, a.Name)
+	})
+	if len(images.Items) == 0 || images.Items[0].Name != i.IBMPowerVSImage.Name {
+		log.V(3).Info("Image is not the most recent image of the family, skipping retention policy", "family", family)
+		return nil
+	}
+
+	var errs []error
+	for idx := int(retention.KeepLastVersions); idx < len(images.Items); idx++ {
+		image := &images.Items[idx]
+		if !image.DeletionTimestamp.IsZero() {
+			continue
+		}
+		usage, err := GetImageUsage(ctx, i.Client, image)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if usage.ReferenceCount > 0 {
+			log.V(3).Info("Image of the family is still in use, not deleting", "image", image.Name, "referenceCount", usage.ReferenceCount)
+			continue
+		}
+		log.Info("Deleting image exceeding the retention policy of the family", "image", image.Name, "family", family)
+		if err := i.Client.Delete(ctx, image); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete IBMPowerVSImage %s: %w", image.Name, err))
+			continue
+		}
+		record.Eventf(i.IBMPowerVSImage, "SuccessfulDeleteImageVersion", "Deleted image %q of family %q exceeding the retention policy", image.Name, family)
+	}
+	return errors.Join(errs...)
+}
@@ -19,9 +19,13 @@ package powervs
 import (
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
 	"github.com/IBM-Cloud/power-go-client/power/models"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/go-openapi/strfmt"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -32,6 +36,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	mockcos "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 
 	. "github.com/onsi/gomega"
@@ -164,6 +170,7 @@ func TestCreateImageCOSBucket(t *testing.T) {
 			out, _, err := scope.CreateImageCOSBucket(ctx)
 			g.Expect(err).To(BeNil())
 			require.Equal(t, imageReference, out)
+			g.Expect(scope.IBMPowerVSImage.Status.Adopted).To(BeTrue())
 		})
 
 		t.Run("Error while listing images", func(t *testing.T) {
@@ -203,6 +210,143 @@ func TestCreateImageCOSBucket(t *testing.T) {
 			_, _, err := scope.CreateImageCOSBucket(ctx)
 			g.Expect(err).To((Not(BeNil())))
 		})
+
+		t.Run("Should create image import job when the expected checksum matches", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockCOS := mockcos.NewMockCos(mockCtrl)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.COSClient = mockCOS
+			scope.IBMPowerVSImage.Spec.ExpectedChecksum = &infrav1.IBMPowerVSImageChecksum{Algorithm: infrav1.PowerVSImageChecksumAlgorithmMD5, Value: "d41d8cd98f00b204e9800998ecf8427e"}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetCosImages(gomock.AssignableToTypeOf(serviceInstanceID)).Return(job, nil)
+			mockCOS.EXPECT().HeadObject(&s3.HeadObjectInput{Bucket: ptr.To("foo-bucket"), Key: ptr.To("foo-obj")}).Return(&s3.HeadObjectOutput{ETag: ptr.To(`"d41d8cd98f00b204e9800998ecf8427e"`)}, nil)
+			mockpowervs.EXPECT().CreateCosImage(gomock.AssignableToTypeOf(body)).Return(jobReference, nil)
+			_, out, err := scope.CreateImageCOSBucket(ctx)
+			g.Expect(err).To(BeNil())
+			require.Equal(t, jobReference, out)
+			g.Expect(scope.IBMPowerVSImage.Status.Import.ChecksumVerified).To(BeTrue())
+		})
+
+		t.Run("Should not create image import job when the expected checksum does not match", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			mockCOS := mockcos.NewMockCos(mockCtrl)
+			scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+			scope.COSClient = mockCOS
+			scope.IBMPowerVSImage.Spec.ExpectedChecksum = &infrav1.IBMPowerVSImageChecksum{Algorithm: infrav1.PowerVSImageChecksumAlgorithmSHA256, Value: "foo-checksum"}
+			mockpowervs.EXPECT().GetAllImage().Return(images, nil)
+			mockpowervs.EXPECT().GetCosImages(gomock.AssignableToTypeOf(serviceInstanceID)).Return(job, nil)
+			mockCOS.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{Metadata: map[string]*string{"Sha256": ptr.To("bar-checksum")}}, nil)
+			_, _, err := scope.CreateImageCOSBucket(ctx)
+			g.Expect(errors.Is(err, ErrImageChecksumMismatch)).To(BeTrue())
+		})
+	})
+}
+
+func TestSetImportProgress(t *testing.T) {
+	g := NewWithT(t)
+	scope := setupPowerVSImageScope(pvsImage, nil)
+	created := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	scope.SetImportProgress(&models.Job{
+		CreateTimestamp: strfmt.DateTime(created),
+		Status:          &models.Status{State: ptr.To("running"), Progress: ptr.To("45%")},
+	})
+	g.Expect(scope.IBMPowerVSImage.Status.Import.Progress).To(Equal("45%"))
+	g.Expect(scope.IBMPowerVSImage.Status.Import.StartTime.Time).To(BeTemporally("==", created))
+	g.Expect(scope.IBMPowerVSImage.Status.Import.CompletionTime).To(BeNil())
+
+	scope.SetImportProgress(&models.Job{
+		Status: &models.Status{State: ptr.To("completed"), Progress: ptr.To("100%")},
+	})
+	g.Expect(scope.IBMPowerVSImage.Status.Import.Progress).To(Equal("100%"))
+	g.Expect(scope.IBMPowerVSImage.Status.Import.CompletionTime).ToNot(BeNil())
+}
+
+func TestCopyStockImage(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	stockImages := &models.Images{
+		Images: []*models.ImageReference{
+			{
+				ImageID:        ptr.To("stock-image-id"),
+				Name:           ptr.To("CentOS-Stream-9"),
+				Specifications: &models.ImageSpecifications{SourceChecksum: "foo-checksum"},
+			},
+		},
+	}
+
+	t.Run("Should copy stock image", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		scope.IBMPowerVSImage.Spec.StockImage = &infrav1.IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")}
+		mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+		mockpowervs.EXPECT().CreateImage(&models.CreateImage{Source: ptr.To("root-project"), ImageID: "stock-image-id"}).Return(&models.Image{ImageID: ptr.To("foo-image-id"), Name: ptr.To("CentOS-Stream-9"), State: "queued"}, nil)
+		out, err := scope.CopyStockImage(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(*out.ImageID).To(Equal("foo-image-id"))
+		g.Expect(scope.IBMPowerVSImage.Status.Source).To(Equal(&infrav1.IBMPowerVSImageSource{Type: infrav1.PowerVSImageSourceTypeStockImage, Location: "stock-image-id", Name: "CentOS-Stream-9"}))
+		g.Expect(scope.IBMPowerVSImage.Status.Checksum).To(Equal("foo-checksum"))
+	})
+
+	t.Run("Should return existing copy of the stock image", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		scope.IBMPowerVSImage.Spec.StockImage = &infrav1.IBMPowerVSResourceReference{ID: ptr.To("stock-image-id")}
+		scope.IBMPowerVSImage.Status.ImageID = "foo-image-id"
+		image := &models.ImageReference{ImageID: ptr.To("foo-image-id"), Name: ptr.To("CentOS-Stream-9")}
+		mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{image}}, nil)
+		out, err := scope.CopyStockImage(ctx)
+		g.Expect(err).To(BeNil())
+		require.Equal(t, image, out)
+		g.Expect(scope.IBMPowerVSImage.Status.Adopted).To(BeFalse())
+	})
+
+	t.Run("Should adopt existing image not copied by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		scope.IBMPowerVSImage.Spec.StockImage = &infrav1.IBMPowerVSResourceReference{ID: ptr.To("stock-image-id")}
+		image := &models.ImageReference{ImageID: ptr.To("foo-image-id"), Name: ptr.To("CentOS-Stream-9")}
+		mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{image}}, nil)
+		out, err := scope.CopyStockImage(ctx)
+		g.Expect(err).To(BeNil())
+		require.Equal(t, image, out)
+		g.Expect(scope.IBMPowerVSImage.Status.Adopted).To(BeTrue())
+	})
+
+	t.Run("Should return error when stock image is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		scope.IBMPowerVSImage.Spec.StockImage = &infrav1.IBMPowerVSResourceReference{Name: ptr.To("SLES15-SP5")}
+		mockpowervs.EXPECT().GetAllStockImages().Return(stockImages, nil)
+		_, err := scope.CopyStockImage(ctx)
+		g.Expect(err).ToNot(BeNil())
 	})
 }
 
@@ -287,3 +431,319 @@ func TestDeleteImportJob(t *testing.T) {
 		})
 	})
 }
+
+func TestReconcileImageTargets(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	const targetID = "target-service-ID"
+	setupTargetScope := func() *ImageScope {
+		scope := setupPowerVSImageScope(pvsImage, mockpowervs)
+		scope.serviceInstanceID = "test-service-ID"
+		scope.IBMPowerVSImage.Spec.Targets = []infrav1.IBMPowerVSImageTarget{
+			{ServiceInstance: infrav1.IBMPowerVSResourceReference{ID: ptr.To(targetID)}},
+			{ServiceInstance: infrav1.IBMPowerVSResourceReference{ID: ptr.To("test-service-ID")}},
+		}
+		scope.targetClients = map[string]powervs.PowerVS{targetID: mockpowervs}
+		return scope
+	}
+
+	t.Run("Should create image import job in target service instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+		mockpowervs.EXPECT().GetCosImages(targetID).Return(nil, nil)
+		mockpowervs.EXPECT().CreateCosImage(gomock.Any()).Return(&models.JobReference{ID: ptr.To("foo-job-id")}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets).To(HaveLen(1))
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].ServiceInstanceID).To(Equal(targetID))
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].JobID).To(Equal("foo-job-id"))
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].Attempts).To(HaveLen(1))
+	})
+
+	t.Run("Should create image import job in target service instance without previous import job", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+		mockpowervs.EXPECT().GetCosImages(targetID).Return(nil, p_cloud_images.NewPcloudV1CloudinstancesCosimagesGetNotFound())
+		mockpowervs.EXPECT().CreateCosImage(gomock.Any()).Return(&models.JobReference{ID: ptr.To("foo-job-id")}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].JobID).To(Equal("foo-job-id"))
+	})
+
+	t.Run("Should return error when getting the previous import job in target service instance fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+		mockpowervs.EXPECT().GetCosImages(targetID).Return(nil, errors.New("failed to get import job"))
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].JobID).To(BeEmpty())
+	})
+
+	t.Run("Should set image id once the import job in target service instance is completed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{ServiceInstanceID: targetID, JobID: "foo-job-id"}}
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(&models.Job{Status: &models.Status{State: ptr.To("completed")}}, nil)
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{{Name: ptr.To(pvsImage), ImageID: ptr.To("foo-image-id"), State: ptr.To("active")}}}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].ImageID).To(Equal("foo-image-id"))
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].Adopted).To(BeFalse())
+	})
+
+	t.Run("Should return error when the import job in target service instance failed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{ServiceInstanceID: targetID, JobID: "foo-job-id"}}
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(&models.Job{Status: &models.Status{State: ptr.To("failed"), Message: "import failed"}}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].Message).To(Equal("import failed"))
+	})
+
+	t.Run("Should wait before retrying the failed import job in target service instance", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Spec.RetryPolicy = &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 2}
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{ServiceInstanceID: targetID, JobID: "foo-job-id"}}
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(&models.Job{ID: ptr.To("foo-job-id"), Status: &models.Status{State: ptr.To("failed"), Message: "import failed"}}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		target := scope.IBMPowerVSImage.Status.Targets[0]
+		g.Expect(target.JobID).To(Equal("foo-job-id"))
+		g.Expect(target.Attempts).To(HaveLen(1))
+		g.Expect(target.Attempts[0].State).To(Equal(infrav1.PowerVSImageStateFailed))
+	})
+
+	t.Run("Should retry the failed import job in target service instance once the backoff has elapsed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Spec.RetryPolicy = &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 2}
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{
+			ServiceInstanceID: targetID,
+			JobID:             "foo-job-id",
+			Attempts: []infrav1.IBMPowerVSImageImportAttempt{{
+				Attempt:        1,
+				JobID:          "foo-job-id",
+				State:          infrav1.PowerVSImageStateFailed,
+				CompletionTime: ptr.To(metav1.NewTime(time.Now().Add(-time.Hour))),
+			}},
+		}}
+		mockpowervs.EXPECT().GetJob("foo-job-id").Return(&models.Job{ID: ptr.To("foo-job-id"), Status: &models.Status{State: ptr.To("failed"), Message: "import failed"}}, nil)
+		mockpowervs.EXPECT().DeleteJob("foo-job-id").Return(nil)
+		gomock.InOrder(
+			mockpowervs.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{{Name: ptr.To(pvsImage), ImageID: ptr.To("partial-image-id")}}}, nil),
+			mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil),
+		)
+		mockpowervs.EXPECT().DeleteImage("partial-image-id").Return(nil)
+		mockpowervs.EXPECT().GetCosImages(targetID).Return(nil, nil)
+		mockpowervs.EXPECT().CreateCosImage(gomock.Any()).Return(&models.JobReference{ID: ptr.To("bar-job-id")}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeFalse())
+		target := scope.IBMPowerVSImage.Status.Targets[0]
+		g.Expect(target.JobID).To(Equal("bar-job-id"))
+		g.Expect(target.Adopted).To(BeFalse())
+		g.Expect(target.Attempts).To(HaveLen(2))
+		g.Expect(target.Attempts[1].Attempt).To(Equal(int32(2)))
+		g.Expect(target.Attempts[1].JobID).To(Equal("bar-job-id"))
+	})
+
+	t.Run("Should return error when the failed import job in target service instance exhausted the retry policy", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Spec.RetryPolicy = &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 2}
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{
+			ServiceInstanceID: targetID,
+			JobID:             "bar-job-id",
+			Attempts: []infrav1.IBMPowerVSImageImportAttempt{
+				{Attempt: 1, JobID: "foo-job-id", State: infrav1.PowerVSImageStateFailed},
+				{Attempt: 2, JobID: "bar-job-id", State: infrav1.PowerVSImageStateQueued},
+			},
+		}}
+		mockpowervs.EXPECT().GetJob("bar-job-id").Return(&models.Job{ID: ptr.To("bar-job-id"), Status: &models.Status{State: ptr.To("failed"), Message: "import failed"}}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(ready).To(BeFalse())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].JobID).To(Equal("bar-job-id"))
+	})
+
+	t.Run("Should adopt existing image in target service instance not imported by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		mockpowervs.EXPECT().GetAllImage().Return(&models.Images{Images: []*models.ImageReference{{Name: ptr.To(pvsImage), ImageID: ptr.To("foo-image-id"), State: ptr.To("active")}}}, nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].ImageID).To(Equal("foo-image-id"))
+		g.Expect(scope.IBMPowerVSImage.Status.Targets[0].Adopted).To(BeTrue())
+	})
+
+	t.Run("Should not delete adopted image from service instance no longer targeted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Spec.Targets = nil
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{ServiceInstanceID: targetID, ImageID: "foo-image-id", Adopted: true}}
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets).To(BeEmpty())
+	})
+
+	t.Run("Should delete image from service instance no longer targeted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupTargetScope()
+		scope.IBMPowerVSImage.Spec.Targets = nil
+		scope.IBMPowerVSImage.Status.Targets = []infrav1.IBMPowerVSImageTargetStatus{{ServiceInstanceID: targetID, ImageID: "foo-image-id"}}
+		mockpowervs.EXPECT().DeleteImage("foo-image-id").Return(nil)
+		ready, err := scope.ReconcileImageTargets(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(ready).To(BeTrue())
+		g.Expect(scope.IBMPowerVSImage.Status.Targets).To(BeEmpty())
+	})
+}
+
+func TestReconcileRetention(t *testing.T) {
+	newFamilyImage := func(name string, created time.Time) *infrav1.IBMPowerVSImage {
+		image := newPowervsImage(name)
+		image.Labels = map[string]string{infrav1.IBMPowerVSImageFamilyLabel: "rhcos"}
+		image.CreationTimestamp = metav1.NewTime(created)
+		return image
+	}
+	now := time.Now()
+
+	t.Run("Should delete the unused images of the family exceeding the retention policy", func(t *testing.T) {
+		g := NewWithT(t)
+		latest := newFamilyImage("rhcos-3", now)
+		latest.Spec.Retention = &infrav1.IBMPowerVSImageRetention{KeepLastVersions: 1}
+		inUse := newFamilyImage("rhcos-2", now.Add(-time.Hour))
+		unused := newFamilyImage("rhcos-1", now.Add(-2*time.Hour))
+		machine := &infrav1.IBMPowerVSMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: defaultNamespace},
+			Spec:       infrav1.IBMPowerVSMachineSpec{ImageRef: infrav1.ImageReference{Name: "rhcos-2"}},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(latest, inUse, unused, machine).Build()
+		scope := &ImageScope{Client: c, IBMPowerVSImage: latest}
+
+		g.Expect(scope.ReconcileRetention(ctx)).To(Succeed())
+		images := &infrav1.IBMPowerVSImageList{}
+		g.Expect(c.List(ctx, images)).To(Succeed())
+		var names []string
+		for _, image := range images.Items {
+			names = append(names, image.Name)
+		}
+		g.Expect(names).To(ConsistOf("rhcos-3", "rhcos-2"))
+	})
+
+	t.Run("Should not apply the retention policy of an image which is not the most recent of the family", func(t *testing.T) {
+		g := NewWithT(t)
+		latest := newFamilyImage("rhcos-2", now)
+		older := newFamilyImage("rhcos-1", now.Add(-time.Hour))
+		older.Spec.Retention = &infrav1.IBMPowerVSImageRetention{KeepLastVersions: 1}
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(latest, older).Build()
+		scope := &ImageScope{Client: c, IBMPowerVSImage: older}
+
+		g.Expect(scope.ReconcileRetention(ctx)).To(Succeed())
+		images := &infrav1.IBMPowerVSImageList{}
+		g.Expect(c.List(ctx, images)).To(Succeed())
+		g.Expect(images.Items).To(HaveLen(2))
+	})
+}
+
+func TestShouldRetryImport(t *testing.T) {
+	failedAttempt := func(attempt int32, completed time.Time) infrav1.IBMPowerVSImageImportAttempt {
+		return infrav1.IBMPowerVSImageImportAttempt{Attempt: attempt, State: infrav1.PowerVSImageStateFailed, CompletionTime: ptr.To(metav1.NewTime(completed))}
+	}
+	testCases := []struct {
+		name          string
+		retryPolicy   *infrav1.IBMPowerVSImageRetryPolicy
+		attempts      []infrav1.IBMPowerVSImageImportAttempt
+		expectRetry   bool
+		expectBackoff time.Duration
+	}{
+		{
+			name:     "Should not retry without retry policy",
+			attempts: []infrav1.IBMPowerVSImageImportAttempt{failedAttempt(1, time.Now())},
+		},
+		{
+			name:        "Should not retry when max attempts are reached",
+			retryPolicy: &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 2},
+			attempts:    []infrav1.IBMPowerVSImageImportAttempt{failedAttempt(1, time.Now()), failedAttempt(2, time.Now())},
+		},
+		{
+			name:          "Should retry after the backoff",
+			retryPolicy:   &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 3, BackoffSeconds: ptr.To(int32(100))},
+			attempts:      []infrav1.IBMPowerVSImageImportAttempt{failedAttempt(1, time.Now())},
+			expectRetry:   true,
+			expectBackoff: 100 * time.Second,
+		},
+		{
+			name:          "Should double the backoff after every failed attempt",
+			retryPolicy:   &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 3, BackoffSeconds: ptr.To(int32(100))},
+			attempts:      []infrav1.IBMPowerVSImageImportAttempt{failedAttempt(1, time.Now()), failedAttempt(2, time.Now())},
+			expectRetry:   true,
+			expectBackoff: 200 * time.Second,
+		},
+		{
+			name:        "Should retry immediately once the backoff has elapsed",
+			retryPolicy: &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 3},
+			attempts:    []infrav1.IBMPowerVSImageImportAttempt{failedAttempt(1, time.Now().Add(-time.Hour))},
+			expectRetry: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope := setupPowerVSImageScope(pvsImage, nil)
+			scope.IBMPowerVSImage.Spec.RetryPolicy = tc.retryPolicy
+			scope.IBMPowerVSImage.Status.Attempts = tc.attempts
+			retry, backoff := scope.ShouldRetryImport()
+			g.Expect(retry).To(Equal(tc.expectRetry))
+			g.Expect(backoff).To(BeNumerically("~", tc.expectBackoff, time.Second))
+		})
+	}
+}
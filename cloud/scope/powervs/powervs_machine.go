@@ -23,9 +23,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
 	"net/url"
 	"path"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -41,6 +44,7 @@ import (
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
@@ -56,22 +60,40 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
 	ignV2Types "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ignition"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ipam"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
 )
 
 const cosURLDomain = "cloud-object-storage.appdomain.cloud"
 
+const (
+	// staticConnectionName is the name of the NetworkManager connection profile with the static address of the instance.
+	staticConnectionName = "capibm-static"
+	// staticConnectionPath is the path of the NetworkManager connection profile with the static address of the instance.
+	staticConnectionPath = "/etc/NetworkManager/system-connections/" + staticConnectionName + ".nmconnection"
+)
+
+var (
+	// ErrInsufficientWorkspaceQuota is returned when creating the instance would exceed the available capacity of the PowerVS workspace.
+	ErrInsufficientWorkspaceQuota = errors.New("insufficient PowerVS workspace quota")
+	// ErrUnsupportedByZone is returned when the instance requests a system type or processor type not available in the PowerVS zone.
+	ErrUnsupportedByZone = errors.New("unsupported by PowerVS zone")
+)
+
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
 type MachineScopeParams struct {
 	Logger            logr.Logger
@@ -89,16 +111,21 @@ type MachineScopeParams struct {
 type MachineScope struct {
 	Client client.Client
 
-	IBMPowerVSClient  powervs.PowerVS
-	IBMVPCClient      vpc.Vpc
-	ResourceClient    resourcecontroller.ResourceController
-	Cluster           *clusterv1.Cluster
-	Machine           *clusterv1.Machine
-	IBMPowerVSCluster *infrav1.IBMPowerVSCluster
-	IBMPowerVSMachine *infrav1.IBMPowerVSMachine
-	IBMPowerVSImage   *infrav1.IBMPowerVSImage
-	ServiceEndpoint   []endpoints.ServiceEndpoint
-	DHCPIPCacheStore  cache.Store
+	IBMPowerVSClient    powervs.PowerVS
+	IBMVPCClient        vpc.Vpc
+	ResourceClient      resourcecontroller.ResourceController
+	GlobalTaggingClient globaltagging.GlobalTagging
+	Cluster             *clusterv1.Cluster
+	Machine             *clusterv1.Machine
+	IBMPowerVSCluster   *infrav1.IBMPowerVSCluster
+	IBMPowerVSMachine   *infrav1.IBMPowerVSMachine
+	IBMPowerVSImage     *infrav1.IBMPowerVSImage
+	ServiceEndpoint     []endpoints.ServiceEndpoint
+	DHCPIPCacheStore    cache.Store
+
+	serviceInstanceID string
+	// ipAddress is the IP address allocated for the instance from spec.ipAddressPool.
+	ipAddress *ipamv1.IPAddress
 }
 
 // NewMachineScope creates a new MachineScope from the supplied parameters.
@@ -158,10 +185,7 @@ func NewMachineScope(params MachineScopeParams) (scope *MachineScope, err error)
 	if params.IBMPowerVSMachine.Spec.ServiceInstance != nil && params.IBMPowerVSMachine.Spec.ServiceInstance.ID != nil {
 		serviceInstanceID = *params.IBMPowerVSMachine.Spec.ServiceInstance.ID
 	} else {
-		serviceInstanceName = fmt.Sprintf("%s-%s", params.IBMPowerVSCluster.GetName(), "serviceInstance")
-		if params.IBMPowerVSCluster.Spec.ServiceInstance != nil && params.IBMPowerVSCluster.Spec.ServiceInstance.Name != nil {
-			serviceInstanceName = *params.IBMPowerVSCluster.Spec.ServiceInstance.Name
-		}
+		serviceInstanceName = *getServiceName(params.IBMPowerVSCluster, infrav1.ResourceTypeServiceInstance)
 	}
 	serviceInstance, err := rc.GetServiceInstance(serviceInstanceID, serviceInstanceName, params.IBMPowerVSCluster.Spec.Zone)
 	if err != nil {
@@ -202,6 +226,7 @@ func NewMachineScope(params MachineScopeParams) (scope *MachineScope, err error)
 	c.WithClients(serviceOptions)
 
 	scope.IBMPowerVSClient = c
+	scope.serviceInstanceID = serviceInstanceID
 	scope.DHCPIPCacheStore = params.DHCPIPCacheStore
 
 	var vpcRegion string
@@ -219,6 +244,20 @@ func NewMachineScope(params MachineScopeParams) (scope *MachineScope, err error)
 		return nil, fmt.Errorf("failed to create IBM VPC client: %w", err)
 	}
 	scope.IBMVPCClient = vpcClient
+
+	// Create Global Tagging client.
+	gtOptions := globaltagging.ServiceOptions{
+		GlobalTaggingV1Options: &globaltaggingv1.GlobalTaggingV1Options{},
+	}
+	if gtEndpoint := endpoints.FetchEndpoints(string(endpoints.GlobalTagging), params.ServiceEndpoint); gtEndpoint != "" {
+		gtOptions.URL = gtEndpoint
+		params.Logger.V(3).Info("Overriding the default global tagging endpoint", "globalTaggingEndpoint", gtEndpoint)
+	}
+	gtClient, err := globaltagging.NewService(gtOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create global tagging client: %w", err)
+	}
+	scope.GlobalTaggingClient = gtClient
 	return scope, nil
 }
 
@@ -257,12 +296,6 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*models.PVMInstanceRe
 		}
 	}
 
-	// TODO(karthik-k-n): Fix this
-	userData, userDataErr := m.resolveUserData(ctx)
-	if userDataErr != nil {
-		return nil, fmt.Errorf("failed to resolve userdata %w", userDataErr)
-	}
-
 	memory := float64(machineSpec.MemoryGiB)
 
 	var processors float64
@@ -276,9 +309,17 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*models.PVMInstanceRe
 		}
 	}
 
+	if err := m.checkZoneCapabilities(); err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "UnsupportedByZone", "Instance not created - %v", err)
+		return nil, err
+	}
+
 	var imageID *string
 	if m.IBMPowerVSImage != nil {
 		imageID = &m.IBMPowerVSImage.Status.ImageID
+		if target := m.imageTarget(); target != nil {
+			imageID = &target.ImageID
+		}
 	} else {
 		imageID, err = getImageID(machineSpec.Image, m)
 		if err != nil {
@@ -287,12 +328,13 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*models.PVMInstanceRe
 		}
 		log.V(3).Info("Retrieved image id", "imageID", *imageID)
 	}
-	network := machineSpec.Network
-	if network.ID == nil && network.Name == nil && network.RegEx == nil {
-		// if the network is nil, Fetch from cluster.
-		if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
-			network.ID = m.IBMPowerVSCluster.Status.Network.ID
-		}
+	if err := m.checkWorkspaceQuota(*imageID, processors, memory); err != nil {
+		return nil, err
+	}
+	network, err := m.machineNetwork()
+	if err != nil {
+		record.Warnf(m.IBMPowerVSMachine, "FailedRetrieveNetwork", "Failed network retrieval - %v", err)
+		return nil, err
 	}
 
 	networkID, err := getNetworkID(network, m)
@@ -302,16 +344,26 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*models.PVMInstanceRe
 	}
 	log.V(3).Info("Retrieved network id", "networkID", *networkID)
 
+	// TODO(karthik-k-n): Fix this
+	userData, userDataErr := m.resolveUserData(ctx, *networkID)
+	if userDataErr != nil {
+		return nil, fmt.Errorf("failed to resolve userdata %w", userDataErr)
+	}
+
+	instanceNetwork := &models.PVMInstanceAddNetwork{
+		NetworkID: networkID,
+	}
+	if m.ipAddress != nil {
+		log.V(3).Info("Using IP address allocated from IP address pool", "ipAddress", m.ipAddress.Spec.Address)
+		instanceNetwork.IPAddress = m.ipAddress.Spec.Address
+	}
+
 	procType := strings.ToLower(string(machineSpec.ProcessorType))
 
 	params := &p_cloud_p_vm_instances.PcloudPvminstancesPostParams{
 		Body: &models.PVMInstanceCreate{
-			ImageID: imageID,
-			Networks: []*models.PVMInstanceAddNetwork{
-				{
-					NetworkID: networkID,
-				},
-			},
+			ImageID:    imageID,
+			Networks:   []*models.PVMInstanceAddNetwork{instanceNetwork},
 			ServerName: &m.IBMPowerVSMachine.Name,
 			Memory:     &memory,
 			Processors: &processors,
@@ -323,6 +375,11 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*models.PVMInstanceRe
 	if machineSpec.SSHKey != "" {
 		params.Body.KeyPairName = machineSpec.SSHKey
 	}
+	if bootVolume := machineSpec.BootVolume; bootVolume != nil {
+		params.Body.StorageType = string(bootVolume.StorageTier)
+		params.Body.StoragePool = bootVolume.StoragePool
+		params.Body.StorageAffinity = storageAffinity(bootVolume.StorageAffinity)
+	}
 	log.V(3).Info("Creating PowerVS instance", "params", params)
 	_, err = m.IBMPowerVSClient.CreateInstance(params.Body)
 	if err != nil {
@@ -333,12 +390,220 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*models.PVMInstanceRe
 	return nil, nil
 }
 
-func (m *MachineScope) resolveUserData(ctx context.Context) (string, error) {
+// imageTarget returns the status of the referenced IBMPowerVSImage in the workspace of the machine
+// when the workspace is one of the additional workspaces the image is imported into.
+func (m *MachineScope) imageTarget() *infrav1.IBMPowerVSImageTargetStatus {
+	if m.IBMPowerVSImage == nil || m.serviceInstanceID == "" {
+		return nil
+	}
+	for i := range m.IBMPowerVSImage.Status.Targets {
+		if m.IBMPowerVSImage.Status.Targets[i].ServiceInstanceID == m.serviceInstanceID {
+			return &m.IBMPowerVSImage.Status.Targets[i]
+		}
+	}
+	return nil
+}
+
+// storageAffinity returns the storage affinity used by the PowerVS API to select the storage pool of a volume.
+func storageAffinity(affinity *infrav1.PowerVSStorageAffinity) *models.StorageAffinity {
+	if affinity == nil {
+		return nil
+	}
+	if affinity.Policy == infrav1.PowerVSStorageAffinityPolicyAntiAffinity {
+		return &models.StorageAffinity{
+			AffinityPolicy:           ptr.To(models.StorageAffinityAffinityPolicyAntiDashAffinity),
+			AntiAffinityVolumes:      affinity.Volumes,
+			AntiAffinityPVMInstances: affinity.Instances,
+		}
+	}
+	storageAffinity := &models.StorageAffinity{
+		AffinityPolicy: ptr.To(models.StorageAffinityAffinityPolicyAffinity),
+	}
+	if len(affinity.Volumes) != 0 {
+		storageAffinity.AffinityVolume = &affinity.Volumes[0]
+	} else if len(affinity.Instances) != 0 {
+		storageAffinity.AffinityPVMInstance = &affinity.Instances[0]
+	}
+	return storageAffinity
+}
+
+// checkZoneCapabilities returns ErrUnsupportedByZone when the system type or the boot volume storage tier or pool of the instance
+// is not available in the PowerVS zone, according to the zone capabilities reported in the IBMPowerVSCluster status.
+// the processor type is not validated as the zone capabilities do not tell which processor types a system type supports.
+func (m *MachineScope) checkZoneCapabilities() error {
+	if m.IBMPowerVSCluster == nil || m.IBMPowerVSCluster.Status.ZoneCapabilities == nil {
+		return nil
+	}
+	zoneCapabilities := m.IBMPowerVSCluster.Status.ZoneCapabilities
+	if bootVolume := m.IBMPowerVSMachine.Spec.BootVolume; bootVolume != nil && bootVolume.StorageTier != "" && len(zoneCapabilities.StorageTiers) != 0 {
+		if !slices.Contains(zoneCapabilities.StorageTiers, string(bootVolume.StorageTier)) {
+			return fmt.Errorf("%w: storage tier %s is not available, available storage tiers are %s", ErrUnsupportedByZone, bootVolume.StorageTier, strings.Join(zoneCapabilities.StorageTiers, ", "))
+		}
+	}
+	if bootVolume := m.IBMPowerVSMachine.Spec.BootVolume; bootVolume != nil && bootVolume.StoragePool != "" && len(zoneCapabilities.StoragePools) != 0 {
+		if !slices.Contains(zoneCapabilities.StoragePools, bootVolume.StoragePool) {
+			return fmt.Errorf("%w: storage pool %s is not available, available storage pools are %s", ErrUnsupportedByZone, bootVolume.StoragePool, strings.Join(zoneCapabilities.StoragePools, ", "))
+		}
+	}
+	systemType := m.IBMPowerVSMachine.Spec.SystemType
+	if systemType == "" || len(zoneCapabilities.SystemTypes) == 0 {
+		// the platform chooses the system type.
+		return nil
+	}
+	systemTypes := zoneCapabilities.SystemTypes
+	if !slices.ContainsFunc(systemTypes, func(capabilities infrav1.SystemTypeCapabilities) bool {
+		return capabilities.Name == systemType
+	}) {
+		names := make([]string, 0, len(systemTypes))
+		for _, capabilities := range systemTypes {
+			names = append(names, capabilities.Name)
+		}
+		return fmt.Errorf("%w: system type %s is not available, available system types are %s", ErrUnsupportedByZone, systemType, strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// checkWorkspaceQuota returns ErrInsufficientWorkspaceQuota when creating an instance with the given processors and memory in GiB,
+// along with its boot volume of the size of the image, would exceed the available capacity of the workspace.
+// the usage and limits are read from the workspace, the capacity reported in the IBMPowerVSCluster status is stale once other machines are created.
+func (m *MachineScope) checkWorkspaceQuota(imageID string, processors, memoryGiB float64) error {
+	if m.serviceInstanceID == "" {
+		return nil
+	}
+	workspace, err := m.IBMPowerVSClient.GetCloudInstance(m.serviceInstanceID)
+	if err != nil {
+		return fmt.Errorf("failed to get PowerVS workspace usage and limits: %w", err)
+	}
+	quota := workspaceQuota(workspace)
+	if quota == nil {
+		return nil
+	}
+	available := quota.Available
+	var exceeded []string
+	if available.Instances != nil && *available.Instances < 1 {
+		exceeded = append(exceeded, "no instances available")
+	}
+	if requested := processorsQuantity(processors); available.Processors != nil && requested.Cmp(*available.Processors) > 0 {
+		exceeded = append(exceeded, fmt.Sprintf("requested processors %s, available %s", requested.String(), available.Processors.String()))
+	}
+	if requested := gibQuantity(memoryGiB); available.Memory != nil && requested.Cmp(*available.Memory) > 0 {
+		exceeded = append(exceeded, fmt.Sprintf("requested memory %s, available %s", requested.String(), available.Memory.String()))
+	}
+	image, err := m.IBMPowerVSClient.GetImage(imageID)
+	if err != nil {
+		return fmt.Errorf("failed to get image %s: %w", imageID, err)
+	}
+	// the boot volume is created with the size of the image in GiB.
+	if image.Size != nil && available.Storage != nil {
+		if requested := gibQuantity(*image.Size); requested.Cmp(*available.Storage) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("requested boot volume storage %s, available %s", requested.String(), available.Storage.String()))
+		}
+	}
+	if len(exceeded) != 0 {
+		return fmt.Errorf("%w: %s", ErrInsufficientWorkspaceQuota, strings.Join(exceeded, ", "))
+	}
+	return nil
+}
+
+// ReconcileIPAddress claims the IP address of the instance from spec.ipAddressPool through an IPAddressClaim.
+// returns true once the IP address is allocated or when spec.ipAddressPool is not set.
+func (m *MachineScope) ReconcileIPAddress(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	poolRef := m.IBMPowerVSMachine.Spec.IPAddressPool
+	if poolRef == nil {
+		return true, nil
+	}
+	// the claim carries the watch label of the machine for its changes to pass the event filter of the controller.
+	var labels map[string]string
+	if watchFilterValue, ok := m.IBMPowerVSMachine.Labels[clusterv1.WatchLabel]; ok {
+		labels = map[string]string{clusterv1.WatchLabel: watchFilterValue}
+	}
+	ipAddress, err := ipam.ClaimIPAddress(ctx, m.Client, ipam.Claim{
+		Name:        m.IBMPowerVSMachine.Name,
+		Namespace:   m.IBMPowerVSMachine.Namespace,
+		ClusterName: m.Cluster.Name,
+		Owner: metav1.OwnerReference{
+			APIVersion: infrav1.GroupVersion.String(),
+			Kind:       "IBMPowerVSMachine",
+			Name:       m.IBMPowerVSMachine.Name,
+			UID:        m.IBMPowerVSMachine.UID,
+		},
+		PoolRef: *poolRef,
+		Labels:  labels,
+	})
+	if err != nil {
+		return false, err
+	}
+	if ipAddress == nil {
+		log.V(3).Info("IP address is not yet allocated", "pool", poolRef.Name)
+		return false, nil
+	}
+	m.ipAddress = ipAddress
+	return true, nil
+}
+
+// ignitionWithStaticAddress adds a NetworkManager connection profile with the IP address allocated for the instance
+// and the gateway and DNS servers of the network to the ignition bootstrap data.
+func (m *MachineScope) ignitionWithStaticAddress(userData []byte, networkID string) ([]byte, error) {
+	network, err := m.IBMPowerVSClient.GetNetworkByID(networkID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get network: %w", err)
+	}
+	gateway := m.ipAddress.Spec.Gateway
+	if gateway == "" {
+		gateway = network.Gateway
+	}
+	ipFamily := "ipv4"
+	if ip := net.ParseIP(m.ipAddress.Spec.Address); ip != nil && ip.To4() == nil {
+		ipFamily = "ipv6"
+	}
+	// the prefix of the network is used when the IP address has no prefix.
+	var prefix int
+	switch {
+	case m.ipAddress.Spec.Prefix != nil:
+		prefix = int(*m.ipAddress.Spec.Prefix)
+	case network.Cidr != nil:
+		cidr, err := netip.ParsePrefix(*network.Cidr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CIDR %s of network %s: %w", *network.Cidr, networkID, err)
+		}
+		prefix = cidr.Bits()
+	default:
+		return nil, fmt.Errorf("prefix of IP address %s is not set and network %s has no CIDR", m.ipAddress.Name, networkID)
+	}
+	address := fmt.Sprintf("%s/%d", m.ipAddress.Spec.Address, prefix)
+	if gateway != "" {
+		address = fmt.Sprintf("%s,%s", address, gateway)
+	}
+	keyfile := fmt.Sprintf("[connection]\nid=%s\ntype=ethernet\nautoconnect-priority=100\n\n[%s]\nmethod=manual\naddress1=%s\n", staticConnectionName, ipFamily, address)
+	if len(network.DNSServers) > 0 {
+		keyfile += fmt.Sprintf("dns=%s;\n", strings.Join(network.DNSServers, ";"))
+	}
+	return addIgnitionFile(userData, staticConnectionPath, keyfile)
+}
+
+// IsImageReady returns true when the referenced IBMPowerVSImage is ready in the workspace of the machine.
+func (m *MachineScope) IsImageReady() bool {
+	if target := m.imageTarget(); target != nil {
+		return target.Ready
+	}
+	return m.IBMPowerVSImage.Status.Ready
+}
+
+func (m *MachineScope) resolveUserData(ctx context.Context, networkID string) (string, error) {
 	userData, err := m.GetRawBootstrapData()
 	if err != nil {
 		return "", err
 	}
 	if m.UseIgnition() {
+		// with cloud-init the static address is configured from the network data PowerVS provides to the instance,
+		// ignition has no such support, hence the network configuration is added to the bootstrap data.
+		if m.ipAddress != nil {
+			userData, err = m.ignitionWithStaticAddress(userData, networkID)
+			if err != nil {
+				return "", fmt.Errorf("failed to add network configuration to bootstrap data: %w", err)
+			}
+		}
 		data, err := m.ignitionUserData(ctx, userData)
 		if err != nil {
 			return "", err
@@ -493,6 +758,45 @@ func (m *MachineScope) ignitionUserData(ctx context.Context, userData []byte) ([
 	}
 }
 
+// addIgnitionFile adds the file with the contents to storage.files of the ignition config in data.
+func addIgnitionFile(data []byte, path, contents string) ([]byte, error) {
+	config := map[string]any{}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse ignition config: %w", err)
+	}
+	ignition, _ := config["ignition"].(map[string]any)
+	version, _ := ignition["version"].(string)
+	semver, err := semver.ParseTolerant(version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse ignition version %q: %w", version, err)
+	}
+
+	file := map[string]any{
+		"path": path,
+		"mode": 0o600,
+		"contents": map[string]any{
+			"source": "data:," + url.PathEscape(contents),
+		},
+	}
+	switch semver.Major {
+	case 2:
+		file["filesystem"] = "root"
+	case 3:
+		file["overwrite"] = true
+	default:
+		return nil, fmt.Errorf("unsupported ignition version %q", version)
+	}
+
+	storage, _ := config["storage"].(map[string]any)
+	if storage == nil {
+		storage = map[string]any{}
+		config["storage"] = storage
+	}
+	files, _ := storage["files"].([]any)
+	storage["files"] = append(files, file)
+	return json.Marshal(config)
+}
+
 // UseIgnition returns true if Ignition is set in IBMPowerVSCluster.
 func (m *MachineScope) UseIgnition() bool {
 	return m.IBMPowerVSCluster.Spec.Ignition != nil
@@ -547,12 +851,7 @@ func (m *MachineScope) DeleteMachineIgnition(ctx context.Context) error {
 // createCOSClient creates a new cosClient from the supplied parameters.
 func (m *MachineScope) createCOSClient(ctx context.Context) (cos.Cos, error) {
 	log := ctrl.LoggerFrom(ctx)
-	var cosInstanceName string
-	if m.IBMPowerVSCluster.Spec.CosInstance == nil || m.IBMPowerVSCluster.Spec.CosInstance.Name == "" {
-		cosInstanceName = fmt.Sprintf("%s-%s", m.IBMPowerVSCluster.GetName(), "cosinstance")
-	} else {
-		cosInstanceName = m.IBMPowerVSCluster.Spec.CosInstance.Name
-	}
+	cosInstanceName := *getServiceName(m.IBMPowerVSCluster, infrav1.ResourceTypeCOSInstance)
 
 	resourceInstance := resourcecontroller.InstanceFilter{
 		Name:           cosInstanceName,
@@ -655,6 +954,29 @@ func (m *MachineScope) GetImages() (*models.Images, error) {
 	return m.IBMPowerVSClient.GetAllImage()
 }
 
+// machineNetwork returns the reference to the network of the machine.
+// When the network is not set in the spec, the network of the DHCP network named in spec.dhcpNetwork
+// or the network of the cluster is used.
+func (m *MachineScope) machineNetwork() (infrav1.IBMPowerVSResourceReference, error) {
+	network := m.IBMPowerVSMachine.Spec.Network
+	if network.ID != nil || network.Name != nil || network.RegEx != nil {
+		return network, nil
+	}
+	if dhcpNetwork := m.IBMPowerVSMachine.Spec.DHCPNetwork; dhcpNetwork != "" {
+		status, ok := m.IBMPowerVSCluster.Status.DHCPNetworks[dhcpNetwork]
+		if !ok || status.Network == nil || status.Network.ID == nil {
+			return network, fmt.Errorf("network of DHCP network %s is not yet available", dhcpNetwork)
+		}
+		network.ID = status.Network.ID
+		return network, nil
+	}
+	// if the network is nil, Fetch from cluster.
+	if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
+		network.ID = m.IBMPowerVSCluster.Status.Network.ID
+	}
+	return network, nil
+}
+
 func getNetworkID(network infrav1.IBMPowerVSResourceReference, m *MachineScope) (*string, error) {
 	if network.ID != nil {
 		return network.ID, nil
@@ -767,6 +1089,16 @@ func (m *MachineScope) SetAddresses(ctx context.Context, instance *models.PVMIns
 			})
 		}
 	}
+	hasInternalIP := slices.ContainsFunc(addresses, func(address clusterv1.MachineAddress) bool {
+		return address.Type == clusterv1.MachineInternalIP
+	})
+	if !hasInternalIP && m.ipAddress != nil {
+		// the IP address allocated from the IP address pool is the internal IP of the instance until it is reported under instance.Networks.
+		addresses = append(addresses, clusterv1.MachineAddress{
+			Type:    clusterv1.MachineInternalIP,
+			Address: m.ipAddress.Spec.Address,
+		})
+	}
 	m.IBMPowerVSMachine.Status.Addresses = addresses
 	if len(addresses) > 2 {
 		// If the address length is more than 2 means either MachineInternalIP or MachineExternalIP is updated so return
@@ -788,12 +1120,10 @@ func (m *MachineScope) SetAddresses(ctx context.Context, instance *models.PVMIns
 		return
 	}
 	// Fetch the VM network ID
-	network := m.IBMPowerVSMachine.Spec.Network
-	if network.ID == nil && network.Name == nil && network.RegEx == nil {
-		// if the network is nil, Fetch from cluster.
-		if m.IBMPowerVSCluster.Status.Network != nil && m.IBMPowerVSCluster.Status.Network.ID != nil {
-			network.ID = m.IBMPowerVSCluster.Status.Network.ID
-		}
+	network, err := m.machineNetwork()
+	if err != nil {
+		log.Error(err, "failed to get the network of the machine")
+		return
 	}
 	networkID, err := getNetworkID(network, m)
 	if err != nil {
@@ -878,6 +1208,63 @@ func (m *MachineScope) SetInstanceState(status *string) {
 	m.IBMPowerVSMachine.Status.InstanceState = infrav1.PowerVSInstanceState(*status)
 }
 
+// SetBootVolume will set the storage tier and storage pool of the boot volume for the machine.
+func (m *MachineScope) SetBootVolume(instance *models.PVMInstance) {
+	if instance.StorageType == nil && instance.StoragePool == "" {
+		return
+	}
+	m.IBMPowerVSMachine.Status.BootVolume = &infrav1.PowerVSBootVolumeStatus{
+		StorageTier: infrav1.PowerVSStorageTier(ptr.Deref(instance.StorageType, "")),
+		StoragePool: instance.StoragePool,
+	}
+}
+
+// ReconcileTags attaches the user tags of the cluster and the machine and the cluster UID tag to the instance and its volumes, and detaches the tags removed from spec.
+// the tags are only attached again when the tags in spec or the volumes of the instance change.
+func (m *MachineScope) ReconcileTags(ctx context.Context, instance *models.PVMInstance) error {
+	log := ctrl.LoggerFrom(ctx)
+	tags := genutil.ClusterUserTags(m.IBMPowerVSCluster.UID, m.IBMPowerVSCluster.Spec.Tags, m.IBMPowerVSMachine.Spec.Tags)
+	tagsStatus := ptr.Deref(m.IBMPowerVSMachine.Status.Tags, infrav1.TagsStatus{})
+	if len(tags) == 0 && len(tagsStatus.Applied) == 0 {
+		return nil
+	}
+
+	resourceIDs := append([]string{*instance.PvmInstanceID}, instance.VolumeIDs...)
+	slices.Sort(resourceIDs)
+	if slices.Equal(tags, tagsStatus.Applied) && slices.Equal(resourceIDs, tagsStatus.Resources) {
+		return nil
+	}
+
+	resourceCRNs := []string{string(instance.Crn)}
+	for _, volumeID := range instance.VolumeIDs {
+		volume, err := m.IBMPowerVSClient.GetVolume(volumeID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch volume %s: %w", volumeID, err)
+		}
+		resourceCRNs = append(resourceCRNs, string(volume.Crn))
+	}
+	removedTags := slices.DeleteFunc(slices.Clone(tagsStatus.Applied), func(tag string) bool {
+		return slices.Contains(tags, tag)
+	})
+	log.Info("Reconciling user tags of the instance and its volumes", "tags", tags, "removedTags", removedTags)
+	if err := m.GlobalTaggingClient.DetachUserTags(removedTags, resourceCRNs); err != nil {
+		return fmt.Errorf("failed to detach user tags from the instance: %w", err)
+	}
+	if err := m.GlobalTaggingClient.AttachUserTags(tags, resourceCRNs); err != nil {
+		return fmt.Errorf("failed to attach user tags to the instance: %w", err)
+	}
+
+	if len(tags) == 0 {
+		m.IBMPowerVSMachine.Status.Tags = nil
+		return nil
+	}
+	m.IBMPowerVSMachine.Status.Tags = &infrav1.TagsStatus{
+		Applied:   tags,
+		Resources: resourceIDs,
+	}
+	return nil
+}
+
 // GetInstanceState will get the state for the machine.
 func (m *MachineScope) GetInstanceState() infrav1.PowerVSInstanceState {
 	return m.IBMPowerVSMachine.Status.InstanceState
@@ -968,14 +1355,14 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(ctx context.Context) (*vp
 	loadBalancers := make([]infrav1.VPCLoadBalancerSpec, 0)
 	if len(m.IBMPowerVSCluster.Spec.LoadBalancers) == 0 {
 		loadBalancer := infrav1.VPCLoadBalancerSpec{
-			Name:   fmt.Sprintf("%s-loadbalancer", m.IBMPowerVSCluster.Name),
+			Name:   *getServiceName(m.IBMPowerVSCluster, infrav1.ResourceTypeLoadBalancer),
 			Public: ptr.To(true),
 		}
 		loadBalancers = append(loadBalancers, loadBalancer)
 	}
 	for index, loadBalancer := range m.IBMPowerVSCluster.Spec.LoadBalancers {
 		if loadBalancer.Name == "" {
-			loadBalancer.Name = fmt.Sprintf("%s-loadbalancer-%d", m.IBMPowerVSCluster.Name, index)
+			loadBalancer.Name = fmt.Sprintf("%s-%d", *getServiceName(m.IBMPowerVSCluster, infrav1.ResourceTypeLoadBalancer), index)
 		}
 		loadBalancers = append(loadBalancers, loadBalancer)
 	}
@@ -1133,12 +1520,9 @@ func (m *MachineScope) APIServerPort() int32 {
 	return infrav1.DefaultAPIServerPort
 }
 
-// TODO: reuse getServiceName function instead.
+// bucketName returns the name of the COS bucket of the cluster.
 func (m *MachineScope) bucketName() string {
-	if m.IBMPowerVSCluster.Spec.CosInstance != nil && m.IBMPowerVSCluster.Spec.CosInstance.BucketName != "" {
-		return m.IBMPowerVSCluster.Spec.CosInstance.BucketName
-	}
-	return fmt.Sprintf("%s-%s", m.IBMPowerVSCluster.GetName(), "cosbucket")
+	return *getServiceName(m.IBMPowerVSCluster, infrav1.ResourceTypeCOSBucket)
 }
 
 // bucketRegion returns the region of the COS bucket for the MachineScope.
@@ -18,9 +18,12 @@ package powervs
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	"strings"
 
 	"testing"
 	"time"
@@ -43,8 +46,10 @@ import (
 
 	resourcecontrollermock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller/mock"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
@@ -918,6 +923,451 @@ func TestGetNetworkID(t *testing.T) {
 	})
 }
 
+func TestMachineNetwork(t *testing.T) {
+	testCases := []struct {
+		name            string
+		machine         *infrav1.IBMPowerVSMachine
+		cluster         *infrav1.IBMPowerVSCluster
+		expectedNetwork infrav1.IBMPowerVSResourceReference
+		expectedErr     bool
+	}{
+		{
+			name: "Returns the network set in spec",
+			machine: &infrav1.IBMPowerVSMachine{Spec: infrav1.IBMPowerVSMachineSpec{
+				Network: infrav1.IBMPowerVSResourceReference{Name: ptr.To("network-name")},
+			}},
+			cluster: &infrav1.IBMPowerVSCluster{Status: infrav1.IBMPowerVSClusterStatus{
+				Network: &infrav1.ResourceReference{ID: ptr.To("cluster-network-id")},
+			}},
+			expectedNetwork: infrav1.IBMPowerVSResourceReference{Name: ptr.To("network-name")},
+		},
+		{
+			name:    "Returns the network of the cluster when network is not set in spec",
+			machine: &infrav1.IBMPowerVSMachine{},
+			cluster: &infrav1.IBMPowerVSCluster{Status: infrav1.IBMPowerVSClusterStatus{
+				Network: &infrav1.ResourceReference{ID: ptr.To("cluster-network-id")},
+			}},
+			expectedNetwork: infrav1.IBMPowerVSResourceReference{ID: ptr.To("cluster-network-id")},
+		},
+		{
+			name: "Returns the network of the DHCP network set in spec",
+			machine: &infrav1.IBMPowerVSMachine{Spec: infrav1.IBMPowerVSMachineSpec{
+				DHCPNetwork: "storage",
+			}},
+			cluster: &infrav1.IBMPowerVSCluster{Status: infrav1.IBMPowerVSClusterStatus{
+				Network: &infrav1.ResourceReference{ID: ptr.To("cluster-network-id")},
+				DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+					"storage": {Network: &infrav1.ResourceReference{ID: ptr.To("storage-network-id")}},
+				},
+			}},
+			expectedNetwork: infrav1.IBMPowerVSResourceReference{ID: ptr.To("storage-network-id")},
+		},
+		{
+			name: "Returns error when the network of the DHCP network is not yet available",
+			machine: &infrav1.IBMPowerVSMachine{Spec: infrav1.IBMPowerVSMachineSpec{
+				DHCPNetwork: "storage",
+			}},
+			cluster: &infrav1.IBMPowerVSCluster{Status: infrav1.IBMPowerVSClusterStatus{
+				DHCPNetworks: map[string]infrav1.DHCPNetworkStatus{
+					"storage": {DHCPServer: &infrav1.ResourceReference{ID: ptr.To("dhcp-server-id")}},
+				},
+			}},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope := MachineScope{
+				IBMPowerVSMachine: tc.machine,
+				IBMPowerVSCluster: tc.cluster,
+			}
+			network, err := scope.machineNetwork()
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(network).To(Equal(tc.expectedNetwork))
+		})
+	}
+}
+
+func TestCheckZoneCapabilities(t *testing.T) {
+	zoneCapabilities := &infrav1.ZoneCapabilitiesStatus{
+		SystemTypes: []infrav1.SystemTypeCapabilities{
+			{Name: "e1080"},
+			{Name: "s922"},
+		},
+	}
+	testCases := []struct {
+		name             string
+		zoneCapabilities *infrav1.ZoneCapabilitiesStatus
+		machineSpec      infrav1.IBMPowerVSMachineSpec
+		expectedErr      string
+	}{
+		{
+			name:        "When the zone capabilities are not set in status",
+			machineSpec: infrav1.IBMPowerVSMachineSpec{SystemType: "e980"},
+		},
+		{
+			name:             "When the system type is not set in spec",
+			zoneCapabilities: zoneCapabilities,
+		},
+		{
+			name:             "When the system type is available",
+			zoneCapabilities: zoneCapabilities,
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{SystemType: "s922", ProcessorType: infrav1.PowerVSProcessorTypeCapped},
+		},
+		{
+			name:             "When the storage tier is available",
+			zoneCapabilities: &infrav1.ZoneCapabilitiesStatus{StorageTiers: []string{"tier1", "tier3"}},
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier3}},
+		},
+		{
+			name:             "When the storage tier is not available",
+			zoneCapabilities: &infrav1.ZoneCapabilitiesStatus{StorageTiers: []string{"tier1", "tier3"}},
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{SystemType: "s922", BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier0}},
+			expectedErr:      "storage tier tier0 is not available, available storage tiers are tier1, tier3",
+		},
+		{
+			name:             "When the storage pool is available",
+			zoneCapabilities: &infrav1.ZoneCapabilitiesStatus{StoragePools: []string{"Tier1-Flash-1", "Tier3-Flash-1"}},
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{BootVolume: &infrav1.PowerVSBootVolume{StoragePool: "Tier3-Flash-1"}},
+		},
+		{
+			name:             "When the storage pool is not available",
+			zoneCapabilities: &infrav1.ZoneCapabilitiesStatus{StoragePools: []string{"Tier1-Flash-1", "Tier3-Flash-1"}},
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{BootVolume: &infrav1.PowerVSBootVolume{StoragePool: "Tier0-Flash-1"}},
+			expectedErr:      "storage pool Tier0-Flash-1 is not available, available storage pools are Tier1-Flash-1, Tier3-Flash-1",
+		},
+		{
+			name:             "When the system type is not available",
+			zoneCapabilities: zoneCapabilities,
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{SystemType: "e980"},
+			expectedErr:      "system type e980 is not available, available system types are e1080, s922",
+		},
+		{
+			name:             "When the system type is available with the dedicated processor type",
+			zoneCapabilities: zoneCapabilities,
+			machineSpec:      infrav1.IBMPowerVSMachineSpec{SystemType: "e1080", ProcessorType: infrav1.PowerVSProcessorTypeDedicated},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scope := MachineScope{
+				IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{Spec: tc.machineSpec},
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{Status: infrav1.IBMPowerVSClusterStatus{ZoneCapabilities: tc.zoneCapabilities}},
+			}
+			err := scope.checkZoneCapabilities()
+			if tc.expectedErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			g.Expect(errors.Is(err, ErrUnsupportedByZone)).To(BeTrue())
+			g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+		})
+	}
+}
+
+func TestCheckWorkspaceQuota(t *testing.T) {
+	// the workspace has 2 instances, 1 processor, 16GiB of memory and 0.5TiB of storage available.
+	workspace := &models.CloudInstance{
+		Limits: &models.CloudInstanceUsageLimits{
+			Instances:  ptr.To(10.0),
+			Processors: ptr.To(4.0),
+			Memory:     ptr.To(64.0),
+			Storage:    ptr.To(2.0),
+		},
+		Usage: &models.CloudInstanceUsageLimits{
+			Instances:  ptr.To(8.0),
+			Processors: ptr.To(3.0),
+			Memory:     ptr.To(48.0),
+			Storage:    ptr.To(1.5),
+		},
+	}
+	testCases := []struct {
+		name              string
+		serviceInstanceID string
+		workspace         *models.CloudInstance
+		workspaceErr      error
+		image             *models.Image
+		imageErr          error
+		processors        float64
+		memoryGiB         float64
+		expectedErr       string
+		expectQuotaErr    bool
+	}{
+		{
+			name:       "When the service instance id is not known",
+			processors: 4,
+			memoryGiB:  64,
+		},
+		{
+			name:              "When the workspace usage and limits are not reported",
+			serviceInstanceID: "serviceInstanceID",
+			workspace:         &models.CloudInstance{},
+			processors:        4,
+			memoryGiB:         64,
+		},
+		{
+			name:              "When the instance fits in the available capacity",
+			serviceInstanceID: "serviceInstanceID",
+			workspace:         workspace,
+			image:             &models.Image{Size: ptr.To(120.0)},
+			processors:        0.5,
+			memoryGiB:         16,
+		},
+		{
+			name:              "When the instance exceeds the available processors",
+			serviceInstanceID: "serviceInstanceID",
+			workspace:         workspace,
+			image:             &models.Image{Size: ptr.To(120.0)},
+			processors:        1.25,
+			memoryGiB:         8,
+			expectedErr:       "requested processors 1250m, available 1",
+			expectQuotaErr:    true,
+		},
+		{
+			name:              "When the instance exceeds the available memory",
+			serviceInstanceID: "serviceInstanceID",
+			workspace:         workspace,
+			image:             &models.Image{Size: ptr.To(120.0)},
+			processors:        0.25,
+			memoryGiB:         32,
+			expectedErr:       "requested memory 32Gi, available 16Gi",
+			expectQuotaErr:    true,
+		},
+		{
+			name:              "When the boot volume exceeds the available storage",
+			serviceInstanceID: "serviceInstanceID",
+			workspace:         workspace,
+			image:             &models.Image{Size: ptr.To(1024.0)},
+			processors:        0.25,
+			memoryGiB:         2,
+			expectedErr:       "requested boot volume storage 1Ti, available 512Gi",
+			expectQuotaErr:    true,
+		},
+		{
+			name:              "When no instances are available",
+			serviceInstanceID: "serviceInstanceID",
+			workspace: &models.CloudInstance{
+				Limits: &models.CloudInstanceUsageLimits{Instances: ptr.To(2.0)},
+				Usage:  &models.CloudInstanceUsageLimits{Instances: ptr.To(2.0)},
+			},
+			image:          &models.Image{Size: ptr.To(120.0)},
+			processors:     0.25,
+			memoryGiB:      2,
+			expectedErr:    "no instances available",
+			expectQuotaErr: true,
+		},
+		{
+			name:              "When getting the workspace usage and limits fails",
+			serviceInstanceID: "serviceInstanceID",
+			workspaceErr:      errors.New("failed to get cloud instance"),
+			processors:        0.25,
+			memoryGiB:         2,
+			expectedErr:       "failed to get PowerVS workspace usage and limits",
+		},
+		{
+			name:              "When getting the image fails",
+			serviceInstanceID: "serviceInstanceID",
+			workspace:         workspace,
+			imageErr:          errors.New("failed to get image"),
+			processors:        0.25,
+			memoryGiB:         2,
+			expectedErr:       "failed to get image image-id",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockPowerVS := mock.NewMockPowerVS(mockCtrl)
+			if tc.serviceInstanceID != "" {
+				mockPowerVS.EXPECT().GetCloudInstance(tc.serviceInstanceID).Return(tc.workspace, tc.workspaceErr)
+			}
+			if tc.image != nil || tc.imageErr != nil {
+				mockPowerVS.EXPECT().GetImage("image-id").Return(tc.image, tc.imageErr)
+			}
+			scope := MachineScope{
+				IBMPowerVSClient:  mockPowerVS,
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{},
+				serviceInstanceID: tc.serviceInstanceID,
+			}
+			err := scope.checkWorkspaceQuota("image-id", tc.processors, tc.memoryGiB)
+			if tc.expectedErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+				return
+			}
+			g.Expect(errors.Is(err, ErrInsufficientWorkspaceQuota)).To(Equal(tc.expectQuotaErr))
+			g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+		})
+	}
+}
+
+func TestReconcileIPAddress(t *testing.T) {
+	poolRef := &ipamv1.IPPoolReference{
+		APIGroup: "ipam.cluster.x-k8s.io",
+		Kind:     "InClusterIPPool",
+		Name:     "pool",
+	}
+
+	t.Run("When IP address pool is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, nil)
+		allocated, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(allocated).To(BeTrue())
+		g.Expect(scope.ipAddress).To(BeNil())
+	})
+	t.Run("When IPAddressClaim is created", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, nil)
+		scope.IBMPowerVSMachine.Spec.IPAddressPool = poolRef
+		allocated, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(allocated).To(BeFalse())
+
+		claim := &ipamv1.IPAddressClaim{}
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: machineName}, claim)).To(Succeed())
+		g.Expect(claim.Spec.PoolRef).To(Equal(*poolRef))
+		g.Expect(claim.OwnerReferences).To(HaveLen(1))
+		g.Expect(claim.OwnerReferences[0].Kind).To(Equal("IBMPowerVSMachine"))
+	})
+	t.Run("When IP address is allocated", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, nil)
+		scope.IBMPowerVSMachine.Spec.IPAddressPool = poolRef
+		claim := &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: defaultNamespace},
+			Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "address"}},
+		}
+		address := &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{Name: "address", Namespace: defaultNamespace},
+			Spec:       ipamv1.IPAddressSpec{Address: "192.168.10.10", Prefix: ptr.To[int32](24)},
+		}
+		g.Expect(scope.Client.Create(ctx, claim)).To(Succeed())
+		g.Expect(scope.Client.Create(ctx, address)).To(Succeed())
+		allocated, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(allocated).To(BeTrue())
+		g.Expect(scope.ipAddress.Spec.Address).To(Equal("192.168.10.10"))
+	})
+}
+
+func TestAddIgnitionFile(t *testing.T) {
+	testCases := []struct {
+		name          string
+		data          string
+		expectedFiles string
+		expectedErr   bool
+	}{
+		{
+			name:          "Adds the file to ignition v2 config",
+			data:          `{"ignition":{"version":"2.3.0"}}`,
+			expectedFiles: `[{"contents":{"source":"data:,a=b%0A"},"filesystem":"root","mode":384,"path":"/etc/test"}]`,
+		},
+		{
+			name:          "Appends the file to the files of ignition v3 config",
+			data:          `{"ignition":{"version":"3.4.0"},"storage":{"files":[{"path":"/etc/existing"}]}}`,
+			expectedFiles: `[{"path":"/etc/existing"},{"contents":{"source":"data:,a=b%0A"},"mode":384,"overwrite":true,"path":"/etc/test"}]`,
+		},
+		{
+			name:        "Returns error for unsupported ignition version",
+			data:        `{"ignition":{"version":"1.0.0"}}`,
+			expectedErr: true,
+		},
+		{
+			name:        "Returns error for invalid ignition config",
+			data:        `#cloud-config`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			data, err := addIgnitionFile([]byte(tc.data), "/etc/test", "a=b\n")
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			config := map[string]map[string]json.RawMessage{}
+			g.Expect(json.Unmarshal(data, &config)).To(Succeed())
+			g.Expect(config["storage"]["files"]).To(MatchJSON(tc.expectedFiles))
+		})
+	}
+}
+
+func TestIgnitionWithStaticAddress(t *testing.T) {
+	testCases := []struct {
+		name            string
+		ipAddress       ipamv1.IPAddressSpec
+		network         *models.Network
+		expectedAddress string
+		expectedErr     bool
+	}{
+		{
+			name:            "Uses the prefix and gateway of the IP address",
+			ipAddress:       ipamv1.IPAddressSpec{Address: "192.168.10.10", Prefix: ptr.To[int32](24), Gateway: "192.168.10.1"},
+			network:         &models.Network{Cidr: ptr.To("192.168.0.0/16"), Gateway: "192.168.0.1"},
+			expectedAddress: "address1=192.168.10.10/24,192.168.10.1\n",
+		},
+		{
+			name:            "Uses the prefix and gateway of the network when the IP address has none",
+			ipAddress:       ipamv1.IPAddressSpec{Address: "192.168.10.10"},
+			network:         &models.Network{Cidr: ptr.To("192.168.0.0/16"), Gateway: "192.168.0.1"},
+			expectedAddress: "address1=192.168.10.10/16,192.168.0.1\n",
+		},
+		{
+			name:        "Returns error when neither the IP address nor the network has a prefix",
+			ipAddress:   ipamv1.IPAddressSpec{Address: "192.168.10.10"},
+			network:     &models.Network{Gateway: "192.168.0.1"},
+			expectedErr: true,
+		},
+		{
+			name:        "Returns error when the CIDR of the network is invalid",
+			ipAddress:   ipamv1.IPAddressSpec{Address: "192.168.10.10"},
+			network:     &models.Network{Cidr: ptr.To("192.168.0.0")},
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockPowerVS := mock.NewMockPowerVS(mockCtrl)
+			mockPowerVS.EXPECT().GetNetworkByID("network-id").Return(tc.network, nil)
+			scope := MachineScope{
+				IBMPowerVSClient: mockPowerVS,
+				ipAddress:        &ipamv1.IPAddress{Spec: tc.ipAddress},
+			}
+			data, err := scope.ignitionWithStaticAddress([]byte(`{"ignition":{"version":"3.4.0"}}`), "network-id")
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			config := struct {
+				Storage struct {
+					Files []struct {
+						Contents struct {
+							Source string `json:"source"`
+						} `json:"contents"`
+					} `json:"files"`
+				} `json:"storage"`
+			}{}
+			g.Expect(json.Unmarshal(data, &config)).To(Succeed())
+			g.Expect(config.Storage.Files).To(HaveLen(1))
+			contents, err := url.PathUnescape(strings.TrimPrefix(config.Storage.Files[0].Contents.Source, "data:,"))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(contents).To(ContainSubstring(tc.expectedAddress))
+		})
+	}
+}
+
 func TestGetMachineInternalIP(t *testing.T) {
 	t.Run("Get Machine Internal IP", func(t *testing.T) {
 		t.Run("Returns machine IP for address type - Node Internal IP", func(t *testing.T) {
@@ -1325,6 +1775,61 @@ func TestDeleteMachineIgnition(t *testing.T) {
 	})
 }
 
+func TestSetBootVolume(t *testing.T) {
+	t.Run("Set boot volume storage tier and storage pool", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := MachineScope{
+			IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{},
+		}
+		scope.SetBootVolume(&models.PVMInstance{StorageType: ptr.To("tier1"), StoragePool: "Tier1-Flash-1"})
+		g.Expect(scope.IBMPowerVSMachine.Status.BootVolume).To(Equal(&infrav1.PowerVSBootVolumeStatus{
+			StorageTier: infrav1.PowerVSStorageTierTier1,
+			StoragePool: "Tier1-Flash-1",
+		}))
+	})
+	t.Run("Do not set boot volume when storage is not reported", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := MachineScope{
+			IBMPowerVSMachine: &infrav1.IBMPowerVSMachine{},
+		}
+		scope.SetBootVolume(&models.PVMInstance{})
+		g.Expect(scope.IBMPowerVSMachine.Status.BootVolume).To(BeNil())
+	})
+}
+
+func TestStorageAffinity(t *testing.T) {
+	testCases := []struct {
+		name     string
+		affinity *infrav1.PowerVSStorageAffinity
+		expected *models.StorageAffinity
+	}{
+		{
+			name: "When storage affinity is not set",
+		},
+		{
+			name:     "When Affinity policy is based on a volume",
+			affinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSStorageAffinityPolicyAffinity, Volumes: []string{"volume-1"}},
+			expected: &models.StorageAffinity{AffinityPolicy: ptr.To("affinity"), AffinityVolume: ptr.To("volume-1")},
+		},
+		{
+			name:     "When Affinity policy is based on an instance",
+			affinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSStorageAffinityPolicyAffinity, Instances: []string{"instance-1"}},
+			expected: &models.StorageAffinity{AffinityPolicy: ptr.To("affinity"), AffinityPVMInstance: ptr.To("instance-1")},
+		},
+		{
+			name:     "When AntiAffinity policy is based on volumes and instances",
+			affinity: &infrav1.PowerVSStorageAffinity{Policy: infrav1.PowerVSStorageAffinityPolicyAntiAffinity, Volumes: []string{"volume-1"}, Instances: []string{"instance-1"}},
+			expected: &models.StorageAffinity{AffinityPolicy: ptr.To("anti-affinity"), AntiAffinityVolumes: []string{"volume-1"}, AntiAffinityPVMInstances: []string{"instance-1"}},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(storageAffinity(tc.affinity)).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestCreateMachinePVS(t *testing.T) {
 	var (
 		mockpowervs *mock.MockPowerVS
@@ -1378,6 +1883,86 @@ func TestCreateMachinePVS(t *testing.T) {
 			g.Expect(err).To(BeNil())
 		})
 
+		t.Run("Should create Machine with IP address allocated from IP address pool", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.ipAddress = &ipamv1.IPAddress{Spec: ipamv1.IPAddressSpec{Address: "192.168.10.10", Prefix: ptr.To[int32](24)}}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(params *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+				g.Expect(params.Networks).To(HaveLen(1))
+				g.Expect(*params.Networks[0].NetworkID).To(Equal(pvsNetwork))
+				g.Expect(params.Networks[0].IPAddress).To(Equal("192.168.10.10"))
+				return pvmInstanceList, nil
+			})
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine with boot volume in the storage tier and storage affinity of spec", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.IBMPowerVSMachine.Spec.BootVolume = &infrav1.PowerVSBootVolume{
+				StorageTier: infrav1.PowerVSStorageTierFixedIOPS,
+				StorageAffinity: &infrav1.PowerVSStorageAffinity{
+					Policy:    infrav1.PowerVSStorageAffinityPolicyAntiAffinity,
+					Instances: []string{"foo-machine-1"},
+				},
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).DoAndReturn(func(params *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
+				g.Expect(params.StorageType).To(Equal("tier5k"))
+				g.Expect(params.StoragePool).To(BeEmpty())
+				g.Expect(params.StorageAffinity).To(Equal(&models.StorageAffinity{
+					AffinityPolicy:           ptr.To(models.StorageAffinityAffinityPolicyAntiDashAffinity),
+					AntiAffinityPVMInstances: []string{"foo-machine-1"},
+				}))
+				return pvmInstanceList, nil
+			})
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should create Machine when it fits in the workspace quota", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.serviceInstanceID = "serviceInstanceID"
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetCloudInstance("serviceInstanceID").Return(&models.CloudInstance{
+				Limits: &models.CloudInstanceUsageLimits{Instances: ptr.To(10.0), Processors: ptr.To(4.0), Memory: ptr.To(64.0), Storage: ptr.To(2.0)},
+				Usage:  &models.CloudInstanceUsageLimits{Instances: ptr.To(1.0), Processors: ptr.To(1.0), Memory: ptr.To(8.0), Storage: ptr.To(0.5)},
+			}, nil)
+			mockpowervs.EXPECT().GetImage(pvsImage).Return(&models.Image{Size: ptr.To(120.0)}, nil)
+			mockpowervs.EXPECT().CreateInstance(gomock.AssignableToTypeOf(pvmInstanceCreate)).Return(pvmInstanceList, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+		})
+
+		t.Run("Should not create Machine when the workspace quota is exceeded", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+			scope.serviceInstanceID = "serviceInstanceID"
+			// the IBMPowerVSCluster status still reports the capacity available before the workspace was filled up.
+			scope.IBMPowerVSCluster.Status.WorkspaceQuota = &infrav1.WorkspaceQuotaStatus{
+				Available: infrav1.WorkspaceCapacity{Instances: ptr.To[int64](9)},
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(pvmInstances, nil)
+			mockpowervs.EXPECT().GetCloudInstance("serviceInstanceID").Return(&models.CloudInstance{
+				Limits: &models.CloudInstanceUsageLimits{Instances: ptr.To(10.0), Processors: ptr.To(4.0), Memory: ptr.To(64.0), Storage: ptr.To(2.0)},
+				Usage:  &models.CloudInstanceUsageLimits{Instances: ptr.To(10.0), Processors: ptr.To(1.0), Memory: ptr.To(8.0), Storage: ptr.To(0.5)},
+			}, nil)
+			mockpowervs.EXPECT().GetImage(pvsImage).Return(&models.Image{Size: ptr.To(120.0)}, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(errors.Is(err, ErrInsufficientWorkspaceQuota)).To(BeTrue())
+		})
+
 		t.Run("Return exsisting Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
@@ -2233,6 +2818,105 @@ func TestDeleteMachinePVS(t *testing.T) {
 	})
 }
 
+func TestReconcileMachineTags(t *testing.T) {
+	var (
+		mockpowervs *mock.MockPowerVS
+		mockgt      *gtmock.MockGlobalTagging
+		mockCtrl    *gomock.Controller
+	)
+
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockpowervs = mock.NewMockPowerVS(mockCtrl)
+		mockgt = gtmock.NewMockGlobalTagging(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	instance := &models.PVMInstance{
+		PvmInstanceID: ptr.To("instance-id"),
+		Crn:           models.CRN("instance-crn"),
+		VolumeIDs:     []string{"volume-id"},
+	}
+
+	t.Run("When no tags are set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.GlobalTaggingClient = mockgt
+		err := scope.ReconcileTags(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMPowerVSMachine.Status.Tags).To(BeNil())
+	})
+
+	t.Run("When cluster and machine tags are attached to the instance and its volumes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMPowerVSCluster.Spec.Tags = map[string]string{"owner": "finance", "env": "dev"}
+		scope.IBMPowerVSMachine.Spec.Tags = map[string]string{"env": "prod"}
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{Crn: models.CRN("volume-crn")}, nil)
+		mockgt.EXPECT().DetachUserTags(gomock.Len(0), []string{"instance-crn", "volume-crn"}).Return(nil)
+		mockgt.EXPECT().AttachUserTags([]string{"env:prod", "owner:finance"}, []string{"instance-crn", "volume-crn"}).Return(nil)
+		err := scope.ReconcileTags(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMPowerVSMachine.Status.Tags).To(Equal(&infrav1.TagsStatus{
+			Applied:   []string{"env:prod", "owner:finance"},
+			Resources: []string{"instance-id", "volume-id"},
+		}))
+	})
+
+	t.Run("When only the cluster UID tag is attached to the instance and its volumes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMPowerVSCluster.UID = "cluster-uid"
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(&models.Volume{Crn: models.CRN("volume-crn")}, nil)
+		mockgt.EXPECT().DetachUserTags(gomock.Len(0), []string{"instance-crn", "volume-crn"}).Return(nil)
+		mockgt.EXPECT().AttachUserTags([]string{"capibm-cluster-uid:cluster-uid"}, []string{"instance-crn", "volume-crn"}).Return(nil)
+		err := scope.ReconcileTags(ctx, instance)
+		g.Expect(err).To(BeNil())
+		g.Expect(scope.IBMPowerVSMachine.Status.Tags).To(Equal(&infrav1.TagsStatus{
+			Applied:   []string{"capibm-cluster-uid:cluster-uid"},
+			Resources: []string{"instance-id", "volume-id"},
+		}))
+	})
+
+	t.Run("When tags are already attached to the instance and its volumes", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMPowerVSMachine.Spec.Tags = map[string]string{"owner": "finance"}
+		scope.IBMPowerVSMachine.Status.Tags = &infrav1.TagsStatus{
+			Applied:   []string{"owner:finance"},
+			Resources: []string{"instance-id", "volume-id"},
+		}
+		err := scope.ReconcileTags(ctx, instance)
+		g.Expect(err).To(BeNil())
+	})
+
+	t.Run("When fetching a volume fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+		scope := setupPowerVSMachineScope(clusterName, machineName, ptr.To(pvsImage), ptr.To(pvsNetwork), true, mockpowervs)
+		scope.GlobalTaggingClient = mockgt
+		scope.IBMPowerVSMachine.Spec.Tags = map[string]string{"owner": "finance"}
+		mockpowervs.EXPECT().GetVolume("volume-id").Return(nil, errors.New("failed to get volume"))
+		err := scope.ReconcileTags(ctx, instance)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(scope.IBMPowerVSMachine.Status.Tags).To(BeNil())
+	})
+}
+
 func TestSetAddresses(t *testing.T) {
 	instanceName := "test_vm"
 	networkID := "test-net-ID"
@@ -2262,7 +2946,69 @@ func TestSetAddresses(t *testing.T) {
 		expectedError       error
 		dhcpCacheStoreFunc  func() cache.Store
 		setNetworkID        bool
+		ipAddress           *ipamv1.IPAddress
 	}{
+		{
+			testcase: "should set internal IP address from IP address allocated from IP address pool",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: newPowerVSInstance(instanceName, networkID, instanceMac),
+			expectedNodeAddress: append(defaultExpectedMachineAddress, clusterv1.MachineAddress{
+				Type:    clusterv1.MachineInternalIP,
+				Address: "192.168.10.10",
+			}),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+			ipAddress:          &ipamv1.IPAddress{Spec: ipamv1.IPAddressSpec{Address: "192.168.10.10"}},
+		},
+		{
+			testcase: "should set internal IP address from IP address pool when instance network only has external IP address",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						ExternalIP: "10.11.2.3",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress,
+				clusterv1.MachineAddress{
+					Type:    clusterv1.MachineExternalIP,
+					Address: "10.11.2.3",
+				},
+				clusterv1.MachineAddress{
+					Type:    clusterv1.MachineInternalIP,
+					Address: "192.168.10.10",
+				}),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+			ipAddress:          &ipamv1.IPAddress{Spec: ipamv1.IPAddressSpec{Address: "192.168.10.10"}},
+		},
+		{
+			testcase: "should not set IP address from IP address pool when instance network has internal IP address",
+			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
+				mockPowerVSClient := mock.NewMockPowerVS(ctrl)
+				return mockPowerVSClient
+			},
+			pvmInstance: &models.PVMInstance{
+				Networks: []*models.PVMInstanceNetwork{
+					{
+						IPAddress: "192.168.10.10",
+					},
+				},
+				ServerName: ptr.To(instanceName),
+			},
+			expectedNodeAddress: append(defaultExpectedMachineAddress, clusterv1.MachineAddress{
+				Type:    clusterv1.MachineInternalIP,
+				Address: "192.168.10.10",
+			}),
+			dhcpCacheStoreFunc: defaultDhcpCacheStoreFunc,
+			ipAddress:          &ipamv1.IPAddress{Spec: ipamv1.IPAddressSpec{Address: "192.168.10.10"}},
+		},
 		{
 			testcase: "should set external IP address from instance network",
 			powerVSClientFunc: func(ctrl *gomock.Controller) *mock.MockPowerVS {
@@ -2494,6 +3240,7 @@ func TestSetAddresses(t *testing.T) {
 			mockPowerVSClient := tc.powerVSClientFunc(ctrl)
 			scope := setupPowerVSMachineScope("test-cluster", "test-machine-0", ptr.To("test-image-ID"), &networkID, tc.setNetworkID, mockPowerVSClient)
 			scope.DHCPIPCacheStore = tc.dhcpCacheStoreFunc()
+			scope.ipAddress = tc.ipAddress
 			scope.SetAddresses(ctx, tc.pvmInstance)
 			g.Expect(scope.IBMPowerVSMachine.Status.Addresses).To(Equal(tc.expectedNodeAddress))
 		})
@@ -26,3 +26,13 @@ var (
 	// DHCPServerNotFound is the error returned when a DHCP server is not found.
 	DHCPServerNotFound = ResourceNotFound("dhcp server does not exist")
 )
+
+// ResourceVerificationResult holds the outcome of the verification of the resources referenced in the status of IBMPowerVSCluster.
+type ResourceVerificationResult struct {
+	// Missing are the resources that no longer exist and are reported.
+	Missing []string
+	// Recreating are the resources created by the controller that no longer exist, and whose status is cleared to create them again.
+	Recreating []string
+	// Drifted are the resources that no longer match spec.
+	Drifted []string
+}
@@ -482,6 +482,11 @@ func (s *ClusterScope) CreateLoadBalancer() (*vpcv1.LoadBalancer, error) {
 	options.SetResourceGroup(&vpcv1.ResourceGroupIdentity{
 		ID: &s.IBMVPCCluster.Spec.ResourceGroup,
 	})
+	if profile := s.IBMVPCCluster.Spec.ControlPlaneLoadBalancer.Profile; profile == infrav1.VPCLoadBalancerProfileNetworkFixed {
+		options.SetProfile(&vpcv1.LoadBalancerProfileIdentityByName{
+			Name: core.StringPtr(string(profile)),
+		})
+	}
 
 	if s.IBMVPCCluster.Status.Subnet.ID != nil {
 		subnet := &vpcv1.SubnetIdentity{
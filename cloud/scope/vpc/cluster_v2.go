@@ -20,12 +20,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"net/netip"
 	"reflect"
+	"slices"
+	"time"
 
 	"github.com/go-logr/logr"
 
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
@@ -41,11 +47,14 @@ import (
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcecontroller"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/resourcemanager"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
 )
@@ -57,10 +66,16 @@ const (
 	// vpcSubnetIPVersion4 defines the IP v4 string used for VPC Subnet generation.
 	vpcSubnetIPVersion4 = "ipv4"
 
+	// vpcSubnetPrefixLength defines the prefix length of the VPC Subnets generated, providing 256 IPs.
+	vpcSubnetPrefixLength = 24
+
 	// privateLBSuffix is used to tag a default Load Balancer name as private.
 	privateLBSuffix = "private"
 	// publicLBSuffix is used to tag a default Load Balancer name as public.
 	publicLBSuffix = "public"
+
+	// defaultResourceVerificationInterval is the time between two verifications of the resources referenced in the Network Status.
+	defaultResourceVerificationInterval = 10 * time.Minute
 )
 
 // ClusterScopeParamsV2 defines the input parameters used to create a new ClusterScopeV2.
@@ -81,9 +96,11 @@ type ClusterScopeV2 struct {
 	patchHelper *v1beta1patch.Helper
 
 	COSClient                cos.Cos
+	DNSServicesClient        dnsservices.DNSServices
 	GlobalTaggingClient      globaltagging.GlobalTagging
 	ResourceControllerClient resourcecontroller.ResourceController
 	ResourceManagerClient    resourcemanager.ResourceManager
+	TransitGatewayClient     transitgateway.TransitGateway
 	VPCClient                vpc.Vpc
 
 	Cluster         *clusterv1.Cluster
@@ -179,6 +196,41 @@ func NewClusterScopeV2(params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
 		return nil, fmt.Errorf("failed to create resource manager client: %w", err)
 	}
 
+	// Create DNS Services client, only when a DNS record is requested for the control plane endpoint.
+	var dnsServicesClient dnsservices.DNSServices
+	if params.IBMVPCCluster.Spec.DNS != nil {
+		dnsOptions := &dnssvcsv1.DnsSvcsV1Options{
+			Authenticator: auth,
+		}
+		// Override the DNS Services endpoint if provided.
+		if dnsEndpoint := endpoints.FetchEndpoints(string(endpoints.DNSServices), params.ServiceEndpoint); dnsEndpoint != "" {
+			dnsOptions.URL = dnsEndpoint
+			params.Logger.V(3).Info("Overriding the default DNS Services endpoint", "DNSServicesEndpoint", dnsEndpoint)
+		}
+		dnsServicesClient, err = dnsservices.NewService(dnsOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create DNS Services client: %w", err)
+		}
+	}
+
+	// Create Transit Gateway client, only when a Transit Gateway is requested to connect the VPC or remains to be cleaned up.
+	var transitGatewayClient transitgateway.TransitGateway
+	if (params.IBMVPCCluster.Spec.Network != nil && params.IBMVPCCluster.Spec.Network.TransitGateway != nil) ||
+		(params.IBMVPCCluster.Status.Network != nil && params.IBMVPCCluster.Status.Network.TransitGateway != nil) {
+		tgOptions := &tgapiv1.TransitGatewayApisV1Options{
+			Authenticator: auth,
+		}
+		// Override the Transit Gateway endpoint if provided.
+		if tgEndpoint := endpoints.FetchEndpoints(string(endpoints.TransitGateway), params.ServiceEndpoint); tgEndpoint != "" {
+			tgOptions.URL = tgEndpoint
+			params.Logger.V(3).Info("Overriding the default Transit Gateway endpoint", "TransitGatewayEndpoint", tgEndpoint)
+		}
+		transitGatewayClient, err = transitgateway.NewService(tgOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Transit Gateway client: %w", err)
+		}
+	}
+
 	clusterScope := &ClusterScopeV2{
 		Logger:                   params.Logger,
 		Client:                   params.Client,
@@ -186,9 +238,11 @@ func NewClusterScopeV2(params ClusterScopeParamsV2) (*ClusterScopeV2, error) {
 		Cluster:                  params.Cluster,
 		IBMVPCCluster:            params.IBMVPCCluster,
 		ServiceEndpoint:          params.ServiceEndpoint,
+		DNSServicesClient:        dnsServicesClient,
 		GlobalTaggingClient:      globalTaggingClient,
 		ResourceControllerClient: resourceControllerClient,
 		ResourceManagerClient:    resourceManagerClient,
+		TransitGatewayClient:     transitGatewayClient,
 		VPCClient:                vpcClient,
 	}
 	return clusterScope, nil
@@ -430,29 +484,94 @@ func (s *ClusterScopeV2) GetServiceName(resourceType infrav1.ResourceType) *stri
 	case infrav1.ResourceTypeVPC:
 		// Generate a name based off cluster name if no VPC defined in Spec, or no VPC name nor ID.
 		if s.NetworkSpec().VPC == nil || (s.NetworkSpec().VPC.Name == nil && s.NetworkSpec().VPC.ID == nil) {
-			return ptr.To(fmt.Sprintf("%s-vpc", s.Name()))
+			return s.generateServiceName(resourceType, fmt.Sprintf("%s-vpc", s.Name()))
 		}
 		if s.NetworkSpec().VPC.Name != nil {
 			return s.NetworkSpec().VPC.Name
 		}
 	case infrav1.ResourceTypeSubnet:
 		// Generate a generic subnet name based off the cluster name, which can be extended as necessary (for Zones).
-		return ptr.To(fmt.Sprintf("%s-subnet", s.IBMVPCCluster.Name))
+		return s.generateServiceName(resourceType, fmt.Sprintf("%s-subnet", s.IBMVPCCluster.Name))
 	case infrav1.ResourceTypePublicGateway:
 		// Generate a generic public gateway name based off the cluster name, which can be extedned as necessary (for Zone).
-		return ptr.To(fmt.Sprintf("%s-pgateway", s.IBMVPCCluster.Name))
+		return s.generateServiceName(resourceType, fmt.Sprintf("%s-pgateway", s.IBMVPCCluster.Name))
 	case infrav1.ResourceTypeLoadBalancer:
 		// Generate a generic load balancer name based off the cluster name, which can be extended as necessary (for public vs private).
-		return ptr.To(fmt.Sprintf("%s-lb", s.IBMVPCCluster.Name))
+		return s.generateServiceName(resourceType, fmt.Sprintf("%s-lb", s.IBMVPCCluster.Name))
 	case infrav1.ResourceTypeLoadBalancerPool:
 		// Generate a generic load balancer pool name based off the cluster name, which can be extended as necessary (for LB).
-		return ptr.To(fmt.Sprintf("%s-lbpool", s.IBMVPCCluster.Name))
+		return s.generateServiceName(resourceType, fmt.Sprintf("%s-lbpool", s.IBMVPCCluster.Name))
+	case infrav1.ResourceTypeTransitGateway:
+		// Generate a transit gateway name based off the cluster name if no name defined in Spec.
+		if s.TransitGatewaySpec() != nil && s.TransitGatewaySpec().Name != nil {
+			return s.TransitGatewaySpec().Name
+		}
+		return s.generateServiceName(resourceType, fmt.Sprintf("%s-transitgateway", s.IBMVPCCluster.Name))
 	default:
 		s.V(3).Info("unsupported resource type", "resourceType", resourceType)
 	}
 	return nil
 }
 
+// GetZonalServiceName returns the name of a resource created in each zone, like subnets and public gateways.
+// An error is returned when the resource name template fails to render, rather than falling back to the default name.
+func (s *ClusterScopeV2) GetZonalServiceName(resourceType infrav1.ResourceType, zone string) (*string, error) {
+	if s.IBMVPCCluster.Spec.ResourceNameTemplate == nil {
+		return ptr.To(fmt.Sprintf("%s-%s", *s.GetServiceName(resourceType), zone)), nil
+	}
+	name, err := genutil.RenderZonalResourceName(*s.IBMVPCCluster.Spec.ResourceNameTemplate, s.resourceNameTemplateData(resourceType, zone))
+	if err != nil {
+		return nil, fmt.Errorf("error generating the name of the %s in zone %s: %w", resourceType, zone, err)
+	}
+	return ptr.To(name), nil
+}
+
+// CheckResourceNameTemplate renders the resource name template of the cluster for every resource type named by the controller.
+// It is called before any resource is reconciled, so resources are never created under the default names when the template fails to render.
+func (s *ClusterScopeV2) CheckResourceNameTemplate() error {
+	if s.IBMVPCCluster.Spec.ResourceNameTemplate == nil {
+		return nil
+	}
+	for _, resourceType := range []infrav1.ResourceType{
+		infrav1.ResourceTypeVPC,
+		infrav1.ResourceTypeSubnet,
+		infrav1.ResourceTypePublicGateway,
+		infrav1.ResourceTypeLoadBalancer,
+		infrav1.ResourceTypeLoadBalancerPool,
+		infrav1.ResourceTypeTransitGateway,
+	} {
+		if _, err := genutil.RenderResourceName(*s.IBMVPCCluster.Spec.ResourceNameTemplate, s.resourceNameTemplateData(resourceType, "")); err != nil {
+			return fmt.Errorf("error generating the name of the %s: %w", resourceType, err)
+		}
+	}
+	return nil
+}
+
+// generateServiceName returns the name rendered from the resource name template of the cluster, or the default name when no template is set.
+// The controller stops reconciling the cluster when CheckResourceNameTemplate fails, so the default name is never used to create resources
+// for a template failing to render.
+func (s *ClusterScopeV2) generateServiceName(resourceType infrav1.ResourceType, defaultName string) *string {
+	if s.IBMVPCCluster.Spec.ResourceNameTemplate == nil {
+		return ptr.To(defaultName)
+	}
+	name, err := genutil.RenderResourceName(*s.IBMVPCCluster.Spec.ResourceNameTemplate, s.resourceNameTemplateData(resourceType, ""))
+	if err != nil {
+		s.Error(err, "failed to render resource name template, using the default name", "resourceType", resourceType)
+		return ptr.To(defaultName)
+	}
+	return ptr.To(name)
+}
+
+// resourceNameTemplateData returns the data the resource name template of the cluster is rendered with.
+func (s *ClusterScopeV2) resourceNameTemplateData(resourceType infrav1.ResourceType, zone string) genutil.ResourceNameTemplateData {
+	return genutil.ResourceNameTemplateData{
+		ClusterName:  s.IBMVPCCluster.Name,
+		Namespace:    s.IBMVPCCluster.Namespace,
+		ResourceType: string(resourceType),
+		Zone:         zone,
+	}
+}
+
 // GetSubnetID returns the ID of a subnet, provided the name.
 func (s *ClusterScopeV2) GetSubnetID(name string) (*string, error) {
 	// Check Status first
@@ -638,6 +757,217 @@ func (s *ClusterScopeV2) TagResource(tagName string, resourceCRN string) error {
 	return nil
 }
 
+// taggedResource is a resource referenced in status the user tags of the cluster are attached to.
+type taggedResource struct {
+	id string
+	// controllerCreated is whether the controller created the resource, nil when status does not track it.
+	controllerCreated *bool
+	// getCRN returns the CRN of the resource, nil when the resource no longer exists.
+	getCRN func() (*string, error)
+}
+
+// ReconcileTags attaches the user tags of the cluster and the cluster UID tag to the resources created for the cluster and detaches the tags removed from spec.
+// The tags are only attached again when the tags in spec or the resources in status change.
+func (s *ClusterScopeV2) ReconcileTags(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil {
+		return nil
+	}
+	tags := genutil.ClusterUserTags(s.IBMVPCCluster.UID, s.IBMVPCCluster.Spec.Tags)
+	resources := s.taggedResources()
+	resourceIDs := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		resourceIDs = append(resourceIDs, resource.id)
+	}
+	slices.Sort(resourceIDs)
+
+	tagsStatus := ptr.Deref(s.IBMVPCCluster.Status.Tags, infrav1.TagsStatus{})
+	if slices.Equal(tags, tagsStatus.Applied) && (len(tags) == 0 || slices.Equal(resourceIDs, tagsStatus.Resources)) {
+		return nil
+	}
+
+	var resourceCRNs []string
+	for _, resource := range resources {
+		crn, err := s.taggedResourceCRN(resource)
+		if err != nil {
+			return err
+		}
+		if crn != nil {
+			resourceCRNs = append(resourceCRNs, *crn)
+		}
+	}
+	removedTags := slices.DeleteFunc(slices.Clone(tagsStatus.Applied), func(tag string) bool {
+		return slices.Contains(tags, tag)
+	})
+	log.Info("Reconciling user tags of the cluster resources", "tags", tags, "removedTags", removedTags, "resources", len(resourceCRNs))
+	if err := s.GlobalTaggingClient.DetachUserTags(removedTags, resourceCRNs); err != nil {
+		return fmt.Errorf("error detaching user tags from the cluster resources: %w", err)
+	}
+	if err := s.GlobalTaggingClient.AttachUserTags(tags, resourceCRNs); err != nil {
+		return fmt.Errorf("error attaching user tags to the cluster resources: %w", err)
+	}
+
+	if len(tags) == 0 {
+		s.IBMVPCCluster.Status.Tags = nil
+		return nil
+	}
+	s.IBMVPCCluster.Status.Tags = &infrav1.TagsStatus{
+		Applied:   tags,
+		Resources: resourceIDs,
+	}
+	return nil
+}
+
+// taggedResourceCRN returns the CRN of the resource when it was created for the cluster, otherwise nil.
+func (s *ClusterScopeV2) taggedResourceCRN(resource taggedResource) (*string, error) {
+	if resource.controllerCreated != nil && !*resource.controllerCreated {
+		return nil, nil
+	}
+	crn, err := resource.getCRN()
+	if err != nil || crn == nil {
+		return nil, err
+	}
+	if resource.controllerCreated == nil {
+		// The resources created for the cluster are tagged with the cluster name.
+		attachedTags, err := s.GlobalTaggingClient.GetAttachedUserTags(*crn)
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving user tags of resource %s: %w", resource.id, err)
+		}
+		if !slices.Contains(attachedTags, s.Name()) && !slices.Contains(attachedTags, s.IBMVPCCluster.Name) {
+			return nil, nil
+		}
+	}
+	return crn, nil
+}
+
+// taggedResources returns the resources referenced in status the user tags of the cluster are attached to.
+func (s *ClusterScopeV2) taggedResources() []taggedResource { //nolint:gocyclo
+	var resources []taggedResource
+	networkStatus := s.NetworkStatus()
+	if networkStatus.VPC != nil && networkStatus.VPC.ID != "" {
+		id := networkStatus.VPC.ID
+		resources = append(resources, taggedResource{id: id, getCRN: func() (*string, error) {
+			vpcDetails, detailedResponse, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving vpc with id %s: %w", id, err)
+			}
+			if vpcDetails == nil {
+				return nil, nil
+			}
+			return vpcDetails.CRN, nil
+		}})
+	}
+	if s.IBMVPCCluster.Status.Image != nil && s.IBMVPCCluster.Status.Image.ID != "" {
+		id := s.IBMVPCCluster.Status.Image.ID
+		resources = append(resources, taggedResource{id: id, getCRN: func() (*string, error) {
+			imageDetails, detailedResponse, err := s.VPCClient.GetImage(&vpcv1.GetImageOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving image with id %s: %w", id, err)
+			}
+			if imageDetails == nil {
+				return nil, nil
+			}
+			return imageDetails.CRN, nil
+		}})
+	}
+	for _, subnets := range []map[string]*infrav1.ResourceStatus{networkStatus.ControlPlaneSubnets, networkStatus.WorkerSubnets} {
+		for _, subnet := range subnets {
+			if subnet == nil || subnet.ID == "" {
+				continue
+			}
+			id := subnet.ID
+			resources = append(resources, taggedResource{id: id, getCRN: func() (*string, error) {
+				subnetDetails, detailedResponse, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To(id)})
+				if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+					return nil, fmt.Errorf("error retrieving subnet with id %s: %w", id, err)
+				}
+				if subnetDetails == nil {
+					return nil, nil
+				}
+				return subnetDetails.CRN, nil
+			}})
+		}
+	}
+	for _, publicGateway := range networkStatus.PublicGateways {
+		if publicGateway == nil || publicGateway.ID == "" {
+			continue
+		}
+		id := publicGateway.ID
+		resources = append(resources, taggedResource{id: id, getCRN: func() (*string, error) {
+			publicGatewayDetails, detailedResponse, err := s.VPCClient.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving public gateway with id %s: %w", id, err)
+			}
+			if publicGatewayDetails == nil {
+				return nil, nil
+			}
+			return publicGatewayDetails.CRN, nil
+		}})
+	}
+	for _, securityGroup := range networkStatus.SecurityGroups {
+		if securityGroup == nil || securityGroup.ID == "" {
+			continue
+		}
+		id := securityGroup.ID
+		resources = append(resources, taggedResource{id: id, getCRN: func() (*string, error) {
+			securityGroupDetails, detailedResponse, err := s.VPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving security group with id %s: %w", id, err)
+			}
+			if securityGroupDetails == nil {
+				return nil, nil
+			}
+			return securityGroupDetails.CRN, nil
+		}})
+	}
+	for id, loadBalancer := range networkStatus.LoadBalancers {
+		if loadBalancer == nil {
+			continue
+		}
+		resources = append(resources, taggedResource{id: id, controllerCreated: loadBalancer.ControllerCreated, getCRN: func() (*string, error) {
+			loadBalancerDetails, detailedResponse, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving load balancer with id %s: %w", id, err)
+			}
+			if loadBalancerDetails == nil {
+				return nil, nil
+			}
+			return loadBalancerDetails.CRN, nil
+		}})
+	}
+	for _, endpointGateway := range networkStatus.EndpointGateways {
+		if endpointGateway == nil || endpointGateway.ID == "" {
+			continue
+		}
+		id := endpointGateway.ID
+		resources = append(resources, taggedResource{id: id, controllerCreated: endpointGateway.ControllerCreated, getCRN: func() (*string, error) {
+			endpointGatewayDetails, detailedResponse, err := s.VPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving endpoint gateway with id %s: %w", id, err)
+			}
+			if endpointGatewayDetails == nil {
+				return nil, nil
+			}
+			return endpointGatewayDetails.CRN, nil
+		}})
+	}
+	if transitGatewayStatus := s.TransitGatewayStatus(); transitGatewayStatus != nil && transitGatewayStatus.ID != nil {
+		id := *transitGatewayStatus.ID
+		// The Transit Gateway is not tagged with the cluster name, only the one created by the controller is tagged.
+		resources = append(resources, taggedResource{id: id, controllerCreated: ptr.To(ptr.Deref(transitGatewayStatus.ControllerCreated, false)), getCRN: func() (*string, error) {
+			transitGateway, detailedResponse, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{ID: ptr.To(id)})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				return nil, fmt.Errorf("error retrieving transit gateway with id %s: %w", id, err)
+			}
+			if transitGateway == nil {
+				return nil, nil
+			}
+			return transitGateway.Crn, nil
+		}})
+	}
+	return resources
+}
+
 // ReconcileVPC reconciles the cluster's VPC.
 func (s *ClusterScopeV2) ReconcileVPC(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -669,6 +999,13 @@ func (s *ClusterScopeV2) ReconcileVPC(ctx context.Context) (bool, error) {
 			Ready: !requeue,
 		})
 
+		// Once the VPC is available, make sure the address prefixes exist before any subnets get created within them.
+		if !requeue {
+			if err := s.reconcileVPCAddressPrefixes(ctx, vpcID); err != nil {
+				return false, fmt.Errorf("failed to reconcile vpc address prefixes: %w", err)
+			}
+		}
+
 		// After updating the Status of VPC, return with requeue or return as reconcile complete.
 		return requeue, nil
 	}
@@ -697,8 +1034,11 @@ func (s *ClusterScopeV2) createVPC() error {
 		vpcName = s.NetworkSpec().VPC.Name
 	}
 
-	// TODO(cjschaef): Look at adding support to specify prefix management
-	addressPrefixManagement := "auto"
+	// Default address prefixes are created in each zone, unless the address prefixes are managed manually.
+	addressPrefixManagement := string(infrav1.VPCAddressPrefixManagementAuto)
+	if s.NetworkSpec() != nil && s.NetworkSpec().AddressPrefixManagement != "" {
+		addressPrefixManagement = string(s.NetworkSpec().AddressPrefixManagement)
+	}
 	vpcOptions := &vpcv1.CreateVPCOptions{
 		AddressPrefixManagement: &addressPrefixManagement,
 		Name:                    vpcName,
@@ -727,6 +1067,76 @@ func (s *ClusterScopeV2) createVPC() error {
 	return nil
 }
 
+// reconcileVPCAddressPrefixes creates the address prefixes defined in the Network spec which do not exist in the VPC yet.
+// Existing address prefixes are matched by cidr and zone, and are never deleted. Once all the address prefixes are tracked in Status, the VPC is no longer queried.
+func (s *ClusterScopeV2) reconcileVPCAddressPrefixes(ctx context.Context, vpcID *string) error {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkSpec() == nil || len(s.NetworkSpec().AddressPrefixes) == 0 {
+		return nil
+	}
+
+	missingPrefixes := slices.DeleteFunc(slices.Clone(s.NetworkSpec().AddressPrefixes), func(addressPrefix infrav1.VPCAddressPrefix) bool {
+		if s.NetworkStatus() == nil {
+			return false
+		}
+		_, ok := s.NetworkStatus().AddressPrefixes[addressPrefix.CIDR]
+		return ok
+	})
+	if len(missingPrefixes) == 0 {
+		return nil
+	}
+
+	existingPrefixes, err := s.VPCClient.GetVPCAddressPrefixes(*vpcID)
+	if err != nil {
+		return fmt.Errorf("error listing vpc address prefixes: %w", err)
+	}
+
+	for _, addressPrefix := range missingPrefixes {
+		if index := slices.IndexFunc(existingPrefixes, func(existing vpcv1.AddressPrefix) bool {
+			return ptr.Deref(existing.CIDR, "") == addressPrefix.CIDR && existing.Zone != nil && ptr.Deref(existing.Zone.Name, "") == addressPrefix.Zone
+		}); index != -1 {
+			s.setAddressPrefixStatus(addressPrefix.CIDR, &infrav1.ResourceStatus{
+				ID:    ptr.Deref(existingPrefixes[index].ID, ""),
+				Name:  existingPrefixes[index].Name,
+				Ready: true,
+			})
+			continue
+		}
+
+		log.V(3).Info("Creating vpc address prefix", "cidr", addressPrefix.CIDR, "zone", addressPrefix.Zone)
+		createdPrefix, _, err := s.VPCClient.CreateVPCAddressPrefix(&vpcv1.CreateVPCAddressPrefixOptions{
+			VPCID: vpcID,
+			CIDR:  ptr.To(addressPrefix.CIDR),
+			Zone: &vpcv1.ZoneIdentity{
+				Name: ptr.To(addressPrefix.Zone),
+			},
+			Name: addressPrefix.Name,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating vpc address prefix %s in zone %s: %w", addressPrefix.CIDR, addressPrefix.Zone, err)
+		} else if createdPrefix == nil || createdPrefix.ID == nil {
+			return fmt.Errorf("error no address prefix details after creating address prefix %s in zone %s", addressPrefix.CIDR, addressPrefix.Zone)
+		}
+		s.setAddressPrefixStatus(addressPrefix.CIDR, &infrav1.ResourceStatus{
+			ID:    *createdPrefix.ID,
+			Name:  createdPrefix.Name,
+			Ready: true,
+		})
+	}
+	return nil
+}
+
+// setAddressPrefixStatus sets the Status of a VPC address prefix, keyed by its cidr.
+func (s *ClusterScopeV2) setAddressPrefixStatus(cidr string, resource *infrav1.ResourceStatus) {
+	if s.NetworkStatus() == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{}
+	}
+	if s.NetworkStatus().AddressPrefixes == nil {
+		s.IBMVPCCluster.Status.Network.AddressPrefixes = make(map[string]*infrav1.ResourceStatus)
+	}
+	s.IBMVPCCluster.Status.Network.AddressPrefixes[cidr] = resource
+}
+
 // ReconcileVPCCustomImage reconciles the VPC Custom Image.
 func (s *ClusterScopeV2) ReconcileVPCCustomImage(ctx context.Context) (bool, error) {
 	log := ctrl.LoggerFrom(ctx)
@@ -1066,12 +1476,55 @@ func (s *ClusterScopeV2) buildSubnetsForZones() ([]infrav1.Subnet, error) {
 	if len(zones) == 0 {
 		return subnets, fmt.Errorf("error retrieving subnet zones, no zones found in %s", s.IBMVPCCluster.Spec.Region)
 	}
+
+	// Collect the address prefixes of each zone, and the cidrs already claimed by the subnets in spec, to allocate the subnet cidrs from.
+	zonePrefixes := make(map[string][]netip.Prefix)
+	var reservedCIDRs []netip.Prefix
+	if s.NetworkSpec() != nil {
+		for _, addressPrefix := range s.NetworkSpec().AddressPrefixes {
+			prefix, err := netip.ParsePrefix(addressPrefix.CIDR)
+			if err != nil {
+				return subnets, fmt.Errorf("error parsing address prefix cidr %s: %w", addressPrefix.CIDR, err)
+			}
+			zonePrefixes[addressPrefix.Zone] = append(zonePrefixes[addressPrefix.Zone], prefix.Masked())
+		}
+		for _, subnet := range slices.Concat(s.NetworkSpec().ControlPlaneSubnets, s.NetworkSpec().WorkerSubnets) {
+			if subnet.Ipv4CidrBlock == nil {
+				continue
+			}
+			cidr, err := netip.ParsePrefix(*subnet.Ipv4CidrBlock)
+			if err != nil {
+				return subnets, fmt.Errorf("error parsing subnet cidr %s: %w", *subnet.Ipv4CidrBlock, err)
+			}
+			reservedCIDRs = append(reservedCIDRs, cidr.Masked())
+		}
+	}
+	manualPrefixes := s.NetworkSpec() != nil && s.NetworkSpec().AddressPrefixManagement == infrav1.VPCAddressPrefixManagementManual
+
 	for _, zone := range zones {
-		name := fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1.ResourceTypeSubnet), zone)
-		subnets = append(subnets, infrav1.Subnet{
-			Name: ptr.To(name),
+		name, err := s.GetZonalServiceName(infrav1.ResourceTypeSubnet, zone)
+		if err != nil {
+			return subnets, err
+		}
+		subnet := infrav1.Subnet{
+			Name: name,
 			Zone: ptr.To(zone),
-		})
+		}
+		if prefixes, ok := zonePrefixes[zone]; ok {
+			cidr, err := allocateSubnetCIDR(prefixes, reservedCIDRs, vpcSubnetPrefixLength)
+			if err != nil {
+				return subnets, fmt.Errorf("error allocating cidr for subnet %s: %w", *subnet.Name, err)
+			}
+			reservedCIDRs = append(reservedCIDRs, cidr)
+			subnet.Ipv4CidrBlock = ptr.To(cidr.String())
+		} else if manualPrefixes {
+			// Without a default address prefix, no subnet can be created in a zone with no address prefix defined.
+			continue
+		}
+		subnets = append(subnets, subnet)
+	}
+	if len(subnets) == 0 {
+		return subnets, fmt.Errorf("error no address prefixes defined for any zone in %s", s.IBMVPCCluster.Spec.Region)
 	}
 	return subnets, nil
 }
@@ -1099,6 +1552,7 @@ func (s *ClusterScopeV2) updateSubnetStatus(subnetDetails *vpcv1.Subnet, isContr
 
 // createSubnet creates a new VPC subnet.
 func (s *ClusterScopeV2) createSubnet(ctx context.Context, subnet infrav1.Subnet, isControlPlane bool) error {
+	log := ctrl.LoggerFrom(ctx)
 	// TODO(cjschaef): Move to webhook validation.
 	if subnet.Zone == nil {
 		return fmt.Errorf("error subnet zone must be defined for subnet %s", *subnet.Name)
@@ -1117,21 +1571,35 @@ func (s *ClusterScopeV2) createSubnet(ctx context.Context, subnet infrav1.Subnet
 		return fmt.Errorf("error retrieving vpc id for subnet creation: %w", err)
 	}
 
-	// NOTE(cjschaef): We likely will want to add support to use custom Address Prefixes.
-	// For now, we rely on the API to assign us prefixes, as we request via IP count.
-	var ipCount int64 = 256
+	// When no cidr is provided, we rely on the API to assign us a cidr from the zone's address prefixes, as we request via IP count.
+	var ipCount int64 = 1 << (32 - vpcSubnetPrefixLength)
 	// We currnetly only support IP v4.
 	ipVersion := vpcSubnetIPVersion4
 
 	// Find or create a Public Gateway in this zone for the subnet, only one Public Gateway is required for each zone, for this cluster.
-	// NOTE(cjschaef): We may need to add support to not attach Public Gateways to subnets.
-	publicGateway, err := s.findOrCreatePublicGateway(ctx, *subnet.Zone)
-	if err != nil {
-		return fmt.Errorf("error failed to find or create public gateway for subnet %s: %w", *subnet.Name, err)
+	// Unless the subnet egress is managed outside of the cluster, or an existing Public Gateway was requested.
+	var publicGateway *vpcv1.PublicGateway
+	egress := s.subnetEgress(subnet)
+	switch {
+	case egress.Mode == infrav1.VPCEgressModeNone:
+		log.V(3).Info("Skipping public gateway for subnet as egress mode is None", "subnetName", subnet.Name)
+	case egress.PublicGateway != nil:
+		publicGateway, err = s.getExistingPublicGateway(*egress.PublicGateway)
+		if err != nil {
+			return fmt.Errorf("error failed to retrieve existing public gateway for subnet %s: %w", *subnet.Name, err)
+		}
+		if publicGateway.Zone == nil || ptr.Deref(publicGateway.Zone.Name, "") != *subnet.Zone {
+			return fmt.Errorf("error existing public gateway %s is not in zone %s of subnet %s", ptr.Deref(publicGateway.Name, ""), *subnet.Zone, *subnet.Name)
+		}
+	default:
+		publicGateway, err = s.findOrCreatePublicGateway(ctx, *subnet.Zone)
+		if err != nil {
+			return fmt.Errorf("error failed to find or create public gateway for subnet %s: %w", *subnet.Name, err)
+		}
 	}
 
 	options := &vpcv1.CreateSubnetOptions{}
-	options.SetSubnetPrototype(&vpcv1.SubnetPrototype{
+	subnetPrototype := &vpcv1.SubnetPrototype{
 		IPVersion:             ptr.To(ipVersion),
 		TotalIpv4AddressCount: ptr.To(ipCount),
 		Name:                  subnet.Name,
@@ -1144,10 +1612,18 @@ func (s *ClusterScopeV2) createSubnet(ctx context.Context, subnet infrav1.Subnet
 		ResourceGroup: &vpcv1.ResourceGroupIdentity{
 			ID: ptr.To(resourceGroupID),
 		},
-		PublicGateway: &vpcv1.PublicGatewayIdentity{
+	}
+	if publicGateway != nil {
+		subnetPrototype.PublicGateway = &vpcv1.PublicGatewayIdentity{
 			ID: publicGateway.ID,
-		},
-	})
+		}
+	}
+	// A cidr provided, or allocated within the address prefixes, replaces the IP count.
+	if subnet.Ipv4CidrBlock != nil {
+		subnetPrototype.Ipv4CIDRBlock = subnet.Ipv4CidrBlock
+		subnetPrototype.TotalIpv4AddressCount = nil
+	}
+	options.SetSubnetPrototype(subnetPrototype)
 
 	// Create subnet.
 	subnetDetails, _, err := s.VPCClient.CreateSubnet(options)
@@ -1179,10 +1655,60 @@ func (s *ClusterScopeV2) createSubnet(ctx context.Context, subnet infrav1.Subnet
 	return nil
 }
 
+// subnetEgress returns the egress of the subnet, falling back to the egress of the network, or a Public Gateway created by the controller if neither is defined.
+func (s *ClusterScopeV2) subnetEgress(subnet infrav1.Subnet) infrav1.VPCEgress {
+	egress := infrav1.VPCEgress{
+		Mode: infrav1.VPCEgressModePublicGateway,
+	}
+	if subnet.Egress != nil {
+		egress = *subnet.Egress
+	} else if s.NetworkSpec() != nil && s.NetworkSpec().Egress != nil {
+		egress = *s.NetworkSpec().Egress
+	}
+	if egress.Mode == "" {
+		egress.Mode = infrav1.VPCEgressModePublicGateway
+	}
+	return egress
+}
+
+// getExistingPublicGateway retrieves an existing Public Gateway by id, or by name within the network Resource Group.
+func (s *ClusterScopeV2) getExistingPublicGateway(reference infrav1.VPCResource) (*vpcv1.PublicGateway, error) {
+	if reference.ID != nil {
+		publicGateway, _, err := s.VPCClient.GetPublicGateway(&vpcv1.GetPublicGatewayOptions{
+			ID: reference.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving public gateway by id %s: %w", *reference.ID, err)
+		} else if publicGateway == nil {
+			return nil, fmt.Errorf("error failed to find public gateway with id %s", *reference.ID)
+		}
+		return publicGateway, nil
+	}
+
+	if reference.Name == nil {
+		return nil, fmt.Errorf("error public gateway has no defined id or name, one is required")
+	}
+	resourceGroupID, err := s.GetNetworkResourceGroupID()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving resource group id for public gateway: %w", err)
+	}
+	publicGateway, err := s.VPCClient.GetVPCPublicGatewayByName(*reference.Name, resourceGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving public gateway by name %s: %w", *reference.Name, err)
+	} else if publicGateway == nil || publicGateway.ID == nil {
+		return nil, fmt.Errorf("error failed to find public gateway with name %s", *reference.Name)
+	}
+	return publicGateway, nil
+}
+
 // findOrCreatePublicGateway will attempt to find if there is an existing Public Gateway for a specific zone, for the cluster (in cluster's Resource Group and VPC), or create a new one. Only one Public Gateway is required in each zone, for any subnets in that zone.
 func (s *ClusterScopeV2) findOrCreatePublicGateway(ctx context.Context, zone string) (*vpcv1.PublicGateway, error) {
 	log := ctrl.LoggerFrom(ctx)
-	publicGatewayName := fmt.Sprintf("%s-%s", *s.GetServiceName(infrav1.ResourceTypePublicGateway), zone)
+	name, err := s.GetZonalServiceName(infrav1.ResourceTypePublicGateway, zone)
+	if err != nil {
+		return nil, err
+	}
+	publicGatewayName := *name
 	// We will use the cluster Resource Group ID, as we expect to create all resources (Public Gateways and Subnets) in that Resource Group.
 	resourceGroupID, err := s.GetResourceGroupID()
 	if err != nil {
@@ -1828,6 +2354,14 @@ func (s *ClusterScopeV2) ReconcileLoadBalancers(ctx context.Context) (bool, erro
 			// If the Load Balancer status isn't ready, flag for requeue and continue to next Load Balancer.
 			if isReady := s.isLoadBalancerReady(ctx, lbStatus.State); !isReady {
 				requeue = true
+				continue
+			}
+			// Reconcile the Pools and Listeners of the active Load Balancer, any change requires a requeue.
+			updated, err := s.reconcileLoadBalancerResources(ctx, loadBalancer, *lbStatus.ID)
+			if err != nil {
+				return false, fmt.Errorf("error reconciling load balancer pools and listeners: %w", err)
+			} else if updated {
+				requeue = true
 			}
 			continue
 		}
@@ -1935,11 +2469,24 @@ func (s *ClusterScopeV2) createLoadBalancer(ctx context.Context, loadBalancer in
 		ID: &resourceGroupID,
 	})
 
+	// Only a network load balancer requires the profile, the API defaults to an application load balancer.
+	isNetworkLoadBalancer := loadBalancer.Profile == infrav1.VPCLoadBalancerProfileNetworkFixed
+	if isNetworkLoadBalancer {
+		options.SetProfile(&vpcv1.LoadBalancerProfileIdentityByName{
+			Name: ptr.To(string(loadBalancer.Profile)),
+		})
+	}
+
 	// Build the load balancer's subnets, requiring subnet ID's.
 	subnetIDs, err := s.getLoadBalancerSubnetIDs(loadBalancer)
 	if err != nil {
 		return fmt.Errorf("error collecting load balancer subnets: %w", err)
 	}
+	// A network load balancer supports a single subnet, when defaulting to the Control Plane subnets use the first one.
+	if isNetworkLoadBalancer && len(subnetIDs) > 1 {
+		log.V(3).Info("network load balancer supports a single subnet, using the first subnet", "loadBalancerName", loadBalancer.Name, "subnetID", subnetIDs[0])
+		subnetIDs = subnetIDs[:1]
+	}
 	for _, subnetID := range subnetIDs {
 		subnet := &vpcv1.SubnetIdentityByID{
 			ID: ptr.To(subnetID),
@@ -1953,6 +2500,14 @@ func (s *ClusterScopeV2) createLoadBalancer(ctx context.Context, loadBalancer in
 	if err != nil {
 		return fmt.Errorf("error collecting load balancer security groups: %w", err)
 	}
+	if isNetworkLoadBalancer && len(securityGroupIDs) > 0 {
+		supported, err := s.loadBalancerProfileSupportsSecurityGroups(loadBalancer.Profile)
+		if err != nil {
+			return err
+		} else if !supported {
+			return fmt.Errorf("error load balancer profile %s does not support security groups", loadBalancer.Profile)
+		}
+	}
 	for _, securityGroupID := range securityGroupIDs {
 		sg := &vpcv1.SecurityGroupIdentityByID{
 			ID: ptr.To(securityGroupID),
@@ -2018,6 +2573,26 @@ func (s *ClusterScopeV2) createLoadBalancer(ctx context.Context, loadBalancer in
 	return nil
 }
 
+// loadBalancerProfileSupportsSecurityGroups checks whether security groups can be attached to a load balancer with the profile.
+func (s *ClusterScopeV2) loadBalancerProfileSupportsSecurityGroups(profile infrav1.VPCLoadBalancerProfile) (bool, error) {
+	profileDetails, _, err := s.VPCClient.GetLoadBalancerProfile(&vpcv1.GetLoadBalancerProfileOptions{
+		Name: ptr.To(string(profile)),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error retrieving load balancer profile %s: %w", profile, err)
+	} else if profileDetails == nil {
+		return false, fmt.Errorf("error load balancer profile not found: %s", profile)
+	}
+
+	// Older profiles report a fixed value of false, profiles with a dependent value support security groups.
+	if supported, ok := profileDetails.SecurityGroupsSupported.(*vpcv1.LoadBalancerProfileSecurityGroupsSupported); ok && supported != nil {
+		if ptr.Deref(supported.Type, "") == vpcv1.LoadBalancerProfileSecurityGroupsSupportedTypeFixedConst {
+			return ptr.Deref(supported.Value, false), nil
+		}
+	}
+	return true, nil
+}
+
 // getLoadBalancerSubnetIDs builds the set of subnet ID's for a load balancer, or defaults to the Control Plane subnet ID's if no subnets were provided. This will attempt to transform subnet names into their respective ID's.
 func (s *ClusterScopeV2) getLoadBalancerSubnetIDs(loadBalancer infrav1.VPCLoadBalancerSpec) ([]string, error) {
 	subnetIDs := make([]string, 0)
@@ -2165,24 +2740,1205 @@ func (s *ClusterScopeV2) buildLoadBalancerListener(additionalListener infrav1.Ad
 			Name: additionalListener.DefaultPoolName,
 		}
 	}
+	// Set the certificate to terminate TLS if it was defined.
+	if additionalListener.CertificateCRN != nil {
+		listener.CertificateInstance = &vpcv1.CertificateInstanceIdentityByCRN{
+			CRN: additionalListener.CertificateCRN,
+		}
+	}
+	if additionalListener.IdleConnectionTimeout != nil {
+		listener.IdleConnectionTimeout = additionalListener.IdleConnectionTimeout
+	}
+	// NOTE: The HTTPS redirect references the target Listener by ID, so it can only be set once the Load Balancer has been created, see reconcileLoadBalancerListeners.
 
 	return listener
 }
 
-// getDefaultLoadBalancerListeners returns a list of default Load Balancer Listeners for a Load Balancer.
-func (s *ClusterScopeV2) getDefaultLoadBalancerListeners(defaultBackendPool bool) []vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext {
-	defaultListeners := make([]vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext, 0, 1)
+// reconcileLoadBalancerResources reconciles the Pools and Listeners of the Load Balancer against the spec, recording their ID's in status.
+// Load Balancers using the default Pools and Listeners are not reconciled.
+// As the Load Balancer is not active while being updated, at most one change is made per call, returning true if a change was made.
+func (s *ClusterScopeV2) reconcileLoadBalancerResources(ctx context.Context, loadBalancer infrav1.VPCLoadBalancerSpec, loadBalancerID string) (bool, error) {
+	lbStatus, ok := s.NetworkStatus().LoadBalancers[loadBalancerID]
+	if !ok {
+		return false, fmt.Errorf("error load balancer status not found: %s", loadBalancerID)
+	}
+	if loadBalancer.BackendPools == nil && loadBalancer.AdditionalListeners == nil && len(lbStatus.Listeners) == 0 {
+		return false, nil
+	}
 
-	// For now only one default listener is expected.
-	defaultListener := infrav1.AdditionalListenerSpec{
-		Port:     int64(s.GetAPIServerPort()),
-		Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolTCP),
+	loadBalancerDetails, _, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+		ID: ptr.To(loadBalancerID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error retrieving load balancer %s: %w", loadBalancerID, err)
+	} else if loadBalancerDetails == nil {
+		return false, fmt.Errorf("error load balancer not found: %s", loadBalancerID)
 	}
 
-	if defaultBackendPool {
-		defaultListener.DefaultPoolName = s.GetServiceName(infrav1.ResourceTypeLoadBalancerPool)
+	// Collect the Load Balancer's Pools by name.
+	pools := make(map[string]*vpcv1.LoadBalancerPool)
+	poolCollection, _, err := s.VPCClient.ListLoadBalancerPools(&vpcv1.ListLoadBalancerPoolsOptions{
+		LoadBalancerID: ptr.To(loadBalancerID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing load balancer pools for load balancer %s: %w", loadBalancerID, err)
+	} else if poolCollection != nil {
+		for i := range poolCollection.Pools {
+			if poolCollection.Pools[i].Name != nil {
+				pools[*poolCollection.Pools[i].Name] = &poolCollection.Pools[i]
+			}
+		}
 	}
 
-	defaultListeners = append(defaultListeners, s.buildLoadBalancerListener(defaultListener))
-	return defaultListeners
+	// Collect the Load Balancer's Listeners by port.
+	listeners := make(map[int64]*vpcv1.LoadBalancerListener)
+	for _, listenerReference := range loadBalancerDetails.Listeners {
+		listener, _, err := s.VPCClient.GetLoadBalancerListener(&vpcv1.GetLoadBalancerListenerOptions{
+			LoadBalancerID: ptr.To(loadBalancerID),
+			ID:             listenerReference.ID,
+		})
+		if err != nil {
+			return false, fmt.Errorf("error retrieving load balancer listener %s: %w", *listenerReference.ID, err)
+		}
+		if listener.Port != nil {
+			listeners[*listener.Port] = listener
+		}
+	}
+
+	// Collect the Listeners previously created for the spec which were removed from it, to be deleted if pruning is enabled.
+	var prunedListeners []infrav1.VPCLoadBalancerListenerStatus
+	if ptr.Deref(loadBalancer.PruneListeners, false) {
+		desiredPorts := make(map[int64]bool)
+		for _, additionalListener := range loadBalancer.AdditionalListeners {
+			desiredPorts[additionalListener.Port] = true
+		}
+		for _, listenerStatus := range lbStatus.Listeners {
+			if listener, ok := listeners[listenerStatus.Port]; ok && !desiredPorts[listenerStatus.Port] {
+				prunedListeners = append(prunedListeners, infrav1.VPCLoadBalancerListenerStatus{Port: listenerStatus.Port, ID: listener.ID})
+			}
+		}
+	}
+
+	// Record the Pools and Listeners of the spec, along with the Listeners pending deletion.
+	lbStatus.Pools = nil
+	for _, backendPool := range loadBalancer.BackendPools {
+		if backendPool.Name == nil {
+			continue
+		}
+		if pool, ok := pools[*backendPool.Name]; ok {
+			lbStatus.Pools = append(lbStatus.Pools, infrav1.VPCLoadBalancerPoolStatus{Name: *backendPool.Name, ID: pool.ID})
+		}
+	}
+	lbStatus.Listeners = nil
+	for _, additionalListener := range loadBalancer.AdditionalListeners {
+		if listener, ok := listeners[additionalListener.Port]; ok {
+			lbStatus.Listeners = append(lbStatus.Listeners, infrav1.VPCLoadBalancerListenerStatus{Port: additionalListener.Port, ID: listener.ID})
+		}
+	}
+	lbStatus.Listeners = append(lbStatus.Listeners, prunedListeners...)
+
+	// Pools are reconciled first, as Listeners reference their default Pool.
+	if updated, err := s.reconcileLoadBalancerPools(ctx, loadBalancer, loadBalancerID, pools); err != nil || updated {
+		return updated, err
+	}
+	if updated, err := s.reconcileLoadBalancerListeners(ctx, loadBalancer, loadBalancerID, pools, listeners); err != nil || updated {
+		return updated, err
+	}
+
+	for _, listenerStatus := range prunedListeners {
+		ctrl.LoggerFrom(ctx).Info("deleting load balancer listener", "loadBalancerID", loadBalancerID, "listenerPort", listenerStatus.Port)
+		if _, err := s.VPCClient.DeleteLoadBalancerListener(&vpcv1.DeleteLoadBalancerListenerOptions{
+			LoadBalancerID: ptr.To(loadBalancerID),
+			ID:             listenerStatus.ID,
+		}); err != nil {
+			return false, fmt.Errorf("error deleting load balancer listener with port %d: %w", listenerStatus.Port, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// reconcileLoadBalancerPools creates the missing Pools of the Load Balancer, or updates the algorithm, protocol and health monitor of a Pool which does not match the spec.
+// At most one Pool is created or updated per call, returning true if a Pool was changed.
+func (s *ClusterScopeV2) reconcileLoadBalancerPools(ctx context.Context, loadBalancer infrav1.VPCLoadBalancerSpec, loadBalancerID string, pools map[string]*vpcv1.LoadBalancerPool) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	for _, backendPool := range loadBalancer.BackendPools {
+		// Pools without a name are named by the VPC service and cannot be matched against the spec.
+		if backendPool.Name == nil {
+			continue
+		}
+		pool, ok := pools[*backendPool.Name]
+		if !ok {
+			poolPrototype := s.buildLoadBalancerBackendPool(backendPool)
+			log.Info("creating load balancer pool", "loadBalancerID", loadBalancerID, "backendPoolName", *backendPool.Name)
+			if _, _, err := s.VPCClient.CreateLoadBalancerPool(&vpcv1.CreateLoadBalancerPoolOptions{
+				LoadBalancerID: ptr.To(loadBalancerID),
+				Algorithm:      poolPrototype.Algorithm,
+				HealthMonitor:  poolPrototype.HealthMonitor,
+				Name:           poolPrototype.Name,
+				Protocol:       poolPrototype.Protocol,
+			}); err != nil {
+				return false, fmt.Errorf("error creating load balancer pool %s: %w", *backendPool.Name, err)
+			}
+			return true, nil
+		}
+		poolPatch, err := loadBalancerPoolPatch(backendPool, pool)
+		if err != nil {
+			return false, fmt.Errorf("error building patch for load balancer pool %s: %w", *backendPool.Name, err)
+		} else if poolPatch == nil {
+			continue
+		}
+		log.Info("updating load balancer pool", "loadBalancerID", loadBalancerID, "backendPoolName", *backendPool.Name)
+		if _, _, err := s.VPCClient.UpdateLoadBalancerPool(&vpcv1.UpdateLoadBalancerPoolOptions{
+			LoadBalancerID:        ptr.To(loadBalancerID),
+			ID:                    pool.ID,
+			LoadBalancerPoolPatch: poolPatch,
+		}); err != nil {
+			return false, fmt.Errorf("error updating load balancer pool %s: %w", *backendPool.Name, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// loadBalancerPoolPatch returns the patch to apply to the Pool to match the spec, or nil if the Pool is up to date.
+func loadBalancerPoolPatch(backendPool infrav1.VPCLoadBalancerBackendPoolSpec, pool *vpcv1.LoadBalancerPool) (map[string]interface{}, error) {
+	poolPatch := &vpcv1.LoadBalancerPoolPatch{}
+	changed := false
+	if ptr.Deref(pool.Algorithm, "") != string(backendPool.Algorithm) {
+		poolPatch.Algorithm = ptr.To(string(backendPool.Algorithm))
+		changed = true
+	}
+	if ptr.Deref(pool.Protocol, "") != string(backendPool.Protocol) {
+		poolPatch.Protocol = ptr.To(string(backendPool.Protocol))
+		changed = true
+	}
+	monitor := backendPool.HealthMonitor
+	current := loadBalancerPoolHealthMonitor(pool.HealthMonitor)
+	if current == nil || ptr.Deref(current.Delay, 0) != monitor.Delay || ptr.Deref(current.MaxRetries, 0) != monitor.Retries || ptr.Deref(current.Timeout, 0) != monitor.Timeout ||
+		ptr.Deref(current.Type, "") != string(monitor.Type) || ptr.Deref(current.Port, 0) != ptr.Deref(monitor.Port, 0) || ptr.Deref(current.URLPath, "") != ptr.Deref(monitor.URLPath, "") {
+		poolPatch.HealthMonitor = &vpcv1.LoadBalancerPoolHealthMonitorPatch{
+			Delay:      ptr.To(monitor.Delay),
+			MaxRetries: ptr.To(monitor.Retries),
+			Timeout:    ptr.To(monitor.Timeout),
+			Type:       ptr.To(string(monitor.Type)),
+			Port:       monitor.Port,
+			URLPath:    monitor.URLPath,
+		}
+		changed = true
+	}
+	if !changed {
+		return nil, nil
+	}
+	return poolPatch.AsPatch()
+}
+
+// loadBalancerPoolHealthMonitor returns the health monitor of a Pool, regardless of its type.
+func loadBalancerPoolHealthMonitor(healthMonitor vpcv1.LoadBalancerPoolHealthMonitorIntf) *vpcv1.LoadBalancerPoolHealthMonitor {
+	switch monitor := healthMonitor.(type) {
+	case *vpcv1.LoadBalancerPoolHealthMonitor:
+		return monitor
+	case *vpcv1.LoadBalancerPoolHealthMonitorTypeHttphttps:
+		return &vpcv1.LoadBalancerPoolHealthMonitor{
+			Delay:      monitor.Delay,
+			MaxRetries: monitor.MaxRetries,
+			Port:       monitor.Port,
+			Timeout:    monitor.Timeout,
+			Type:       monitor.Type,
+			URLPath:    monitor.URLPath,
+		}
+	case *vpcv1.LoadBalancerPoolHealthMonitorTypeTCP:
+		return &vpcv1.LoadBalancerPoolHealthMonitor{
+			Delay:      monitor.Delay,
+			MaxRetries: monitor.MaxRetries,
+			Port:       monitor.Port,
+			Timeout:    monitor.Timeout,
+			Type:       monitor.Type,
+		}
+	}
+	return nil
+}
+
+// reconcileLoadBalancerListeners creates the missing Listeners of the Load Balancer, or updates the default Pool, certificate, HTTPS redirect and idle connection timeout of a Listener which does not match the spec.
+// At most one Listener is created or updated per call, returning true if a Listener was changed.
+func (s *ClusterScopeV2) reconcileLoadBalancerListeners(ctx context.Context, loadBalancer infrav1.VPCLoadBalancerSpec, loadBalancerID string, pools map[string]*vpcv1.LoadBalancerPool, listeners map[int64]*vpcv1.LoadBalancerListener) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	for _, additionalListener := range loadBalancer.AdditionalListeners {
+		var defaultPoolID *string
+		if additionalListener.DefaultPoolName != nil {
+			pool, ok := pools[*additionalListener.DefaultPoolName]
+			if !ok {
+				return false, fmt.Errorf("error default pool %s not found for load balancer listener with port %d", *additionalListener.DefaultPoolName, additionalListener.Port)
+			}
+			defaultPoolID = pool.ID
+		}
+
+		listener, ok := listeners[additionalListener.Port]
+		if !ok {
+			listenerPrototype := s.buildLoadBalancerListener(additionalListener)
+			options := &vpcv1.CreateLoadBalancerListenerOptions{
+				LoadBalancerID:        ptr.To(loadBalancerID),
+				Port:                  listenerPrototype.Port,
+				Protocol:              listenerPrototype.Protocol,
+				CertificateInstance:   listenerPrototype.CertificateInstance,
+				IdleConnectionTimeout: listenerPrototype.IdleConnectionTimeout,
+			}
+			if defaultPoolID != nil {
+				options.DefaultPool = &vpcv1.LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID{
+					ID: defaultPoolID,
+				}
+			}
+			log.Info("creating load balancer listener", "loadBalancerID", loadBalancerID, "listenerPort", additionalListener.Port)
+			if _, _, err := s.VPCClient.CreateLoadBalancerListener(options); err != nil {
+				return false, fmt.Errorf("error creating load balancer listener with port %d: %w", additionalListener.Port, err)
+			}
+			return true, nil
+		}
+
+		listenerPatch, err := loadBalancerListenerPatch(additionalListener, listener, listeners, defaultPoolID)
+		if err != nil {
+			return false, fmt.Errorf("error building patch for load balancer listener with port %d: %w", additionalListener.Port, err)
+		} else if listenerPatch == nil {
+			continue
+		}
+		log.Info("updating load balancer listener", "loadBalancerID", loadBalancerID, "listenerPort", additionalListener.Port)
+		if _, _, err := s.VPCClient.UpdateLoadBalancerListener(&vpcv1.UpdateLoadBalancerListenerOptions{
+			LoadBalancerID:            ptr.To(loadBalancerID),
+			ID:                        listener.ID,
+			LoadBalancerListenerPatch: listenerPatch,
+		}); err != nil {
+			return false, fmt.Errorf("error updating load balancer listener with port %d: %w", additionalListener.Port, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// loadBalancerListenerPatch returns the patch to apply to the Listener to match the spec, or nil if the Listener is up to date.
+func loadBalancerListenerPatch(additionalListener infrav1.AdditionalListenerSpec, listener *vpcv1.LoadBalancerListener, listeners map[int64]*vpcv1.LoadBalancerListener, defaultPoolID *string) (map[string]interface{}, error) {
+	listenerPatch := &vpcv1.LoadBalancerListenerPatch{}
+	changed := false
+	if defaultPoolID != nil && (listener.DefaultPool == nil || ptr.Deref(listener.DefaultPool.ID, "") != *defaultPoolID) {
+		listenerPatch.DefaultPool = &vpcv1.LoadBalancerListenerDefaultPoolPatchLoadBalancerPoolIdentityByID{
+			ID: defaultPoolID,
+		}
+		changed = true
+	}
+	if additionalListener.CertificateCRN != nil && (listener.CertificateInstance == nil || ptr.Deref(listener.CertificateInstance.CRN, "") != *additionalListener.CertificateCRN) {
+		listenerPatch.CertificateInstance = &vpcv1.CertificateInstanceIdentityByCRN{
+			CRN: additionalListener.CertificateCRN,
+		}
+		changed = true
+	}
+	if additionalListener.IdleConnectionTimeout != nil && ptr.Deref(listener.IdleConnectionTimeout, 0) != *additionalListener.IdleConnectionTimeout {
+		listenerPatch.IdleConnectionTimeout = additionalListener.IdleConnectionTimeout
+		changed = true
+	}
+	removeRedirect := false
+	if redirect := additionalListener.HTTPSRedirect; redirect != nil {
+		target, ok := listeners[redirect.ListenerPort]
+		if !ok {
+			return nil, fmt.Errorf("error redirect listener with port %d not found", redirect.ListenerPort)
+		}
+		statusCode := ptr.Deref(redirect.HTTPStatusCode, 301)
+		current := listener.HTTPSRedirect
+		if current == nil || current.Listener == nil || ptr.Deref(current.Listener.ID, "") != *target.ID || ptr.Deref(current.HTTPStatusCode, 0) != statusCode || ptr.Deref(current.URI, "") != ptr.Deref(redirect.URI, "") {
+			listenerPatch.HTTPSRedirect = &vpcv1.LoadBalancerListenerHTTPSRedirectPatch{
+				HTTPStatusCode: ptr.To(statusCode),
+				Listener: &vpcv1.LoadBalancerListenerIdentityByID{
+					ID: target.ID,
+				},
+				URI: redirect.URI,
+			}
+			changed = true
+		}
+	} else if listener.HTTPSRedirect != nil {
+		removeRedirect = true
+	}
+	if !changed && !removeRedirect {
+		return nil, nil
+	}
+
+	patch, err := listenerPatch.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	// A nil redirect is omitted from the patch, so explicitly remove it.
+	if removeRedirect {
+		patch["https_redirect"] = nil
+	}
+	return patch, nil
+}
+
+// getDefaultLoadBalancerListeners returns a list of default Load Balancer Listeners for a Load Balancer.
+func (s *ClusterScopeV2) getDefaultLoadBalancerListeners(defaultBackendPool bool) []vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext {
+	defaultListeners := make([]vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext, 0, 1)
+
+	// For now only one default listener is expected.
+	defaultListener := infrav1.AdditionalListenerSpec{
+		Port:     int64(s.GetAPIServerPort()),
+		Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolTCP),
+	}
+
+	if defaultBackendPool {
+		defaultListener.DefaultPoolName = s.GetServiceName(infrav1.ResourceTypeLoadBalancerPool)
+	}
+
+	defaultListeners = append(defaultListeners, s.buildLoadBalancerListener(defaultListener))
+	return defaultListeners
+}
+
+// DNSRecordHostName returns the fully qualified name of the control plane endpoint's DNS record, or an empty string if no DNS record is defined.
+func (s *ClusterScopeV2) DNSRecordHostName() string {
+	if s.IBMVPCCluster.Spec.DNS == nil {
+		return ""
+	}
+	return dnsservices.RecordSpec(*s.IBMVPCCluster.Spec.DNS).HostName()
+}
+
+// ReconcileDNSRecord reconciles the control plane endpoint's DNS record, to resolve to the target (Load Balancer hostname or IP address).
+func (s *ClusterScopeV2) ReconcileDNSRecord(ctx context.Context, target string) error {
+	if s.IBMVPCCluster.Spec.DNS == nil {
+		return nil
+	}
+	status, err := dnsservices.ReconcileRecord(ctx, s.DNSServicesClient, dnsservices.RecordSpec(*s.IBMVPCCluster.Spec.DNS), (*dnsservices.RecordStatus)(s.IBMVPCCluster.Status.DNS), target)
+	s.IBMVPCCluster.Status.DNS = (*infrav1.DNSRecordStatus)(status)
+	return err
+}
+
+// DeleteDNSRecord deletes the control plane endpoint's DNS record, if it was created by the controller.
+func (s *ClusterScopeV2) DeleteDNSRecord(ctx context.Context) error {
+	if s.IBMVPCCluster.Spec.DNS == nil {
+		return nil
+	}
+	if err := dnsservices.DeleteRecord(ctx, s.DNSServicesClient, s.IBMVPCCluster.Spec.DNS.InstanceID, (*dnsservices.RecordStatus)(s.IBMVPCCluster.Status.DNS)); err != nil {
+		return err
+	}
+	s.IBMVPCCluster.Status.DNS = nil
+	return nil
+}
+
+// TransitGatewaySpec returns the ClusterScopeV2's Transit Gateway spec.
+func (s *ClusterScopeV2) TransitGatewaySpec() *infrav1.VPCTransitGateway {
+	if s.NetworkSpec() == nil {
+		return nil
+	}
+	return s.NetworkSpec().TransitGateway
+}
+
+// TransitGatewayStatus returns the ClusterScopeV2's Transit Gateway status.
+func (s *ClusterScopeV2) TransitGatewayStatus() *infrav1.VPCTransitGatewayStatus {
+	if s.NetworkStatus() == nil {
+		return nil
+	}
+	return s.NetworkStatus().TransitGateway
+}
+
+// GetTransitGatewayID returns the Transit Gateway id, if available.
+func (s *ClusterScopeV2) GetTransitGatewayID() *string {
+	// Check if the Transit Gateway ID is available from Status first.
+	if s.TransitGatewayStatus() != nil && s.TransitGatewayStatus().ID != nil {
+		return s.TransitGatewayStatus().ID
+	}
+	if s.TransitGatewaySpec() != nil {
+		return s.TransitGatewaySpec().ID
+	}
+	return nil
+}
+
+// setTransitGatewayStatus sets the Status of the Transit Gateway.
+func (s *ClusterScopeV2) setTransitGatewayStatus(id *string, controllerCreated bool) {
+	if s.NetworkStatus() == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{}
+	}
+	s.IBMVPCCluster.Status.Network.TransitGateway = &infrav1.VPCTransitGatewayStatus{
+		ID:                id,
+		ControllerCreated: ptr.To(controllerCreated),
+	}
+}
+
+// setTransitGatewayConnectionStatus sets the Status of a Transit Gateway connection to one of the other VPCs.
+func (s *ClusterScopeV2) setTransitGatewayConnectionStatus(connection infrav1.VPCTransitGatewayConnectionStatus) {
+	status := s.TransitGatewayStatus()
+	for i := range status.VPCConnections {
+		if status.VPCConnections[i].Name == connection.Name {
+			status.VPCConnections[i] = connection
+			return
+		}
+	}
+	status.VPCConnections = append(status.VPCConnections, connection)
+}
+
+// removeTransitGatewayConnectionStatus removes the Status of a Transit Gateway connection to one of the other VPCs.
+func (s *ClusterScopeV2) removeTransitGatewayConnectionStatus(name string) {
+	status := s.TransitGatewayStatus()
+	connections := status.VPCConnections[:0]
+	for _, connection := range status.VPCConnections {
+		if connection.Name != name {
+			connections = append(connections, connection)
+		}
+	}
+	status.VPCConnections = connections
+}
+
+// ReconcileTransitGateway reconciles the Transit Gateway connecting the cluster's VPC to other VPCs.
+func (s *ClusterScopeV2) ReconcileTransitGateway(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.TransitGatewaySpec() == nil {
+		return false, nil
+	}
+
+	transitGateway, err := s.getTransitGateway()
+	if err != nil {
+		return false, err
+	}
+
+	// If no Transit Gateway was found, we need to create a new one.
+	if transitGateway == nil {
+		log.Info("Creating transit gateway")
+		if err := s.createTransitGateway(ctx); err != nil {
+			return false, fmt.Errorf("error creating transit gateway: %w", err)
+		}
+		return true, nil
+	}
+
+	switch ptr.Deref(transitGateway.Status, "") {
+	case tgapiv1.TransitGateway_Status_Available:
+		log.V(3).Info("Transit gateway is in available state", "id", transitGateway.ID)
+	case tgapiv1.TransitGateway_Status_Pending:
+		log.V(3).Info("Transit gateway is in pending state", "id", transitGateway.ID)
+		return true, nil
+	default:
+		return false, fmt.Errorf("error transit gateway %s is in %s state", ptr.Deref(transitGateway.Name, ""), ptr.Deref(transitGateway.Status, ""))
+	}
+
+	return s.reconcileTransitGatewayConnections(ctx, transitGateway)
+}
+
+// getTransitGateway retrieves the Transit Gateway by id from Status or Spec, or looks it up by name. If it does not exist, returns nil.
+func (s *ClusterScopeV2) getTransitGateway() (*tgapiv1.TransitGateway, error) {
+	if id := s.GetTransitGatewayID(); id != nil {
+		transitGateway, _, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+			ID: id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving transit gateway with id %s: %w", *id, err)
+		}
+		if s.TransitGatewayStatus() == nil {
+			s.setTransitGatewayStatus(transitGateway.ID, false)
+		}
+		return transitGateway, nil
+	}
+
+	name := s.GetServiceName(infrav1.ResourceTypeTransitGateway)
+	transitGateway, err := s.TransitGatewayClient.GetTransitGatewayByName(*name)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving transit gateway by name %s: %w", *name, err)
+	}
+	if transitGateway == nil || transitGateway.ID == nil {
+		return nil, nil
+	}
+	s.setTransitGatewayStatus(transitGateway.ID, false)
+	return transitGateway, nil
+}
+
+// createTransitGateway creates the Transit Gateway, deciding the routing from the regions of the connected VPCs.
+func (s *ClusterScopeV2) createTransitGateway(ctx context.Context) error {
+	log := ctrl.LoggerFrom(ctx)
+	resourceGroupID, err := s.GetNetworkResourceGroupID()
+	if err != nil {
+		return fmt.Errorf("error retrieving resource group id: %w", err)
+	} else if resourceGroupID == "" {
+		return fmt.Errorf("error resource group id is empty cannot create transit gateway")
+	}
+
+	connectedVPCRegions := make([]string, 0, len(s.TransitGatewaySpec().VPCConnections))
+	for _, connection := range s.TransitGatewaySpec().VPCConnections {
+		crn, err := parseCRN(connection.VPCCRN)
+		if err != nil {
+			return fmt.Errorf("error parsing vpc crn of transit gateway connection %s: %w", connection.Name, err)
+		}
+		connectedVPCRegions = append(connectedVPCRegions, crn.Region)
+	}
+	location, globalRouting, err := genutil.GetVPCTransitGatewayLocationAndRouting(ptr.To(s.IBMVPCCluster.Spec.Region), connectedVPCRegions)
+	if err != nil {
+		return fmt.Errorf("error retrieving transit gateway location and routing: %w", err)
+	}
+	if routing := s.TransitGatewaySpec().GlobalRouting; routing != nil {
+		// Local routing cannot be used when one of the connected VPCs is in another region.
+		if !*routing && *globalRouting {
+			return fmt.Errorf("error local routing cannot be used for transit gateway since connected VPCs are in different regions and require global routing")
+		}
+		globalRouting = routing
+	}
+
+	name := s.GetServiceName(infrav1.ResourceTypeTransitGateway)
+	log.V(3).Info("Creating transit gateway", "name", name, "location", location, "globalRouting", globalRouting)
+	transitGateway, _, err := s.TransitGatewayClient.CreateTransitGateway(&tgapiv1.CreateTransitGatewayOptions{
+		Location:      location,
+		Name:          name,
+		Global:        globalRouting,
+		ResourceGroup: &tgapiv1.ResourceGroupIdentity{ID: ptr.To(resourceGroupID)},
+	})
+	if err != nil {
+		return err
+	}
+	s.setTransitGatewayStatus(transitGateway.ID, true)
+	return nil
+}
+
+// reconcileTransitGatewayConnections creates the Transit Gateway connections to the cluster's VPC and the other VPCs which do not exist and checks the state of the existing ones.
+// The connections created by the controller which were removed from Spec are deleted.
+func (s *ClusterScopeV2) reconcileTransitGatewayConnections(ctx context.Context, transitGateway *tgapiv1.TransitGateway) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	connections, _, err := s.TransitGatewayClient.ListTransitGatewayConnections(&tgapiv1.ListTransitGatewayConnectionsOptions{
+		TransitGatewayID: transitGateway.ID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing transit gateway connections: %w", err)
+	}
+
+	vpcID, err := s.GetVPCID()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving vpc id: %w", err)
+	} else if vpcID == nil {
+		return false, fmt.Errorf("error vpc id is empty cannot connect transit gateway")
+	}
+	vpcDetails, _, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{
+		ID: vpcID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error retrieving vpc with id %s: %w", *vpcID, err)
+	}
+
+	// Connect the cluster's VPC.
+	requeue := false
+	if connection := findTransitGatewayVPCConnection(connections.Connections, ptr.Deref(vpcDetails.CRN, "")); connection != nil {
+		pending, err := checkTransitGatewayConnectionStatus(*connection)
+		if err != nil {
+			return false, err
+		}
+		requeue = pending
+		if s.TransitGatewayStatus().VPCConnection == nil {
+			s.TransitGatewayStatus().VPCConnection = &infrav1.VPCTransitGatewayConnectionStatus{Name: ptr.Deref(connection.Name, ""), ID: connection.ID, ControllerCreated: ptr.To(false)}
+		}
+	} else {
+		name := fmt.Sprintf("%s-vpc-con", ptr.Deref(transitGateway.Name, s.IBMVPCCluster.Name))
+		log.Info("Creating transit gateway connection to the cluster vpc", "name", name)
+		connection, err := s.createTransitGatewayConnection(transitGateway.ID, name, vpcDetails.CRN)
+		if err != nil {
+			return false, err
+		}
+		s.TransitGatewayStatus().VPCConnection = &infrav1.VPCTransitGatewayConnectionStatus{Name: name, ID: connection.ID, ControllerCreated: ptr.To(true)}
+		requeue = true
+	}
+
+	// Connect the other VPCs.
+	desiredConnections := make(map[string]bool)
+	for _, vpcConnection := range s.TransitGatewaySpec().VPCConnections {
+		desiredConnections[vpcConnection.Name] = true
+		connection := findTransitGatewayVPCConnection(connections.Connections, vpcConnection.VPCCRN)
+		if connection == nil {
+			log.Info("Creating transit gateway connection", "name", vpcConnection.Name, "vpcCRN", vpcConnection.VPCCRN)
+			connection, err := s.createTransitGatewayConnection(transitGateway.ID, vpcConnection.Name, ptr.To(vpcConnection.VPCCRN))
+			if err != nil {
+				return false, err
+			}
+			s.setTransitGatewayConnectionStatus(infrav1.VPCTransitGatewayConnectionStatus{Name: vpcConnection.Name, ID: connection.ID, ControllerCreated: ptr.To(true)})
+			requeue = true
+			continue
+		}
+
+		pending, err := checkTransitGatewayConnectionStatus(*connection)
+		if err != nil {
+			return false, err
+		}
+		requeue = requeue || pending
+		if !slices.ContainsFunc(s.TransitGatewayStatus().VPCConnections, func(status infrav1.VPCTransitGatewayConnectionStatus) bool {
+			return status.Name == vpcConnection.Name
+		}) {
+			s.setTransitGatewayConnectionStatus(infrav1.VPCTransitGatewayConnectionStatus{Name: vpcConnection.Name, ID: connection.ID, ControllerCreated: ptr.To(false)})
+		}
+	}
+
+	// Remove the connections which are no longer in Spec.
+	for _, connectionStatus := range slices.Clone(s.TransitGatewayStatus().VPCConnections) {
+		if desiredConnections[connectionStatus.Name] {
+			continue
+		}
+		if ptr.Deref(connectionStatus.ControllerCreated, false) {
+			log.Info("Deleting transit gateway connection removed from spec", "name", connectionStatus.Name)
+			if err := s.deleteTransitGatewayConnection(transitGateway.ID, connectionStatus.ID); err != nil {
+				return false, err
+			}
+			requeue = true
+		}
+		s.removeTransitGatewayConnectionStatus(connectionStatus.Name)
+	}
+	return requeue, nil
+}
+
+// createTransitGatewayConnection creates a Transit Gateway connection to a VPC.
+func (s *ClusterScopeV2) createTransitGatewayConnection(transitGatewayID *string, name string, vpcCRN *string) (*tgapiv1.TransitGatewayConnectionCust, error) {
+	connection, _, err := s.TransitGatewayClient.CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+		TransitGatewayID: transitGatewayID,
+		NetworkType:      ptr.To(tgapiv1.CreateTransitGatewayConnectionOptions_NetworkType_Vpc),
+		NetworkID:        vpcCRN,
+		Name:             ptr.To(name),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating transit gateway connection %s: %w", name, err)
+	}
+	return connection, nil
+}
+
+// deleteTransitGatewayConnection deletes a Transit Gateway connection, ignoring a connection which no longer exists.
+func (s *ClusterScopeV2) deleteTransitGatewayConnection(transitGatewayID, connectionID *string) error {
+	if detailedResponse, err := s.TransitGatewayClient.DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{
+		TransitGatewayID: transitGatewayID,
+		ID:               connectionID,
+	}); err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("error deleting transit gateway connection %s: %w", ptr.Deref(connectionID, ""), err)
+	}
+	return nil
+}
+
+// findTransitGatewayVPCConnection returns the Transit Gateway connection to the VPC, or nil if it does not exist.
+func findTransitGatewayVPCConnection(connections []tgapiv1.TransitGatewayConnectionCust, vpcCRN string) *tgapiv1.TransitGatewayConnectionCust {
+	for i, connection := range connections {
+		if ptr.Deref(connection.NetworkType, "") == tgapiv1.TransitGatewayConnectionCust_NetworkType_Vpc && ptr.Deref(connection.NetworkID, "") == vpcCRN {
+			return &connections[i]
+		}
+	}
+	return nil
+}
+
+// checkTransitGatewayConnectionStatus checks the state of a Transit Gateway connection.
+// If the connection is not yet attached, true is returned indicating a requeue for reconciliation.
+func checkTransitGatewayConnectionStatus(connection tgapiv1.TransitGatewayConnectionCust) (bool, error) {
+	switch ptr.Deref(connection.Status, "") {
+	case tgapiv1.TransitGatewayConnectionCust_Status_Attached:
+		return false, nil
+	case tgapiv1.TransitGatewayConnectionCust_Status_Failed:
+		return false, fmt.Errorf("error transit gateway connection %s is in failed state", ptr.Deref(connection.Name, ""))
+	}
+	return true, nil
+}
+
+// DeleteTransitGateway deletes the Transit Gateway connections created by the controller, and the Transit Gateway itself if it was created by the controller.
+// If the deletion is in progress, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScopeV2) DeleteTransitGateway(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	status := s.TransitGatewayStatus()
+	if status == nil || status.ID == nil {
+		return false, nil
+	}
+
+	transitGateway, detailedResponse, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: status.ID,
+	})
+	if err != nil {
+		if detailedResponse != nil && detailedResponse.StatusCode == http.StatusNotFound {
+			log.Info("Transit gateway not found, removing it from status", "id", status.ID)
+			s.IBMVPCCluster.Status.Network.TransitGateway = nil
+			return false, nil
+		}
+		return false, fmt.Errorf("error retrieving transit gateway with id %s: %w", *status.ID, err)
+	}
+	if ptr.Deref(transitGateway.Status, "") == tgapiv1.TransitGateway_Status_Deleting {
+		log.V(3).Info("Transit gateway is being deleted", "id", status.ID)
+		return true, nil
+	}
+
+	connections, _, err := s.TransitGatewayClient.ListTransitGatewayConnections(&tgapiv1.ListTransitGatewayConnectionsOptions{
+		TransitGatewayID: status.ID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing transit gateway connections: %w", err)
+	}
+
+	// A Transit Gateway created by the controller can only be deleted once all its connections are removed,
+	// otherwise only the connections created by the controller are removed.
+	controllerCreatedConnections := make(map[string]bool)
+	if status.VPCConnection != nil && status.VPCConnection.ID != nil && ptr.Deref(status.VPCConnection.ControllerCreated, false) {
+		controllerCreatedConnections[*status.VPCConnection.ID] = true
+	}
+	for _, connection := range status.VPCConnections {
+		if connection.ID != nil && ptr.Deref(connection.ControllerCreated, false) {
+			controllerCreatedConnections[*connection.ID] = true
+		}
+	}
+	deleting := false
+	for _, connection := range connections.Connections {
+		if !ptr.Deref(status.ControllerCreated, false) && !controllerCreatedConnections[ptr.Deref(connection.ID, "")] {
+			continue
+		}
+		deleting = true
+		if ptr.Deref(connection.Status, "") == tgapiv1.TransitGatewayConnectionCust_Status_Deleting {
+			continue
+		}
+		log.Info("Deleting transit gateway connection", "name", connection.Name)
+		if err := s.deleteTransitGatewayConnection(status.ID, connection.ID); err != nil {
+			return false, err
+		}
+	}
+	if deleting {
+		return true, nil
+	}
+
+	if !ptr.Deref(status.ControllerCreated, false) {
+		log.Info("Skipping transit gateway deletion as resource is not created by controller")
+		s.IBMVPCCluster.Status.Network.TransitGateway = nil
+		return false, nil
+	}
+
+	log.Info("Deleting transit gateway", "id", status.ID)
+	if detailedResponse, err := s.TransitGatewayClient.DeleteTransitGateway(&tgapiv1.DeleteTransitGatewayOptions{
+		ID: status.ID,
+	}); err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		return false, fmt.Errorf("error deleting transit gateway with id %s: %w", *status.ID, err)
+	}
+	return true, nil
+}
+
+// ReconcileEndpointGateways reconciles the Virtual Private Endpoint gateways of the VPC.
+// If an endpoint gateway was just created or is not yet stable, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScopeV2) ReconcileEndpointGateways(ctx context.Context) (bool, error) {
+	if s.NetworkSpec() == nil || len(s.NetworkSpec().EndpointGateways) == 0 {
+		return false, nil
+	}
+
+	vpcID, err := s.GetVPCID()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving vpc id: %w", err)
+	} else if vpcID == nil {
+		return false, fmt.Errorf("error vpc id is empty cannot reconcile endpoint gateways")
+	}
+
+	if s.NetworkStatus() == nil {
+		s.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{}
+	}
+
+	requeue := false
+	for _, endpointGateway := range s.NetworkSpec().EndpointGateways {
+		if requiresRequeue, err := s.reconcileEndpointGateway(ctx, *vpcID, endpointGateway); err != nil {
+			return false, fmt.Errorf("error failed reconciling endpoint gateway %s: %w", endpointGateway.Name, err)
+		} else if requiresRequeue {
+			requeue = true
+		}
+	}
+	return requeue, nil
+}
+
+// reconcileEndpointGateway will attempt to find the existing endpoint gateway, or create it if necessary, and update its status.
+func (s *ClusterScopeV2) reconcileEndpointGateway(ctx context.Context, vpcID string, endpointGateway infrav1.VPCEndpointGateway) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	var endpointGatewayDetails *vpcv1.EndpointGateway
+	controllerCreated := false
+	if status, ok := s.NetworkStatus().EndpointGateways[endpointGateway.Name]; ok && status != nil {
+		details, _, err := s.VPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: ptr.To(status.ID),
+		})
+		if err != nil {
+			return false, fmt.Errorf("error retrieving endpoint gateway by id %s: %w", status.ID, err)
+		}
+		endpointGatewayDetails = details
+		controllerCreated = ptr.Deref(status.ControllerCreated, false)
+	} else {
+		details, err := s.VPCClient.GetEndpointGatewayByName(vpcID, endpointGateway.Name)
+		if err != nil {
+			return false, fmt.Errorf("error retrieving endpoint gateway by name: %w", err)
+		}
+		endpointGatewayDetails = details
+	}
+
+	// If the endpoint gateway was not found, create it.
+	if endpointGatewayDetails == nil {
+		log.V(3).Info("Creating endpoint gateway", "name", endpointGateway.Name, "serviceCRN", endpointGateway.ServiceCRN)
+		details, err := s.createEndpointGateway(vpcID, endpointGateway)
+		if err != nil {
+			return false, err
+		}
+		s.setEndpointGatewayStatus(endpointGateway.Name, details, true)
+		return true, nil
+	}
+
+	s.setEndpointGatewayStatus(endpointGateway.Name, endpointGatewayDetails, controllerCreated)
+	switch ptr.Deref(endpointGatewayDetails.LifecycleState, "") {
+	case vpcv1.EndpointGatewayLifecycleStateStableConst:
+		return false, nil
+	case vpcv1.EndpointGatewayLifecycleStateFailedConst, vpcv1.EndpointGatewayLifecycleStateSuspendedConst:
+		return false, fmt.Errorf("error endpoint gateway is in %s state", *endpointGatewayDetails.LifecycleState)
+	}
+	log.V(3).Info("Endpoint gateway is not yet stable", "name", endpointGateway.Name, "state", endpointGatewayDetails.LifecycleState)
+	return true, nil
+}
+
+// createEndpointGateway creates a new endpoint gateway, with a reserved IP in a cluster subnet of each zone.
+func (s *ClusterScopeV2) createEndpointGateway(vpcID string, endpointGateway infrav1.VPCEndpointGateway) (*vpcv1.EndpointGateway, error) {
+	resourceGroupID, err := s.GetResourceGroupID()
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving resource group id for endpoint gateway creation: %w", err)
+	} else if resourceGroupID == "" {
+		return nil, fmt.Errorf("error resource group id is empty cannot create endpoint gateway")
+	}
+
+	subnetIDs, err := s.getEndpointGatewaySubnetIDs()
+	if err != nil {
+		return nil, err
+	}
+	reservedIPs := make([]vpcv1.EndpointGatewayReservedIPIntf, 0, len(subnetIDs))
+	for _, subnetID := range subnetIDs {
+		reservedIPs = append(reservedIPs, &vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext{
+			AutoDelete: ptr.To(true),
+			Subnet: &vpcv1.SubnetIdentityByID{
+				ID: ptr.To(subnetID),
+			},
+		})
+	}
+
+	endpointGatewayDetails, _, err := s.VPCClient.CreateEndpointGateway(&vpcv1.CreateEndpointGatewayOptions{
+		Name:   ptr.To(endpointGateway.Name),
+		Target: genutil.GetEndpointGatewayTarget(endpointGateway.ServiceCRN),
+		VPC: &vpcv1.VPCIdentityByID{
+			ID: ptr.To(vpcID),
+		},
+		Ips: reservedIPs,
+		ResourceGroup: &vpcv1.ResourceGroupIdentityByID{
+			ID: ptr.To(resourceGroupID),
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error unknown failure creating endpoint gateway: %w", err)
+	}
+	if endpointGatewayDetails == nil || endpointGatewayDetails.ID == nil || endpointGatewayDetails.CRN == nil {
+		return nil, fmt.Errorf("error failed creating endpoint gateway")
+	}
+
+	// NOTE: This tagging is only attempted once. We may wish to refactor in case this single attempt fails.
+	if err := s.TagResource(s.IBMVPCCluster.Name, *endpointGatewayDetails.CRN); err != nil {
+		return nil, fmt.Errorf("error failed to tag endpoint gateway %s: %w", endpointGateway.Name, err)
+	}
+	return endpointGatewayDetails, nil
+}
+
+// getEndpointGatewaySubnetIDs returns a cluster subnet id for each zone, as an endpoint gateway can only have a single reserved IP per zone.
+// Control Plane subnets are preferred, then the subnet ids are sorted to keep the selection stable.
+func (s *ClusterScopeV2) getEndpointGatewaySubnetIDs() ([]string, error) {
+	var subnetIDs []string
+	for _, subnets := range []map[string]*infrav1.ResourceStatus{s.NetworkStatus().ControlPlaneSubnets, s.NetworkStatus().WorkerSubnets} {
+		ids := make([]string, 0, len(subnets))
+		for _, subnet := range subnets {
+			if subnet != nil && subnet.ID != "" {
+				ids = append(ids, subnet.ID)
+			}
+		}
+		slices.Sort(ids)
+		subnetIDs = append(subnetIDs, ids...)
+	}
+
+	zones := make(map[string]bool)
+	zoneSubnetIDs := make([]string, 0)
+	for _, subnetID := range subnetIDs {
+		subnetDetails, _, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+			ID: ptr.To(subnetID),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error retrieving subnet by id %s: %w", subnetID, err)
+		} else if subnetDetails == nil || subnetDetails.Zone == nil || subnetDetails.Zone.Name == nil {
+			return nil, fmt.Errorf("error failed to retrieve zone of subnet %s", subnetID)
+		}
+		if zones[*subnetDetails.Zone.Name] {
+			continue
+		}
+		zones[*subnetDetails.Zone.Name] = true
+		zoneSubnetIDs = append(zoneSubnetIDs, subnetID)
+	}
+	return zoneSubnetIDs, nil
+}
+
+// setEndpointGatewayStatus sets the status of an endpoint gateway in the Network Status.
+func (s *ClusterScopeV2) setEndpointGatewayStatus(name string, endpointGateway *vpcv1.EndpointGateway, controllerCreated bool) {
+	if s.NetworkStatus().EndpointGateways == nil {
+		s.NetworkStatus().EndpointGateways = make(map[string]*infrav1.VPCEndpointGatewayStatus)
+	}
+	reservedIPs := make([]string, 0, len(endpointGateway.Ips))
+	for _, reservedIP := range endpointGateway.Ips {
+		if reservedIP.Address != nil {
+			reservedIPs = append(reservedIPs, *reservedIP.Address)
+		}
+	}
+	s.NetworkStatus().EndpointGateways[name] = &infrav1.VPCEndpointGatewayStatus{
+		ID:                ptr.Deref(endpointGateway.ID, ""),
+		Ready:             ptr.Deref(endpointGateway.LifecycleState, "") == vpcv1.EndpointGatewayLifecycleStateStableConst,
+		ControllerCreated: ptr.To(controllerCreated),
+		ReservedIPs:       reservedIPs,
+	}
+}
+
+// DeleteEndpointGateways deletes the Virtual Private Endpoint gateways created by the controller, the ones which were not are only removed from Status.
+// If the deletion is in progress, true is returned indicating a requeue for reconciliation.
+func (s *ClusterScopeV2) DeleteEndpointGateways(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if s.NetworkStatus() == nil {
+		return false, nil
+	}
+
+	requeue := false
+	for _, name := range slices.Sorted(maps.Keys(s.NetworkStatus().EndpointGateways)) {
+		status := s.NetworkStatus().EndpointGateways[name]
+		if status == nil || status.ID == "" || !ptr.Deref(status.ControllerCreated, false) {
+			log.Info("Skipping endpoint gateway deletion as resource is not created by controller", "name", name)
+			delete(s.NetworkStatus().EndpointGateways, name)
+			continue
+		}
+
+		endpointGatewayDetails, detailedResponse, err := s.VPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: ptr.To(status.ID),
+		})
+		if err != nil {
+			if detailedResponse != nil && detailedResponse.StatusCode == http.StatusNotFound {
+				log.Info("Endpoint gateway successfully deleted", "name", name)
+				delete(s.NetworkStatus().EndpointGateways, name)
+				continue
+			}
+			return false, fmt.Errorf("error retrieving endpoint gateway %s with id %s: %w", name, status.ID, err)
+		}
+		if endpointGatewayDetails != nil && ptr.Deref(endpointGatewayDetails.LifecycleState, "") == vpcv1.EndpointGatewayLifecycleStateDeletingConst {
+			log.V(3).Info("Endpoint gateway is being deleted", "name", name)
+			requeue = true
+			continue
+		}
+
+		log.Info("Deleting endpoint gateway", "name", name, "id", status.ID)
+		if detailedResponse, err := s.VPCClient.DeleteEndpointGateway(&vpcv1.DeleteEndpointGatewayOptions{
+			ID: ptr.To(status.ID),
+		}); err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			return false, fmt.Errorf("error deleting endpoint gateway %s with id %s: %w", name, status.ID, err)
+		}
+		requeue = true
+	}
+	return requeue, nil
+}
+
+// ResourceVerificationResult holds the outcome of the verification of the resources referenced in the Network Status.
+type ResourceVerificationResult struct {
+	// Missing are the resources that no longer exist and are reported.
+	Missing []string
+	// Recreating are the resources created by the controller that no longer exist, and whose status is cleared to create them again.
+	Recreating []string
+	// Drifted are the resources that no longer match spec.
+	Drifted []string
+}
+
+// ResourceVerificationInterval returns the time between two verifications of the resources referenced in the Network Status.
+func (s *ClusterScopeV2) ResourceVerificationInterval() time.Duration {
+	verification := s.IBMVPCCluster.Spec.ResourceVerification
+	if verification == nil || verification.Interval == nil || verification.Interval.Duration <= 0 {
+		return defaultResourceVerificationInterval
+	}
+	return verification.Interval.Duration
+}
+
+// VerifyResources verifies that the resources referenced in the Network Status still exist and match spec.
+// Depending on the missing resource policy, the status of the missing resources created by the controller is cleared
+// so that they are created again by the reconciliation, other missing resources are reported.
+func (s *ClusterScopeV2) VerifyResources(ctx context.Context) (*ResourceVerificationResult, error) {
+	result := &ResourceVerificationResult{}
+	if s.NetworkStatus() == nil {
+		return result, nil
+	}
+	var errs []error
+	for _, verify := range []func(context.Context, *ResourceVerificationResult) error{
+		s.verifyVPC,
+		s.verifySubnets,
+		s.verifySecurityGroups,
+		s.verifyEndpointGateways,
+		s.verifyTransitGateway,
+		s.verifyLoadBalancers,
+	} {
+		if err := verify(ctx, result); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return result, nil
+}
+
+// missingResource records a resource referenced in the Network Status that no longer exists.
+// It returns true when the status of the resource is to be cleared to create it again.
+func (s *ClusterScopeV2) missingResource(ctx context.Context, result *ResourceVerificationResult, resource string, controllerCreated *bool) bool {
+	log := ctrl.LoggerFrom(ctx)
+	verification := s.IBMVPCCluster.Spec.ResourceVerification
+	if verification != nil && verification.MissingResourcePolicy == infrav1.MissingResourcePolicyRecreate && ptr.Deref(controllerCreated, false) {
+		log.Info("Resource created by the controller no longer exists, clearing its status to create it again", "resource", resource)
+		result.Recreating = append(result.Recreating, resource)
+		return true
+	}
+	log.Info("Resource referenced in status no longer exists", "resource", resource)
+	result.Missing = append(result.Missing, resource)
+	return false
+}
+
+// driftedResource records a resource whose name no longer matches the expected name.
+func driftedResource(result *ResourceVerificationResult, resource string, name *string, expectedName string) {
+	if name == nil || *name == expectedName {
+		return
+	}
+	result.Drifted = append(result.Drifted, fmt.Sprintf("%s is named %s instead of %s", resource, *name, expectedName))
+}
+
+// verifyVPC verifies the VPC referenced in the Network Status.
+func (s *ClusterScopeV2) verifyVPC(ctx context.Context, result *ResourceVerificationResult) error {
+	status := s.NetworkStatus().VPC
+	if status == nil || status.ID == "" {
+		return nil
+	}
+	resource := fmt.Sprintf("vpc %s", status.ID)
+	vpcDetails, detailedResponse, err := s.VPCClient.GetVPC(&vpcv1.GetVPCOptions{
+		ID: ptr.To(status.ID),
+	})
+	if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("error retrieving vpc with id %s: %w", status.ID, err)
+	}
+	if vpcDetails == nil {
+		// The controller does not track whether the VPC was created by it.
+		s.missingResource(ctx, result, resource, nil)
+		return nil
+	}
+	if s.NetworkSpec().VPC == nil || s.NetworkSpec().VPC.ID == nil {
+		driftedResource(result, resource, vpcDetails.Name, *s.GetServiceName(infrav1.ResourceTypeVPC))
+	}
+	return nil
+}
+
+// verifySubnets verifies the control plane and worker subnets referenced in the Network Status.
+func (s *ClusterScopeV2) verifySubnets(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, subnets := range []map[string]*infrav1.ResourceStatus{s.NetworkStatus().ControlPlaneSubnets, s.NetworkStatus().WorkerSubnets} {
+		for _, name := range slices.Sorted(maps.Keys(subnets)) {
+			status := subnets[name]
+			if status == nil || status.ID == "" {
+				continue
+			}
+			resource := fmt.Sprintf("subnet %s", name)
+			subnetDetails, detailedResponse, err := s.VPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+				ID: ptr.To(status.ID),
+			})
+			if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				errs = append(errs, fmt.Errorf("error retrieving subnet with id %s: %w", status.ID, err))
+				continue
+			}
+			if subnetDetails == nil {
+				// The controller does not track whether the subnet was created by it.
+				s.missingResource(ctx, result, resource, nil)
+				continue
+			}
+			driftedResource(result, resource, subnetDetails.Name, name)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// verifySecurityGroups verifies the security groups referenced in the Network Status.
+func (s *ClusterScopeV2) verifySecurityGroups(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.NetworkStatus().SecurityGroups)) {
+		status := s.NetworkStatus().SecurityGroups[name]
+		if status == nil || status.ID == "" {
+			continue
+		}
+		resource := fmt.Sprintf("security group %s", name)
+		securityGroupDetails, detailedResponse, err := s.VPCClient.GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{
+			ID: ptr.To(status.ID),
+		})
+		if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			errs = append(errs, fmt.Errorf("error retrieving security group with id %s: %w", status.ID, err))
+			continue
+		}
+		if securityGroupDetails == nil {
+			// The controller does not track whether the security group was created by it.
+			s.missingResource(ctx, result, resource, nil)
+			continue
+		}
+		driftedResource(result, resource, securityGroupDetails.Name, name)
+	}
+	return errors.Join(errs...)
+}
+
+// verifyEndpointGateways verifies the endpoint gateways referenced in the Network Status.
+func (s *ClusterScopeV2) verifyEndpointGateways(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, name := range slices.Sorted(maps.Keys(s.NetworkStatus().EndpointGateways)) {
+		status := s.NetworkStatus().EndpointGateways[name]
+		if status == nil || status.ID == "" {
+			continue
+		}
+		resource := fmt.Sprintf("endpoint gateway %s", name)
+		endpointGatewayDetails, detailedResponse, err := s.VPCClient.GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{
+			ID: ptr.To(status.ID),
+		})
+		if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			errs = append(errs, fmt.Errorf("error retrieving endpoint gateway with id %s: %w", status.ID, err))
+			continue
+		}
+		if endpointGatewayDetails == nil {
+			if s.missingResource(ctx, result, resource, status.ControllerCreated) {
+				delete(s.NetworkStatus().EndpointGateways, name)
+			}
+			continue
+		}
+		driftedResource(result, resource, endpointGatewayDetails.Name, name)
+	}
+	return errors.Join(errs...)
+}
+
+// verifyTransitGateway verifies the Transit Gateway referenced in the Network Status.
+func (s *ClusterScopeV2) verifyTransitGateway(ctx context.Context, result *ResourceVerificationResult) error {
+	status := s.TransitGatewayStatus()
+	if status == nil || status.ID == nil {
+		return nil
+	}
+	resource := fmt.Sprintf("transit gateway %s", *status.ID)
+	transitGateway, detailedResponse, err := s.TransitGatewayClient.GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{
+		ID: status.ID,
+	})
+	if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("error retrieving transit gateway with id %s: %w", *status.ID, err)
+	}
+	if transitGateway == nil {
+		if s.missingResource(ctx, result, resource, status.ControllerCreated) {
+			s.NetworkStatus().TransitGateway = nil
+		}
+		return nil
+	}
+	if s.TransitGatewaySpec() == nil || s.TransitGatewaySpec().ID == nil {
+		driftedResource(result, resource, transitGateway.Name, *s.GetServiceName(infrav1.ResourceTypeTransitGateway))
+	}
+	return nil
+}
+
+// verifyLoadBalancers verifies the Load Balancers referenced in the Network Status.
+func (s *ClusterScopeV2) verifyLoadBalancers(ctx context.Context, result *ResourceVerificationResult) error {
+	var errs []error
+	for _, id := range slices.Sorted(maps.Keys(s.NetworkStatus().LoadBalancers)) {
+		status := s.NetworkStatus().LoadBalancers[id]
+		if status == nil {
+			continue
+		}
+		resource := fmt.Sprintf("load balancer %s", id)
+		loadBalancer, detailedResponse, err := s.VPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+			ID: ptr.To(id),
+		})
+		if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			errs = append(errs, fmt.Errorf("error retrieving load balancer with id %s: %w", id, err))
+			continue
+		}
+		if loadBalancer == nil && s.missingResource(ctx, result, resource, status.ControllerCreated) {
+			delete(s.NetworkStatus().LoadBalancers, id)
+		}
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,2453 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	mockdns "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/dnsservices/mock"
+	gtmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging/mock"
+	tgmock "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/transitgateway/mock"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc/mock"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestClusterScopeV2ReconcileDNSRecord(t *testing.T) {
+	var (
+		mockDNS  *mockdns.MockDNSServices
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = mockdns.NewMockDNSServices(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func() *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com.",
+						RecordName: "api.capi",
+						TTL:        ptr.To[int64](300),
+					},
+				},
+			},
+			DNSServicesClient: mockDNS,
+		}
+	}
+	lbIP := "10.240.0.10"
+
+	t.Run("When DNS is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{IBMVPCCluster: &infrav1.IBMVPCCluster{}}
+		g.Expect(clusterScope.DNSRecordHostName()).To(BeEmpty())
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When fetching the DNS zone fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com.").Return(nil, errors.New("failed to get zone"))
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS record does not exist it creates an A record for an IP address", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		g.Expect(clusterScope.DNSRecordHostName()).To(Equal("api.capi.example.com"))
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com.").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.Type).To(Equal(dnssvcsv1.ResourceRecord_Type_A))
+			g.Expect(*options.Name).To(Equal("api.capi.example.com"))
+			g.Expect(*options.TTL).To(Equal(int64(300)))
+			g.Expect(options.Rdata).To(Equal(&dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: ptr.To(lbIP)}))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To("record-id")}, nil, nil
+		})
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(Equal(&infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("zone-id"),
+			ID:                ptr.To("record-id"),
+			Hostname:          ptr.To("api.capi.example.com"),
+			Target:            ptr.To(lbIP),
+			ControllerCreated: ptr.To(true),
+		}))
+	})
+	t.Run("When DNS record creation fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com.").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).Return(nil, nil, errors.New("failed to create record"))
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS record TTL differs it is updated and remains controller created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.DNS = &infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("zone-id"),
+			ID:                ptr.To("record-id"),
+			Hostname:          ptr.To("api.capi.example.com"),
+			Target:            ptr.To(lbIP),
+			ControllerCreated: ptr.To(true),
+		}
+		record := &dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_A),
+			Rdata: map[string]interface{}{"ip": lbIP},
+			TTL:   ptr.To[int64](900),
+		}
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(record, nil)
+		mockDNS.EXPECT().UpdateResourceRecord(gomock.Any()).DoAndReturn(func(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+			g.Expect(*options.RecordID).To(Equal("record-id"))
+			g.Expect(*options.TTL).To(Equal(int64(300)))
+			g.Expect(options.Rdata).To(Equal(&dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: ptr.To(lbIP)}))
+			return &dnssvcsv1.ResourceRecord{ID: ptr.To("record-id")}, nil, nil
+		})
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).To(Succeed())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.ControllerCreated).To(BeTrue())
+	})
+	t.Run("When existing DNS record is up to date it is adopted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		record := &dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_A),
+			Rdata: map[string]interface{}{"ip": lbIP},
+			TTL:   ptr.To[int64](300),
+		}
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com.").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(record, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).To(Succeed())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.ID).To(Equal("record-id"))
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.ControllerCreated).To(BeFalse())
+	})
+	t.Run("When existing DNS record has a different type", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		record := &dnssvcsv1.ResourceRecord{
+			ID:    ptr.To("record-id"),
+			Type:  ptr.To(dnssvcsv1.ResourceRecord_Type_Cname),
+			Rdata: map[string]interface{}{"cname": "abcd-us-south.lb.appdomain.cloud"},
+		}
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com.").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(record, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).ToNot(Succeed())
+	})
+	t.Run("When DNS zone is changed the previously created record is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.DNS = &infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("old-zone-id"),
+			ID:                ptr.To("old-record-id"),
+			Hostname:          ptr.To("api.capi.example.org"),
+			ControllerCreated: ptr.To(true),
+		}
+		mockDNS.EXPECT().DeleteResourceRecord(&dnssvcsv1.DeleteResourceRecordOptions{
+			InstanceID: ptr.To("dns-instance-id"),
+			DnszoneID:  ptr.To("old-zone-id"),
+			RecordID:   ptr.To("old-record-id"),
+		}).Return(nil, nil)
+		mockDNS.EXPECT().GetDNSZoneByName("dns-instance-id", "example.com.").Return(&dnssvcsv1.Dnszone{ID: ptr.To("zone-id")}, nil)
+		mockDNS.EXPECT().GetResourceRecordByName("dns-instance-id", "zone-id", "api.capi.example.com").Return(nil, nil)
+		mockDNS.EXPECT().CreateResourceRecord(gomock.Any()).Return(&dnssvcsv1.ResourceRecord{ID: ptr.To("record-id")}, nil, nil)
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).To(Succeed())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.ZoneID).To(Equal("zone-id"))
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.ID).To(Equal("record-id"))
+	})
+	t.Run("When deleting the previously created record fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.DNS = &infrav1.DNSRecordStatus{
+			ZoneID:            ptr.To("old-zone-id"),
+			ID:                ptr.To("old-record-id"),
+			Hostname:          ptr.To("api.capi.example.org"),
+			ControllerCreated: ptr.To(true),
+		}
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(nil, errors.New("failed to delete record"))
+		g.Expect(clusterScope.ReconcileDNSRecord(ctx, lbIP)).ToNot(Succeed())
+		g.Expect(*clusterScope.IBMVPCCluster.Status.DNS.ID).To(Equal("old-record-id"))
+	})
+}
+
+func TestClusterScopeV2DeleteDNSRecord(t *testing.T) {
+	var (
+		mockDNS  *mockdns.MockDNSServices
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockDNS = mockdns.NewMockDNSServices(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(controllerCreated bool) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com",
+						RecordName: "api.capi",
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					DNS: &infrav1.DNSRecordStatus{
+						ZoneID:            ptr.To("zone-id"),
+						ID:                ptr.To("record-id"),
+						Hostname:          ptr.To("api.capi.example.com"),
+						ControllerCreated: ptr.To(controllerCreated),
+					},
+				},
+			},
+			DNSServicesClient: mockDNS,
+		}
+	}
+
+	t.Run("When DNS is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{IBMVPCCluster: &infrav1.IBMVPCCluster{}}
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
+	})
+	t.Run("When DNS record is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(false)
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS record is deleted successfully", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(&dnssvcsv1.DeleteResourceRecordOptions{
+			InstanceID: ptr.To("dns-instance-id"),
+			DnszoneID:  ptr.To("zone-id"),
+			RecordID:   ptr.To("record-id"),
+		}).Return(nil, nil)
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS record is already deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(&core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("not found"))
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).To(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).To(BeNil())
+	})
+	t.Run("When DNS record deletion fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(true)
+		mockDNS.EXPECT().DeleteResourceRecord(gomock.Any()).Return(nil, errors.New("failed to delete"))
+		g.Expect(clusterScope.DeleteDNSRecord(ctx)).ToNot(Succeed())
+		g.Expect(clusterScope.IBMVPCCluster.Status.DNS).ToNot(BeNil())
+	})
+}
+
+func TestClusterScopeV2BuildLoadBalancerListener(t *testing.T) {
+	clusterScope := ClusterScopeV2{}
+
+	t.Run("When protocol is not set it defaults to TCP", func(t *testing.T) {
+		g := NewWithT(t)
+		listener := clusterScope.buildLoadBalancerListener(infrav1.AdditionalListenerSpec{Port: 22})
+		g.Expect(listener).To(Equal(vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
+			Port:     ptr.To[int64](22),
+			Protocol: ptr.To("tcp"),
+		}))
+	})
+	t.Run("When HTTPS listener sets certificate, default pool and idle connection timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		listener := clusterScope.buildLoadBalancerListener(infrav1.AdditionalListenerSpec{
+			Port:                  443,
+			Protocol:              ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTPS),
+			DefaultPoolName:       ptr.To("https-pool"),
+			CertificateCRN:        ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:cert"),
+			IdleConnectionTimeout: ptr.To[int64](120),
+			HTTPSRedirect:         &infrav1.VPCLoadBalancerListenerHTTPSRedirect{ListenerPort: 8443},
+		})
+		g.Expect(listener).To(Equal(vpcv1.LoadBalancerListenerPrototypeLoadBalancerContext{
+			Port:                  ptr.To[int64](443),
+			Protocol:              ptr.To("https"),
+			DefaultPool:           &vpcv1.LoadBalancerPoolIdentityByName{Name: ptr.To("https-pool")},
+			CertificateInstance:   &vpcv1.CertificateInstanceIdentityByCRN{CRN: ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:cert")},
+			IdleConnectionTimeout: ptr.To[int64](120),
+		}))
+	})
+}
+
+func TestClusterScopeV2ReconcileLoadBalancerListeners(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	oldCertCRN := "crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:old-cert"
+	newCertCRN := "crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:new-cert"
+	newListeners := func() map[int64]*vpcv1.LoadBalancerListener {
+		return map[int64]*vpcv1.LoadBalancerListener{
+			80: {
+				ID:       ptr.To("http-listener-id"),
+				Port:     ptr.To[int64](80),
+				Protocol: ptr.To("http"),
+				HTTPSRedirect: &vpcv1.LoadBalancerListenerHTTPSRedirect{
+					HTTPStatusCode: ptr.To[int64](301),
+					Listener:       &vpcv1.LoadBalancerListenerReference{ID: ptr.To("https-listener-id")},
+				},
+			},
+			443: {
+				ID:                  ptr.To("https-listener-id"),
+				Port:                ptr.To[int64](443),
+				Protocol:            ptr.To("https"),
+				CertificateInstance: &vpcv1.CertificateInstanceReference{CRN: ptr.To(oldCertCRN)},
+				DefaultPool:         &vpcv1.LoadBalancerPoolReference{ID: ptr.To("https-pool-id")},
+			},
+		}
+	}
+	pools := map[string]*vpcv1.LoadBalancerPool{
+		"https-pool": {ID: ptr.To("https-pool-id"), Name: ptr.To("https-pool")},
+	}
+	newLoadBalancerSpec := func() infrav1.VPCLoadBalancerSpec {
+		return infrav1.VPCLoadBalancerSpec{
+			Name: "capi-lb",
+			AdditionalListeners: []infrav1.AdditionalListenerSpec{
+				{
+					Port:          80,
+					Protocol:      ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTP),
+					HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{ListenerPort: 443},
+				},
+				{
+					Port:            443,
+					Protocol:        ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTPS),
+					DefaultPoolName: ptr.To("https-pool"),
+					CertificateCRN:  ptr.To(oldCertCRN),
+				},
+			},
+		}
+	}
+
+	t.Run("When listeners are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, newLoadBalancerSpec(), "lb-id", pools, newListeners())
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When listener does not exist it is created with the default pool id", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		listeners := newListeners()
+		delete(listeners, 443)
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners = loadBalancer.AdditionalListeners[1:]
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		mockVPC.EXPECT().CreateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(*options.LoadBalancerID).To(Equal("lb-id"))
+			g.Expect(*options.Port).To(Equal(int64(443)))
+			g.Expect(*options.Protocol).To(Equal("https"))
+			g.Expect(options.CertificateInstance).To(Equal(&vpcv1.CertificateInstanceIdentityByCRN{CRN: ptr.To(oldCertCRN)}))
+			g.Expect(options.DefaultPool).To(Equal(&vpcv1.LoadBalancerPoolIdentityLoadBalancerPoolIdentityByID{ID: ptr.To("https-pool-id")}))
+			return &vpcv1.LoadBalancerListener{ID: ptr.To("https-listener-id")}, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, loadBalancer, "lb-id", pools, listeners)
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When default pool of the listener does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, newLoadBalancerSpec(), "lb-id", map[string]*vpcv1.LoadBalancerPool{}, newListeners())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When HTTPS redirect is not set on the listener", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		listeners := newListeners()
+		listeners[80].HTTPSRedirect = nil
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		mockVPC.EXPECT().UpdateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("http-listener-id"))
+			g.Expect(options.LoadBalancerListenerPatch).To(HaveKeyWithValue("https_redirect", And(
+				HaveKeyWithValue("http_status_code", ptr.To[int64](301)),
+				HaveKeyWithValue("listener", HaveKeyWithValue("id", ptr.To("https-listener-id"))),
+			)))
+			return listeners[80], nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, newLoadBalancerSpec(), "lb-id", pools, listeners)
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When HTTPS redirect is removed from the spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners[0].HTTPSRedirect = nil
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		mockVPC.EXPECT().UpdateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("http-listener-id"))
+			g.Expect(options.LoadBalancerListenerPatch).To(HaveKeyWithValue("https_redirect", BeNil()))
+			return nil, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, loadBalancer, "lb-id", pools, newListeners())
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When HTTPS redirect target listener does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners[0].HTTPSRedirect.ListenerPort = 8443
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, loadBalancer, "lb-id", pools, newListeners())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When certificate CRN and idle connection timeout are changed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners[1].CertificateCRN = ptr.To(newCertCRN)
+		loadBalancer.AdditionalListeners[1].IdleConnectionTimeout = ptr.To[int64](120)
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		mockVPC.EXPECT().UpdateLoadBalancerListener(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("https-listener-id"))
+			g.Expect(options.LoadBalancerListenerPatch).To(HaveKeyWithValue("certificate_instance", HaveKeyWithValue("crn", ptr.To(newCertCRN))))
+			g.Expect(options.LoadBalancerListenerPatch).To(HaveKeyWithValue("idle_connection_timeout", ptr.To[int64](120)))
+			g.Expect(options.LoadBalancerListenerPatch).ToNot(HaveKey("https_redirect"))
+			return nil, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, loadBalancer, "lb-id", pools, newListeners())
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When UpdateLoadBalancerListener returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners[1].CertificateCRN = ptr.To(newCertCRN)
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		mockVPC.EXPECT().UpdateLoadBalancerListener(gomock.Any()).Return(nil, nil, errors.New("failed to update listener"))
+		updated, err := clusterScope.reconcileLoadBalancerListeners(ctx, loadBalancer, "lb-id", pools, newListeners())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+}
+
+func TestClusterScopeV2ReconcileLoadBalancerPools(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	newBackendPool := func() infrav1.VPCLoadBalancerBackendPoolSpec {
+		return infrav1.VPCLoadBalancerBackendPoolSpec{
+			Name:      ptr.To("http-pool"),
+			Algorithm: infrav1.VPCLoadBalancerBackendPoolAlgorithmRoundRobin,
+			Protocol:  infrav1.VPCLoadBalancerBackendPoolProtocolHTTP,
+			HealthMonitor: infrav1.VPCLoadBalancerHealthMonitorSpec{
+				Delay:   5,
+				Retries: 2,
+				Timeout: 2,
+				Type:    infrav1.VPCLoadBalancerBackendPoolHealthMonitorTypeHTTP,
+				Port:    ptr.To[int64](8080),
+				URLPath: ptr.To("/healthz"),
+			},
+		}
+	}
+	newPools := func() map[string]*vpcv1.LoadBalancerPool {
+		return map[string]*vpcv1.LoadBalancerPool{
+			"http-pool": {
+				ID:        ptr.To("http-pool-id"),
+				Name:      ptr.To("http-pool"),
+				Algorithm: ptr.To("round_robin"),
+				Protocol:  ptr.To("http"),
+				HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorTypeHttphttps{
+					Delay:      ptr.To[int64](5),
+					MaxRetries: ptr.To[int64](2),
+					Timeout:    ptr.To[int64](2),
+					Type:       ptr.To("http"),
+					Port:       ptr.To[int64](8080),
+					URLPath:    ptr.To("/healthz"),
+				},
+			},
+		}
+	}
+
+	t.Run("When pools are up to date", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{newBackendPool()}}
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", newPools())
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When pool without a name is skipped", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		backendPool := newBackendPool()
+		backendPool.Name = nil
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{backendPool}}
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", map[string]*vpcv1.LoadBalancerPool{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When pool does not exist it is created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{newBackendPool()}}
+		mockVPC.EXPECT().CreateLoadBalancerPool(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+			g.Expect(*options.LoadBalancerID).To(Equal("lb-id"))
+			g.Expect(*options.Name).To(Equal("http-pool"))
+			g.Expect(*options.Algorithm).To(Equal("round_robin"))
+			g.Expect(*options.Protocol).To(Equal("http"))
+			g.Expect(options.HealthMonitor).To(Equal(&vpcv1.LoadBalancerPoolHealthMonitorPrototype{
+				Delay:      ptr.To[int64](5),
+				MaxRetries: ptr.To[int64](2),
+				Timeout:    ptr.To[int64](2),
+				Type:       ptr.To("http"),
+				Port:       ptr.To[int64](8080),
+				URLPath:    ptr.To("/healthz"),
+			}))
+			return &vpcv1.LoadBalancerPool{ID: ptr.To("http-pool-id")}, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", map[string]*vpcv1.LoadBalancerPool{})
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When CreateLoadBalancerPool returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{newBackendPool()}}
+		mockVPC.EXPECT().CreateLoadBalancerPool(gomock.Any()).Return(nil, nil, errors.New("failed to create pool"))
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", map[string]*vpcv1.LoadBalancerPool{})
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When pool algorithm is changed only the algorithm is updated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		backendPool := newBackendPool()
+		backendPool.Algorithm = infrav1.VPCLoadBalancerBackendPoolAlgorithmLeastConnections
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{backendPool}}
+		mockVPC.EXPECT().UpdateLoadBalancerPool(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+			g.Expect(*options.ID).To(Equal("http-pool-id"))
+			g.Expect(options.LoadBalancerPoolPatch).To(Equal(map[string]interface{}{"algorithm": ptr.To("least_connections")}))
+			return nil, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", newPools())
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When health monitor is changed it is updated", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		backendPool := newBackendPool()
+		backendPool.HealthMonitor.URLPath = ptr.To("/readyz")
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{backendPool}}
+		mockVPC.EXPECT().UpdateLoadBalancerPool(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+			g.Expect(options.LoadBalancerPoolPatch).ToNot(HaveKey("algorithm"))
+			g.Expect(options.LoadBalancerPoolPatch).ToNot(HaveKey("protocol"))
+			g.Expect(options.LoadBalancerPoolPatch).To(HaveKeyWithValue("health_monitor", HaveKeyWithValue("url_path", ptr.To("/readyz"))))
+			return nil, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", newPools())
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When health monitor type is changed from TCP to HTTP", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		pools := newPools()
+		pools["http-pool"].HealthMonitor = &vpcv1.LoadBalancerPoolHealthMonitorTypeTCP{
+			Delay:      ptr.To[int64](5),
+			MaxRetries: ptr.To[int64](2),
+			Timeout:    ptr.To[int64](2),
+			Type:       ptr.To("tcp"),
+			Port:       ptr.To[int64](8080),
+		}
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{newBackendPool()}}
+		mockVPC.EXPECT().UpdateLoadBalancerPool(gomock.Any()).DoAndReturn(func(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+			g.Expect(options.LoadBalancerPoolPatch).To(HaveKeyWithValue("health_monitor", HaveKeyWithValue("type", ptr.To("http"))))
+			return nil, nil, nil
+		})
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", pools)
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When UpdateLoadBalancerPool returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		backendPool := newBackendPool()
+		backendPool.Protocol = infrav1.VPCLoadBalancerBackendPoolProtocolTCP
+		clusterScope := ClusterScopeV2{VPCClient: mockVPC}
+		loadBalancer := infrav1.VPCLoadBalancerSpec{BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{backendPool}}
+		mockVPC.EXPECT().UpdateLoadBalancerPool(gomock.Any()).Return(nil, nil, errors.New("failed to update pool"))
+		updated, err := clusterScope.reconcileLoadBalancerPools(ctx, loadBalancer, "lb-id", newPools())
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+}
+
+func TestLoadBalancerPoolHealthMonitor(t *testing.T) {
+	t.Run("When health monitor is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(loadBalancerPoolHealthMonitor(nil)).To(BeNil())
+	})
+	t.Run("When health monitor is an HTTP health monitor", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(loadBalancerPoolHealthMonitor(&vpcv1.LoadBalancerPoolHealthMonitorTypeHttphttps{
+			Delay:      ptr.To[int64](5),
+			MaxRetries: ptr.To[int64](2),
+			Timeout:    ptr.To[int64](2),
+			Type:       ptr.To("https"),
+			URLPath:    ptr.To("/healthz"),
+		})).To(Equal(&vpcv1.LoadBalancerPoolHealthMonitor{
+			Delay:      ptr.To[int64](5),
+			MaxRetries: ptr.To[int64](2),
+			Timeout:    ptr.To[int64](2),
+			Type:       ptr.To("https"),
+			URLPath:    ptr.To("/healthz"),
+		}))
+	})
+	t.Run("When health monitor is a TCP health monitor", func(t *testing.T) {
+		g := NewWithT(t)
+		g.Expect(loadBalancerPoolHealthMonitor(&vpcv1.LoadBalancerPoolHealthMonitorTypeTCP{
+			Delay:      ptr.To[int64](5),
+			MaxRetries: ptr.To[int64](2),
+			Timeout:    ptr.To[int64](2),
+			Type:       ptr.To("tcp"),
+			Port:       ptr.To[int64](6443),
+		})).To(Equal(&vpcv1.LoadBalancerPoolHealthMonitor{
+			Delay:      ptr.To[int64](5),
+			MaxRetries: ptr.To[int64](2),
+			Timeout:    ptr.To[int64](2),
+			Type:       ptr.To("tcp"),
+			Port:       ptr.To[int64](6443),
+		}))
+	})
+}
+
+func TestClusterScopeV2ReconcileLoadBalancerResources(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+
+	newClusterScope := func(lbStatus *infrav1.VPCLoadBalancerStatus) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						LoadBalancers: map[string]*infrav1.VPCLoadBalancerStatus{"lb-id": lbStatus},
+					},
+				},
+			},
+			VPCClient: mockVPC,
+		}
+	}
+	tcpPool := vpcv1.LoadBalancerPool{
+		ID:        ptr.To("tcp-pool-id"),
+		Name:      ptr.To("tcp-pool"),
+		Algorithm: ptr.To("round_robin"),
+		Protocol:  ptr.To("tcp"),
+		HealthMonitor: &vpcv1.LoadBalancerPoolHealthMonitorTypeTCP{
+			Delay:      ptr.To[int64](5),
+			MaxRetries: ptr.To[int64](2),
+			Timeout:    ptr.To[int64](2),
+			Type:       ptr.To("tcp"),
+		},
+	}
+	tcpListener := &vpcv1.LoadBalancerListener{
+		ID:          ptr.To("tcp-listener-id"),
+		Port:        ptr.To[int64](22),
+		Protocol:    ptr.To("tcp"),
+		DefaultPool: &vpcv1.LoadBalancerPoolReference{ID: ptr.To("tcp-pool-id")},
+	}
+	newLoadBalancerSpec := func() infrav1.VPCLoadBalancerSpec {
+		return infrav1.VPCLoadBalancerSpec{
+			Name: "capi-lb",
+			BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{
+				{
+					Name:      ptr.To("tcp-pool"),
+					Algorithm: infrav1.VPCLoadBalancerBackendPoolAlgorithmRoundRobin,
+					Protocol:  infrav1.VPCLoadBalancerBackendPoolProtocolTCP,
+					HealthMonitor: infrav1.VPCLoadBalancerHealthMonitorSpec{
+						Delay:   5,
+						Retries: 2,
+						Timeout: 2,
+						Type:    infrav1.VPCLoadBalancerBackendPoolHealthMonitorTypeTCP,
+					},
+				},
+			},
+			AdditionalListeners: []infrav1.AdditionalListenerSpec{
+				{Port: 22, DefaultPoolName: ptr.To("tcp-pool")},
+			},
+		}
+	}
+
+	t.Run("When load balancer status is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCLoadBalancerStatus{})
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, newLoadBalancerSpec(), "other-lb-id")
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When load balancer uses the default pools and listeners", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCLoadBalancerStatus{})
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, infrav1.VPCLoadBalancerSpec{Name: "capi-lb"}, "lb-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When GetLoadBalancer returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCLoadBalancerStatus{})
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, nil, errors.New("failed to get load balancer"))
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, newLoadBalancerSpec(), "lb-id")
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(updated).To(BeFalse())
+	})
+	t.Run("When pools and listeners are up to date their ids are recorded in status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		lbStatus := &infrav1.VPCLoadBalancerStatus{}
+		clusterScope := newClusterScope(lbStatus)
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:        ptr.To("lb-id"),
+			Listeners: []vpcv1.LoadBalancerListenerReference{{ID: ptr.To("tcp-listener-id")}},
+		}, nil, nil)
+		mockVPC.EXPECT().ListLoadBalancerPools(gomock.Any()).Return(&vpcv1.LoadBalancerPoolCollection{Pools: []vpcv1.LoadBalancerPool{tcpPool}}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(tcpListener, nil, nil)
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, newLoadBalancerSpec(), "lb-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+		g.Expect(lbStatus.Pools).To(Equal([]infrav1.VPCLoadBalancerPoolStatus{{Name: "tcp-pool", ID: ptr.To("tcp-pool-id")}}))
+		g.Expect(lbStatus.Listeners).To(Equal([]infrav1.VPCLoadBalancerListenerStatus{{Port: 22, ID: ptr.To("tcp-listener-id")}}))
+	})
+	t.Run("When pool does not exist it is created before the listener", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCLoadBalancerStatus{})
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id")}, nil, nil)
+		mockVPC.EXPECT().ListLoadBalancerPools(gomock.Any()).Return(&vpcv1.LoadBalancerPoolCollection{}, nil, nil)
+		mockVPC.EXPECT().CreateLoadBalancerPool(gomock.Any()).Return(&vpcv1.LoadBalancerPool{ID: ptr.To("tcp-pool-id")}, nil, nil)
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, newLoadBalancerSpec(), "lb-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+	})
+	t.Run("When listener removed from spec is pruned", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		lbStatus := &infrav1.VPCLoadBalancerStatus{
+			Listeners: []infrav1.VPCLoadBalancerListenerStatus{{Port: 22, ID: ptr.To("tcp-listener-id")}},
+		}
+		clusterScope := newClusterScope(lbStatus)
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners = nil
+		loadBalancer.PruneListeners = ptr.To(true)
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:        ptr.To("lb-id"),
+			Listeners: []vpcv1.LoadBalancerListenerReference{{ID: ptr.To("tcp-listener-id")}},
+		}, nil, nil)
+		mockVPC.EXPECT().ListLoadBalancerPools(gomock.Any()).Return(&vpcv1.LoadBalancerPoolCollection{Pools: []vpcv1.LoadBalancerPool{tcpPool}}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(tcpListener, nil, nil)
+		mockVPC.EXPECT().DeleteLoadBalancerListener(&vpcv1.DeleteLoadBalancerListenerOptions{
+			LoadBalancerID: ptr.To("lb-id"),
+			ID:             ptr.To("tcp-listener-id"),
+		}).Return(nil, nil)
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, loadBalancer, "lb-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeTrue())
+		// The pruned listener is kept in status until it is no longer found on the load balancer.
+		g.Expect(lbStatus.Listeners).To(Equal([]infrav1.VPCLoadBalancerListenerStatus{{Port: 22, ID: ptr.To("tcp-listener-id")}}))
+	})
+	t.Run("When listener removed from spec is not pruned", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		lbStatus := &infrav1.VPCLoadBalancerStatus{
+			Listeners: []infrav1.VPCLoadBalancerListenerStatus{{Port: 22, ID: ptr.To("tcp-listener-id")}},
+		}
+		clusterScope := newClusterScope(lbStatus)
+		loadBalancer := newLoadBalancerSpec()
+		loadBalancer.AdditionalListeners = nil
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{
+			ID:        ptr.To("lb-id"),
+			Listeners: []vpcv1.LoadBalancerListenerReference{{ID: ptr.To("tcp-listener-id")}},
+		}, nil, nil)
+		mockVPC.EXPECT().ListLoadBalancerPools(gomock.Any()).Return(&vpcv1.LoadBalancerPoolCollection{Pools: []vpcv1.LoadBalancerPool{tcpPool}}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancerListener(gomock.Any()).Return(tcpListener, nil, nil)
+		updated, err := clusterScope.reconcileLoadBalancerResources(ctx, loadBalancer, "lb-id")
+		g.Expect(err).To(BeNil())
+		g.Expect(updated).To(BeFalse())
+		g.Expect(lbStatus.Listeners).To(BeEmpty())
+	})
+}
+
+func TestClusterScopeV2ReconcileTransitGateway(t *testing.T) {
+	var (
+		mockTG   *tgmock.MockTransitGateway
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	remoteVPCCRN := "crn:v1:bluemix:public:is:eu-de:a/account::vpc:r010-remote"
+	newClusterScope := func(transitGateway *infrav1.VPCTransitGateway) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			TransitGatewayClient: mockTG,
+			VPCClient:            mockVPC,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi"},
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region: "us-south",
+					Network: &infrav1.VPCNetworkSpec{
+						TransitGateway: transitGateway,
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					ResourceGroup: &infrav1.ResourceStatus{ID: "rg-id"},
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{ID: "vpc-id"},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When transit gateway is not set in spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When transit gateway is adopted by id from spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{ID: ptr.To("tg-id")}).Return(&tgapiv1.TransitGateway{
+			ID:     ptr.To("tg-id"),
+			Name:   ptr.To("existing-tg"),
+			Status: ptr.To(tgapiv1.TransitGateway_Status_Pending),
+		}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(Equal(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("When transit gateway is adopted by name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{Name: ptr.To("existing-tg")})
+		mockTG.EXPECT().GetTransitGatewayByName("existing-tg").Return(&tgapiv1.TransitGateway{
+			ID:     ptr.To("tg-id"),
+			Name:   ptr.To("existing-tg"),
+			Status: ptr.To(tgapiv1.TransitGateway_Status_Pending),
+		}, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(Equal(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(false)}))
+	})
+	t.Run("When retrieving transit gateway by name fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{})
+		mockTG.EXPECT().GetTransitGatewayByName("capi-transitgateway").Return(nil, errors.New("failed to list transit gateways"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When transit gateway is created with local routing", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{})
+		mockTG.EXPECT().GetTransitGatewayByName("capi-transitgateway").Return(nil, nil)
+		mockTG.EXPECT().CreateTransitGateway(&tgapiv1.CreateTransitGatewayOptions{
+			Location:      ptr.To("us-south"),
+			Name:          ptr.To("capi-transitgateway"),
+			Global:        ptr.To(false),
+			ResourceGroup: &tgapiv1.ResourceGroupIdentity{ID: ptr.To("rg-id")},
+		}).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(Equal(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)}))
+	})
+	t.Run("When transit gateway is created with global routing for a VPC in another region", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{
+			VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}},
+		})
+		mockTG.EXPECT().GetTransitGatewayByName("capi-transitgateway").Return(nil, nil)
+		mockTG.EXPECT().CreateTransitGateway(&tgapiv1.CreateTransitGatewayOptions{
+			Location:      ptr.To("us-south"),
+			Name:          ptr.To("capi-transitgateway"),
+			Global:        ptr.To(true),
+			ResourceGroup: &tgapiv1.ResourceGroupIdentity{ID: ptr.To("rg-id")},
+		}).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When local routing is requested for a VPC in another region", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{
+			GlobalRouting:  ptr.To(false),
+			VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}},
+		})
+		mockTG.EXPECT().GetTransitGatewayByName("capi-transitgateway").Return(nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(BeNil())
+	})
+	t.Run("When connection VPC CRN is malformed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{
+			VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: "r010-remote"}},
+		})
+		mockTG.EXPECT().GetTransitGatewayByName("capi-transitgateway").Return(nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When creating transit gateway fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{})
+		mockTG.EXPECT().GetTransitGatewayByName("capi-transitgateway").Return(nil, nil)
+		mockTG.EXPECT().CreateTransitGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create transit gateway"))
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(BeNil())
+	})
+	t.Run("When transit gateway is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{
+			ID:     ptr.To("tg-id"),
+			Name:   ptr.To("existing-tg"),
+			Status: ptr.To(tgapiv1.TransitGateway_Status_Failed),
+		}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When transit gateway is available the connections are reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGateway{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{
+			ID:     ptr.To("tg-id"),
+			Name:   ptr.To("existing-tg"),
+			Status: ptr.To(tgapiv1.TransitGateway_Status_Available),
+		}, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{{
+				ID:          ptr.To("vpc-con-id"),
+				Name:        ptr.To("existing-tg-vpc-con"),
+				NetworkType: ptr.To(tgapiv1.TransitGatewayConnectionCust_NetworkType_Vpc),
+				NetworkID:   ptr.To("vpc-crn"),
+				Status:      ptr.To(tgapiv1.TransitGatewayConnectionCust_Status_Attached),
+			}},
+		}, nil, nil)
+		mockVPC.EXPECT().GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To("vpc-id")}).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+		requeue, err := clusterScope.ReconcileTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnection).To(Equal(&infrav1.VPCTransitGatewayConnectionStatus{
+			Name: "existing-tg-vpc-con", ID: ptr.To("vpc-con-id"), ControllerCreated: ptr.To(false),
+		}))
+	})
+}
+
+func TestClusterScopeV2ReconcileTransitGatewayConnections(t *testing.T) {
+	var (
+		mockTG   *tgmock.MockTransitGateway
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	remoteVPCCRN := "crn:v1:bluemix:public:is:us-south:a/account::vpc:r006-remote"
+	transitGateway := &tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Name: ptr.To("capi-tg")}
+	newClusterScope := func(connections []infrav1.VPCTransitGatewayConnection, status *infrav1.VPCTransitGatewayStatus) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			TransitGatewayClient: mockTG,
+			VPCClient:            mockVPC,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{
+						TransitGateway: &infrav1.VPCTransitGateway{VPCConnections: connections},
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						VPC:            &infrav1.ResourceStatus{ID: "vpc-id"},
+						TransitGateway: status,
+					},
+				},
+			},
+		}
+	}
+	newConnection := func(id, name, vpcCRN, status string) tgapiv1.TransitGatewayConnectionCust {
+		return tgapiv1.TransitGatewayConnectionCust{
+			ID:          ptr.To(id),
+			Name:        ptr.To(name),
+			NetworkType: ptr.To(tgapiv1.TransitGatewayConnectionCust_NetworkType_Vpc),
+			NetworkID:   ptr.To(vpcCRN),
+			Status:      ptr.To(status),
+		}
+	}
+	expectGetVPC := func() {
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{ID: ptr.To("vpc-id"), CRN: ptr.To("vpc-crn")}, nil, nil)
+	}
+
+	t.Run("When connections do not exist they are created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope([]infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}}, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		expectGetVPC()
+		mockTG.EXPECT().CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+			TransitGatewayID: ptr.To("tg-id"),
+			NetworkType:      ptr.To(tgapiv1.CreateTransitGatewayConnectionOptions_NetworkType_Vpc),
+			NetworkID:        ptr.To("vpc-crn"),
+			Name:             ptr.To("capi-tg-vpc-con"),
+		}).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("vpc-con-id")}, nil, nil)
+		mockTG.EXPECT().CreateTransitGatewayConnection(&tgapiv1.CreateTransitGatewayConnectionOptions{
+			TransitGatewayID: ptr.To("tg-id"),
+			NetworkType:      ptr.To(tgapiv1.CreateTransitGatewayConnectionOptions_NetworkType_Vpc),
+			NetworkID:        ptr.To(remoteVPCCRN),
+			Name:             ptr.To("remote"),
+		}).Return(&tgapiv1.TransitGatewayConnectionCust{ID: ptr.To("remote-con-id")}, nil, nil)
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnection).To(Equal(&infrav1.VPCTransitGatewayConnectionStatus{
+			Name: "capi-tg-vpc-con", ID: ptr.To("vpc-con-id"), ControllerCreated: ptr.To(true),
+		}))
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnections).To(Equal([]infrav1.VPCTransitGatewayConnectionStatus{
+			{Name: "remote", ID: ptr.To("remote-con-id"), ControllerCreated: ptr.To(true)},
+		}))
+	})
+	t.Run("When connections exist they are adopted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope([]infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}}, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				newConnection("vpc-con-id", "existing-vpc-con", "vpc-crn", tgapiv1.TransitGatewayConnectionCust_Status_Attached),
+				newConnection("remote-con-id", "existing-remote-con", remoteVPCCRN, tgapiv1.TransitGatewayConnectionCust_Status_Attached),
+			},
+		}, nil, nil)
+		expectGetVPC()
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnection).To(Equal(&infrav1.VPCTransitGatewayConnectionStatus{
+			Name: "existing-vpc-con", ID: ptr.To("vpc-con-id"), ControllerCreated: ptr.To(false),
+		}))
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnections).To(Equal([]infrav1.VPCTransitGatewayConnectionStatus{
+			{Name: "remote", ID: ptr.To("remote-con-id"), ControllerCreated: ptr.To(false)},
+		}))
+	})
+	t.Run("When connection is pending", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				newConnection("vpc-con-id", "capi-tg-vpc-con", "vpc-crn", tgapiv1.TransitGatewayConnectionCust_Status_Pending),
+			},
+		}, nil, nil)
+		expectGetVPC()
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When connection is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope([]infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}}, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				newConnection("vpc-con-id", "capi-tg-vpc-con", "vpc-crn", tgapiv1.TransitGatewayConnectionCust_Status_Attached),
+				newConnection("remote-con-id", "remote", remoteVPCCRN, tgapiv1.TransitGatewayConnectionCust_Status_Failed),
+			},
+		}, nil, nil)
+		expectGetVPC()
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When connections removed from spec are deleted only if created by the controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil, &infrav1.VPCTransitGatewayStatus{
+			ID:            ptr.To("tg-id"),
+			VPCConnection: &infrav1.VPCTransitGatewayConnectionStatus{Name: "capi-tg-vpc-con", ID: ptr.To("vpc-con-id"), ControllerCreated: ptr.To(true)},
+			VPCConnections: []infrav1.VPCTransitGatewayConnectionStatus{
+				{Name: "created", ID: ptr.To("created-con-id"), ControllerCreated: ptr.To(true)},
+				{Name: "adopted", ID: ptr.To("adopted-con-id"), ControllerCreated: ptr.To(false)},
+			},
+		})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{
+			Connections: []tgapiv1.TransitGatewayConnectionCust{
+				newConnection("vpc-con-id", "capi-tg-vpc-con", "vpc-crn", tgapiv1.TransitGatewayConnectionCust_Status_Attached),
+			},
+		}, nil, nil)
+		expectGetVPC()
+		mockTG.EXPECT().DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{
+			TransitGatewayID: ptr.To("tg-id"),
+			ID:               ptr.To("created-con-id"),
+		}).Return(nil, nil)
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnections).To(BeEmpty())
+	})
+	t.Run("When listing connections fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(nil, nil, errors.New("failed to list connections"))
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When retrieving the cluster VPC fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, nil, errors.New("failed to get vpc"))
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When creating connection fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil, &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id")})
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(&tgapiv1.TransitGatewayConnectionCollection{}, nil, nil)
+		expectGetVPC()
+		mockTG.EXPECT().CreateTransitGatewayConnection(gomock.Any()).Return(nil, nil, errors.New("failed to create connection"))
+		requeue, err := clusterScope.reconcileTransitGatewayConnections(ctx, transitGateway)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus().VPCConnection).To(BeNil())
+	})
+}
+
+func TestClusterScopeV2DeleteTransitGateway(t *testing.T) {
+	var (
+		mockTG   *tgmock.MockTransitGateway
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(status *infrav1.VPCTransitGatewayStatus) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			TransitGatewayClient: mockTG,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{TransitGateway: status},
+				},
+			},
+		}
+	}
+	availableTransitGateway := &tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Status: ptr.To(tgapiv1.TransitGateway_Status_Available)}
+	newConnections := func(ids ...string) *tgapiv1.TransitGatewayConnectionCollection {
+		connections := &tgapiv1.TransitGatewayConnectionCollection{}
+		for _, id := range ids {
+			connections.Connections = append(connections.Connections, tgapiv1.TransitGatewayConnectionCust{
+				ID:     ptr.To(id),
+				Name:   ptr.To(id),
+				Status: ptr.To(tgapiv1.TransitGatewayConnectionCust_Status_Attached),
+			})
+		}
+		return connections
+	}
+
+	t.Run("When transit gateway is not in status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When transit gateway is not found it is removed from status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("not found"))
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(BeNil())
+	})
+	t.Run("When retrieving transit gateway fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: http.StatusInternalServerError}, errors.New("internal error"))
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus()).ToNot(BeNil())
+	})
+	t.Run("When transit gateway is being deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{ID: ptr.To("tg-id"), Status: ptr.To(tgapiv1.TransitGateway_Status_Deleting)}, nil, nil)
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When transit gateway created by the controller has connections they are all deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(availableTransitGateway, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(newConnections("vpc-con-id", "other-con-id"), nil, nil)
+		mockTG.EXPECT().DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{TransitGatewayID: ptr.To("tg-id"), ID: ptr.To("vpc-con-id")}).Return(nil, nil)
+		mockTG.EXPECT().DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{TransitGatewayID: ptr.To("tg-id"), ID: ptr.To("other-con-id")}).Return(nil, nil)
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When adopted transit gateway has connections only the controller created ones are deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{
+			ID:                ptr.To("tg-id"),
+			ControllerCreated: ptr.To(false),
+			VPCConnection:     &infrav1.VPCTransitGatewayConnectionStatus{Name: "vpc-con", ID: ptr.To("vpc-con-id"), ControllerCreated: ptr.To(true)},
+			VPCConnections:    []infrav1.VPCTransitGatewayConnectionStatus{{Name: "adopted", ID: ptr.To("adopted-con-id"), ControllerCreated: ptr.To(false)}},
+		})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(availableTransitGateway, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(newConnections("vpc-con-id", "adopted-con-id"), nil, nil)
+		mockTG.EXPECT().DeleteTransitGatewayConnection(&tgapiv1.DeleteTransitGatewayConnectionOptions{TransitGatewayID: ptr.To("tg-id"), ID: ptr.To("vpc-con-id")}).Return(nil, nil)
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When deleting connection fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(availableTransitGateway, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(newConnections("vpc-con-id"), nil, nil)
+		mockTG.EXPECT().DeleteTransitGatewayConnection(gomock.Any()).Return(&core.DetailedResponse{StatusCode: http.StatusInternalServerError}, errors.New("internal error"))
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When adopted transit gateway has no controller created connections it is removed from status", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(false)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(availableTransitGateway, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(newConnections("adopted-con-id"), nil, nil)
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.TransitGatewayStatus()).To(BeNil())
+	})
+	t.Run("When transit gateway created by the controller has no connections it is deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(availableTransitGateway, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(newConnections(), nil, nil)
+		mockTG.EXPECT().DeleteTransitGateway(&tgapiv1.DeleteTransitGatewayOptions{ID: ptr.To("tg-id")}).Return(nil, nil)
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When deleting transit gateway fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)})
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(availableTransitGateway, nil, nil)
+		mockTG.EXPECT().ListTransitGatewayConnections(gomock.Any()).Return(newConnections(), nil, nil)
+		mockTG.EXPECT().DeleteTransitGateway(gomock.Any()).Return(&core.DetailedResponse{StatusCode: http.StatusInternalServerError}, errors.New("internal error"))
+		requeue, err := clusterScope.DeleteTransitGateway(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+}
+
+func TestClusterScopeV2ReconcileVPCAddressPrefixes(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	addressPrefixes := []infrav1.VPCAddressPrefix{
+		{Name: ptr.To("prefix-1"), CIDR: "10.240.0.0/18", Zone: "us-south-1"},
+		{CIDR: "10.240.64.0/18", Zone: "us-south-2"},
+	}
+	newClusterScope := func(networkStatus *infrav1.VPCNetworkStatus) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			VPCClient: mockVPC,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{AddressPrefixes: addressPrefixes},
+				},
+				Status: infrav1.IBMVPCClusterStatus{Network: networkStatus},
+			},
+		}
+	}
+
+	t.Run("When address prefixes are not set in spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		clusterScope.IBMVPCCluster.Spec.Network.AddressPrefixes = nil
+		g.Expect(clusterScope.reconcileVPCAddressPrefixes(ctx, ptr.To("vpc-id"))).To(Succeed())
+	})
+	t.Run("When all address prefixes are in status the VPC is not queried", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCNetworkStatus{
+			AddressPrefixes: map[string]*infrav1.ResourceStatus{
+				"10.240.0.0/18":  {ID: "prefix-1-id", Ready: true},
+				"10.240.64.0/18": {ID: "prefix-2-id", Ready: true},
+			},
+		})
+		g.Expect(clusterScope.reconcileVPCAddressPrefixes(ctx, ptr.To("vpc-id"))).To(Succeed())
+	})
+	t.Run("When address prefixes exist they are adopted and missing ones are created", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCNetworkStatus{})
+		mockVPC.EXPECT().GetVPCAddressPrefixes("vpc-id").Return([]vpcv1.AddressPrefix{
+			{ID: ptr.To("prefix-1-id"), Name: ptr.To("prefix-1"), CIDR: ptr.To("10.240.0.0/18"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}},
+			// A prefix with the same cidr in another zone does not match.
+			{ID: ptr.To("other-id"), CIDR: ptr.To("10.240.64.0/18"), Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-3")}},
+		}, nil)
+		mockVPC.EXPECT().CreateVPCAddressPrefix(&vpcv1.CreateVPCAddressPrefixOptions{
+			VPCID: ptr.To("vpc-id"),
+			CIDR:  ptr.To("10.240.64.0/18"),
+			Zone:  &vpcv1.ZoneIdentity{Name: ptr.To("us-south-2")},
+		}).Return(&vpcv1.AddressPrefix{ID: ptr.To("prefix-2-id"), Name: ptr.To("generated-name")}, nil, nil)
+		g.Expect(clusterScope.reconcileVPCAddressPrefixes(ctx, ptr.To("vpc-id"))).To(Succeed())
+		g.Expect(clusterScope.NetworkStatus().AddressPrefixes).To(Equal(map[string]*infrav1.ResourceStatus{
+			"10.240.0.0/18":  {ID: "prefix-1-id", Name: ptr.To("prefix-1"), Ready: true},
+			"10.240.64.0/18": {ID: "prefix-2-id", Name: ptr.To("generated-name"), Ready: true},
+		}))
+	})
+	t.Run("When only the address prefixes missing from status are reconciled", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCNetworkStatus{
+			AddressPrefixes: map[string]*infrav1.ResourceStatus{
+				"10.240.0.0/18": {ID: "prefix-1-id", Ready: true},
+			},
+		})
+		mockVPC.EXPECT().GetVPCAddressPrefixes("vpc-id").Return(nil, nil)
+		mockVPC.EXPECT().CreateVPCAddressPrefix(gomock.Any()).Return(&vpcv1.AddressPrefix{ID: ptr.To("prefix-2-id")}, nil, nil)
+		g.Expect(clusterScope.reconcileVPCAddressPrefixes(ctx, ptr.To("vpc-id"))).To(Succeed())
+		g.Expect(clusterScope.NetworkStatus().AddressPrefixes).To(HaveLen(2))
+	})
+	t.Run("When listing address prefixes fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		mockVPC.EXPECT().GetVPCAddressPrefixes("vpc-id").Return(nil, errors.New("failed to list address prefixes"))
+		g.Expect(clusterScope.reconcileVPCAddressPrefixes(ctx, ptr.To("vpc-id"))).ToNot(Succeed())
+		g.Expect(clusterScope.NetworkStatus()).To(BeNil())
+	})
+	t.Run("When creating address prefix fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCNetworkStatus{})
+		mockVPC.EXPECT().GetVPCAddressPrefixes("vpc-id").Return(nil, nil)
+		mockVPC.EXPECT().CreateVPCAddressPrefix(gomock.Any()).Return(nil, nil, errors.New("failed to create address prefix"))
+		g.Expect(clusterScope.reconcileVPCAddressPrefixes(ctx, ptr.To("vpc-id"))).ToNot(Succeed())
+		g.Expect(clusterScope.NetworkStatus().AddressPrefixes).To(BeEmpty())
+	})
+}
+
+func TestClusterScopeV2SubnetEgress(t *testing.T) {
+	existingPublicGateway := &infrav1.VPCEgress{
+		Mode:          infrav1.VPCEgressModePublicGateway,
+		PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")},
+	}
+	testcases := []struct {
+		name           string
+		network        *infrav1.VPCNetworkSpec
+		subnet         infrav1.Subnet
+		expectedEgress infrav1.VPCEgress
+	}{
+		{
+			name:           "Defaults to a public gateway created by the controller",
+			expectedEgress: infrav1.VPCEgress{Mode: infrav1.VPCEgressModePublicGateway},
+		},
+		{
+			name:           "Uses the egress of the network",
+			network:        &infrav1.VPCNetworkSpec{Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone}},
+			expectedEgress: infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone},
+		},
+		{
+			name:           "Uses the egress of the subnet over the egress of the network",
+			network:        &infrav1.VPCNetworkSpec{Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone}},
+			subnet:         infrav1.Subnet{Egress: existingPublicGateway},
+			expectedEgress: *existingPublicGateway,
+		},
+		{
+			name:           "Defaults the mode of the egress to public gateway",
+			subnet:         infrav1.Subnet{Egress: &infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")}}},
+			expectedEgress: *existingPublicGateway,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &ClusterScopeV2{
+				IBMVPCCluster: &infrav1.IBMVPCCluster{
+					Spec: infrav1.IBMVPCClusterSpec{Network: tc.network},
+				},
+			}
+			g.Expect(clusterScope.subnetEgress(tc.subnet)).To(Equal(tc.expectedEgress))
+		})
+	}
+}
+
+func TestClusterScopeV2CreateSubnetEgress(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockGT   *gtmock.MockGlobalTagging
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockGT = gtmock.NewMockGlobalTagging(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(egress *infrav1.VPCEgress) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			VPCClient:           mockVPC,
+			GlobalTaggingClient: mockGT,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi"},
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region:  "us-south",
+					Network: &infrav1.VPCNetworkSpec{Egress: egress},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					ResourceGroup: &infrav1.ResourceStatus{ID: "rg-id"},
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{ID: "vpc-id"},
+					},
+				},
+			},
+		}
+	}
+	subnet := infrav1.Subnet{Name: ptr.To("capi-subnet-us-south-1"), Zone: ptr.To("us-south-1")}
+	// expectCreateSubnet expects the subnet creation, with the public gateway attached to the subnet, if any.
+	expectCreateSubnet := func(g *WithT, publicGatewayID *string) {
+		mockVPC.EXPECT().CreateSubnet(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateSubnetOptions) (*vpcv1.Subnet, *core.DetailedResponse, error) {
+			prototype, ok := options.SubnetPrototype.(*vpcv1.SubnetPrototype)
+			g.Expect(ok).To(BeTrue())
+			if publicGatewayID == nil {
+				g.Expect(prototype.PublicGateway).To(BeNil())
+			} else {
+				g.Expect(prototype.PublicGateway).To(Equal(&vpcv1.PublicGatewayIdentity{ID: publicGatewayID}))
+			}
+			return &vpcv1.Subnet{ID: ptr.To("subnet-id"), CRN: ptr.To("subnet-crn"), Name: subnet.Name}, nil, nil
+		})
+		mockGT.EXPECT().GetTagByName("capi").Return(&globaltaggingv1.Tag{}, nil)
+		mockGT.EXPECT().AttachTag(gomock.Any()).Return(nil, nil, nil)
+	}
+
+	t.Run("When egress mode is None no public gateway is attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone})
+		expectCreateSubnet(g, nil)
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).To(Succeed())
+		g.Expect(clusterScope.NetworkStatus().ControlPlaneSubnets).To(HaveKey("capi-subnet-us-south-1"))
+	})
+	t.Run("When egress is not set the public gateway of the zone is attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		mockVPC.EXPECT().GetVPCPublicGatewayByName("capi-pgateway-us-south-1", "rg-id").Return(&vpcv1.PublicGateway{ID: ptr.To("zone-pgw-id")}, nil)
+		expectCreateSubnet(g, ptr.To("zone-pgw-id"))
+		g.Expect(clusterScope.createSubnet(ctx, subnet, false)).To(Succeed())
+		g.Expect(clusterScope.NetworkStatus().WorkerSubnets).To(HaveKey("capi-subnet-us-south-1"))
+	})
+	t.Run("When existing public gateway is referenced by id it is attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")}})
+		mockVPC.EXPECT().GetPublicGateway(&vpcv1.GetPublicGatewayOptions{ID: ptr.To("pgw-id")}).Return(&vpcv1.PublicGateway{
+			ID:   ptr.To("pgw-id"),
+			Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")},
+		}, nil, nil)
+		expectCreateSubnet(g, ptr.To("pgw-id"))
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).To(Succeed())
+	})
+	t.Run("When existing public gateway is referenced by name it is attached", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{Name: ptr.To("existing-pgw")}})
+		mockVPC.EXPECT().GetVPCPublicGatewayByName("existing-pgw", "rg-id").Return(&vpcv1.PublicGateway{
+			ID:   ptr.To("pgw-id"),
+			Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")},
+		}, nil)
+		expectCreateSubnet(g, ptr.To("pgw-id"))
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).To(Succeed())
+	})
+	t.Run("When existing public gateway is in another zone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")}})
+		mockVPC.EXPECT().GetPublicGateway(gomock.Any()).Return(&vpcv1.PublicGateway{
+			ID:   ptr.To("pgw-id"),
+			Name: ptr.To("existing-pgw"),
+			Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")},
+		}, nil, nil)
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).ToNot(Succeed())
+		g.Expect(clusterScope.NetworkStatus().ControlPlaneSubnets).To(BeEmpty())
+	})
+	t.Run("When existing public gateway is not found by name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{Name: ptr.To("existing-pgw")}})
+		mockVPC.EXPECT().GetVPCPublicGatewayByName("existing-pgw", "rg-id").Return(nil, nil)
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).ToNot(Succeed())
+	})
+	t.Run("When retrieving existing public gateway by id fails", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")}})
+		mockVPC.EXPECT().GetPublicGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get public gateway"))
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).ToNot(Succeed())
+	})
+	t.Run("When existing public gateway has no id nor name", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(&infrav1.VPCEgress{PublicGateway: &infrav1.VPCResource{}})
+		g.Expect(clusterScope.createSubnet(ctx, subnet, true)).ToNot(Succeed())
+	})
+}
+
+func TestClusterScopeV2ReconcileEndpointGateways(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockGT   *gtmock.MockGlobalTagging
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockGT = gtmock.NewMockGlobalTagging(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func() *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			VPCClient:           mockVPC,
+			GlobalTaggingClient: mockGT,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi"},
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{
+						EndpointGateways: []infrav1.VPCEndpointGateway{
+							{
+								Name:       "vpe-cos",
+								ServiceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.us-south.cloud-object-storage.appdomain.cloud",
+							},
+						},
+					},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					ResourceGroup: &infrav1.ResourceStatus{ID: "rg-id"},
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{ID: "vpc-id"},
+						ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+							"control-plane-subnet-1": {ID: "subnet-id-1"},
+						},
+						WorkerSubnets: map[string]*infrav1.ResourceStatus{
+							"worker-subnet-1": {ID: "subnet-id-2"},
+							"worker-subnet-2": {ID: "subnet-id-3"},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("When endpoint gateways are not set in spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Spec.Network.EndpointGateways = nil
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When VPC ID is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.Network.VPC = nil
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When GetEndpointGatewayByName returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, errors.New("failed to list endpoint gateways"))
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When endpoint gateway exists in cloud and is stable", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst),
+			Ips:            []vpcv1.ReservedIPReference{{Address: ptr.To("10.240.0.5")}},
+		}, nil)
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways["vpe-cos"]).To(Equal(&infrav1.VPCEndpointGatewayStatus{
+			ID:                "vpe-id",
+			Ready:             true,
+			ControllerCreated: ptr.To(false),
+			ReservedIPs:       []string{"10.240.0.5"},
+		}))
+	})
+	t.Run("When endpoint gateway ID is set in status and endpoint gateway is pending", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.Network.EndpointGateways = map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		}
+		mockVPC.EXPECT().GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStatePendingConst),
+		}, nil, nil)
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways["vpe-cos"].Ready).To(BeFalse())
+		g.Expect(*clusterScope.NetworkStatus().EndpointGateways["vpe-cos"].ControllerCreated).To(BeTrue())
+	})
+	t.Run("When GetEndpointGateway returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.Network.EndpointGateways = map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		}
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, nil, errors.New("failed to get endpoint gateway"))
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When endpoint gateway is in failed state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.Network.EndpointGateways = map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		}
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateFailedConst),
+		}, nil, nil)
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When endpoint gateway is created with a reserved IP in a subnet of each zone", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-1")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-2")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id-3")}).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-2")}}, nil, nil)
+		mockVPC.EXPECT().CreateEndpointGateway(gomock.Any()).DoAndReturn(func(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+			g.Expect(*options.Name).To(Equal("vpe-cos"))
+			g.Expect(*options.VPC.(*vpcv1.VPCIdentityByID).ID).To(Equal("vpc-id"))
+			g.Expect(*options.ResourceGroup.(*vpcv1.ResourceGroupIdentityByID).ID).To(Equal("rg-id"))
+			g.Expect(*options.Target.(*vpcv1.EndpointGatewayTargetPrototype).ResourceType).To(Equal(vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderCloudServiceConst))
+			g.Expect(options.Ips).To(HaveLen(2))
+			// The control plane subnet is preferred over the worker subnet in the same zone.
+			g.Expect(*options.Ips[0].(*vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext).Subnet.(*vpcv1.SubnetIdentityByID).ID).To(Equal("subnet-id-1"))
+			g.Expect(*options.Ips[1].(*vpcv1.EndpointGatewayReservedIPReservedIPPrototypeTargetContext).Subnet.(*vpcv1.SubnetIdentityByID).ID).To(Equal("subnet-id-3"))
+			return &vpcv1.EndpointGateway{
+				ID:             ptr.To("vpe-id"),
+				CRN:            ptr.To("vpe-crn"),
+				LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStatePendingConst),
+			}, nil, nil
+		})
+		mockGT.EXPECT().GetTagByName("capi").Return(&globaltaggingv1.Tag{}, nil)
+		mockGT.EXPECT().AttachTag(gomock.Any()).Return(nil, nil, nil)
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways["vpe-cos"].ID).To(Equal("vpe-id"))
+		g.Expect(*clusterScope.NetworkStatus().EndpointGateways["vpe-cos"].ControllerCreated).To(BeTrue())
+	})
+	t.Run("When GetSubnet returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(BeEmpty())
+	})
+	t.Run("When CreateEndpointGateway returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetEndpointGatewayByName("vpc-id", "vpe-cos").Return(nil, nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Zone: &vpcv1.ZoneReference{Name: ptr.To("us-south-1")}}, nil, nil).Times(3)
+		mockVPC.EXPECT().CreateEndpointGateway(gomock.Any()).Return(nil, nil, errors.New("failed to create endpoint gateway"))
+		requeue, err := clusterScope.ReconcileEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(BeEmpty())
+	})
+}
+
+func TestClusterScopeV2DeleteEndpointGateways(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	newClusterScope := func(endpointGateways map[string]*infrav1.VPCEndpointGatewayStatus) *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			VPCClient: mockVPC,
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{EndpointGateways: endpointGateways},
+				},
+			},
+		}
+	}
+
+	t.Run("When network status is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(nil)
+		clusterScope.IBMVPCCluster.Status.Network = nil
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When endpoint gateway is not created by controller", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(false)},
+		})
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(BeEmpty())
+	})
+	t.Run("When endpoint gateway is not found", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		})
+		mockVPC.EXPECT().GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("not found"))
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(BeEmpty())
+	})
+	t.Run("When GetEndpointGateway returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		})
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, &core.DetailedResponse{StatusCode: http.StatusInternalServerError}, errors.New("internal error"))
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(HaveKey("vpe-cos"))
+	})
+	t.Run("When endpoint gateway is in deleting state", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		})
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateDeletingConst),
+		}, nil, nil)
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+	})
+	t.Run("When DeleteEndpointGateway returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		})
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst),
+		}, nil, nil)
+		mockVPC.EXPECT().DeleteEndpointGateway(gomock.Any()).Return(&core.DetailedResponse{StatusCode: http.StatusInternalServerError}, errors.New("internal error"))
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(requeue).To(BeFalse())
+	})
+	t.Run("When DeleteEndpointGateway successfully deletes endpoint gateway", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope(map[string]*infrav1.VPCEndpointGatewayStatus{
+			"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+		})
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{
+			ID:             ptr.To("vpe-id"),
+			LifecycleState: ptr.To(vpcv1.EndpointGatewayLifecycleStateStableConst),
+		}, nil, nil)
+		mockVPC.EXPECT().DeleteEndpointGateway(&vpcv1.DeleteEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(nil, nil)
+		requeue, err := clusterScope.DeleteEndpointGateways(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(requeue).To(BeTrue())
+		// The endpoint gateway is kept in status until it is no longer found.
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(HaveKey("vpe-cos"))
+	})
+}
+
+func TestClusterScopeV2VerifyResources(t *testing.T) {
+	var (
+		mockVPC  *mock.MockVpc
+		mockTG   *tgmock.MockTransitGateway
+		mockCtrl *gomock.Controller
+	)
+	setup := func(t *testing.T) {
+		t.Helper()
+		mockCtrl = gomock.NewController(t)
+		mockVPC = mock.NewMockVpc(mockCtrl)
+		mockTG = tgmock.NewMockTransitGateway(mockCtrl)
+	}
+	teardown := func() {
+		mockCtrl.Finish()
+	}
+	notFound := &core.DetailedResponse{StatusCode: http.StatusNotFound}
+	newClusterScope := func() *ClusterScopeV2 {
+		return &ClusterScopeV2{
+			VPCClient:            mockVPC,
+			TransitGatewayClient: mockTG,
+			Cluster:              &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capi"}},
+			IBMVPCCluster: &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi"},
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{},
+				},
+				Status: infrav1.IBMVPCClusterStatus{
+					Network: &infrav1.VPCNetworkStatus{
+						VPC: &infrav1.ResourceStatus{ID: "vpc-id"},
+						ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+							"capi-subnet-us-south-1": {ID: "subnet-id"},
+						},
+						SecurityGroups: map[string]*infrav1.ResourceStatus{
+							"capi-sg": {ID: "sg-id"},
+						},
+						EndpointGateways: map[string]*infrav1.VPCEndpointGatewayStatus{
+							"vpe-cos": {ID: "vpe-id", ControllerCreated: ptr.To(true)},
+						},
+						TransitGateway: &infrav1.VPCTransitGatewayStatus{ID: ptr.To("tg-id"), ControllerCreated: ptr.To(true)},
+						LoadBalancers: map[string]*infrav1.VPCLoadBalancerStatus{
+							"lb-id": {ControllerCreated: ptr.To(true)},
+						},
+					},
+				},
+			},
+		}
+	}
+	expectAllResources := func() {
+		mockVPC.EXPECT().GetVPC(&vpcv1.GetVPCOptions{ID: ptr.To("vpc-id")}).Return(&vpcv1.VPC{Name: ptr.To("capi-vpc")}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("subnet-id")}).Return(&vpcv1.Subnet{Name: ptr.To("capi-subnet-us-south-1")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(&vpcv1.GetSecurityGroupOptions{ID: ptr.To("sg-id")}).Return(&vpcv1.SecurityGroup{Name: ptr.To("capi-sg")}, nil, nil)
+		mockVPC.EXPECT().GetEndpointGateway(&vpcv1.GetEndpointGatewayOptions{ID: ptr.To("vpe-id")}).Return(&vpcv1.EndpointGateway{Name: ptr.To("vpe-cos")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(&tgapiv1.GetTransitGatewayOptions{ID: ptr.To("tg-id")}).Return(&tgapiv1.TransitGateway{Name: ptr.To("capi-transitgateway")}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{ID: ptr.To("lb-id")}).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id")}, nil, nil)
+	}
+
+	t.Run("When network status is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Status.Network = nil
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result).To(Equal(&ResourceVerificationResult{}))
+	})
+	t.Run("When all resources exist and match spec", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		expectAllResources()
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result).To(Equal(&ResourceVerificationResult{}))
+	})
+	t.Run("When resources are missing and the missing resource policy is Report", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("capi-sg")}, nil, nil)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(Equal([]string{"vpc vpc-id", "subnet capi-subnet-us-south-1", "endpoint gateway vpe-cos", "transit gateway tg-id", "load balancer lb-id"}))
+		g.Expect(result.Recreating).To(BeEmpty())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(HaveKey("vpe-cos"))
+		g.Expect(clusterScope.NetworkStatus().TransitGateway).ToNot(BeNil())
+		g.Expect(clusterScope.NetworkStatus().LoadBalancers).To(HaveKey("lb-id"))
+	})
+	t.Run("When resources are missing and the missing resource policy is Recreate", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Spec.ResourceVerification = &infrav1.ResourceVerification{MissingResourcePolicy: infrav1.MissingResourcePolicyRecreate}
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("capi-subnet-us-south-1")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("capi-sg")}, nil, nil)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		// The VPC does not track whether it was created by the controller, so it is only reported.
+		g.Expect(result.Missing).To(Equal([]string{"vpc vpc-id"}))
+		g.Expect(result.Recreating).To(Equal([]string{"endpoint gateway vpe-cos", "transit gateway tg-id", "load balancer lb-id"}))
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(BeEmpty())
+		g.Expect(clusterScope.NetworkStatus().TransitGateway).To(BeNil())
+		g.Expect(clusterScope.NetworkStatus().LoadBalancers).To(BeEmpty())
+	})
+	t.Run("When resources not created by the controller are missing and the missing resource policy is Recreate", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Spec.ResourceVerification = &infrav1.ResourceVerification{MissingResourcePolicy: infrav1.MissingResourcePolicyRecreate}
+		clusterScope.IBMVPCCluster.Status.Network.EndpointGateways["vpe-cos"].ControllerCreated = ptr.To(false)
+		clusterScope.IBMVPCCluster.Status.Network.TransitGateway.ControllerCreated = ptr.To(false)
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{Name: ptr.To("capi-vpc")}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("capi-subnet-us-south-1")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("capi-sg")}, nil, nil)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(nil, notFound, errors.New("not found"))
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(Equal([]string{"endpoint gateway vpe-cos", "transit gateway tg-id"}))
+		g.Expect(result.Recreating).To(BeEmpty())
+		g.Expect(clusterScope.NetworkStatus().EndpointGateways).To(HaveKey("vpe-cos"))
+		g.Expect(clusterScope.NetworkStatus().TransitGateway).ToNot(BeNil())
+	})
+	t.Run("When resources are renamed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{Name: ptr.To("renamed-vpc")}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("renamed-subnet")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("capi-sg")}, nil, nil)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{Name: ptr.To("vpe-cos")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("renamed-tg")}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result.Missing).To(BeEmpty())
+		g.Expect(result.Drifted).To(Equal([]string{
+			"vpc vpc-id is named renamed-vpc instead of capi-vpc",
+			"subnet capi-subnet-us-south-1 is named renamed-subnet instead of capi-subnet-us-south-1",
+			"transit gateway tg-id is named renamed-tg instead of capi-transitgateway",
+		}))
+	})
+	t.Run("When existing VPC and transit gateway are renamed", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		clusterScope.IBMVPCCluster.Spec.Network.VPC = &infrav1.VPCResource{ID: ptr.To("vpc-id")}
+		clusterScope.IBMVPCCluster.Spec.Network.TransitGateway = &infrav1.VPCTransitGateway{ID: ptr.To("tg-id")}
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(&vpcv1.VPC{Name: ptr.To("renamed-vpc")}, nil, nil)
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("capi-subnet-us-south-1")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(&vpcv1.SecurityGroup{Name: ptr.To("capi-sg")}, nil, nil)
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{Name: ptr.To("vpe-cos")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("renamed-tg")}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(result).To(Equal(&ResourceVerificationResult{}))
+	})
+	t.Run("When retrieving resources returns error", func(t *testing.T) {
+		g := NewWithT(t)
+		setup(t)
+		t.Cleanup(teardown)
+
+		clusterScope := newClusterScope()
+		internalError := &core.DetailedResponse{StatusCode: http.StatusInternalServerError}
+		mockVPC.EXPECT().GetVPC(gomock.Any()).Return(nil, internalError, errors.New("internal error"))
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(&vpcv1.Subnet{Name: ptr.To("capi-subnet-us-south-1")}, nil, nil)
+		mockVPC.EXPECT().GetSecurityGroup(gomock.Any()).Return(nil, nil, errors.New("connection reset"))
+		mockVPC.EXPECT().GetEndpointGateway(gomock.Any()).Return(&vpcv1.EndpointGateway{Name: ptr.To("vpe-cos")}, nil, nil)
+		mockTG.EXPECT().GetTransitGateway(gomock.Any()).Return(&tgapiv1.TransitGateway{Name: ptr.To("capi-transitgateway")}, nil, nil)
+		mockVPC.EXPECT().GetLoadBalancer(gomock.Any()).Return(&vpcv1.LoadBalancer{ID: ptr.To("lb-id")}, nil, nil)
+		result, err := clusterScope.VerifyResources(ctx)
+		g.Expect(err).ToNot(BeNil())
+		g.Expect(err.Error()).To(ContainSubstring("error retrieving vpc with id vpc-id"))
+		g.Expect(err.Error()).To(ContainSubstring("error retrieving security group with id sg-id"))
+		g.Expect(result).To(BeNil())
+	})
+}
+
+func TestClusterScopeV2GetZonalServiceName(t *testing.T) {
+	testcases := []struct {
+		name         string
+		nameTemplate *string
+		expectedName string
+		expectedErr  string
+	}{
+		{
+			name:         "Resource name template is not set",
+			expectedName: "capi-cluster-pgateway-us-south-1",
+		},
+		{
+			name:         "Resource name template references the zone",
+			nameTemplate: ptr.To("fin-{{ .ClusterName }}-{{ .Zone }}-{{ lower .ResourceType }}"),
+			expectedName: "fin-capi-cluster-us-south-1-publicgateway",
+		},
+		{
+			name:         "Resource name template fails to render",
+			nameTemplate: ptr.To("fin-{{ index .Zone 20 }}-{{ lower .ResourceType }}"),
+			expectedErr:  "error generating the name of the publicGateway in zone us-south-1",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &ClusterScopeV2{
+				IBMVPCCluster: &infrav1.IBMVPCCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+					Spec:       infrav1.IBMVPCClusterSpec{ResourceNameTemplate: tc.nameTemplate},
+				},
+			}
+			name, err := clusterScope.GetZonalServiceName(infrav1.ResourceTypePublicGateway, "us-south-1")
+			if tc.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+				g.Expect(name).To(BeNil())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(*name).To(Equal(tc.expectedName))
+		})
+	}
+}
+
+func TestClusterScopeV2CheckResourceNameTemplate(t *testing.T) {
+	testcases := []struct {
+		name         string
+		nameTemplate *string
+		expectedErr  string
+	}{
+		{
+			name: "Resource name template is not set",
+		},
+		{
+			name:         "Resource name template renders for every resource type",
+			nameTemplate: ptr.To("fin-{{ .ClusterName }}-{{ lower .ResourceType }}"),
+		},
+		{
+			name:         "Resource name template fails to render",
+			nameTemplate: ptr.To("fin-{{ index .ClusterName 20 }}-{{ lower .ResourceType }}"),
+			expectedErr:  "error generating the name of the vpc",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &ClusterScopeV2{
+				IBMVPCCluster: &infrav1.IBMVPCCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+					Spec:       infrav1.IBMVPCClusterSpec{ResourceNameTemplate: tc.nameTemplate},
+				},
+			}
+			err := clusterScope.CheckResourceNameTemplate()
+			if tc.expectedErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(tc.expectedErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
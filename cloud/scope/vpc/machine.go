@@ -20,15 +20,20 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/go-logr/logr"
+	"go.yaml.in/yaml/v3"
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -40,11 +45,13 @@ import (
 	v1beta1patch "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/patch" //nolint:staticcheck
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/globaltagging"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/vpc"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/ipam"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/pagingutils"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/record"
@@ -154,6 +161,10 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 	if err != nil {
 		return nil, err
 	}
+	cloudInitData, err = m.injectProxyIntoBootstrapData(ctx, cloudInitData)
+	if err != nil {
+		return nil, err
+	}
 
 	options := &vpcv1.CreateInstanceOptions{}
 	// Build common field resources, as unique InstancePrototype's are defined based on machine source.
@@ -165,34 +176,23 @@ func (m *MachineScope) CreateMachine(ctx context.Context) (*vpcv1.Instance, erro
 		Name: &m.IBMVPCMachine.Spec.Profile,
 	}
 
-	subnetIdentity := &vpcv1.SubnetIdentity{}
-	// If Network Status is available, attempt to retrieve subnet ID from there.
-	if m.IBMVPCCluster.Status.Network != nil {
-		if m.IBMVPCCluster.Status.Network.ControlPlaneSubnets != nil {
-			if subnet, ok := m.IBMVPCCluster.Status.Network.ControlPlaneSubnets[m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet]; ok {
-				subnetIdentity.ID = ptr.To(subnet.ID)
-			}
-		}
-		if m.IBMVPCCluster.Status.Network.WorkerSubnets != nil {
-			if subnet, ok := m.IBMVPCCluster.Status.Network.WorkerSubnets[m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet]; ok {
-				subnetIdentity.ID = ptr.To(subnet.ID)
-			}
-		}
-	}
-	// If the ID hasn't been set yet, rely on Machine Spec for lookup, and finally falling back to previous logic of using the subnet value directly as an ID.
-	if subnetIdentity.ID == nil {
-		// For Machines not reliant directly on Cluster managed subnets, lookup subnet ID by name.
-		subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet)
-		if err != nil {
-			return nil, fmt.Errorf("error retrieving subnet ID for machine %s: %w", m.IBMVPCMachine.Name, err)
-		} else if subnetDetails != nil {
-			subnetIdentity.ID = subnetDetails.ID
-		} else {
-			subnetIdentity.ID = &m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet
-		}
+	subnetID, err := m.primarySubnetID()
+	if err != nil {
+		return nil, err
 	}
 	primaryNetworkInterface := &vpcv1.NetworkInterfacePrototype{
-		Subnet: subnetIdentity,
+		Subnet: &vpcv1.SubnetIdentity{
+			ID: subnetID,
+		},
+	}
+	// Use the reserved IP of the address allocated from the IPAM pool as the primary IP, if configured.
+	if m.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool != nil {
+		if m.IBMVPCMachine.Status.PrimaryReservedIP == nil || m.IBMVPCMachine.Status.PrimaryReservedIP.ID == "" {
+			return nil, fmt.Errorf("error primary reserved IP is not yet available for machine %s", m.IBMVPCMachine.Name)
+		}
+		primaryNetworkInterface.PrimaryIP = &vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID{
+			ID: ptr.To(m.IBMVPCMachine.Status.PrimaryReservedIP.ID),
+		}
 	}
 
 	// Populate the PrimaryNetworkInterface's SecurityGroups, if provided.
@@ -457,6 +457,147 @@ func (m *MachineScope) volumeToVPCVolumeAttachment(ctx context.Context, volume *
 	return bootVolume
 }
 
+// primarySubnetID returns the ID of the subnet of the primary network interface.
+func (m *MachineScope) primarySubnetID() (*string, error) {
+	subnetName := m.IBMVPCMachine.Spec.PrimaryNetworkInterface.Subnet
+	// If Network Status is available, attempt to retrieve subnet ID from there.
+	if m.IBMVPCCluster.Status.Network != nil {
+		if subnet, ok := m.IBMVPCCluster.Status.Network.WorkerSubnets[subnetName]; ok {
+			return ptr.To(subnet.ID), nil
+		}
+		if subnet, ok := m.IBMVPCCluster.Status.Network.ControlPlaneSubnets[subnetName]; ok {
+			return ptr.To(subnet.ID), nil
+		}
+	}
+	// Rely on Machine Spec for lookup, and finally falling back to previous logic of using the subnet value directly as an ID.
+	// For Machines not reliant directly on Cluster managed subnets, lookup subnet ID by name.
+	subnetDetails, err := m.IBMVPCClient.GetVPCSubnetByName(subnetName)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving subnet ID for machine %s: %w", m.IBMVPCMachine.Name, err)
+	} else if subnetDetails != nil {
+		return subnetDetails.ID, nil
+	}
+	return ptr.To(subnetName), nil
+}
+
+// ReconcileIPAddress claims the primary IP address of the instance from spec.primaryNetworkInterface.ipAddressPool through
+// an IPAddressClaim and reserves the allocated address on the subnet of the primary network interface.
+// returns true once the address is reserved or when spec.primaryNetworkInterface.ipAddressPool is not set.
+func (m *MachineScope) ReconcileIPAddress(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	poolRef := m.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool
+	if poolRef == nil {
+		return true, nil
+	}
+	if m.IBMVPCMachine.Status.PrimaryReservedIP != nil && m.IBMVPCMachine.Status.PrimaryReservedIP.Ready {
+		return true, nil
+	}
+
+	ipAddress, err := ipam.ClaimIPAddress(ctx, m.Client, ipam.Claim{
+		Name:        m.IBMVPCMachine.Name,
+		Namespace:   m.IBMVPCMachine.Namespace,
+		ClusterName: m.Cluster.Name,
+		Owner: metav1.OwnerReference{
+			APIVersion: infrav1.GroupVersion.String(),
+			Kind:       "IBMVPCMachine",
+			Name:       m.IBMVPCMachine.Name,
+			UID:        m.IBMVPCMachine.UID,
+		},
+		PoolRef: *poolRef,
+	})
+	if err != nil {
+		return false, err
+	}
+	if ipAddress == nil {
+		log.V(3).Info("IP address is not yet allocated", "pool", poolRef.Name)
+		return false, nil
+	}
+
+	subnetID, err := m.primarySubnetID()
+	if err != nil {
+		return false, err
+	}
+	reservedIP, err := m.IBMVPCClient.GetSubnetReservedIPByAddress(*subnetID, ipAddress.Spec.Address)
+	if err != nil {
+		return false, fmt.Errorf("error retrieving reserved IP %s in subnet %s: %w", ipAddress.Spec.Address, *subnetID, err)
+	}
+	if reservedIP != nil {
+		// The address must not be reserved by another resource in the subnet.
+		if reservedIP.Name == nil || *reservedIP.Name != m.IBMVPCMachine.Name {
+			return false, fmt.Errorf("error IP address %s allocated from pool %s is already reserved in subnet %s", ipAddress.Spec.Address, poolRef.Name, *subnetID)
+		}
+	} else {
+		log.Info("Reserving IP address in subnet", "address", ipAddress.Spec.Address, "subnetID", *subnetID)
+		reservedIP, _, err = m.IBMVPCClient.CreateSubnetReservedIP(&vpcv1.CreateSubnetReservedIPOptions{
+			SubnetID: subnetID,
+			Address:  ptr.To(ipAddress.Spec.Address),
+			// The reserved IP is created unbound, it is released explicitly on deletion of the machine.
+			AutoDelete: ptr.To(false),
+			Name:       ptr.To(m.IBMVPCMachine.Name),
+		})
+		if err != nil {
+			record.Warnf(m.IBMVPCMachine, "FailedCreateReservedIP", "Failed reserved IP creation - %v", err)
+			return false, fmt.Errorf("error creating reserved IP %s in subnet %s: %w", ipAddress.Spec.Address, *subnetID, err)
+		}
+		if reservedIP == nil || reservedIP.ID == nil {
+			return false, fmt.Errorf("error created reserved IP %s in subnet %s is nil", ipAddress.Spec.Address, *subnetID)
+		}
+		record.Eventf(m.IBMVPCMachine, "SuccessfulCreateReservedIP", "Created reserved IP %q", ipAddress.Spec.Address)
+	}
+
+	m.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.ResourceStatus{
+		ID:    *reservedIP.ID,
+		Name:  reservedIP.Name,
+		Ready: true,
+	}
+	return true, nil
+}
+
+// ReleaseIPAddress releases the reserved IP of the primary network interface and the IPAddressClaim of the machine.
+// returns false while the reserved IP is still bound to the network interface of the instance being deleted.
+func (m *MachineScope) ReleaseIPAddress(ctx context.Context) (bool, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if m.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool == nil {
+		return true, nil
+	}
+
+	if m.IBMVPCMachine.Status.PrimaryReservedIP != nil && m.IBMVPCMachine.Status.PrimaryReservedIP.ID != "" {
+		subnetID, err := m.primarySubnetID()
+		if err != nil {
+			return false, err
+		}
+		reservedIPID := m.IBMVPCMachine.Status.PrimaryReservedIP.ID
+		reservedIP, detailedResponse, err := m.IBMVPCClient.GetSubnetReservedIP(&vpcv1.GetSubnetReservedIPOptions{
+			SubnetID: subnetID,
+			ID:       ptr.To(reservedIPID),
+		})
+		if err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+			return false, fmt.Errorf("error retrieving reserved IP %s in subnet %s: %w", reservedIPID, *subnetID, err)
+		}
+		if err == nil && reservedIP != nil {
+			if reservedIP.Target != nil {
+				log.V(3).Info("Reserved IP is still bound, waiting for the instance to be deleted", "reservedIPID", reservedIPID)
+				return false, nil
+			}
+			log.Info("Deleting reserved IP", "reservedIPID", reservedIPID)
+			if detailedResponse, err := m.IBMVPCClient.DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{
+				SubnetID: subnetID,
+				ID:       ptr.To(reservedIPID),
+			}); err != nil && (detailedResponse == nil || detailedResponse.StatusCode != http.StatusNotFound) {
+				record.Warnf(m.IBMVPCMachine, "FailedDeleteReservedIP", "Failed reserved IP deletion - %v", err)
+				return false, fmt.Errorf("error deleting reserved IP %s in subnet %s: %w", reservedIPID, *subnetID, err)
+			}
+			record.Eventf(m.IBMVPCMachine, "SuccessfulDeleteReservedIP", "Deleted reserved IP %q", reservedIPID)
+		}
+		m.IBMVPCMachine.Status.PrimaryReservedIP = nil
+	}
+
+	if err := ipam.ReleaseIPAddress(ctx, m.Client, client.ObjectKey{Namespace: m.IBMVPCMachine.Namespace, Name: m.IBMVPCMachine.Name}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // DeleteMachine deletes the vpc machine associated with machine instance id.
 func (m *MachineScope) DeleteMachine() error {
 	if m.IBMVPCMachine.Status.InstanceID == "" {
@@ -464,7 +605,10 @@ func (m *MachineScope) DeleteMachine() error {
 	}
 	options := &vpcv1.DeleteInstanceOptions{}
 	options.SetID(m.IBMVPCMachine.Status.InstanceID)
-	_, err := m.IBMVPCClient.DeleteInstance(options)
+	detailedResponse, err := m.IBMVPCClient.DeleteInstance(options)
+	if err != nil && detailedResponse != nil && detailedResponse.StatusCode == http.StatusNotFound {
+		return nil
+	}
 	if err != nil {
 		record.Warnf(m.IBMVPCMachine, "FailedDeleteInstance", "Failed instance deletion - %v", err)
 	} else {
@@ -602,8 +746,8 @@ func (m *MachineScope) checkVPCLoadBalancerPoolMemberExists(ctx context.Context,
 
 	for _, member := range poolMembers.Members {
 		if target, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
-			// Verify the target address matches the Machine's internal IP.
-			if *target.Address == *internalIP {
+			// Verify the target matches the Machine's instance or internal IP.
+			if poolMemberTargetMatches(target, m.IBMVPCMachine.Status.InstanceID, internalIP) {
 				log.Info("Found existing load balancer pool member for machine", "internalIP", *internalIP, "poolID", *poolID, "loadBalancerID", *loadBalancerID)
 				return ptr.To(member), nil
 			}
@@ -628,14 +772,19 @@ func (m *MachineScope) createVPCLoadBalancerPoolMember(ctx context.Context, pool
 		return false, fmt.Errorf("error creating load balancer pool member: %w", err)
 	}
 
+	loadBalancer, _, err := m.IBMVPCClient.GetLoadBalancer(&vpcv1.GetLoadBalancerOptions{
+		ID: loadBalancerID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("error creating load balancer pool member, failed to get load balancer: %w", err)
+	}
+
 	// Populate the LB Pool Member options.
 	options := &vpcv1.CreateLoadBalancerPoolMemberOptions{
 		LoadBalancerID: loadBalancerID,
 		PoolID:         loadBalancerBackendPoolID,
 		Port:           ptr.To(poolMember.Port),
-		Target: &vpcv1.LoadBalancerPoolMemberTargetPrototypeIP{
-			Address: internalIP,
-		},
+		Target:         m.loadBalancerPoolMemberTarget(loadBalancer, internalIP),
 	}
 
 	// Set the weight if it was provided.
@@ -695,9 +844,7 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(ctx context.Context, inte
 	options := &vpcv1.CreateLoadBalancerPoolMemberOptions{}
 	options.SetLoadBalancerID(*loadBalancer.ID)
 	options.SetPoolID(*loadBalancer.Pools[0].ID)
-	options.SetTarget(&vpcv1.LoadBalancerPoolMemberTargetPrototype{
-		Address: internalIP,
-	})
+	options.SetTarget(m.loadBalancerPoolMemberTarget(loadBalancer, internalIP))
 	options.SetPort(targetPort)
 
 	listOptions := &vpcv1.ListLoadBalancerPoolMembersOptions{}
@@ -711,7 +858,7 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(ctx context.Context, inte
 	for _, member := range listLoadBalancerPoolMembers.Members {
 		if _, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
 			mtarget := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
-			if *mtarget.Address == *internalIP && *member.Port == targetPort {
+			if poolMemberTargetMatches(mtarget, m.IBMVPCMachine.Status.InstanceID, internalIP) && *member.Port == targetPort {
 				log.V(3).Info("PoolMember already exist")
 				return nil, nil
 			}
@@ -725,6 +872,32 @@ func (m *MachineScope) CreateVPCLoadBalancerPoolMember(ctx context.Context, inte
 	return loadBalancerPoolMember, nil
 }
 
+// loadBalancerPoolMemberTarget returns the pool member target for the Machine.
+// Network load balancer pool members target the instance, application load balancer pool members target the internal IP.
+func (m *MachineScope) loadBalancerPoolMemberTarget(loadBalancer *vpcv1.LoadBalancer, internalIP *string) vpcv1.LoadBalancerPoolMemberTargetPrototypeIntf {
+	if isNetworkLoadBalancer(loadBalancer) {
+		return &vpcv1.LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByID{
+			ID: ptr.To(m.IBMVPCMachine.Status.InstanceID),
+		}
+	}
+	return &vpcv1.LoadBalancerPoolMemberTargetPrototypeIP{
+		Address: internalIP,
+	}
+}
+
+// isNetworkLoadBalancer checks whether the load balancer uses a network load balancer profile.
+func isNetworkLoadBalancer(loadBalancer *vpcv1.LoadBalancer) bool {
+	return loadBalancer != nil && loadBalancer.Profile != nil && ptr.Deref(loadBalancer.Profile.Family, "") == vpcv1.LoadBalancerProfileReferenceFamilyNetworkConst
+}
+
+// poolMemberTargetMatches checks whether the pool member target is the instance or its internal IP.
+func poolMemberTargetMatches(target *vpcv1.LoadBalancerPoolMemberTarget, instanceID string, internalIP *string) bool {
+	if target.ID != nil && instanceID != "" && *target.ID == instanceID {
+		return true
+	}
+	return target.Address != nil && internalIP != nil && *target.Address == *internalIP
+}
+
 // DeleteVPCLoadBalancerPoolMember deletes a pool member from the load balancer pool.
 func (m *MachineScope) DeleteVPCLoadBalancerPoolMember(ctx context.Context) error {
 	log := ctrl.LoggerFrom(ctx)
@@ -767,7 +940,7 @@ func (m *MachineScope) DeleteVPCLoadBalancerPoolMember(ctx context.Context) erro
 	for _, member := range listLoadBalancerPoolMembers.Members {
 		if _, ok := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget); ok {
 			mtarget := member.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
-			if *mtarget.Address == *instance.PrimaryNetworkInterface.PrimaryIP.Address {
+			if poolMemberTargetMatches(mtarget, m.IBMVPCMachine.Status.InstanceID, instance.PrimaryNetworkInterface.PrimaryIP.Address) {
 				if *loadBalancer.ProvisioningStatus != string(infrav1.VPCLoadBalancerStateActive) {
 					return fmt.Errorf("load balancer is not in active state")
 				}
@@ -843,8 +1016,8 @@ func (m *MachineScope) deleteVPCLoadBalancerPoolMembers(ctx context.Context) err
 
 		for _, poolMember := range poolMembers.Members {
 			poolMemberTarget, ok := poolMember.Target.(*vpcv1.LoadBalancerPoolMemberTarget)
-			// If the member isn't a LoadBalancerPoolMemberTarget, has no Address, or the Address doesn't match the Machine's Primary IP Address, move to the next member.
-			if !ok || poolMemberTarget.Address == nil || *poolMemberTarget.Address != *instanceDetails.PrimaryNetworkInterface.PrimaryIP.Address {
+			// If the member isn't a LoadBalancerPoolMemberTarget, or targets neither the Machine's instance nor its Primary IP Address, move to the next member.
+			if !ok || !poolMemberTargetMatches(poolMemberTarget, m.IBMVPCMachine.Status.InstanceID, instanceDetails.PrimaryNetworkInterface.PrimaryIP.Address) {
 				continue
 			}
 
@@ -910,6 +1083,175 @@ func (m *MachineScope) GetBootstrapData() (string, error) {
 	return string(value), nil
 }
 
+// injectProxyIntoBootstrapData adds the proxy settings of the cluster network to cloud-config bootstrap data, for the machine environment and the container runtime.
+// The cloud-config is edited in place, keeping the comments and the order of the existing keys. Bootstrap data in other formats is returned unchanged.
+func (m *MachineScope) injectProxyIntoBootstrapData(ctx context.Context, data string) (string, error) {
+	log := ctrl.LoggerFrom(ctx)
+	if m.IBMVPCCluster == nil || m.IBMVPCCluster.Spec.Network == nil || m.IBMVPCCluster.Spec.Network.Proxy == nil {
+		return data, nil
+	}
+
+	// Keep the leading comment lines, such as the "#cloud-config" header, ahead of the edited cloud-config.
+	lines := strings.SplitAfter(data, "\n")
+	headerLines := 0
+	for headerLines < len(lines) && strings.HasPrefix(lines[headerLines], "#") {
+		headerLines++
+	}
+	header := strings.Join(lines[:headerLines], "")
+	if !strings.Contains(header, "#cloud-config") {
+		log.V(3).Info("Skipping proxy injection as bootstrap data is not cloud-config")
+		return data, nil
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal([]byte(strings.Join(lines[headerLines:], "")), &document); err != nil {
+		return "", fmt.Errorf("failed to parse cloud-config bootstrap data: %w", err)
+	}
+	if document.Kind == 0 {
+		document = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}}
+	}
+	cloudConfig := document.Content[0]
+	if cloudConfig.Kind != yaml.MappingNode {
+		return "", errors.New("failed to parse cloud-config bootstrap data: cloud-config is not a mapping")
+	}
+
+	noProxy, err := m.noProxyHosts()
+	if err != nil {
+		return "", err
+	}
+	var environment, serviceEnvironment strings.Builder
+	serviceEnvironment.WriteString("[Service]\n")
+	addVariable := func(name, value string) {
+		for _, key := range []string{strings.ToUpper(name), name} {
+			fmt.Fprintf(&environment, "%s=%s\n", key, value)
+			fmt.Fprintf(&serviceEnvironment, "Environment=\"%s=%s\"\n", key, value)
+		}
+	}
+	proxy := m.IBMVPCCluster.Spec.Network.Proxy
+	if proxy.HTTPProxy != nil {
+		addVariable("http_proxy", *proxy.HTTPProxy)
+	}
+	if proxy.HTTPSProxy != nil {
+		addVariable("https_proxy", *proxy.HTTPSProxy)
+	}
+	addVariable("no_proxy", strings.Join(noProxy, ","))
+
+	var files yaml.Node
+	if err := files.Encode([]cloudConfigFile{
+		{
+			Path:    "/etc/environment",
+			Append:  true,
+			Content: environment.String(),
+		},
+		{
+			Path:        "/etc/systemd/system/containerd.service.d/http-proxy.conf",
+			Permissions: "0644",
+			Content:     serviceEnvironment.String(),
+		},
+	}); err != nil {
+		return "", fmt.Errorf("failed to encode cloud-config proxy files: %w", err)
+	}
+	writeFiles, err := cloudConfigSequence(cloudConfig, "write_files")
+	if err != nil {
+		return "", err
+	}
+	writeFiles.Content = append(writeFiles.Content, files.Content...)
+
+	// The container runtime is restarted to pick up the proxy before any image is pulled.
+	var commands yaml.Node
+	if err := commands.Encode([]string{"systemctl daemon-reload", "systemctl restart containerd"}); err != nil {
+		return "", fmt.Errorf("failed to encode cloud-config proxy commands: %w", err)
+	}
+	runCmd, err := cloudConfigSequence(cloudConfig, "runcmd")
+	if err != nil {
+		return "", err
+	}
+	runCmd.Content = append(commands.Content, runCmd.Content...)
+
+	var out strings.Builder
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&document); err != nil {
+		return "", fmt.Errorf("failed to marshal cloud-config bootstrap data: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to marshal cloud-config bootstrap data: %w", err)
+	}
+	return header + out.String(), nil
+}
+
+// cloudConfigFile is a write_files entry of cloud-config.
+type cloudConfigFile struct {
+	Path        string `yaml:"path"`
+	Permissions string `yaml:"permissions,omitempty"`
+	Append      bool   `yaml:"append,omitempty"`
+	Content     string `yaml:"content"`
+}
+
+// cloudConfigSequence returns the sequence under the key of the cloud-config mapping, adding an empty one if the key is not set.
+func cloudConfigSequence(cloudConfig *yaml.Node, key string) (*yaml.Node, error) {
+	for i := 0; i+1 < len(cloudConfig.Content); i += 2 {
+		if cloudConfig.Content[i].Value != key {
+			continue
+		}
+		value := cloudConfig.Content[i+1]
+		if value.Kind != yaml.SequenceNode {
+			return nil, fmt.Errorf("failed to parse cloud-config bootstrap data: %s is not a list", key)
+		}
+		return value, nil
+	}
+	value := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	cloudConfig.Content = append(cloudConfig.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+	return value, nil
+}
+
+// noProxyHosts returns the hosts and cidrs reached without the proxy, the local addresses, the cluster network and the control plane endpoint, along with the user defined ones.
+// The cidrs of the subnets allocated by IBM Cloud are retrieved from the subnets in Status.
+func (m *MachineScope) noProxyHosts() ([]string, error) {
+	noProxy := []string{"localhost", "127.0.0.1", "169.254.169.254", ".svc", ".cluster.local"}
+	if m.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host != "" {
+		noProxy = append(noProxy, m.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host)
+	}
+	if m.Cluster != nil {
+		noProxy = append(noProxy, m.Cluster.Spec.ClusterNetwork.Pods.CIDRBlocks...)
+		noProxy = append(noProxy, m.Cluster.Spec.ClusterNetwork.Services.CIDRBlocks...)
+	}
+	network := m.IBMVPCCluster.Spec.Network
+	for _, addressPrefix := range network.AddressPrefixes {
+		noProxy = append(noProxy, addressPrefix.CIDR)
+	}
+	specCIDRs := make(map[string]bool)
+	for _, subnet := range slices.Concat(network.ControlPlaneSubnets, network.WorkerSubnets) {
+		if subnet.Ipv4CidrBlock != nil {
+			noProxy = append(noProxy, *subnet.Ipv4CidrBlock)
+			if subnet.Name != nil {
+				specCIDRs[*subnet.Name] = true
+			}
+		}
+	}
+	if networkStatus := m.IBMVPCCluster.Status.Network; networkStatus != nil {
+		for _, subnets := range []map[string]*infrav1.ResourceStatus{networkStatus.ControlPlaneSubnets, networkStatus.WorkerSubnets} {
+			for _, name := range slices.Sorted(maps.Keys(subnets)) {
+				if specCIDRs[name] || subnets[name] == nil || subnets[name].ID == "" {
+					continue
+				}
+				subnet, _, err := m.IBMVPCClient.GetSubnet(&vpcv1.GetSubnetOptions{
+					ID: ptr.To(subnets[name].ID),
+				})
+				if err != nil {
+					return nil, fmt.Errorf("failed to retrieve subnet %s for the proxy settings: %w", name, err)
+				}
+				if subnet != nil && subnet.Ipv4CIDRBlock != nil {
+					noProxy = append(noProxy, *subnet.Ipv4CIDRBlock)
+				}
+			}
+		}
+	}
+	noProxy = append(noProxy, network.Proxy.NoProxy...)
+	slices.Sort(noProxy)
+	return slices.Compact(noProxy), nil
+}
+
 func fetchKeyID(ctx context.Context, key *infrav1.IBMVPCResourceReference, m *MachineScope) (*string, error) {
 	log := ctrl.LoggerFrom(ctx)
 	if key.ID == nil && key.Name == nil {
@@ -1155,6 +1497,49 @@ func (m *MachineScope) TagResource(tagName string, resourceCRN string) error {
 	return nil
 }
 
+// ReconcileTags attaches the user tags of the cluster and the machine and the cluster UID tag to the instance and its volumes, and detaches the tags removed from spec.
+// The tags are only attached again when the tags in spec or the volumes of the instance change.
+func (m *MachineScope) ReconcileTags(ctx context.Context, instance *vpcv1.Instance) error {
+	log := ctrl.LoggerFrom(ctx)
+	tags := genutil.ClusterUserTags(m.IBMVPCCluster.UID, m.IBMVPCCluster.Spec.Tags, m.IBMVPCMachine.Spec.Tags)
+	resourceIDs := []string{*instance.ID}
+	resourceCRNs := []string{*instance.CRN}
+	for _, volumeAttachment := range instance.VolumeAttachments {
+		if volumeAttachment.Volume == nil || volumeAttachment.Volume.ID == nil || slices.Contains(resourceIDs, *volumeAttachment.Volume.ID) {
+			continue
+		}
+		resourceIDs = append(resourceIDs, *volumeAttachment.Volume.ID)
+		resourceCRNs = append(resourceCRNs, *volumeAttachment.Volume.CRN)
+	}
+	slices.Sort(resourceIDs)
+
+	tagsStatus := ptr.Deref(m.IBMVPCMachine.Status.Tags, infrav1.TagsStatus{})
+	if slices.Equal(tags, tagsStatus.Applied) && (len(tags) == 0 || slices.Equal(resourceIDs, tagsStatus.Resources)) {
+		return nil
+	}
+
+	removedTags := slices.DeleteFunc(slices.Clone(tagsStatus.Applied), func(tag string) bool {
+		return slices.Contains(tags, tag)
+	})
+	log.Info("Reconciling user tags of the instance and its volumes", "tags", tags, "removedTags", removedTags)
+	if err := m.GlobalTaggingClient.DetachUserTags(removedTags, resourceCRNs); err != nil {
+		return fmt.Errorf("failed to detach user tags from the instance: %w", err)
+	}
+	if err := m.GlobalTaggingClient.AttachUserTags(tags, resourceCRNs); err != nil {
+		return fmt.Errorf("failed to attach user tags to the instance: %w", err)
+	}
+
+	if len(tags) == 0 {
+		m.IBMVPCMachine.Status.Tags = nil
+		return nil
+	}
+	m.IBMVPCMachine.Status.Tags = &infrav1.TagsStatus{
+		Applied:   tags,
+		Resources: resourceIDs,
+	}
+	return nil
+}
+
 // APIServerPort returns the APIServerPort.
 func (m *MachineScope) APIServerPort() int32 {
 	if m.Cluster.Spec.ClusterNetwork.APIServerPort > 0 {
@@ -19,6 +19,7 @@ package vpc
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -31,6 +32,7 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -170,6 +172,128 @@ func TestSetVPCProviderID(t *testing.T) {
 	})
 }
 
+func TestInjectProxyIntoBootstrapData(t *testing.T) {
+	cloudConfig := "## template: jinja\n#cloud-config\nwrite_files:\n- path: /etc/kubeadm.yml\n  content: foo\nruncmd:\n- kubeadm init\n"
+	setupProxyMachineScope := func(t *testing.T) *MachineScope {
+		t.Helper()
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		scope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host = "cluster.example.com"
+		scope.IBMVPCCluster.Spec.Network = &infrav1.VPCNetworkSpec{
+			ControlPlaneSubnets: []infrav1.Subnet{{Ipv4CidrBlock: ptr.To("10.0.0.0/24")}},
+			Proxy: &infrav1.VPCProxy{
+				HTTPSProxy: ptr.To("http://proxy.example.com:3128"),
+				NoProxy:    []string{".example.com"},
+			},
+		}
+		return scope
+	}
+
+	t.Run("Should return bootstrap data unchanged when no proxy is defined", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupMachineScope(clusterName, machineName, mock.NewMockVpc(gomock.NewController(t)))
+		data, err := scope.injectProxyIntoBootstrapData(ctx, cloudConfig)
+		g.Expect(err).To(BeNil())
+		g.Expect(data).To(Equal(cloudConfig))
+	})
+
+	t.Run("Should return bootstrap data unchanged when it is not cloud-config", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		data, err := scope.injectProxyIntoBootstrapData(ctx, `{"ignition":{"version":"3.4.0"}}`)
+		g.Expect(err).To(BeNil())
+		g.Expect(data).To(Equal(`{"ignition":{"version":"3.4.0"}}`))
+	})
+
+	t.Run("Should inject proxy into cloud-config bootstrap data", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		data, err := scope.injectProxyIntoBootstrapData(ctx, cloudConfig)
+		g.Expect(err).To(BeNil())
+		g.Expect(data).To(HavePrefix("## template: jinja\n#cloud-config\n"))
+		g.Expect(data).To(ContainSubstring("path: /etc/kubeadm.yml"))
+		g.Expect(data).To(ContainSubstring("path: /etc/systemd/system/containerd.service.d/http-proxy.conf"))
+		g.Expect(data).To(ContainSubstring("HTTPS_PROXY=http://proxy.example.com:3128"))
+		g.Expect(data).To(ContainSubstring("NO_PROXY=.cluster.local,.example.com,.svc,10.0.0.0/24,127.0.0.1,169.254.169.254,cluster.example.com,localhost"))
+		g.Expect(data).ToNot(ContainSubstring("HTTP_PROXY"))
+		g.Expect(data).To(ContainSubstring("runcmd:\n  - systemctl daemon-reload\n  - systemctl restart containerd\n  - kubeadm init\n"))
+	})
+
+	t.Run("Should keep the comments, key order and quoting of cloud-config bootstrap data", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		data, err := scope.injectProxyIntoBootstrapData(ctx, "#cloud-config\n# generated by kubeadm\nruncmd:\n- 'kubeadm join' # join the cluster\nwrite_files:\n- path: /etc/kubeadm.yml\n  owner: root:root\n")
+		g.Expect(err).To(BeNil())
+		g.Expect(data).To(HavePrefix("#cloud-config\n# generated by kubeadm\nruncmd:\n  - systemctl daemon-reload\n  - systemctl restart containerd\n  - 'kubeadm join' # join the cluster\nwrite_files:\n  - path: /etc/kubeadm.yml\n    owner: root:root\n  - path: /etc/environment\n"))
+	})
+
+	t.Run("Should add write_files and runcmd when cloud-config bootstrap data has none", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		data, err := scope.injectProxyIntoBootstrapData(ctx, "#cloud-config\n")
+		g.Expect(err).To(BeNil())
+		g.Expect(data).To(HavePrefix("#cloud-config\nwrite_files:\n  - path: /etc/environment\n"))
+		g.Expect(data).To(ContainSubstring("runcmd:\n  - systemctl daemon-reload\n  - systemctl restart containerd\n"))
+	})
+
+	t.Run("Should add the cidrs of the subnets allocated by IBM Cloud to no_proxy", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController := gomock.NewController(t)
+		mockVPC := mock.NewMockVpc(mockController)
+		scope := setupProxyMachineScope(t)
+		scope.IBMVPCClient = mockVPC
+		scope.IBMVPCCluster.Spec.Network.ControlPlaneSubnets[0].Name = ptr.To("control-plane-subnet")
+		scope.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{
+			ControlPlaneSubnets: map[string]*infrav1.ResourceStatus{
+				"control-plane-subnet": {ID: "control-plane-subnet-id"},
+			},
+			WorkerSubnets: map[string]*infrav1.ResourceStatus{
+				"worker-subnet": {ID: "worker-subnet-id"},
+			},
+		}
+		mockVPC.EXPECT().GetSubnet(&vpcv1.GetSubnetOptions{ID: ptr.To("worker-subnet-id")}).Return(&vpcv1.Subnet{Ipv4CIDRBlock: ptr.To("10.0.1.0/24")}, nil, nil)
+		data, err := scope.injectProxyIntoBootstrapData(ctx, cloudConfig)
+		g.Expect(err).To(BeNil())
+		g.Expect(data).To(ContainSubstring("NO_PROXY=.cluster.local,.example.com,.svc,10.0.0.0/24,10.0.1.0/24,127.0.0.1,169.254.169.254,cluster.example.com,localhost"))
+	})
+
+	t.Run("Error when retrieving the subnets allocated by IBM Cloud fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController := gomock.NewController(t)
+		mockVPC := mock.NewMockVpc(mockController)
+		scope := setupProxyMachineScope(t)
+		scope.IBMVPCClient = mockVPC
+		scope.IBMVPCCluster.Status.Network = &infrav1.VPCNetworkStatus{
+			WorkerSubnets: map[string]*infrav1.ResourceStatus{
+				"worker-subnet": {ID: "worker-subnet-id"},
+			},
+		}
+		mockVPC.EXPECT().GetSubnet(gomock.Any()).Return(nil, nil, errors.New("failed to get subnet"))
+		_, err := scope.injectProxyIntoBootstrapData(ctx, cloudConfig)
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Error when cloud-config bootstrap data is not a mapping", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		_, err := scope.injectProxyIntoBootstrapData(ctx, "#cloud-config\n- kubeadm init\n")
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Error when cloud-config runcmd is not a list", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		_, err := scope.injectProxyIntoBootstrapData(ctx, "#cloud-config\nruncmd: kubeadm init\n")
+		g.Expect(err).ToNot(BeNil())
+	})
+
+	t.Run("Error when cloud-config bootstrap data is invalid", func(t *testing.T) {
+		g := NewWithT(t)
+		scope := setupProxyMachineScope(t)
+		_, err := scope.injectProxyIntoBootstrapData(ctx, "#cloud-config\nruncmd: [\n")
+		g.Expect(err).ToNot(BeNil())
+	})
+}
+
 func TestCreateMachine(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
@@ -211,6 +335,41 @@ func TestCreateMachine(t *testing.T) {
 			require.Equal(t, expectedOutput, out)
 		})
 
+		t.Run("Should create Machine with the reserved IP as primary IP", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool = &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"}
+			scope.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.ResourceStatus{ID: "reserved-ip-id", Ready: true}
+			var createInstanceOptions *vpcv1.CreateInstanceOptions
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
+			mockvpc.EXPECT().CreateInstance(gomock.AssignableToTypeOf(&vpcv1.CreateInstanceOptions{})).DoAndReturn(func(options *vpcv1.CreateInstanceOptions) (*vpcv1.Instance, *core.DetailedResponse, error) {
+				createInstanceOptions = options
+				return &vpcv1.Instance{Name: &scope.Machine.Name}, &core.DetailedResponse{}, nil
+			})
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(BeNil())
+			instancePrototype, ok := createInstanceOptions.InstancePrototype.(*vpcv1.InstancePrototype)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(instancePrototype.PrimaryNetworkInterface.PrimaryIP).To(Equal(&vpcv1.NetworkInterfaceIPPrototypeReservedIPIdentityByID{ID: ptr.To("reserved-ip-id")}))
+		})
+
+		t.Run("Error when the reserved IP is not yet available", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool = &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"}
+			mockvpc.EXPECT().ListInstances(gomock.AssignableToTypeOf(&vpcv1.ListInstancesOptions{})).Return(&vpcv1.InstanceCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName(vpcMachine.Spec.PrimaryNetworkInterface.Subnet).Return(&vpcv1.Subnet{ID: core.StringPtr(testSubnetName)}, nil)
+			_, err := scope.CreateMachine(ctx)
+			g.Expect(err).To(MatchError(ContainSubstring("primary reserved IP is not yet available")))
+		})
+
 		t.Run("Return existing Machine", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -786,6 +945,18 @@ func TestDeleteMachine(t *testing.T) {
 			g.Expect(err).To(Not(BeNil()))
 		})
 
+		t.Run("Instance is already deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(&vpcv1.DeleteInstanceOptions{})).Return(&core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("instance not found"))
+			err := scope.DeleteMachine()
+			g.Expect(err).To(BeNil())
+		})
+
 		t.Run("Empty InstanceID", func(t *testing.T) {
 			g := NewWithT(t)
 			mockController, mockvpc := setup(t)
@@ -798,6 +969,196 @@ func TestDeleteMachine(t *testing.T) {
 	})
 }
 
+func TestReconcileIPAddress(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	poolRef := &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"}
+	allocatedClaim := func() (*ipamv1.IPAddressClaim, *ipamv1.IPAddress) {
+		return &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: defaultNamespace},
+			Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "machine-address"}},
+		}, &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-address", Namespace: defaultNamespace},
+			Spec:       ipamv1.IPAddressSpec{Address: "10.240.0.10", Prefix: ptr.To[int32](24), Gateway: "10.240.0.1"},
+		}
+	}
+
+	t.Run("When ipAddressPool is not set", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		reserved, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(reserved).To(BeTrue())
+	})
+
+	t.Run("When the IP address is not yet allocated", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool = poolRef
+		reserved, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(reserved).To(BeFalse())
+
+		ipAddressClaim := &ipamv1.IPAddressClaim{}
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: machineName}, ipAddressClaim)).To(Succeed())
+		g.Expect(ipAddressClaim.Spec.PoolRef).To(Equal(*poolRef))
+		g.Expect(ipAddressClaim.OwnerReferences[0].Kind).To(Equal("IBMVPCMachine"))
+	})
+
+	t.Run("Should reserve the allocated IP address in the subnet", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{Subnet: testSubnetName, IPAddressPool: poolRef}
+		ipAddressClaim, ipAddress := allocatedClaim()
+		g.Expect(scope.Client.Create(ctx, ipAddressClaim)).To(Succeed())
+		g.Expect(scope.Client.Create(ctx, ipAddress)).To(Succeed())
+		mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id")}, nil)
+		mockvpc.EXPECT().GetSubnetReservedIPByAddress("subnet-id", "10.240.0.10").Return(nil, nil)
+		mockvpc.EXPECT().CreateSubnetReservedIP(&vpcv1.CreateSubnetReservedIPOptions{
+			SubnetID:   ptr.To("subnet-id"),
+			Address:    ptr.To("10.240.0.10"),
+			AutoDelete: ptr.To(false),
+			Name:       ptr.To(machineName),
+		}).Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id"), Name: ptr.To(machineName)}, &core.DetailedResponse{}, nil)
+		reserved, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(reserved).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(Equal(&infrav1.ResourceStatus{ID: "reserved-ip-id", Name: ptr.To(machineName), Ready: true}))
+	})
+
+	t.Run("Should reuse the IP address already reserved for the machine", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{Subnet: testSubnetName, IPAddressPool: poolRef}
+		ipAddressClaim, ipAddress := allocatedClaim()
+		g.Expect(scope.Client.Create(ctx, ipAddressClaim)).To(Succeed())
+		g.Expect(scope.Client.Create(ctx, ipAddress)).To(Succeed())
+		mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id")}, nil)
+		mockvpc.EXPECT().GetSubnetReservedIPByAddress("subnet-id", "10.240.0.10").Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id"), Name: ptr.To(machineName)}, nil)
+		reserved, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(reserved).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP.ID).To(Equal("reserved-ip-id"))
+	})
+
+	t.Run("Error when the IP address is reserved by another resource", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{Subnet: testSubnetName, IPAddressPool: poolRef}
+		ipAddressClaim, ipAddress := allocatedClaim()
+		g.Expect(scope.Client.Create(ctx, ipAddressClaim)).To(Succeed())
+		g.Expect(scope.Client.Create(ctx, ipAddress)).To(Succeed())
+		mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id")}, nil)
+		mockvpc.EXPECT().GetSubnetReservedIPByAddress("subnet-id", "10.240.0.10").Return(&vpcv1.ReservedIP{ID: ptr.To("other-reserved-ip-id"), Name: ptr.To("other")}, nil)
+		reserved, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).To(MatchError(ContainSubstring("is already reserved in subnet")))
+		g.Expect(reserved).To(BeFalse())
+	})
+
+	t.Run("Error when creating reserved IP", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{Subnet: testSubnetName, IPAddressPool: poolRef}
+		ipAddressClaim, ipAddress := allocatedClaim()
+		g.Expect(scope.Client.Create(ctx, ipAddressClaim)).To(Succeed())
+		g.Expect(scope.Client.Create(ctx, ipAddress)).To(Succeed())
+		mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id")}, nil)
+		mockvpc.EXPECT().GetSubnetReservedIPByAddress("subnet-id", "10.240.0.10").Return(nil, nil)
+		mockvpc.EXPECT().CreateSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.CreateSubnetReservedIPOptions{})).Return(nil, &core.DetailedResponse{}, errors.New("failed to create reserved IP"))
+		reserved, err := scope.ReconcileIPAddress(ctx)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(reserved).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(BeNil())
+	})
+}
+
+func TestReleaseIPAddress(t *testing.T) {
+	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
+		t.Helper()
+		return gomock.NewController(t), mock.NewMockVpc(gomock.NewController(t))
+	}
+
+	poolRef := &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"}
+	setupReleaseScope := func(t *testing.T, mockvpc *mock.MockVpc) *MachineScope {
+		t.Helper()
+		scope := setupMachineScope(clusterName, machineName, mockvpc)
+		scope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{Subnet: testSubnetName, IPAddressPool: poolRef}
+		scope.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.ResourceStatus{ID: "reserved-ip-id", Ready: true}
+		ipAddressClaim := &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: machineName, Namespace: defaultNamespace}}
+		if err := scope.Client.Create(ctx, ipAddressClaim); err != nil {
+			t.Fatal(err)
+		}
+		mockvpc.EXPECT().GetVPCSubnetByName(testSubnetName).Return(&vpcv1.Subnet{ID: ptr.To("subnet-id")}, nil)
+		return scope
+	}
+
+	t.Run("Should wait while the reserved IP is bound to the instance", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupReleaseScope(t, mockvpc)
+		mockvpc.EXPECT().GetSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.GetSubnetReservedIPOptions{})).Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id"), Target: &vpcv1.ReservedIPTarget{}}, &core.DetailedResponse{}, nil)
+		released, err := scope.ReleaseIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(released).To(BeFalse())
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: machineName}, &ipamv1.IPAddressClaim{})).To(Succeed())
+	})
+
+	t.Run("Should delete the unbound reserved IP and the IPAddressClaim", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupReleaseScope(t, mockvpc)
+		mockvpc.EXPECT().GetSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.GetSubnetReservedIPOptions{})).Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id")}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteSubnetReservedIP(&vpcv1.DeleteSubnetReservedIPOptions{SubnetID: ptr.To("subnet-id"), ID: ptr.To("reserved-ip-id")}).Return(&core.DetailedResponse{}, nil)
+		released, err := scope.ReleaseIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(released).To(BeTrue())
+		g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).To(BeNil())
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: machineName}, &ipamv1.IPAddressClaim{})).To(Not(Succeed()))
+	})
+
+	t.Run("Should release the IPAddressClaim when the reserved IP is already deleted", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupReleaseScope(t, mockvpc)
+		mockvpc.EXPECT().GetSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.GetSubnetReservedIPOptions{})).Return(nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, errors.New("reserved IP not found"))
+		released, err := scope.ReleaseIPAddress(ctx)
+		g.Expect(err).To(BeNil())
+		g.Expect(released).To(BeTrue())
+		g.Expect(scope.Client.Get(ctx, client.ObjectKey{Namespace: defaultNamespace, Name: machineName}, &ipamv1.IPAddressClaim{})).To(Not(Succeed()))
+	})
+
+	t.Run("Error when deleting reserved IP", func(t *testing.T) {
+		g := NewWithT(t)
+		mockController, mockvpc := setup(t)
+		t.Cleanup(mockController.Finish)
+		scope := setupReleaseScope(t, mockvpc)
+		mockvpc.EXPECT().GetSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.GetSubnetReservedIPOptions{})).Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id")}, &core.DetailedResponse{}, nil)
+		mockvpc.EXPECT().DeleteSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.DeleteSubnetReservedIPOptions{})).Return(&core.DetailedResponse{}, errors.New("failed to delete reserved IP"))
+		released, err := scope.ReleaseIPAddress(ctx)
+		g.Expect(err).To(Not(BeNil()))
+		g.Expect(released).To(BeFalse())
+		g.Expect(scope.IBMVPCMachine.Status.PrimaryReservedIP).ToNot(BeNil())
+	})
+}
+
 func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
 	setup := func(t *testing.T) (*gomock.Controller, *mock.MockVpc) {
 		t.Helper()
@@ -940,6 +1301,67 @@ func TestCreateVPCLoadBalancerPoolMember(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			require.Equal(t, expectedOutput, out)
 		})
+		t.Run("Should create VPCLoadBalancerPoolMember targeting the instance for network load balancer", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.InstanceID = testInstanceID
+			networkLoadBalancer := &vpcv1.LoadBalancer{
+				ID:                 core.StringPtr("foo-load-balancer-id"),
+				ProvisioningStatus: core.StringPtr("active"),
+				Profile: &vpcv1.LoadBalancerProfileReference{
+					Family: core.StringPtr("network"),
+					Name:   core.StringPtr("network-fixed"),
+				},
+				Pools: []vpcv1.LoadBalancerPoolReference{
+					{
+						ID: core.StringPtr("foo-load-balancer-pool-id"),
+					},
+				},
+			}
+			loadBalancerPoolMember := &vpcv1.LoadBalancerPoolMember{
+				ID:   core.StringPtr("foo-load-balancer-pool-member-id"),
+				Port: core.Int64Ptr(int64(infrav1.DefaultAPIServerPort)),
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(networkLoadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(&vpcv1.LoadBalancerPoolMemberCollection{}, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().CreateLoadBalancerPoolMember(gomock.AssignableToTypeOf(&vpcv1.CreateLoadBalancerPoolMemberOptions{})).DoAndReturn(func(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
+				target, ok := options.Target.(*vpcv1.LoadBalancerPoolMemberTargetPrototypeInstanceIdentityInstanceIdentityByID)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(*target.ID).To(Equal(testInstanceID))
+				return loadBalancerPoolMember, &core.DetailedResponse{}, nil
+			})
+			out, err := scope.CreateVPCLoadBalancerPoolMember(ctx, &scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1.DefaultAPIServerPort))
+			g.Expect(err).To(BeNil())
+			require.Equal(t, loadBalancerPoolMember, out)
+		})
+		t.Run("Network load balancer PoolMember targeting the instance already exist", func(t *testing.T) {
+			g := NewWithT(t)
+			mockController, mockvpc := setup(t)
+			t.Cleanup(mockController.Finish)
+			scope := setupMachineScope(clusterName, machineName, mockvpc)
+			scope.IBMVPCMachine.Spec = vpcMachine.Spec
+			scope.IBMVPCMachine.Status = vpcMachine.Status
+			scope.IBMVPCMachine.Status.InstanceID = testInstanceID
+			loadBalancerPoolMemberCollection := &vpcv1.LoadBalancerPoolMemberCollection{
+				Members: []vpcv1.LoadBalancerPoolMember{
+					{
+						Port: core.Int64Ptr(int64(infrav1.DefaultAPIServerPort)),
+						Target: &vpcv1.LoadBalancerPoolMemberTarget{
+							ID: core.StringPtr(testInstanceID),
+						},
+					},
+				},
+			}
+			mockvpc.EXPECT().GetLoadBalancer(gomock.AssignableToTypeOf(&vpcv1.GetLoadBalancerOptions{})).Return(loadBalancer, &core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().ListLoadBalancerPoolMembers(gomock.AssignableToTypeOf(&vpcv1.ListLoadBalancerPoolMembersOptions{})).Return(loadBalancerPoolMemberCollection, &core.DetailedResponse{}, nil)
+			out, err := scope.CreateVPCLoadBalancerPoolMember(ctx, &scope.IBMVPCMachine.Status.Addresses[0].Address, int64(infrav1.DefaultAPIServerPort))
+			g.Expect(err).To(BeNil())
+			g.Expect(out).To(BeNil())
+		})
 	})
 }
 
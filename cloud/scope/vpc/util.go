@@ -17,7 +17,9 @@ limitations under the License.
 package vpc
 
 import (
+	"encoding/binary"
 	"fmt"
+	"net/netip"
 	"strings"
 )
 
@@ -75,3 +77,33 @@ func parseCRN(s string) (*CRN, error) {
 
 	return crn, nil
 }
+
+// allocateSubnetCIDR returns the first block of the provided size, within the address prefixes in order, which does not overlap any of the reserved cidrs.
+// If an address prefix is smaller than the requested size, the whole address prefix is considered as a single block.
+func allocateSubnetCIDR(addressPrefixes []netip.Prefix, reserved []netip.Prefix, bits int) (netip.Prefix, error) {
+	for _, addressPrefix := range addressPrefixes {
+		size := max(bits, addressPrefix.Bits())
+		for candidate := netip.PrefixFrom(addressPrefix.Addr(), size); addressPrefix.Contains(candidate.Addr()); {
+			overlaps := false
+			for _, cidr := range reserved {
+				if cidr.Overlaps(candidate) {
+					overlaps = true
+					break
+				}
+			}
+			if !overlaps {
+				return candidate, nil
+			}
+
+			// Move to the next block of the same size, stopping at the end of the IPv4 address space.
+			address := candidate.Addr().As4()
+			next := binary.BigEndian.Uint32(address[:]) + 1<<(32-size)
+			if next == 0 {
+				break
+			}
+			binary.BigEndian.PutUint32(address[:], next)
+			candidate = netip.PrefixFrom(netip.AddrFrom4(address), size)
+		}
+	}
+	return netip.Prefix{}, fmt.Errorf("no free /%d cidr left in the address prefixes", bits)
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"net/netip"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestAllocateSubnetCIDR(t *testing.T) {
+	prefixes := func(cidrs ...string) []netip.Prefix {
+		result := make([]netip.Prefix, 0, len(cidrs))
+		for _, cidr := range cidrs {
+			result = append(result, netip.MustParsePrefix(cidr))
+		}
+		return result
+	}
+
+	testcases := []struct {
+		name            string
+		addressPrefixes []netip.Prefix
+		reserved        []netip.Prefix
+		bits            int
+		expectedCIDR    string
+		expectError     bool
+	}{
+		{
+			name:            "Returns the first block of the address prefix when nothing is reserved",
+			addressPrefixes: prefixes("10.240.0.0/18"),
+			bits:            24,
+			expectedCIDR:    "10.240.0.0/24",
+		},
+		{
+			name:            "Skips the blocks already allocated",
+			addressPrefixes: prefixes("10.240.0.0/18"),
+			reserved:        prefixes("10.240.0.0/24", "10.240.1.0/24"),
+			bits:            24,
+			expectedCIDR:    "10.240.2.0/24",
+		},
+		{
+			name:            "Skips the blocks overlapping a smaller reserved cidr",
+			addressPrefixes: prefixes("10.240.0.0/18"),
+			reserved:        prefixes("10.240.0.128/26"),
+			bits:            24,
+			expectedCIDR:    "10.240.1.0/24",
+		},
+		{
+			name:            "Skips the blocks overlapping a larger reserved cidr",
+			addressPrefixes: prefixes("10.240.0.0/18"),
+			reserved:        prefixes("10.240.0.0/22"),
+			bits:            24,
+			expectedCIDR:    "10.240.4.0/24",
+		},
+		{
+			name:            "Ignores reserved cidrs outside of the address prefix",
+			addressPrefixes: prefixes("10.240.0.0/18"),
+			reserved:        prefixes("10.250.0.0/24"),
+			bits:            24,
+			expectedCIDR:    "10.240.0.0/24",
+		},
+		{
+			name:            "Moves to the next address prefix once the first one is exhausted",
+			addressPrefixes: prefixes("10.240.0.0/23", "10.241.0.0/23"),
+			reserved:        prefixes("10.240.0.0/24", "10.240.1.0/24"),
+			bits:            24,
+			expectedCIDR:    "10.241.0.0/24",
+		},
+		{
+			name:            "Uses the whole address prefix when it is smaller than the requested size",
+			addressPrefixes: prefixes("10.240.0.0/26"),
+			bits:            24,
+			expectedCIDR:    "10.240.0.0/26",
+		},
+		{
+			name:            "Returns error when the whole address prefix smaller than the requested size is reserved",
+			addressPrefixes: prefixes("10.240.0.0/26"),
+			reserved:        prefixes("10.240.0.0/28"),
+			bits:            24,
+			expectError:     true,
+		},
+		{
+			name:            "Returns error when all the address prefixes are exhausted",
+			addressPrefixes: prefixes("10.240.0.0/23", "10.241.0.0/24"),
+			reserved:        prefixes("10.240.0.0/24", "10.240.1.0/24", "10.241.0.0/24"),
+			bits:            24,
+			expectError:     true,
+		},
+		{
+			name:            "Returns error when the address prefix is fully covered by a reserved cidr",
+			addressPrefixes: prefixes("10.240.0.0/18"),
+			reserved:        prefixes("10.240.0.0/16"),
+			bits:            24,
+			expectError:     true,
+		},
+		{
+			name:            "Stops at the end of the IPv4 address space",
+			addressPrefixes: prefixes("255.255.254.0/23"),
+			reserved:        prefixes("255.255.254.0/24", "255.255.255.0/24"),
+			bits:            24,
+			expectError:     true,
+		},
+		{
+			name:        "Returns error when there are no address prefixes",
+			bits:        24,
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cidr, err := allocateSubnetCIDR(tc.addressPrefixes, tc.reserved, tc.bits)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(cidr.String()).To(Equal(tc.expectedCIDR))
+		})
+	}
+}
@@ -18,7 +18,9 @@ limitations under the License.
 package platformservices
 
 import (
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
 	"github.com/IBM/platform-services-go-sdk/resourcemanagerv2"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
@@ -39,3 +41,19 @@ func NewIAMIdentityClient() (*iamidentityv1.IamIdentityV1, error) {
 		URL:           iamidentityv1.DefaultServiceURL,
 	})
 }
+
+// NewResourceControllerV2Client creates new resource controller client.
+func NewResourceControllerV2Client() (*resourcecontrollerv2.ResourceControllerV2, error) {
+	return resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+		Authenticator: iam.GetIAMAuth(),
+		URL:           resourcecontrollerv2.DefaultServiceURL,
+	})
+}
+
+// NewGlobalSearchV2Client creates new global search client.
+func NewGlobalSearchV2Client() (*globalsearchv2.GlobalSearchV2, error) {
+	return globalsearchv2.NewGlobalSearchV2(&globalsearchv2.GlobalSearchV2Options{
+		Authenticator: iam.GetIAMAuth(),
+		URL:           globalsearchv2.DefaultServiceURL,
+	})
+}
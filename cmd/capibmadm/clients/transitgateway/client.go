@@ -0,0 +1,36 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package transitgateway contains client functions for transit gateway.
+package transitgateway
+
+import (
+	"time"
+
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+)
+
+// NewV1Client creates new transit gateway client.
+func NewV1Client() (*tgapiv1.TransitGatewayApisV1, error) {
+	version := time.Now().Format(time.DateOnly)
+	return tgapiv1.NewTransitGatewayApisV1(&tgapiv1.TransitGatewayApisV1Options{
+		Authenticator: iam.GetIAMAuth(),
+		URL:           tgapiv1.DefaultServiceURL,
+		Version:       &version,
+	})
+}
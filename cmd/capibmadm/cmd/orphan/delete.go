@@ -0,0 +1,531 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	logf "sigs.k8s.io/cluster-api/cmd/clusterctl/log"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/powervs"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/transitgateway"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/vpc"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+// pollInterval is the interval to check whether the deleted resources are gone.
+const pollInterval = 15 * time.Second
+
+// the resources are deleted in stages, a stage is only started once the resources of the previous stage are gone.
+const (
+	stageInstances = iota
+	stageInstanceDependents
+	stageSubnets
+	stageNetworkDependents
+	stageVPCs
+	stageServiceInstances
+)
+
+// zoneSuffixRegex matches the suffix of a VPC zone, the VPC region is the zone without it.
+var zoneSuffixRegex = regexp.MustCompile(`-\d+$`)
+
+type deleteOptions struct {
+	orphanOptions
+	confirm bool
+	timeout time.Duration
+}
+
+// deleter deletes resources, it holds the clients shared by the deletion of the resources.
+type deleter struct {
+	vpcClients       map[string]*vpcv1.VpcV1
+	piSessions       map[string]*ibmpisession.IBMPISession
+	tgClient         *tgapiv1.TransitGatewayApisV1
+	controllerClient *resourcecontrollerv2.ResourceControllerV2
+	handlers         map[string]resourceHandler
+	pollInterval     time.Duration
+}
+
+// resourceHandler deletes and checks the existence of the resources of a type.
+type resourceHandler struct {
+	stage  int
+	delete func(ctx context.Context, d *deleter, crn resourceCRN) error
+	exists func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error)
+}
+
+// resourceHandlers are the handlers of the types of resources created by the controllers, keyed by resource type name.
+var resourceHandlers = map[string]resourceHandler{
+	"is:instance": vpcResourceHandler(stageInstances,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteInstanceWithContext(ctx, &vpcv1.DeleteInstanceOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetInstanceWithContext(ctx, &vpcv1.GetInstanceOptions{ID: &id})
+			return response, err
+		}),
+	"is:volume": vpcResourceHandler(stageInstanceDependents,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteVolumeWithContext(ctx, &vpcv1.DeleteVolumeOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetVolumeWithContext(ctx, &vpcv1.GetVolumeOptions{ID: &id})
+			return response, err
+		}),
+	"is:load-balancer": vpcResourceHandler(stageInstanceDependents,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteLoadBalancerWithContext(ctx, &vpcv1.DeleteLoadBalancerOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetLoadBalancerWithContext(ctx, &vpcv1.GetLoadBalancerOptions{ID: &id})
+			return response, err
+		}),
+	"is:endpoint-gateway": vpcResourceHandler(stageInstanceDependents,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteEndpointGatewayWithContext(ctx, &vpcv1.DeleteEndpointGatewayOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetEndpointGatewayWithContext(ctx, &vpcv1.GetEndpointGatewayOptions{ID: &id})
+			return response, err
+		}),
+	"is:subnet": vpcResourceHandler(stageSubnets,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteSubnetWithContext(ctx, &vpcv1.DeleteSubnetOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetSubnetWithContext(ctx, &vpcv1.GetSubnetOptions{ID: &id})
+			return response, err
+		}),
+	"is:public-gateway": vpcResourceHandler(stageNetworkDependents,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeletePublicGatewayWithContext(ctx, &vpcv1.DeletePublicGatewayOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetPublicGatewayWithContext(ctx, &vpcv1.GetPublicGatewayOptions{ID: &id})
+			return response, err
+		}),
+	"is:security-group": vpcResourceHandler(stageNetworkDependents,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteSecurityGroupWithContext(ctx, &vpcv1.DeleteSecurityGroupOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetSecurityGroupWithContext(ctx, &vpcv1.GetSecurityGroupOptions{ID: &id})
+			return response, err
+		}),
+	"is:image": vpcResourceHandler(stageNetworkDependents,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteImageWithContext(ctx, &vpcv1.DeleteImageOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetImageWithContext(ctx, &vpcv1.GetImageOptions{ID: &id})
+			return response, err
+		}),
+	"is:vpc": vpcResourceHandler(stageVPCs,
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			return c.DeleteVPCWithContext(ctx, &vpcv1.DeleteVPCOptions{ID: &id})
+		},
+		func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error) {
+			_, response, err := c.GetVPCWithContext(ctx, &vpcv1.GetVPCOptions{ID: &id})
+			return response, err
+		}),
+	"power-iaas:pvm-instance": {
+		stage: stageInstances,
+		delete: func(ctx context.Context, d *deleter, crn resourceCRN) error {
+			sess, err := d.piSession(crn)
+			if err != nil {
+				return err
+			}
+			return ignorePowerVSNotFound(instance.NewIBMPIInstanceClient(ctx, sess, crn.serviceInstance).Delete(crn.resource))
+		},
+		exists: func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error) {
+			sess, err := d.piSession(crn)
+			if err != nil {
+				return false, err
+			}
+			_, err = instance.NewIBMPIInstanceClient(ctx, sess, crn.serviceInstance).Get(crn.resource)
+			return powerVSResourceExists(err)
+		},
+	},
+	"power-iaas:volume": {
+		stage: stageInstanceDependents,
+		delete: func(ctx context.Context, d *deleter, crn resourceCRN) error {
+			sess, err := d.piSession(crn)
+			if err != nil {
+				return err
+			}
+			return ignorePowerVSNotFound(instance.NewIBMPIVolumeClient(ctx, sess, crn.serviceInstance).DeleteVolume(crn.resource))
+		},
+		exists: func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error) {
+			sess, err := d.piSession(crn)
+			if err != nil {
+				return false, err
+			}
+			_, err = instance.NewIBMPIVolumeClient(ctx, sess, crn.serviceInstance).Get(crn.resource)
+			return powerVSResourceExists(err)
+		},
+	},
+	"power-iaas:network": {
+		stage: stageSubnets,
+		delete: func(ctx context.Context, d *deleter, crn resourceCRN) error {
+			sess, err := d.piSession(crn)
+			if err != nil {
+				return err
+			}
+			return ignorePowerVSNotFound(instance.NewIBMPINetworkClient(ctx, sess, crn.serviceInstance).Delete(crn.resource))
+		},
+		exists: func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error) {
+			sess, err := d.piSession(crn)
+			if err != nil {
+				return false, err
+			}
+			_, err = instance.NewIBMPINetworkClient(ctx, sess, crn.serviceInstance).Get(crn.resource)
+			return powerVSResourceExists(err)
+		},
+	},
+	"transit:gateway": {
+		stage:  stageInstanceDependents,
+		delete: deleteTransitGateway,
+		exists: func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error) {
+			_, response, err := d.tgClient.GetTransitGatewayWithContext(ctx, &tgapiv1.GetTransitGatewayOptions{ID: &crn.resource})
+			return resourceExists(response, err)
+		},
+	},
+	// the PowerVS workspace.
+	"power-iaas": serviceInstanceHandler,
+	// the COS instance.
+	"cloud-object-storage": serviceInstanceHandler,
+}
+
+var serviceInstanceHandler = resourceHandler{
+	stage: stageServiceInstances,
+	delete: func(ctx context.Context, d *deleter, crn resourceCRN) error {
+		response, err := d.controllerClient.DeleteResourceInstanceWithContext(ctx, &resourcecontrollerv2.DeleteResourceInstanceOptions{
+			ID:        &crn.serviceInstance,
+			Recursive: ptr.To(true),
+		})
+		if err != nil && !isNotFoundResponse(response) {
+			return err
+		}
+		return nil
+	},
+	exists: func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error) {
+		serviceInstance, response, err := d.controllerClient.GetResourceInstanceWithContext(ctx, &resourcecontrollerv2.GetResourceInstanceOptions{ID: &crn.serviceInstance})
+		if err != nil {
+			if isNotFoundResponse(response) {
+				return false, nil
+			}
+			return false, err
+		}
+		state := ptr.Deref(serviceInstance.State, "")
+		return state != "removed" && state != "pending_reclamation", nil
+	},
+}
+
+// DeleteCommand orphan delete command.
+func DeleteCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: "Delete resources of clusters that no longer exist",
+		Long: `Delete the resources tagged with the UID of an IBMVPCCluster or IBMPowerVSCluster that no longer exists.
+The resources of a single cluster are deleted, --cluster-uid is required as the resources tagged by the clusters of another
+management cluster sharing the account are considered orphaned too.
+The resources are deleted in dependency order, the resources that would be deleted are only listed unless --confirm is set.`,
+		Example: `
+# List the resources that would be deleted
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm orphan delete --kubeconfig <path/to/management/cluster/kubeconfig> --cluster-uid <cluster-uid>
+
+# Delete the resources
+capibmadm orphan delete --kubeconfig <path/to/management/cluster/kubeconfig> --cluster-uid <cluster-uid> --confirm`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var deleteOption deleteOptions
+	addOrphanFlags(cmd, &deleteOption.orphanOptions)
+	_ = cmd.MarkFlagRequired("cluster-uid")
+	cmd.Flags().BoolVar(&deleteOption.confirm, "confirm", false, "Delete the resources, otherwise the resources that would be deleted are only listed")
+	cmd.Flags().DurationVar(&deleteOption.timeout, "timeout", 30*time.Minute, "Time to wait for the resources of a deletion stage to be gone")
+	cmd.Flags().BoolVar(&options.GlobalOptions.Debug, "debug", false, "Enable/Disable http transport debugging log")
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return deleteOrphans(cmd.Context(), deleteOption)
+	}
+
+	return cmd
+}
+
+func deleteOrphans(ctx context.Context, deleteOption deleteOptions) error {
+	log := logf.Log
+	if deleteOption.clusterUID == "" {
+		return fmt.Errorf("cluster uid is not provided, set --cluster-uid to the uid of the cluster whose resources are to be deleted")
+	}
+	orphans, err := searchOrphanResources(ctx, deleteOption.orphanOptions)
+	if err != nil {
+		return err
+	}
+
+	stages, skipped := stageResources(orphans, resourceHandlers)
+	for _, resource := range skipped {
+		log.Info("Skipping resource of unsupported type, delete it manually", "type", resource.Type, "crn", resource.CRN)
+	}
+
+	var ordered List
+	for _, stage := range stages {
+		ordered = append(ordered, stage...)
+	}
+	if !deleteOption.confirm {
+		log.Info("Resources that would be deleted in order, set --confirm to delete them")
+		return display(ordered)
+	}
+
+	d, err := newDeleter()
+	if err != nil {
+		return err
+	}
+	if err := d.deleteStages(ctx, stages, deleteOption.timeout); err != nil {
+		return err
+	}
+	log.Info("Successfully deleted the resources of clusters that no longer exist", "resources", len(ordered))
+	return nil
+}
+
+// stageResources groups the resources by deletion stage, in the order the stages are deleted,
+// and returns the resources of types without handler separately.
+func stageResources(resources []Resource, handlers map[string]resourceHandler) ([][]Resource, List) {
+	stages := map[int][]Resource{}
+	var skipped List
+	for _, resource := range resources {
+		handler, ok := handlers[resource.Type]
+		if !ok {
+			skipped = append(skipped, resource)
+			continue
+		}
+		stages[handler.stage] = append(stages[handler.stage], resource)
+	}
+
+	ordered := make([][]Resource, 0, len(stages))
+	for _, stage := range slices.Sorted(maps.Keys(stages)) {
+		ordered = append(ordered, stages[stage])
+	}
+	return ordered, skipped
+}
+
+func newDeleter() (*deleter, error) {
+	tgClient, err := transitgateway.NewV1Client()
+	if err != nil {
+		return nil, err
+	}
+	controllerClient, err := platformservices.NewResourceControllerV2Client()
+	if err != nil {
+		return nil, err
+	}
+	return &deleter{
+		vpcClients:       map[string]*vpcv1.VpcV1{},
+		piSessions:       map[string]*ibmpisession.IBMPISession{},
+		tgClient:         tgClient,
+		controllerClient: controllerClient,
+		handlers:         resourceHandlers,
+		pollInterval:     pollInterval,
+	}, nil
+}
+
+// deleteStages deletes the resources stage by stage, a stage is only started once the resources of the previous stage are gone.
+func (d *deleter) deleteStages(ctx context.Context, stages [][]Resource, timeout time.Duration) error {
+	for _, stage := range stages {
+		if err := d.deleteStage(ctx, stage, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteStage deletes the resources and waits for them to be gone.
+func (d *deleter) deleteStage(ctx context.Context, resources []Resource, timeout time.Duration) error {
+	log := logf.Log
+	crns := make([]resourceCRN, 0, len(resources))
+	for _, resource := range resources {
+		crn, err := parseCRN(resource.CRN)
+		if err != nil {
+			return err
+		}
+		log.Info("Deleting resource", "type", resource.Type, "name", resource.Name, "crn", resource.CRN)
+		if err := d.handlers[resource.Type].delete(ctx, d, crn); err != nil {
+			return fmt.Errorf("failed to delete resource %s: %w", resource.CRN, err)
+		}
+		crns = append(crns, crn)
+	}
+
+	return wait.PollUntilContextTimeout(ctx, d.pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		var remaining []resourceCRN
+		for _, crn := range crns {
+			exists, err := d.handlers[crn.resourceTypeName()].exists(ctx, d, crn)
+			if err != nil {
+				return false, fmt.Errorf("failed to get resource %s: %w", crn.crn, err)
+			}
+			if exists {
+				remaining = append(remaining, crn)
+			}
+		}
+		crns = remaining
+		if len(crns) > 0 {
+			log.Info("Waiting for resources to be deleted", "resources", len(crns))
+		}
+		return len(crns) == 0, nil
+	})
+}
+
+func (d *deleter) vpcClient(crn resourceCRN) (*vpcv1.VpcV1, error) {
+	region := zoneSuffixRegex.ReplaceAllString(crn.location, "")
+	if c, ok := d.vpcClients[region]; ok {
+		return c, nil
+	}
+	c, err := vpc.NewV1Client(region)
+	if err != nil {
+		return nil, err
+	}
+	d.vpcClients[region] = c
+	return c, nil
+}
+
+func (d *deleter) piSession(crn resourceCRN) (*ibmpisession.IBMPISession, error) {
+	if sess, ok := d.piSessions[crn.location]; ok {
+		return sess, nil
+	}
+	sess, err := powervs.NewPISession(crn.accountID, crn.location, options.GlobalOptions.Debug)
+	if err != nil {
+		return nil, err
+	}
+	d.piSessions[crn.location] = sess
+	return sess, nil
+}
+
+// vpcResourceHandler returns the handler of a type of VPC resource.
+func vpcResourceHandler(stage int,
+	deleteFunc func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error),
+	getFunc func(ctx context.Context, c *vpcv1.VpcV1, id string) (*core.DetailedResponse, error)) resourceHandler {
+	return resourceHandler{
+		stage: stage,
+		delete: func(ctx context.Context, d *deleter, crn resourceCRN) error {
+			c, err := d.vpcClient(crn)
+			if err != nil {
+				return err
+			}
+			response, err := deleteFunc(ctx, c, crn.resource)
+			if err != nil && !isNotFoundResponse(response) {
+				return err
+			}
+			return nil
+		},
+		exists: func(ctx context.Context, d *deleter, crn resourceCRN) (bool, error) {
+			c, err := d.vpcClient(crn)
+			if err != nil {
+				return false, err
+			}
+			return resourceExists(getFunc(ctx, c, crn.resource))
+		},
+	}
+}
+
+// deleteTransitGateway deletes the connections of the transit gateway, waits for them to be gone and deletes the transit gateway.
+func deleteTransitGateway(ctx context.Context, d *deleter, crn resourceCRN) error {
+	connections, response, err := d.tgClient.ListTransitGatewayConnectionsWithContext(ctx, &tgapiv1.ListTransitGatewayConnectionsOptions{TransitGatewayID: &crn.resource})
+	if err != nil {
+		if isNotFoundResponse(response) {
+			return nil
+		}
+		return err
+	}
+	for _, connection := range connections.Connections {
+		response, err := d.tgClient.DeleteTransitGatewayConnectionWithContext(ctx, &tgapiv1.DeleteTransitGatewayConnectionOptions{
+			TransitGatewayID: &crn.resource,
+			ID:               connection.ID,
+		})
+		if err != nil && !isNotFoundResponse(response) {
+			return err
+		}
+	}
+	if err := wait.PollUntilContextTimeout(ctx, d.pollInterval, 30*time.Minute, true, func(ctx context.Context) (bool, error) {
+		connections, _, err := d.tgClient.ListTransitGatewayConnectionsWithContext(ctx, &tgapiv1.ListTransitGatewayConnectionsOptions{TransitGatewayID: &crn.resource})
+		if err != nil {
+			return false, err
+		}
+		return len(connections.Connections) == 0, nil
+	}); err != nil {
+		return fmt.Errorf("failed waiting for the connections of the transit gateway to be deleted: %w", err)
+	}
+
+	response, err = d.tgClient.DeleteTransitGatewayWithContext(ctx, &tgapiv1.DeleteTransitGatewayOptions{ID: &crn.resource})
+	if err != nil && !isNotFoundResponse(response) {
+		return err
+	}
+	return nil
+}
+
+func isNotFoundResponse(response *core.DetailedResponse) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
+func resourceExists(response *core.DetailedResponse, err error) (bool, error) {
+	if err != nil {
+		if isNotFoundResponse(response) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// isPowerVSNotFound returns true when the PowerVS api error is not found.
+func isPowerVSNotFound(err error) bool {
+	var codeErr interface{ IsCode(code int) bool }
+	return errors.As(err, &codeErr) && codeErr.IsCode(http.StatusNotFound)
+}
+
+func ignorePowerVSNotFound(err error) error {
+	if isPowerVSNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func powerVSResourceExists(err error) (bool, error) {
+	if err != nil {
+		if isPowerVSNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,460 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_p_vm_instances"
+	"github.com/IBM/go-sdk-core/v5/core"
+	tgapiv1 "github.com/IBM/networking-go-sdk/transitgatewayapisv1"
+	"github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+
+	"k8s.io/utils/ptr"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	instanceCRN       = "crn:v1:bluemix:public:is:us-south-1:a/account-id::instance:instance-id"
+	transitGatewayCRN = "crn:v1:bluemix:public:transit:global:a/account-id::gateway:tg-id"
+)
+
+func TestStageResources(t *testing.T) {
+	g := NewWithT(t)
+	vpc := Resource{CRN: vpcCRN, Type: "is:vpc"}
+	subnet := Resource{CRN: subnetCRN, Type: "is:subnet"}
+	instance := Resource{CRN: instanceCRN, Type: "is:instance"}
+	workspace := Resource{CRN: workspaceCRN, Type: "power-iaas"}
+	transitGateway := Resource{CRN: transitGatewayCRN, Type: "transit:gateway"}
+	dnsZone := Resource{CRN: "crn:v1:bluemix:public:dns-svcs:global:a/account-id:dns-id:zone:zone-id", Type: "dns-svcs:zone"}
+
+	stages, skipped := stageResources([]Resource{workspace, vpc, dnsZone, subnet, transitGateway, instance}, resourceHandlers)
+	g.Expect(stages).To(Equal([][]Resource{
+		{instance},
+		{transitGateway},
+		{subnet},
+		{vpc},
+		{workspace},
+	}))
+	g.Expect(skipped).To(Equal(List{dnsZone}))
+}
+
+func TestDeleterDeleteStages(t *testing.T) {
+	stages := [][]Resource{
+		{{CRN: instanceCRN, Type: "is:instance"}},
+		{{CRN: subnetCRN, Type: "is:subnet"}},
+	}
+
+	// newFakeDeleter returns a deleter whose handlers record the deletions, a resource is gone after its existence was checked twice.
+	newFakeDeleter := func(deleteErr error, exists func(crn resourceCRN) (bool, error)) (*deleter, *[]string) {
+		var events []string
+		checks := map[string]int{}
+		handler := func(stage int) resourceHandler {
+			return resourceHandler{
+				stage: stage,
+				delete: func(_ context.Context, _ *deleter, crn resourceCRN) error {
+					events = append(events, "delete "+crn.resource)
+					return deleteErr
+				},
+				exists: func(_ context.Context, _ *deleter, crn resourceCRN) (bool, error) {
+					if exists != nil {
+						return exists(crn)
+					}
+					checks[crn.resource]++
+					if checks[crn.resource] < 2 {
+						return true, nil
+					}
+					events = append(events, "gone "+crn.resource)
+					return false, nil
+				},
+			}
+		}
+		return &deleter{
+			handlers: map[string]resourceHandler{
+				"is:instance": handler(stageInstances),
+				"is:subnet":   handler(stageSubnets),
+			},
+			pollInterval: time.Millisecond,
+		}, &events
+	}
+
+	t.Run("Should delete a stage once the resources of the previous stage are gone", func(t *testing.T) {
+		g := NewWithT(t)
+		d, events := newFakeDeleter(nil, nil)
+		g.Expect(d.deleteStages(context.Background(), stages, time.Second)).To(Succeed())
+		g.Expect(*events).To(Equal([]string{"delete instance-id", "gone instance-id", "delete subnet-id", "gone subnet-id"}))
+	})
+
+	t.Run("Should not delete the next stages when the deletion of a resource fails", func(t *testing.T) {
+		g := NewWithT(t)
+		d, events := newFakeDeleter(errors.New("delete failed"), nil)
+		g.Expect(d.deleteStages(context.Background(), stages, time.Second)).ToNot(Succeed())
+		g.Expect(*events).To(Equal([]string{"delete instance-id"}))
+	})
+
+	t.Run("Should not delete the next stages when the resources of a stage are not gone before the timeout", func(t *testing.T) {
+		g := NewWithT(t)
+		d, events := newFakeDeleter(nil, func(_ resourceCRN) (bool, error) { return true, nil })
+		g.Expect(d.deleteStages(context.Background(), stages, 10*time.Millisecond)).ToNot(Succeed())
+		g.Expect(*events).To(Equal([]string{"delete instance-id"}))
+	})
+
+	t.Run("Should not delete the next stages when the existence of a resource cannot be checked", func(t *testing.T) {
+		g := NewWithT(t)
+		d, events := newFakeDeleter(nil, func(_ resourceCRN) (bool, error) { return false, errors.New("get failed") })
+		g.Expect(d.deleteStages(context.Background(), stages, time.Second)).ToNot(Succeed())
+		g.Expect(*events).To(Equal([]string{"delete instance-id"}))
+	})
+}
+
+func TestResourceExists(t *testing.T) {
+	testCases := []struct {
+		name           string
+		response       *core.DetailedResponse
+		err            error
+		expectedExists bool
+		expectedErr    bool
+	}{
+		{
+			name:           "Resource exists",
+			response:       &core.DetailedResponse{StatusCode: http.StatusOK},
+			expectedExists: true,
+		},
+		{
+			name:     "Resource not found",
+			response: &core.DetailedResponse{StatusCode: http.StatusNotFound},
+			err:      errors.New("not found"),
+		},
+		{
+			name:        "Failed to get the resource",
+			response:    &core.DetailedResponse{StatusCode: http.StatusInternalServerError},
+			err:         errors.New("internal server error"),
+			expectedErr: true,
+		},
+		{
+			name:        "Failed to get the resource without response",
+			err:         errors.New("connection refused"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			exists, err := resourceExists(tc.response, tc.err)
+			g.Expect(exists).To(Equal(tc.expectedExists))
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestPowerVSResourceExists(t *testing.T) {
+	testCases := []struct {
+		name           string
+		err            error
+		expectedExists bool
+		expectedErr    bool
+	}{
+		{
+			name:           "Resource exists",
+			expectedExists: true,
+		},
+		{
+			name: "Resource not found",
+			err:  fmt.Errorf("failed to Get PVM Instance instance-id :%w", p_cloud_p_vm_instances.NewPcloudPvminstancesGetNotFound()),
+		},
+		{
+			name:        "Failed to get the resource",
+			err:         fmt.Errorf("failed to Get PVM Instance instance-id :%w", p_cloud_p_vm_instances.NewPcloudPvminstancesGetInternalServerError()),
+			expectedErr: true,
+		},
+		{
+			name:        "Failed to get the resource without status code",
+			err:         errors.New("connection refused"),
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			exists, err := powerVSResourceExists(tc.err)
+			g.Expect(exists).To(Equal(tc.expectedExists))
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(ignorePowerVSNotFound(tc.err)).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+				g.Expect(ignorePowerVSNotFound(tc.err)).To(Succeed())
+			}
+		})
+	}
+}
+
+func TestServiceInstanceHandler(t *testing.T) {
+	crn, err := parseCRN(workspaceCRN)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	newDeleter := func(t *testing.T, mux *http.ServeMux) *deleter {
+		t.Helper()
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		controllerClient, err := resourcecontrollerv2.NewResourceControllerV2(&resourcecontrollerv2.ResourceControllerV2Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		NewWithT(t).Expect(err).ToNot(HaveOccurred())
+		return &deleter{controllerClient: controllerClient}
+	}
+
+	t.Run("Should delete the service instance recursively", func(t *testing.T) {
+		g := NewWithT(t)
+		var recursive string
+		mux := http.NewServeMux()
+		mux.HandleFunc("DELETE /v2/resource_instances/workspace-id", func(w http.ResponseWriter, r *http.Request) {
+			recursive = r.URL.Query().Get("recursive")
+			w.WriteHeader(http.StatusAccepted)
+		})
+		g.Expect(serviceInstanceHandler.delete(context.Background(), newDeleter(t, mux), crn)).To(Succeed())
+		g.Expect(recursive).To(Equal("true"))
+	})
+
+	t.Run("Should ignore the service instance not found", func(t *testing.T) {
+		g := NewWithT(t)
+		mux := http.NewServeMux()
+		mux.HandleFunc("DELETE /v2/resource_instances/workspace-id", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		g.Expect(serviceInstanceHandler.delete(context.Background(), newDeleter(t, mux), crn)).To(Succeed())
+	})
+
+	t.Run("Should return error when the deletion of the service instance fails", func(t *testing.T) {
+		g := NewWithT(t)
+		mux := http.NewServeMux()
+		mux.HandleFunc("DELETE /v2/resource_instances/workspace-id", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		g.Expect(serviceInstanceHandler.delete(context.Background(), newDeleter(t, mux), crn)).ToNot(Succeed())
+	})
+
+	testCases := []struct {
+		name           string
+		statusCode     int
+		state          string
+		expectedExists bool
+		expectedErr    bool
+	}{
+		{
+			name:           "Service instance is active",
+			statusCode:     http.StatusOK,
+			state:          "active",
+			expectedExists: true,
+		},
+		{
+			name:       "Service instance is removed",
+			statusCode: http.StatusOK,
+			state:      "removed",
+		},
+		{
+			name:       "Service instance is pending reclamation",
+			statusCode: http.StatusOK,
+			state:      "pending_reclamation",
+		},
+		{
+			name:       "Service instance not found",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:        "Failed to get the service instance",
+			statusCode:  http.StatusInternalServerError,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /v2/resource_instances/workspace-id", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.statusCode)
+				fmt.Fprintf(w, `{"id": "workspace-id", "state": %q}`, tc.state)
+			})
+			exists, err := serviceInstanceHandler.exists(context.Background(), newDeleter(t, mux), crn)
+			g.Expect(exists).To(Equal(tc.expectedExists))
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteTransitGateway(t *testing.T) {
+	crn, err := parseCRN(transitGatewayCRN)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+
+	newDeleter := func(t *testing.T, mux *http.ServeMux) *deleter {
+		t.Helper()
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		tgClient, err := tgapiv1.NewTransitGatewayApisV1(&tgapiv1.TransitGatewayApisV1Options{
+			URL:           server.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			Version:       ptr.To("2024-01-01"),
+		})
+		NewWithT(t).Expect(err).ToNot(HaveOccurred())
+		return &deleter{tgClient: tgClient, pollInterval: time.Millisecond}
+	}
+
+	t.Run("Should delete all the connections before the transit gateway", func(t *testing.T) {
+		g := NewWithT(t)
+		var events []string
+		connections := `{"connections": [{"id": "powervs-connection-id"}, {"id": "vpc-connection-id"}]}`
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /transit_gateways/tg-id/connections", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, connections)
+			connections = `{"connections": []}`
+		})
+		mux.HandleFunc("DELETE /transit_gateways/tg-id/connections/{id}", func(w http.ResponseWriter, r *http.Request) {
+			events = append(events, "delete connection "+r.PathValue("id"))
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("DELETE /transit_gateways/tg-id", func(w http.ResponseWriter, _ *http.Request) {
+			events = append(events, "delete transit gateway")
+			w.WriteHeader(http.StatusNoContent)
+		})
+		g.Expect(deleteTransitGateway(context.Background(), newDeleter(t, mux), crn)).To(Succeed())
+		g.Expect(events).To(Equal([]string{
+			"delete connection powervs-connection-id",
+			"delete connection vpc-connection-id",
+			"delete transit gateway",
+		}))
+	})
+
+	t.Run("Should ignore the transit gateway not found", func(t *testing.T) {
+		g := NewWithT(t)
+		var deleted bool
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /transit_gateways/tg-id/connections", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		mux.HandleFunc("DELETE /", func(w http.ResponseWriter, _ *http.Request) {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		})
+		g.Expect(deleteTransitGateway(context.Background(), newDeleter(t, mux), crn)).To(Succeed())
+		g.Expect(deleted).To(BeFalse())
+	})
+
+	t.Run("Should return error when the deletion of a connection fails", func(t *testing.T) {
+		g := NewWithT(t)
+		var deleted bool
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /transit_gateways/tg-id/connections", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"connections": [{"id": "vpc-connection-id"}]}`)
+		})
+		mux.HandleFunc("DELETE /transit_gateways/tg-id/connections/{id}", func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		mux.HandleFunc("DELETE /transit_gateways/tg-id", func(w http.ResponseWriter, _ *http.Request) {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		})
+		g.Expect(deleteTransitGateway(context.Background(), newDeleter(t, mux), crn)).ToNot(Succeed())
+		g.Expect(deleted).To(BeFalse())
+	})
+}
+
+func TestVPCResourceHandler(t *testing.T) {
+	crn, err := parseCRN(subnetCRN)
+	NewWithT(t).Expect(err).ToNot(HaveOccurred())
+	handler := resourceHandlers["is:subnet"]
+
+	newDeleter := func(t *testing.T, statusCode int) *deleter {
+		t.Helper()
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/subnets/subnet-id", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(statusCode)
+			fmt.Fprint(w, `{"id": "subnet-id"}`)
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		vpcClient, err := vpcv1.NewVpcV1(&vpcv1.VpcV1Options{
+			URL:           server.URL + "/v1",
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		NewWithT(t).Expect(err).ToNot(HaveOccurred())
+		// the client of the region of the zone of the subnet.
+		return &deleter{vpcClients: map[string]*vpcv1.VpcV1{"us-south": vpcClient}}
+	}
+
+	testCases := []struct {
+		name              string
+		statusCode        int
+		expectedExists    bool
+		expectedDeleteErr bool
+		expectedExistsErr bool
+	}{
+		{
+			name:           "Subnet exists",
+			statusCode:     http.StatusOK,
+			expectedExists: true,
+		},
+		{
+			name:       "Subnet not found",
+			statusCode: http.StatusNotFound,
+		},
+		{
+			name:              "Failed to delete and get the subnet",
+			statusCode:        http.StatusInternalServerError,
+			expectedDeleteErr: true,
+			expectedExistsErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			d := newDeleter(t, tc.statusCode)
+			if tc.expectedDeleteErr {
+				g.Expect(handler.delete(context.Background(), d, crn)).ToNot(Succeed())
+			} else {
+				g.Expect(handler.delete(context.Background(), d, crn)).To(Succeed())
+			}
+			exists, err := handler.exists(context.Background(), d, crn)
+			g.Expect(exists).To(Equal(tc.expectedExists))
+			if tc.expectedExistsErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
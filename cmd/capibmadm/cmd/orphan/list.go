@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphan
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/printer"
+)
+
+// ListCommand orphan list command.
+func ListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List resources of clusters that no longer exist",
+		Example: `
+# List the resources tagged with the UID of an IBMVPCCluster or IBMPowerVSCluster that no longer exists
+export IBMCLOUD_API_KEY=<api-key>
+capibmadm orphan list --kubeconfig <path/to/management/cluster/kubeconfig>`,
+	}
+
+	options.AddCommonFlags(cmd)
+	var orphanOption orphanOptions
+	addOrphanFlags(cmd, &orphanOption)
+
+	cmd.RunE = func(cmd *cobra.Command, _ []string) error {
+		return listOrphans(cmd.Context(), orphanOption)
+	}
+
+	return cmd
+}
+
+func listOrphans(ctx context.Context, orphanOption orphanOptions) error {
+	orphans, err := searchOrphanResources(ctx, orphanOption)
+	if err != nil {
+		return err
+	}
+	return display(orphans)
+}
+
+func display(resources List) error {
+	printResources, err := printer.New(options.GlobalOptions.Output, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	switch options.GlobalOptions.Output {
+	case printer.PrinterTypeJSON:
+		err = printResources.Print(resources)
+	default:
+		err = printResources.Print(resources.ToTable())
+	}
+
+	return err
+}
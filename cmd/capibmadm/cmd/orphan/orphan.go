@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package orphan contains the commands to find and delete the resources of clusters that no longer exist.
+package orphan
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/options"
+)
+
+type orphanOptions struct {
+	kubeconfig string
+	clusterUID string
+}
+
+// Commands initialises and returns orphan command.
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orphan",
+		Short: "Commands for operations on resources of clusters that no longer exist",
+		Long: `Commands for operations on the IBM Cloud resources tagged with the UID of an IBMVPCCluster or IBMPowerVSCluster
+that no longer exists in the management cluster, for example when the management cluster was lost or a finalizer was removed.`,
+		PersistentPreRunE: func(_ *cobra.Command, _ []string) error {
+			apiKey := os.Getenv(options.IBMCloudAPIKeyEnvName)
+			if apiKey == "" {
+				return fmt.Errorf("ibmcloud api key is not provided, set %s environmental variable", options.IBMCloudAPIKeyEnvName)
+			}
+			options.GlobalOptions.IBMCloudAPIKey = apiKey
+			return nil
+		},
+	}
+
+	cmd.AddCommand(ListCommand())
+	cmd.AddCommand(DeleteCommand())
+
+	return cmd
+}
+
+func addOrphanFlags(cmd *cobra.Command, orphanOption *orphanOptions) {
+	cmd.Flags().StringVar(&orphanOption.kubeconfig, "kubeconfig", "", "Path to the kubeconfig of the management cluster, the default loading rules are used when not set")
+	cmd.Flags().StringVar(&orphanOption.clusterUID, "cluster-uid", "", "Only consider the resources of the cluster with the UID")
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphan
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1powervs "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	infrav1vpc "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/iam"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/cmd/capibmadm/clients/platformservices"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/accounts"
+)
+
+// searchLimit is the maximum number of resources returned by a global search request.
+const searchLimit = 1000
+
+// resourceCRN holds the segments of the CRN of an IBM Cloud resource.
+// crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource.
+type resourceCRN struct {
+	crn             string
+	serviceName     string
+	location        string
+	accountID       string
+	serviceInstance string
+	resourceType    string
+	resource        string
+}
+
+// parseCRN returns the segments of the CRN.
+func parseCRN(crn string) (resourceCRN, error) {
+	segments := strings.Split(crn, ":")
+	if len(segments) != 10 || segments[0] != "crn" {
+		return resourceCRN{}, fmt.Errorf("invalid crn %s", crn)
+	}
+	return resourceCRN{
+		crn:             crn,
+		serviceName:     segments[4],
+		location:        segments[5],
+		accountID:       strings.TrimPrefix(segments[6], "a/"),
+		serviceInstance: segments[7],
+		resourceType:    segments[8],
+		resource:        segments[9],
+	}, nil
+}
+
+// resourceTypeName returns the type of the resource in the form service-name:resource-type, or the service name for a service instance.
+func (c resourceCRN) resourceTypeName() string {
+	if c.resourceType == "" {
+		return c.serviceName
+	}
+	return c.serviceName + ":" + c.resourceType
+}
+
+// searchOrphanResources searches the resources tagged with the UID of a cluster and returns the ones of the clusters that no longer exist
+// in the management cluster.
+func searchOrphanResources(ctx context.Context, orphanOption orphanOptions) ([]Resource, error) {
+	c, err := newManagementClusterClient(orphanOption.kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := searchTaggedResources(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return findOrphanResources(ctx, c, resources, orphanOption.clusterUID)
+}
+
+// findOrphanResources returns the resources tagged with the UID of a cluster that is not an IBMVPCCluster or IBMPowerVSCluster
+// of the management cluster, only the resources of the cluster with the UID are returned when it is set.
+// a resource is considered orphaned as soon as its cluster is not found in the management cluster, so the resources of the clusters
+// of another management cluster sharing the account are returned too.
+func findOrphanResources(ctx context.Context, c client.Reader, resources []Resource, clusterUID string) ([]Resource, error) {
+	clusterUIDs, err := listClusterUIDs(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := []Resource{}
+	for _, resource := range resources {
+		if clusterUID != "" && resource.ClusterUID != clusterUID {
+			continue
+		}
+		if clusterUIDs[types.UID(resource.ClusterUID)] {
+			continue
+		}
+		orphans = append(orphans, resource)
+	}
+	return orphans, nil
+}
+
+// newManagementClusterClient returns the client of the management cluster of the kubeconfig.
+func newManagementClusterClient(kubeconfig string) (client.Client, error) {
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	loadingRules.ExplicitPath = kubeconfig
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &clientcmd.ConfigOverrides{}).ClientConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the kubeconfig of the management cluster: %w", err)
+	}
+
+	scheme := runtime.NewScheme()
+	if err := infrav1vpc.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	if err := infrav1powervs.AddToScheme(scheme); err != nil {
+		return nil, err
+	}
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the client of the management cluster: %w", err)
+	}
+	return c, nil
+}
+
+// listClusterUIDs returns the UIDs of the IBMVPCClusters and IBMPowerVSClusters of the management cluster.
+func listClusterUIDs(ctx context.Context, c client.Reader) (map[types.UID]bool, error) {
+	clusterUIDs := map[types.UID]bool{}
+	vpcClusters := &infrav1vpc.IBMVPCClusterList{}
+	if err := c.List(ctx, vpcClusters); err != nil {
+		return nil, fmt.Errorf("failed to list IBMVPCClusters: %w", err)
+	}
+	for _, cluster := range vpcClusters.Items {
+		clusterUIDs[cluster.UID] = true
+	}
+	powerVSClusters := &infrav1powervs.IBMPowerVSClusterList{}
+	if err := c.List(ctx, powerVSClusters); err != nil {
+		return nil, fmt.Errorf("failed to list IBMPowerVSClusters: %w", err)
+	}
+	for _, cluster := range powerVSClusters.Items {
+		clusterUIDs[cluster.UID] = true
+	}
+	return clusterUIDs, nil
+}
+
+// searchTaggedResources returns the resources of the account tagged with the UID of a cluster.
+func searchTaggedResources(ctx context.Context) ([]Resource, error) {
+	accountID, err := accounts.GetAccount(iam.GetIAMAuth())
+	if err != nil {
+		return nil, err
+	}
+	searchClient, err := platformservices.NewGlobalSearchV2Client()
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	var searchCursor *string
+	for {
+		searchOptions := &globalsearchv2.SearchOptions{
+			Query:        ptr.To(fmt.Sprintf("tags:%s\\:*", genutil.ClusterUIDTagKey)),
+			Fields:       []string{"crn", "name", "tags"},
+			AccountID:    ptr.To(accountID),
+			Limit:        ptr.To[int64](searchLimit),
+			SearchCursor: searchCursor,
+		}
+		result, _, err := searchClient.SearchWithContext(ctx, searchOptions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search the resources tagged with the cluster UID: %w", err)
+		}
+		for _, item := range result.Items {
+			resource, ok := taggedResource(item)
+			if ok {
+				resources = append(resources, resource)
+			}
+		}
+		if len(result.Items) < searchLimit || result.SearchCursor == nil {
+			break
+		}
+		searchCursor = result.SearchCursor
+	}
+
+	slices.SortFunc(resources, func(a, b Resource) int {
+		return strings.Compare(a.ClusterUID+a.CRN, b.ClusterUID+b.CRN)
+	})
+	return resources, nil
+}
+
+// taggedResource returns the resource of the search result item, false when the item has no valid cluster UID tag.
+func taggedResource(item globalsearchv2.ResultItem) (Resource, bool) {
+	crn, err := parseCRN(ptr.Deref(item.CRN, ""))
+	if err != nil {
+		return Resource{}, false
+	}
+	resource := Resource{
+		CRN:      crn.crn,
+		Type:     crn.resourceTypeName(),
+		Location: crn.location,
+	}
+	if name, ok := item.GetProperty("name").(string); ok {
+		resource.Name = name
+	}
+	tags, _ := item.GetProperty("tags").([]interface{})
+	for _, tag := range tags {
+		tagName, ok := tag.(string)
+		if !ok {
+			continue
+		}
+		if uid, ok := genutil.ClusterUIDFromUserTag(tagName); ok {
+			resource.ClusterUID = string(uid)
+			return resource, true
+		}
+	}
+	return Resource{}, false
+}
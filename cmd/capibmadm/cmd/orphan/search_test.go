@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/IBM/platform-services-go-sdk/globalsearchv2"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1powervs "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	infrav1vpc "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	subnetCRN    = "crn:v1:bluemix:public:is:us-south-1:a/account-id::subnet:subnet-id"
+	vpcCRN       = "crn:v1:bluemix:public:is:us-south:a/account-id::vpc:vpc-id"
+	workspaceCRN = "crn:v1:bluemix:public:power-iaas:dal10:a/account-id:workspace-id::"
+)
+
+func TestParseCRN(t *testing.T) {
+	testCases := []struct {
+		name             string
+		crn              string
+		expectedCRN      resourceCRN
+		expectedTypeName string
+		expectedErr      bool
+	}{
+		{
+			name: "CRN of a VPC resource",
+			crn:  subnetCRN,
+			expectedCRN: resourceCRN{
+				crn:          subnetCRN,
+				serviceName:  "is",
+				location:     "us-south-1",
+				accountID:    "account-id",
+				resourceType: "subnet",
+				resource:     "subnet-id",
+			},
+			expectedTypeName: "is:subnet",
+		},
+		{
+			name: "CRN of a service instance",
+			crn:  workspaceCRN,
+			expectedCRN: resourceCRN{
+				crn:             workspaceCRN,
+				serviceName:     "power-iaas",
+				location:        "dal10",
+				accountID:       "account-id",
+				serviceInstance: "workspace-id",
+			},
+			expectedTypeName: "power-iaas",
+		},
+		{
+			name:        "CRN with missing segments",
+			crn:         "crn:v1:bluemix:public:is:us-south-1",
+			expectedErr: true,
+		},
+		{
+			name:        "Not a CRN",
+			crn:         "arn:v1:bluemix:public:is:us-south-1:a/account-id::subnet:subnet-id",
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			crn, err := parseCRN(tc.crn)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(crn).To(Equal(tc.expectedCRN))
+			g.Expect(crn.resourceTypeName()).To(Equal(tc.expectedTypeName))
+		})
+	}
+}
+
+func TestTaggedResource(t *testing.T) {
+	newItem := func(crn string, tags ...interface{}) globalsearchv2.ResultItem {
+		item := globalsearchv2.ResultItem{CRN: ptr.To(crn)}
+		item.SetProperty("name", "capi-subnet")
+		item.SetProperty("tags", tags)
+		return item
+	}
+	testCases := []struct {
+		name             string
+		item             globalsearchv2.ResultItem
+		expectedResource Resource
+		expectedOK       bool
+	}{
+		{
+			name: "Resource tagged with the cluster UID",
+			item: newItem(subnetCRN, "env:dev", "capibm-cluster-uid:cluster-uid"),
+			expectedResource: Resource{
+				CRN:        subnetCRN,
+				Name:       "capi-subnet",
+				Type:       "is:subnet",
+				Location:   "us-south-1",
+				ClusterUID: "cluster-uid",
+			},
+			expectedOK: true,
+		},
+		{
+			name: "Resource without the cluster UID tag",
+			item: newItem(subnetCRN, "env:dev"),
+		},
+		{
+			name: "Resource with an invalid CRN",
+			item: newItem("invalid", "capibm-cluster-uid:cluster-uid"),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			resource, ok := taggedResource(tc.item)
+			g.Expect(ok).To(Equal(tc.expectedOK))
+			g.Expect(resource).To(Equal(tc.expectedResource))
+		})
+	}
+}
+
+func TestFindOrphanResources(t *testing.T) {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(infrav1vpc.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(infrav1powervs.AddToScheme(scheme)).To(Succeed())
+
+	vpcCluster := &infrav1vpc.IBMVPCCluster{ObjectMeta: metav1.ObjectMeta{Name: "vpc-cluster", Namespace: "default", UID: "vpc-cluster-uid"}}
+	powerVSCluster := &infrav1powervs.IBMPowerVSCluster{ObjectMeta: metav1.ObjectMeta{Name: "powervs-cluster", Namespace: "default", UID: "powervs-cluster-uid"}}
+	resources := []Resource{
+		{CRN: vpcCRN, Type: "is:vpc", ClusterUID: "vpc-cluster-uid"},
+		{CRN: workspaceCRN, Type: "power-iaas", ClusterUID: "powervs-cluster-uid"},
+		{CRN: subnetCRN, Type: "is:subnet", ClusterUID: "deleted-cluster-uid"},
+		// the cluster of another management cluster sharing the account is not known to this management cluster.
+		{CRN: vpcCRN, Type: "is:vpc", ClusterUID: "other-management-cluster-uid"},
+	}
+
+	testCases := []struct {
+		name            string
+		clusterUID      string
+		expectedOrphans []Resource
+	}{
+		{
+			name: "Should return the resources of all the clusters not found in the management cluster",
+			expectedOrphans: []Resource{
+				{CRN: subnetCRN, Type: "is:subnet", ClusterUID: "deleted-cluster-uid"},
+				{CRN: vpcCRN, Type: "is:vpc", ClusterUID: "other-management-cluster-uid"},
+			},
+		},
+		{
+			name:       "Should only return the resources of the cluster with the UID",
+			clusterUID: "deleted-cluster-uid",
+			expectedOrphans: []Resource{
+				{CRN: subnetCRN, Type: "is:subnet", ClusterUID: "deleted-cluster-uid"},
+			},
+		},
+		{
+			name:            "Should not return the resources of a cluster of the management cluster",
+			clusterUID:      "vpc-cluster-uid",
+			expectedOrphans: []Resource{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(vpcCluster, powerVSCluster).Build()
+			orphans, err := findOrphanResources(context.Background(), c, resources, tc.clusterUID)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(orphans).To(Equal(tc.expectedOrphans))
+		})
+	}
+
+	t.Run("Should return error when the clusters of the management cluster cannot be listed", func(t *testing.T) {
+		g := NewWithT(t)
+		c := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+		orphans, err := findOrphanResources(context.Background(), c, resources, "")
+		g.Expect(err).To(HaveOccurred())
+		g.Expect(orphans).To(BeNil())
+	})
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphan
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	columnTypeString = "string"
+)
+
+// Resource is an IBM Cloud resource tagged with the UID of a cluster.
+type Resource struct {
+	CRN        string `json:"crn"`
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Location   string `json:"location"`
+	ClusterUID string `json:"clusterUID"`
+}
+
+// List is list of Resource.
+type List []Resource
+
+// ToTable converts List to *metav1.Table.
+func (resourceList *List) ToTable() *metav1.Table {
+	table := &metav1.Table{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: metav1.SchemeGroupVersion.String(),
+			Kind:       "Table",
+		},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{
+				Name: "CLUSTER UID",
+				Type: columnTypeString,
+			},
+			{
+				Name: "TYPE",
+				Type: columnTypeString,
+			},
+			{
+				Name: "NAME",
+				Type: columnTypeString,
+			},
+			{
+				Name: "LOCATION",
+				Type: columnTypeString,
+			},
+			{
+				Name: "CRN",
+				Type: columnTypeString,
+			},
+		},
+	}
+
+	for _, resource := range *resourceList {
+		row := metav1.TableRow{
+			Cells: []interface{}{resource.ClusterUID, resource.Type, resource.Name, resource.Location, resource.CRN},
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
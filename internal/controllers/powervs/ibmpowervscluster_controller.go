@@ -171,6 +171,25 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		return ctrl.Result{}, nil
 	}
 
+	// stop before any resource is reconciled when the resource name template fails to render,
+	// rather than creating the resources under names the user did not ask for.
+	if err := r.checkResourceNameTemplate(clusterScope); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// verify the resources referenced in status once the cluster is provisioned, the resources may have been removed or changed out-of-band.
+	if ptr.Deref(clusterScope.IBMPowerVSCluster.Status.Initialization.Provisioned, false) && resourceVerificationDue(clusterScope.IBMPowerVSCluster, clusterScope.ResourceVerificationInterval()) {
+		if res, err := r.verifyResources(ctx, clusterScope); err != nil || !res.IsZero() {
+			return res, err
+		}
+	}
+
+	// attach the user tags and the cluster UID tag to the resources created in the previous reconciliations,
+	// so the resources of a cluster can be found even when its provisioning never completes.
+	if err := clusterScope.ReconcileTags(ctx); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to reconcile user tags: %w", err)
+	}
+
 	// validate PER availability for the PowerVS zone, proceed further only if PowerVS zone support PER.
 	// more information about PER can be found here: https://cloud.ibm.com/docs/power-iaas?topic=power-iaas-per
 	if err := clusterScope.IsPowerVSZoneSupportsPER(); err != nil {
@@ -236,6 +255,7 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		})
 		return reconcile.Result{}, fmt.Errorf("failed to reconcile transit gateway: %w", err)
 	} else if requeue {
+		powerVSCluster.updateTransitGatewayRoutesCondition(clusterScope)
 		log.Info("Creating a transit gateway is pending, requeuing")
 		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
@@ -245,6 +265,7 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		Status: metav1.ConditionTrue,
 		Reason: infrav1.TransitGatewayReadyReason,
 	})
+	powerVSCluster.updateTransitGatewayRoutesCondition(clusterScope)
 
 	// reconcile COSInstance
 	if clusterScope.IBMPowerVSCluster.Spec.Ignition != nil {
@@ -292,10 +313,116 @@ func (r *IBMPowerVSClusterReconciler) reconcile(ctx context.Context, clusterScop
 		return reconcile.Result{RequeueAfter: time.Minute}, nil
 	}
 
+	// reconcile DNS record pointing to the load balancer and use it as control plane endpoint.
+	if clusterScope.IBMPowerVSCluster.Spec.DNS != nil {
+		log.Info("Reconciling DNS record")
+		if err := clusterScope.ReconcileDNSRecord(ctx, *hostName); err != nil {
+			powerVSCluster.updateCondition(metav1.Condition{
+				Type:    infrav1.DNSRecordReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.DNSRecordNotReadyReason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, fmt.Errorf("failed to reconcile DNS record: %w", err)
+		}
+		powerVSCluster.updateCondition(metav1.Condition{
+			Type:   infrav1.DNSRecordReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.DNSRecordReadyReason,
+		})
+		hostName = ptr.To(clusterScope.DNSRecordHostName())
+	}
+
 	// update cluster object with load balancer host name
 	clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Host = *hostName
 	clusterScope.IBMPowerVSCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.APIServerPort()
 	clusterScope.IBMPowerVSCluster.Status.Initialization.Provisioned = ptr.To(true)
+
+	// requeue to verify the resources periodically.
+	return ctrl.Result{RequeueAfter: clusterScope.ResourceVerificationInterval()}, nil
+}
+
+// resourceVerificationDue returns whether the resources referenced in status are to be verified.
+// they are verified once the verification interval has passed since the last verification, and on every reconciliation while resources are missing.
+func resourceVerificationDue(cluster *infrav1.IBMPowerVSCluster, interval time.Duration) bool {
+	lastVerification := cluster.Status.LastResourceVerificationTime
+	if lastVerification == nil || time.Since(lastVerification.Time) >= interval {
+		return true
+	}
+	return conditions.GetReason(cluster, infrav1.ResourcesVerifiedCondition) == infrav1.ResourcesMissingReason
+}
+
+// checkResourceNameTemplate checks the resource name template renders the names of all the resources created by the controller,
+// and reports the outcome in the ResourceNameTemplateRendered condition.
+func (r *IBMPowerVSClusterReconciler) checkResourceNameTemplate(clusterScope *powervsscope.ClusterScope) error {
+	if clusterScope.IBMPowerVSCluster.Spec.ResourceNameTemplate == nil {
+		conditions.Delete(clusterScope.IBMPowerVSCluster, infrav1.ResourceNameTemplateRenderedCondition)
+		return nil
+	}
+	if err := clusterScope.CheckResourceNameTemplate(); err != nil {
+		conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:    infrav1.ResourceNameTemplateRenderedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.ResourceNameTemplateRenderFailedReason,
+			Message: err.Error(),
+		})
+		return fmt.Errorf("failed to check resource name template: %w", err)
+	}
+	conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+		Type:   infrav1.ResourceNameTemplateRenderedCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ResourceNameTemplateRenderedReason,
+	})
+	return nil
+}
+
+// verifyResources verifies the resources referenced in status still exist and match spec, and reports the outcome in the ResourcesVerified condition.
+// The reconciliation is stopped and requeued after the verification interval when resources are missing.
+func (r *IBMPowerVSClusterReconciler) verifyResources(ctx context.Context, clusterScope *powervsscope.ClusterScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Verifying resources referenced in status")
+	result, err := clusterScope.VerifyResources(ctx)
+	if err != nil {
+		conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:    infrav1.ResourcesVerifiedCondition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  infrav1.ResourcesVerificationFailedReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, fmt.Errorf("failed to verify resources: %w", err)
+	}
+	clusterScope.IBMPowerVSCluster.Status.LastResourceVerificationTime = ptr.To(metav1.Now())
+
+	var messages []string
+	if len(result.Missing) != 0 {
+		messages = append(messages, fmt.Sprintf("Missing resources: %s", strings.Join(result.Missing, ", ")))
+	}
+	if len(result.Recreating) != 0 {
+		messages = append(messages, fmt.Sprintf("Recreating resources: %s", strings.Join(result.Recreating, ", ")))
+	}
+	if len(result.Drifted) != 0 {
+		messages = append(messages, fmt.Sprintf("Drifted resources: %s", strings.Join(result.Drifted, ", ")))
+	}
+	condition := metav1.Condition{
+		Type:    infrav1.ResourcesVerifiedCondition,
+		Status:  metav1.ConditionFalse,
+		Message: strings.Join(messages, "; "),
+	}
+	switch {
+	case len(result.Missing) != 0:
+		condition.Reason = infrav1.ResourcesMissingReason
+		conditions.Set(clusterScope.IBMPowerVSCluster, condition)
+		log.Info("Resources referenced in status are missing, requeuing", "resources", result.Missing)
+		return ctrl.Result{RequeueAfter: clusterScope.ResourceVerificationInterval()}, nil
+	case len(result.Recreating) != 0:
+		condition.Reason = infrav1.ResourcesRecreatingReason
+	case len(result.Drifted) != 0:
+		condition.Reason = infrav1.ResourcesDriftedReason
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = infrav1.ResourcesVerifiedReason
+	}
+	conditions.Set(clusterScope.IBMPowerVSCluster, condition)
 	return ctrl.Result{}, nil
 }
 
@@ -343,9 +470,29 @@ func (r *IBMPowerVSClusterReconciler) reconcilePowerVSResources(ctx context.Cont
 
 	clusterScope.IBMPowerVSClient.WithClients(powervs.ServiceOptions{CloudInstanceID: clusterScope.GetServiceInstanceID()})
 
+	// reconcile the used and available capacity of the workspace, which is used to hold the creation of machines exceeding the quota.
+	// Do not want to block the reconciliation of the cluster resources on the quota and the zone capabilities, so only logging the errors.
+	log.Info("Reconciling PowerVS workspace quota")
+	if err := clusterScope.ReconcileWorkspaceQuota(ctx); err != nil {
+		log.Error(err, "failed to reconcile PowerVS workspace quota")
+	}
+
+	// reconcile the capabilities of the zone, which are used to reject the machines requesting unsupported system and processor types.
+	log.Info("Reconciling PowerVS zone capabilities")
+	if err := clusterScope.ReconcileZoneCapabilities(ctx); err != nil {
+		log.Error(err, "failed to reconcile PowerVS zone capabilities")
+	}
+
 	// reconcile network
 	log.Info("Reconciling network")
-	if networkActive, err := clusterScope.ReconcileNetwork(ctx); err != nil {
+	networkActive, err := clusterScope.ReconcileNetwork(ctx)
+	if err == nil {
+		// reconcile the additional DHCP networks along with the network of the cluster.
+		var dhcpNetworksActive bool
+		dhcpNetworksActive, err = clusterScope.ReconcileDHCPNetworks(ctx)
+		networkActive = networkActive && dhcpNetworksActive
+	}
+	if err != nil {
 		deprecatedv1beta1conditions.Set(powerVSCluster.cluster, &clusterv1.Condition{
 			Status:   corev1.ConditionFalse,
 			Type:     infrav1.NetworkReadyV1Beta2Condition,
@@ -479,6 +626,35 @@ func (r *IBMPowerVSClusterReconciler) reconcileVPCResources(ctx context.Context,
 		Reason: infrav1.VPCSecurityGroupReadyCondition,
 	})
 
+	// reconcile VPC endpoint gateways
+	if len(clusterScope.IBMPowerVSCluster.Spec.VPCEndpointGateways) != 0 {
+		log.Info("Reconciling VPC endpoint gateways")
+		if requeue, err := clusterScope.ReconcileVPCEndpointGateways(ctx); err != nil {
+			powerVSCluster.updateCondition(metav1.Condition{
+				Type:    infrav1.VPCEndpointGatewayReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.VPCEndpointGatewayReconciliationFailedReason,
+				Message: err.Error(),
+			})
+			ch <- reconcileResult{reconcile.Result{}, fmt.Errorf("failed to reconcile VPC endpoint gateways: %w", err)}
+			return
+		} else if requeue {
+			log.Info("VPC endpoint gateway creation is pending, requeuing")
+			powerVSCluster.updateCondition(metav1.Condition{
+				Type:   infrav1.VPCEndpointGatewayReadyCondition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.VPCEndpointGatewayNotReadyReason,
+			})
+			ch <- reconcileResult{reconcile.Result{RequeueAfter: 20 * time.Second}, nil}
+			return
+		}
+		powerVSCluster.updateCondition(metav1.Condition{
+			Type:   infrav1.VPCEndpointGatewayReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.VPCEndpointGatewayReadyReason,
+		})
+	}
+
 	// reconcile LoadBalancer
 	log.Info("Reconciling VPC load balancers")
 	if loadBalancerReady, err := clusterScope.ReconcileLoadBalancers(ctx); err != nil {
@@ -548,6 +724,18 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
 
+	if clusterScope.IBMPowerVSCluster.Spec.DNS != nil {
+		log.Info("Deleting DNS record")
+		conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.DNSRecordReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.DNSRecordDeletingReason,
+		})
+		if err := clusterScope.DeleteDNSRecord(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete DNS record: %w", err))
+		}
+	}
+
 	log.Info("Deleting VPC load balancer")
 	conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
 		Type:   infrav1.VPCLoadBalancerReadyCondition,
@@ -571,6 +759,21 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 		allErrs = append(allErrs, fmt.Errorf("failed to delete VPC security group: %w", err))
 	}
 
+	if len(clusterScope.IBMPowerVSCluster.Status.VPCEndpointGateways) != 0 {
+		log.Info("Deleting VPC endpoint gateways")
+		conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
+			Type:   infrav1.VPCEndpointGatewayReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.VPCEndpointGatewayDeletingReason,
+		})
+		if requeue, err := clusterScope.DeleteVPCEndpointGateways(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("failed to delete VPC endpoint gateways: %w", err))
+		} else if requeue {
+			log.Info("VPC endpoint gateway deletion is pending, requeuing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
 	log.Info("Deleting VPC subnet")
 	conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
 		Type:   infrav1.VPCSubnetReadyCondition,
@@ -606,6 +809,9 @@ func (r *IBMPowerVSClusterReconciler) reconcileDelete(ctx context.Context, clust
 	if err := clusterScope.DeleteDHCPServer(ctx); err != nil {
 		allErrs = append(allErrs, fmt.Errorf("failed to delete DHCP server: %w", err))
 	}
+	if err := clusterScope.DeleteDHCPNetworks(ctx); err != nil {
+		allErrs = append(allErrs, fmt.Errorf("failed to delete DHCP servers of DHCP networks: %w", err))
+	}
 
 	log.Info("Deleting PowerVS service instance")
 	conditions.Set(clusterScope.IBMPowerVSCluster, metav1.Condition{
@@ -647,6 +853,54 @@ func (update *powerVSCluster) updateCondition(condition metav1.Condition) {
 	conditions.Set(update.cluster, condition)
 }
 
+// updateTransitGatewayRoutesCondition surfaces the overlapping routes found in the route report of the transit gateway.
+// the condition is removed when the route report is not requested.
+func (update *powerVSCluster) updateTransitGatewayRoutesCondition(clusterScope *powervsscope.ClusterScope) {
+	spec := clusterScope.IBMPowerVSCluster.Spec.TransitGateway
+	if spec == nil || !ptr.Deref(spec.GenerateRouteReport, false) {
+		update.mu.Lock()
+		defer update.mu.Unlock()
+		conditions.Delete(update.cluster, infrav1.TransitGatewayRoutesValidCondition)
+		return
+	}
+
+	var routeReport *infrav1.TransitGatewayRouteReportStatus
+	if clusterScope.IBMPowerVSCluster.Status.TransitGateway != nil {
+		routeReport = clusterScope.IBMPowerVSCluster.Status.TransitGateway.RouteReport
+	}
+	if routeReport == nil || routeReport.State != infrav1.TransitGatewayRouteReportStateComplete {
+		update.updateCondition(metav1.Condition{
+			Type:   infrav1.TransitGatewayRoutesValidCondition,
+			Status: metav1.ConditionUnknown,
+			Reason: infrav1.TransitGatewayRouteReportPendingReason,
+		})
+		return
+	}
+	if len(routeReport.OverlappingRoutes) == 0 {
+		update.updateCondition(metav1.Condition{
+			Type:   infrav1.TransitGatewayRoutesValidCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.TransitGatewayRoutesValidReason,
+		})
+		return
+	}
+
+	overlaps := make([]string, 0, len(routeReport.OverlappingRoutes))
+	for _, group := range routeReport.OverlappingRoutes {
+		routes := make([]string, 0, len(group.Routes))
+		for _, route := range group.Routes {
+			routes = append(routes, fmt.Sprintf("%s (%s)", route.Prefix, route.ConnectionName))
+		}
+		overlaps = append(overlaps, strings.Join(routes, ", "))
+	}
+	update.updateCondition(metav1.Condition{
+		Type:    infrav1.TransitGatewayRoutesValidCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.TransitGatewayRoutesOverlappingReason,
+		Message: fmt.Sprintf("Transit gateway routes overlap: %s", strings.Join(overlaps, "; ")),
+	})
+}
+
 func (r *IBMPowerVSClusterReconciler) deleteIBMPowerVSImage(ctx context.Context, clusterScope *powervsscope.ClusterScope) (ctrl.Result, error) {
 	log := ctrl.LoggerFrom(ctx)
 	cluster := clusterScope.IBMPowerVSCluster
@@ -789,12 +1043,16 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *patch.Helper, ibmP
 			infrav1.VPCReadyCondition,
 			infrav1.VPCSubnetReadyCondition,
 			infrav1.VPCSecurityGroupReadyCondition,
+			infrav1.VPCEndpointGatewayReadyCondition,
 			infrav1.VPCLoadBalancerReadyCondition,
 			infrav1.TransitGatewayReadyCondition,
 			infrav1.COSInstanceReadyCondition,
+			infrav1.DNSRecordReadyCondition,
 		},
 		conditions.IgnoreTypesIfMissing{
+			infrav1.VPCEndpointGatewayReadyCondition,
 			infrav1.COSInstanceReadyCondition,
+			infrav1.DNSRecordReadyCondition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge.
 		conditions.CustomMergeStrategy{
@@ -820,8 +1078,13 @@ func patchIBMPowerVSCluster(ctx context.Context, patchHelper *patch.Helper, ibmP
 			infrav1.VPCReadyCondition,
 			infrav1.VPCSubnetReadyCondition,
 			infrav1.VPCSecurityGroupReadyCondition,
+			infrav1.VPCEndpointGatewayReadyCondition,
 			infrav1.TransitGatewayReadyCondition,
+			infrav1.TransitGatewayRoutesValidCondition,
 			infrav1.COSInstanceReadyCondition,
+			infrav1.DNSRecordReadyCondition,
+			infrav1.ResourcesVerifiedCondition,
+			infrav1.ResourceNameTemplateRenderedCondition,
 		}}, patch.Clusterv1ConditionsFieldPath{statusField, deprecatedStatus, v1beta2Version, deprecatedConditionsField},
 	)
 }
@@ -686,6 +686,10 @@ func TestIBMPowerVSClusterReconciler_reconcile(t *testing.T) {
 				mockPowerVS.EXPECT().GetDatacenterCapabilities(gomock.Any()).Return(map[string]bool{"power-edge-router": true}, nil)
 				mockPowerVS.EXPECT().GetNetworkByID(gomock.Any()).Return(nil, errors.New("error get networkByID"))
 				mockPowerVS.EXPECT().WithClients(gomock.Any())
+				mockPowerVS.EXPECT().GetCloudInstance(gomock.Any()).Return(&models.CloudInstance{}, nil)
+				mockPowerVS.EXPECT().GetDatacenter(gomock.Any()).Return(&models.Datacenter{}, nil)
+				mockPowerVS.EXPECT().GetAllStorageTypesCapacity().Return(&models.StorageTypesCapacity{}, nil)
+				mockPowerVS.EXPECT().GetAllStoragePoolsCapacity().Return(&models.StoragePoolsCapacity{}, nil)
 				clusterScope.IBMPowerVSClient = mockPowerVS
 
 				clusterScope.ResourceClient = getMockResourceController(t)
@@ -753,7 +757,8 @@ func TestIBMPowerVSClusterReconciler_reconcile(t *testing.T) {
 
 				return clusterScope
 			},
-			clusterStatus: true,
+			expectedResult: ctrl.Result{RequeueAfter: 10 * time.Minute},
+			clusterStatus:  true,
 		},
 	}
 	for _, tc := range testCases {
@@ -1708,6 +1713,7 @@ func TestReconcilePowerVSResources(t *testing.T) {
 				mockPowerVS := powervsmock.NewMockPowerVS(gomock.NewController(t))
 				mockPowerVS.EXPECT().GetNetworkByID(gomock.Any()).Return(nil, errors.New("error getting network"))
 				mockPowerVS.EXPECT().WithClients(gomock.Any())
+				mockPowerVS.EXPECT().GetCloudInstance(gomock.Any()).Return(&models.CloudInstance{}, nil)
 				mockResourceController := resourceclientmock.NewMockResourceController(gomock.NewController(t))
 				mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{State: ptr.To(string(infrav1.ServiceInstanceStateActive)), Name: ptr.To("serviceInstanceName")}, nil, nil)
 				clusterScope.ResourceClient = mockResourceController
@@ -1746,6 +1752,7 @@ func TestReconcilePowerVSResources(t *testing.T) {
 				mockPowerVS := powervsmock.NewMockPowerVS(gomock.NewController(t))
 				mockPowerVS.EXPECT().GetNetworkByID(gomock.Any()).Return(&models.Network{NetworkID: ptr.To("netID")}, nil)
 				mockPowerVS.EXPECT().WithClients(gomock.Any())
+				mockPowerVS.EXPECT().GetCloudInstance(gomock.Any()).Return(&models.CloudInstance{}, nil)
 				mockResourceController := resourceclientmock.NewMockResourceController(gomock.NewController(t))
 				mockResourceController.EXPECT().GetResourceInstance(gomock.Any()).Return(&resourcecontrollerv2.ResourceInstance{State: ptr.To(string(infrav1.ServiceInstanceStateActive)), Name: ptr.To("serviceInstanceName")}, nil, nil)
 				clusterScope.ResourceClient = mockResourceController
@@ -1874,6 +1881,10 @@ func getMockPowerVS(t *testing.T) *powervsmock.MockPowerVS {
 	network := &models.Network{NetworkID: ptr.To("netID")}
 	mockPowerVS.EXPECT().GetNetworkByID(gomock.Any()).Return(network, nil)
 	mockPowerVS.EXPECT().WithClients(gomock.Any())
+	mockPowerVS.EXPECT().GetCloudInstance(gomock.Any()).Return(&models.CloudInstance{}, nil)
+	mockPowerVS.EXPECT().GetDatacenter(gomock.Any()).Return(&models.Datacenter{}, nil)
+	mockPowerVS.EXPECT().GetAllStorageTypesCapacity().Return(&models.StorageTypesCapacity{}, nil)
+	mockPowerVS.EXPECT().GetAllStoragePoolsCapacity().Return(&models.StoragePoolsCapacity{}, nil)
 	return mockPowerVS
 }
 
@@ -1969,3 +1980,113 @@ func getNetworkReadyCondition() clusterv1.Condition {
 		Status: "True",
 	}
 }
+
+func TestResourceVerificationDue(t *testing.T) {
+	testCases := []struct {
+		name             string
+		lastVerification *metav1.Time
+		conditions       []metav1.Condition
+		expectedDue      bool
+	}{
+		{
+			name:        "Should verify resources when they were never verified",
+			expectedDue: true,
+		},
+		{
+			name:             "Should verify resources when the interval has passed since the last verification",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-11 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedCondition, Status: metav1.ConditionTrue, Reason: infrav1.ResourcesVerifiedReason}},
+			expectedDue:      true,
+		},
+		{
+			name:             "Should not verify resources within the interval since the last verification",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-1 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedCondition, Status: metav1.ConditionTrue, Reason: infrav1.ResourcesVerifiedReason}},
+			expectedDue:      false,
+		},
+		{
+			name:             "Should not verify drifted resources within the interval since the last verification",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-1 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedCondition, Status: metav1.ConditionFalse, Reason: infrav1.ResourcesDriftedReason}},
+			expectedDue:      false,
+		},
+		{
+			name:             "Should verify resources within the interval while resources are missing",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-1 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedCondition, Status: metav1.ConditionFalse, Reason: infrav1.ResourcesMissingReason}},
+			expectedDue:      true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &infrav1.IBMPowerVSCluster{
+				Status: infrav1.IBMPowerVSClusterStatus{
+					Conditions:                   tc.conditions,
+					LastResourceVerificationTime: tc.lastVerification,
+				},
+			}
+			g.Expect(resourceVerificationDue(cluster, 10*time.Minute)).To(Equal(tc.expectedDue))
+		})
+	}
+}
+
+func TestCheckResourceNameTemplate(t *testing.T) {
+	testCases := []struct {
+		name            string
+		nameTemplate    *string
+		conditions      []metav1.Condition
+		expectedErr     bool
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectCondition bool
+	}{
+		{
+			name:       "Should remove the condition when the resource name template is not set",
+			conditions: []metav1.Condition{{Type: infrav1.ResourceNameTemplateRenderedCondition, Status: metav1.ConditionTrue, Reason: infrav1.ResourceNameTemplateRenderedReason}},
+		},
+		{
+			name:            "Should set the condition to true when the resource name template renders",
+			nameTemplate:    ptr.To("fin-{{ .ClusterName }}-{{ lower .ResourceType }}"),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  infrav1.ResourceNameTemplateRenderedReason,
+			expectCondition: true,
+		},
+		{
+			name:            "Should set the condition to false and return error when the resource name template fails to render",
+			nameTemplate:    ptr.To("fin-{{ index .ClusterName 20 }}-{{ lower .ResourceType }}"),
+			expectedErr:     true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  infrav1.ResourceNameTemplateRenderFailedReason,
+			expectCondition: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &powervsscope.ClusterScope{
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+					Spec:       infrav1.IBMPowerVSClusterSpec{ResourceNameTemplate: tc.nameTemplate},
+					Status:     infrav1.IBMPowerVSClusterStatus{Conditions: tc.conditions},
+				},
+			}
+			reconciler := &IBMPowerVSClusterReconciler{}
+			err := reconciler.checkResourceNameTemplate(clusterScope)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			if !tc.expectCondition {
+				g.Expect(clusterScope.IBMPowerVSCluster.Status.Conditions).To(BeEmpty())
+				return
+			}
+			g.Expect(clusterScope.IBMPowerVSCluster.Status.Conditions).To(HaveLen(1))
+			condition := clusterScope.IBMPowerVSCluster.Status.Conditions[0]
+			g.Expect(condition.Type).To(Equal(infrav1.ResourceNameTemplateRenderedCondition))
+			g.Expect(condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
@@ -35,6 +36,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	clusterv1util "sigs.k8s.io/cluster-api/util"
@@ -100,6 +102,7 @@ func (r *IBMPowerVSImageReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, err
 		}
 		scopeParams.Zone = cluster.Spec.Zone
+		scopeParams.IBMPowerVSCluster = cluster
 	}
 
 	// Initialize the patch helper
@@ -177,14 +180,60 @@ func (r *IBMPowerVSImageReconciler) reconcile(ctx context.Context, cluster *infr
 		Reason: infrav1.WorkspaceReadyReason,
 	})
 
+	if err := r.reconcileUsage(ctx, imageScope); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Import into the additional workspaces independently of the import into the primary workspace.
+	targetsResult, targetsErr := r.reconcileImageTargets(ctx, imageScope)
+	result, err := r.reconcileImport(ctx, imageScope)
+	if err == nil && imageScope.IsReady() {
+		if err := imageScope.ReconcileRetention(ctx); err != nil {
+			log.Error(err, "Unable to apply the retention policy of the image family")
+			return ctrl.Result{}, kerrors.NewAggregate([]error{err, targetsErr})
+		}
+	}
+	return clusterv1util.LowestNonZeroResult(result, targetsResult), kerrors.NewAggregate([]error{err, targetsErr})
+}
+
+func (r *IBMPowerVSImageReconciler) reconcileUsage(ctx context.Context, imageScope *powervsscope.ImageScope) error {
+	inUse, err := imageScope.ReconcileUsage(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get usage of IBMPowerVSImage %s/%s: %w", imageScope.IBMPowerVSImage.Namespace, imageScope.IBMPowerVSImage.Name, err)
+	}
+
+	if !inUse {
+		conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+			Type:   infrav1.ImageInUseCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.ImageNotInUseReason,
+		})
+		return nil
+	}
+
+	usage := imageScope.IBMPowerVSImage.Status.Usage
+	conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+		Type:    infrav1.ImageInUseCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  infrav1.ImageInUseReason,
+		Message: fmt.Sprintf("Image is referenced by %d IBMPowerVSMachines and %d IBMPowerVSMachineTemplates", usage.Machines, usage.MachineTemplates),
+	})
+	return nil
+}
+
+func (r *IBMPowerVSImageReconciler) reconcileImport(ctx context.Context, imageScope *powervsscope.ImageScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+
 	if jobID := imageScope.GetJobID(); jobID != "" {
 		job, err := imageScope.IBMPowerVSClient.GetJob(jobID)
 		if err != nil {
 			log.Info("Unable to get job details", "jobID", jobID)
-			return ctrl.Result{RequeueAfter: 2 * time.Minute}, err
+			return ctrl.Result{RequeueAfter: imageScope.JobPollInterval()}, err
 		}
 
 		imageScope.SetImageState(*job.Status.State)
+		imageScope.SetImportProgress(job)
+		imageScope.SetImportAttempt(job)
 		switch imageScope.GetImageState() {
 		case infrav1.PowerVSImageStateCompleted:
 			deprecatedv1beta1conditions.MarkTrue(imageScope.IBMPowerVSImage, infrav1.ImageImportedV1Beta2Condition)
@@ -202,7 +251,24 @@ func (r *IBMPowerVSImageReconciler) reconcile(ctx context.Context, cluster *infr
 				Status: metav1.ConditionFalse,
 				Reason: infrav1.IBMPowerVSImageImportFailedReason,
 			})
-			return ctrl.Result{RequeueAfter: 2 * time.Minute}, fmt.Errorf("failed to import image, message: %s", job.Status.Message)
+			retry, backoff := imageScope.ShouldRetryImport()
+			if !retry {
+				return ctrl.Result{RequeueAfter: imageScope.JobPollInterval()}, fmt.Errorf("failed to import image, message: %s", job.Status.Message)
+			}
+			conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+				Type:    infrav1.IBMPowerVSImageReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.IBMPowerVSImageImportRetryingReason,
+				Message: fmt.Sprintf("Import attempt failed with message %q, retrying", job.Status.Message),
+			})
+			if backoff > 0 {
+				log.Info("Image import failed, waiting before retrying", "backoff", backoff)
+				return ctrl.Result{RequeueAfter: backoff}, nil
+			}
+			if err := imageScope.ResetImport(ctx); err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to reset failed image import: %w", err)
+			}
+			log.Info("Retrying image import")
 		case infrav1.PowerVSImageStateQueued:
 			imageScope.SetNotReady()
 			imageScope.SetImageState(string(infrav1.PowerVSImageStateQueued))
@@ -212,7 +278,7 @@ func (r *IBMPowerVSImageReconciler) reconcile(ctx context.Context, cluster *infr
 				Status: metav1.ConditionFalse,
 				Reason: infrav1.IBMPowerVSImageQueuedReason,
 			})
-			return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
+			return ctrl.Result{RequeueAfter: imageScope.JobPollInterval()}, nil
 		default:
 			imageScope.SetNotReady()
 			imageScope.SetImageState(string(infrav1.PowerVSImageStateImporting))
@@ -222,11 +288,22 @@ func (r *IBMPowerVSImageReconciler) reconcile(ctx context.Context, cluster *infr
 				Status: metav1.ConditionFalse,
 				Reason: infrav1.IBMPowerVSImageNotReadyReason,
 			})
-			return ctrl.Result{RequeueAfter: 2 * time.Minute}, nil
+			return ctrl.Result{RequeueAfter: imageScope.JobPollInterval()}, nil
 		}
 	}
 
 	img, jobRef, err := r.getOrCreate(ctx, imageScope)
+	if errors.Is(err, powervsscope.ErrImageChecksumMismatch) {
+		imageScope.SetNotReady()
+		deprecatedv1beta1conditions.MarkFalse(imageScope.IBMPowerVSImage, infrav1.ImageImportedV1Beta2Condition, infrav1.ImageImportFailedV1Beta2Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+			Type:    infrav1.IBMPowerVSImageReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.IBMPowerVSImageChecksumMismatchReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, fmt.Errorf("image checksum verification failed for IBMPowerVSImage %s/%s: %w", imageScope.IBMPowerVSImage.Namespace, imageScope.IBMPowerVSImage.Name, err)
+	}
 	if err != nil {
 		log.Error(err, "Unable to import image")
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile Image for IBMPowerVSImage %s/%s: %w", imageScope.IBMPowerVSImage.Namespace, imageScope.IBMPowerVSImage.Name, err)
@@ -238,6 +315,44 @@ func (r *IBMPowerVSImageReconciler) reconcile(ctx context.Context, cluster *infr
 	return reconcileImage(ctx, img, imageScope)
 }
 
+func (r *IBMPowerVSImageReconciler) reconcileImageTargets(ctx context.Context, imageScope *powervsscope.ImageScope) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	imageSpec := imageScope.IBMPowerVSImage.Spec
+	if len(imageSpec.Targets) == 0 && imageSpec.ClusterSelector == nil && len(imageScope.IBMPowerVSImage.Status.Targets) == 0 {
+		conditions.Delete(imageScope.IBMPowerVSImage, infrav1.ImageTargetsReadyCondition)
+		return ctrl.Result{}, nil
+	}
+
+	ready, err := imageScope.ReconcileImageTargets(ctx)
+	if err != nil {
+		log.Error(err, "Unable to import image into target service instances")
+		conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+			Type:    infrav1.ImageTargetsReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.ImageTargetsReconciliationFailedReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, err
+	}
+
+	if !ready {
+		log.Info("Image is not yet ready in all target service instances, requeue")
+		conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+			Type:   infrav1.ImageTargetsReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.ImageTargetsNotReadyReason,
+		})
+		return ctrl.Result{RequeueAfter: imageScope.JobPollInterval()}, nil
+	}
+
+	conditions.Set(imageScope.IBMPowerVSImage, metav1.Condition{
+		Type:   infrav1.ImageTargetsReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ImageTargetsReadyReason,
+	})
+	return ctrl.Result{}, nil
+}
+
 func reconcileImage(ctx context.Context, img *models.ImageReference, imageScope *powervsscope.ImageScope) (_ ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
 	if img != nil {
@@ -249,6 +364,7 @@ func reconcileImage(ctx context.Context, img *models.ImageReference, imageScope
 
 		imageScope.SetImageID(image.ImageID)
 		log.Info("ImageID", imageScope.GetImageID())
+		imageScope.SetChecksum(image.Specifications)
 		imageScope.SetImageState(image.State)
 		log.Info("ImageState", image.State)
 
@@ -262,7 +378,7 @@ func reconcileImage(ctx context.Context, img *models.ImageReference, imageScope
 				Status: metav1.ConditionFalse,
 				Reason: infrav1.IBMPowerVSImageNotReadyReason,
 			})
-			return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+			return ctrl.Result{RequeueAfter: imageScope.ImagePollInterval()}, nil
 		case infrav1.PowerVSImageStateACTIVE:
 			log.Info("Image is in active state")
 			imageScope.SetReady()
@@ -288,7 +404,7 @@ func reconcileImage(ctx context.Context, img *models.ImageReference, imageScope
 	// Requeue after 1 minute if image is not ready to update status of the image properly.
 	if !imageScope.IsReady() {
 		log.Info("Image is not yet ready, requeue", "state", imageScope.GetImageState())
-		return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+		return ctrl.Result{RequeueAfter: imageScope.ImagePollInterval()}, nil
 	}
 
 	return ctrl.Result{}, nil
@@ -305,6 +421,23 @@ func (r *IBMPowerVSImageReconciler) reconcileDelete(ctx context.Context, scope *
 		Reason: infrav1.IBMPowerVSImageDeletingReason,
 	})
 
+	// Block the deletion while the image is in use, unless the image is retained in the workspace.
+	if scope.IBMPowerVSImage.Spec.DeletePolicy != string(infrav1.DeletePolicyRetain) {
+		if err := r.reconcileUsage(ctx, scope); err != nil {
+			return ctrl.Result{}, err
+		}
+		if usage := scope.IBMPowerVSImage.Status.Usage; usage.ReferenceCount > 0 {
+			log.Info("IBMPowerVSImage is still in use, blocking deletion", "referenceCount", usage.ReferenceCount)
+			conditions.Set(scope.IBMPowerVSImage, metav1.Condition{
+				Type:    infrav1.ImageInUseCondition,
+				Status:  metav1.ConditionTrue,
+				Reason:  infrav1.ImageDeletionBlockedReason,
+				Message: fmt.Sprintf("Deletion is blocked, image is referenced by %d IBMPowerVSMachines and %d IBMPowerVSMachineTemplates", usage.Machines, usage.MachineTemplates),
+			})
+			return ctrl.Result{RequeueAfter: 1 * time.Minute}, nil
+		}
+	}
+
 	defer func() {
 		if reterr == nil {
 			// IBMPowerVSImage is deleted so remove the finalizer.
@@ -312,6 +445,11 @@ func (r *IBMPowerVSImageReconciler) reconcileDelete(ctx context.Context, scope *
 		}
 	}()
 
+	if err := scope.DeleteImageTargets(ctx); err != nil {
+		log.Error(err, "Error deleting IBMPowerVSImage from target service instances")
+		return ctrl.Result{}, fmt.Errorf("error deleting IBMPowerVSImage from target service instances: %w", err)
+	}
+
 	if scope.GetImageID() == "" {
 		log.Info("IBMPowerVSImage ImageID is not yet set, hence not invoking the PowerVS API to delete the image")
 		if scope.GetJobID() == "" {
@@ -325,6 +463,11 @@ func (r *IBMPowerVSImageReconciler) reconcileDelete(ctx context.Context, scope *
 		return ctrl.Result{}, nil
 	}
 
+	if scope.IBMPowerVSImage.Status.Adopted {
+		log.Info("IBMPowerVSImage was adopted, hence not deleting the image", "imageID", scope.GetImageID())
+		return ctrl.Result{}, nil
+	}
+
 	if scope.IBMPowerVSImage.Spec.DeletePolicy != string(infrav1.DeletePolicyRetain) {
 		if err := scope.DeleteImage(); err != nil {
 			deprecatedv1beta1conditions.MarkFalse(scope.IBMPowerVSImage, infrav1.ImageReadyV1Beta2Condition, infrav1.InternalErrorV1Beta2Reason, clusterv1.ConditionSeverityWarning, "")
@@ -341,6 +484,10 @@ func (r *IBMPowerVSImageReconciler) reconcileDelete(ctx context.Context, scope *
 }
 
 func (r *IBMPowerVSImageReconciler) getOrCreate(ctx context.Context, scope *powervsscope.ImageScope) (*models.ImageReference, *models.JobReference, error) {
+	if scope.IsStockImage() {
+		image, err := scope.CopyStockImage(ctx)
+		return image, nil, err
+	}
 	image, job, err := scope.CreateImageCOSBucket(ctx)
 	return image, job, err
 }
@@ -349,10 +496,72 @@ func (r *IBMPowerVSImageReconciler) shouldAdopt(i infrav1.IBMPowerVSImage) bool
 	return !clusterv1util.HasOwner(i.OwnerReferences, infrav1.GroupVersion.String(), []string{ibmPowerVSClusterKind})
 }
 
+// ibmPowerVSClusterToIBMPowerVSImages is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// of IBMPowerVSImages whose cluster selector matches the IBMPowerVSCluster.
+func (r *IBMPowerVSImageReconciler) ibmPowerVSClusterToIBMPowerVSImages(ctx context.Context, o client.Object) []ctrl.Request {
+	log := ctrl.LoggerFrom(ctx)
+	result := []ctrl.Request{}
+	c, ok := o.(*infrav1.IBMPowerVSCluster)
+	if !ok {
+		log.Error(fmt.Errorf("expected a IBMPowerVSCluster but got a %T", o), "failed to get IBMPowerVSImages for IBMPowerVSCluster")
+		return nil
+	}
+
+	imageList := &infrav1.IBMPowerVSImageList{}
+	if err := r.List(ctx, imageList, client.InNamespace(c.Namespace)); err != nil {
+		log.Error(err, "failed to list IBMPowerVSImages")
+		return nil
+	}
+	for _, image := range imageList.Items {
+		if image.Spec.ClusterSelector == nil {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(image.Spec.ClusterSelector)
+		if err != nil || !selector.Matches(labels.Set(c.Labels)) {
+			continue
+		}
+		result = append(result, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&image)})
+	}
+
+	return result
+}
+
+// ibmPowerVSMachineToIBMPowerVSImage is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// of the IBMPowerVSImage referenced by the IBMPowerVSMachine.
+func (r *IBMPowerVSImageReconciler) ibmPowerVSMachineToIBMPowerVSImage(_ context.Context, o client.Object) []ctrl.Request {
+	m, ok := o.(*infrav1.IBMPowerVSMachine)
+	if !ok || m.Spec.ImageRef.Name == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: m.Namespace, Name: m.Spec.ImageRef.Name}}}
+}
+
+// ibmPowerVSMachineTemplateToIBMPowerVSImage is a handler.ToRequestsFunc to be used to enqueue requests for reconciliation
+// of the IBMPowerVSImage referenced by the IBMPowerVSMachineTemplate.
+func (r *IBMPowerVSImageReconciler) ibmPowerVSMachineTemplateToIBMPowerVSImage(_ context.Context, o client.Object) []ctrl.Request {
+	t, ok := o.(*infrav1.IBMPowerVSMachineTemplate)
+	if !ok || t.Spec.Template.Spec.ImageRef.Name == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Namespace: t.Namespace, Name: t.Spec.Template.Spec.ImageRef.Name}}}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *IBMPowerVSImageReconciler) SetupWithManager(_ context.Context, mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrav1.IBMPowerVSImage{}).
+		Watches(
+			&infrav1.IBMPowerVSCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.ibmPowerVSClusterToIBMPowerVSImages),
+		).
+		Watches(
+			&infrav1.IBMPowerVSMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.ibmPowerVSMachineToIBMPowerVSImage),
+		).
+		Watches(
+			&infrav1.IBMPowerVSMachineTemplate{},
+			handler.EnqueueRequestsFromMapFunc(r.ibmPowerVSMachineTemplateToIBMPowerVSImage),
+		).
 		Complete(r)
 }
 
@@ -407,5 +616,7 @@ func patchIBMPowerVSImage(ctx context.Context, patchHelper *patch.Helper, ibmPow
 		infrav1.IBMPowerVSImageReadyCondition,
 		clusterv1.PausedCondition,
 		infrav1.WorkspaceReadyCondition,
+		infrav1.ImageTargetsReadyCondition,
+		infrav1.ImageInUseCondition,
 	}}, patch.Clusterv1ConditionsFieldPath{statusField, deprecatedStatus, v1beta2Version, deprecatedConditionsField})
 }
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	"go.uber.org/mock/gomock"
 
 	corev1 "k8s.io/api/core/v1"
@@ -41,6 +42,7 @@ import (
 	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	mockcos "sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/cos/mock"
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/powervs/mock"
 
 	. "github.com/onsi/gomega"
@@ -257,6 +259,33 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				expectImageConditionsV1beta2(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1.ImageImportedV1Beta2Condition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.ImageImportFailedV1Beta2Reason}})
 				g.Expect(result.RequeueAfter).To(Not(BeZero()))
 			})
+			t.Run("When import job status is failed and the retry policy allows to retry", func(_ *testing.T) {
+				imageScope.IBMPowerVSImage.Spec.RetryPolicy = &infrav1.IBMPowerVSImageRetryPolicy{MaxAttempts: 2}
+				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf("job-1")).Return(job, nil)
+				result, err := reconciler.reconcile(ctx, powervsCluster, imageScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(BeNumerically(">", 50*time.Second))
+				g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1.IBMPowerVSImageReadyCondition)).To(Equal(infrav1.IBMPowerVSImageImportRetryingReason))
+				g.Expect(imageScope.IBMPowerVSImage.Status.Attempts).To(HaveLen(1))
+
+				// Retry once the backoff has elapsed.
+				imageScope.IBMPowerVSImage.Status.Attempts[0].CompletionTime = ptr.To(metav1.NewTime(time.Now().Add(-time.Hour)))
+				mockpowervs.EXPECT().GetJob(gomock.AssignableToTypeOf("job-1")).Return(job, nil)
+				mockpowervs.EXPECT().DeleteJob(jobID).Return(nil)
+				mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil).Times(2)
+				mockpowervs.EXPECT().GetCosImages(gomock.Any()).Return(job, nil)
+				mockpowervs.EXPECT().CreateCosImage(gomock.Any()).Return(&models.JobReference{ID: ptr.To("job-2")}, nil)
+				result, err = reconciler.reconcile(ctx, powervsCluster, imageScope)
+				g.Expect(err).To(BeNil())
+				g.Expect(result.RequeueAfter).To(Not(BeZero()))
+				g.Expect(imageScope.IBMPowerVSImage.Status.JobID).To(Equal("job-2"))
+				g.Expect(imageScope.IBMPowerVSImage.Status.Attempts).To(HaveLen(2))
+				g.Expect(imageScope.IBMPowerVSImage.Status.Attempts[0].State).To(Equal(infrav1.PowerVSImageStateFailed))
+				g.Expect(imageScope.IBMPowerVSImage.Status.Attempts[1].Attempt).To(Equal(int32(2)))
+
+				imageScope.IBMPowerVSImage.Spec.RetryPolicy = nil
+				imageScope.IBMPowerVSImage.Status.JobID = jobID
+			})
 			job.Status.State = ptr.To("completed")
 			images := &models.Images{
 				Images: []*models.ImageReference{
@@ -317,6 +346,46 @@ func TestIBMPowerVSImageReconciler_reconcile(t *testing.T) {
 				g.Expect(result.RequeueAfter).To(BeZero())
 			})
 		})
+		t.Run("Should fail when the checksum of the image does not match the expected checksum", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			powervsCluster := &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-powervs-cluster", UID: "1"},
+			}
+			powervsImage := &infrav1.IBMPowerVSImage{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "capi-image",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: infrav1.GroupVersion.String(), Kind: "IBMPowerVSCluster", Name: "capi-powervs-cluster", UID: "1"},
+					},
+					Finalizers: []string{infrav1.IBMPowerVSImageFinalizer},
+				},
+				Spec: infrav1.IBMPowerVSImageSpec{
+					ClusterName:      "capi-powervs-cluster",
+					Object:           ptr.To("capi-image.ova.gz"),
+					Region:           ptr.To("us-south"),
+					Bucket:           ptr.To("capi-bucket"),
+					ExpectedChecksum: &infrav1.IBMPowerVSImageChecksum{Algorithm: infrav1.PowerVSImageChecksumAlgorithmSHA256, Value: "foo-checksum"},
+				},
+			}
+			mockCOS := mockcos.NewMockCos(mockCtrl)
+			imageScope := &powervs.ImageScope{
+				Client:           fake.NewClientBuilder().WithObjects(powervsCluster, powervsImage).Build(),
+				IBMPowerVSImage:  powervsImage,
+				IBMPowerVSClient: mockpowervs,
+				COSClient:        mockCOS,
+			}
+			mockpowervs.EXPECT().GetAllImage().Return(&models.Images{}, nil)
+			mockpowervs.EXPECT().GetCosImages(gomock.Any()).Return(nil, nil)
+			mockCOS.EXPECT().HeadObject(gomock.Any()).Return(&s3.HeadObjectOutput{Metadata: map[string]*string{"Sha256": ptr.To("bar-checksum")}}, nil)
+			_, err := reconciler.reconcile(ctx, powervsCluster, imageScope)
+			g.Expect(err).To(MatchError(ContainSubstring("checksum")))
+			g.Expect(imageScope.IBMPowerVSImage.Status.Ready).To(BeFalse())
+			g.Expect(imageScope.IBMPowerVSImage.Status.JobID).To(BeEmpty())
+			g.Expect(conditions.GetReason(imageScope.IBMPowerVSImage, infrav1.IBMPowerVSImageReadyCondition)).To(Equal(infrav1.IBMPowerVSImageChecksumMismatchReason))
+			expectImageConditionsV1beta2(g, imageScope.IBMPowerVSImage, []conditionAssertion{{infrav1.ImageImportedV1Beta2Condition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.ImageImportFailedV1Beta2Reason}})
+		})
 	})
 }
 
@@ -338,6 +407,7 @@ func TestIBMPowerVSImageReconciler_delete(t *testing.T) {
 			Recorder: recorder,
 		}
 		imageScope = &powervs.ImageScope{
+			Client:           fake.NewClientBuilder().Build(),
 			IBMPowerVSImage:  &infrav1.IBMPowerVSImage{},
 			IBMPowerVSClient: mockpowervs,
 		}
@@ -400,6 +470,111 @@ func TestIBMPowerVSImageReconciler_delete(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1.IBMPowerVSImageFinalizer)))
 		})
+		t.Run("Should not delete the image using ID when the image was adopted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Status.Adopted = true
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1.IBMPowerVSImageFinalizer}
+			_, err := reconciler.reconcileDelete(ctx, imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1.IBMPowerVSImageFinalizer)))
+		})
+		t.Run("Should block the deletion of the image while it is in use", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machine := &infrav1.IBMPowerVSMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi-machine", Namespace: "default"},
+				Spec:       infrav1.IBMPowerVSMachineSpec{ImageRef: infrav1.ImageReference{Name: "capi-image"}},
+			}
+			imageScope.Client = fake.NewClientBuilder().WithObjects(machine).Build()
+			imageScope.IBMPowerVSImage.Name = "capi-image"
+			imageScope.IBMPowerVSImage.Namespace = "default"
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1.IBMPowerVSImageFinalizer}
+			result, err := reconciler.reconcileDelete(ctx, imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(ContainElement(infrav1.IBMPowerVSImageFinalizer))
+			g.Expect(imageScope.IBMPowerVSImage.Status.Usage.Machines).To(Equal(int32(1)))
+			condition := conditions.Get(imageScope.IBMPowerVSImage, infrav1.ImageInUseCondition)
+			g.Expect(condition).To(Not(BeNil()))
+			g.Expect(condition.Reason).To(Equal(infrav1.ImageDeletionBlockedReason))
+		})
+		t.Run("Should block the deletion of the image while it is referenced by a machine template of a Cluster not being deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster", Namespace: "default"}}
+			template := &infrav1.IBMPowerVSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-machine-template",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: "capi-cluster", UID: "capi-cluster-uid"},
+					},
+				},
+				Spec: infrav1.IBMPowerVSMachineTemplateSpec{Template: infrav1.IBMPowerVSMachineTemplateResource{
+					Spec: infrav1.IBMPowerVSMachineSpec{ImageRef: infrav1.ImageReference{Name: "capi-image"}},
+				}},
+			}
+			imageScope.Client = fake.NewClientBuilder().WithObjects(cluster, template).Build()
+			imageScope.IBMPowerVSImage.Name = "capi-image"
+			imageScope.IBMPowerVSImage.Namespace = "default"
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1.IBMPowerVSImageFinalizer}
+			result, err := reconciler.reconcileDelete(ctx, imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(ContainElement(infrav1.IBMPowerVSImageFinalizer))
+			g.Expect(imageScope.IBMPowerVSImage.Status.Usage.MachineTemplates).To(Equal(int32(1)))
+		})
+		t.Run("Should delete the image referenced only by machine templates of a Cluster being deleted", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{
+				Name:              "capi-cluster",
+				Namespace:         "default",
+				DeletionTimestamp: ptr.To(metav1.Now()),
+				Finalizers:        []string{clusterv1.ClusterFinalizer},
+			}}
+			ownedTemplate := &infrav1.IBMPowerVSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-machine-template",
+					Namespace: "default",
+					OwnerReferences: []metav1.OwnerReference{
+						{APIVersion: clusterv1.GroupVersion.String(), Kind: "Cluster", Name: "capi-cluster", UID: "capi-cluster-uid"},
+					},
+				},
+				Spec: infrav1.IBMPowerVSMachineTemplateSpec{Template: infrav1.IBMPowerVSMachineTemplateResource{
+					Spec: infrav1.IBMPowerVSMachineSpec{ImageRef: infrav1.ImageReference{Name: "capi-image"}},
+				}},
+			}
+			labeledTemplate := &infrav1.IBMPowerVSMachineTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "capi-machine-template-deleted-cluster",
+					Namespace: "default",
+					Labels:    map[string]string{clusterv1.ClusterNameLabel: "deleted-cluster"},
+				},
+				Spec: infrav1.IBMPowerVSMachineTemplateSpec{Template: infrav1.IBMPowerVSMachineTemplateResource{
+					Spec: infrav1.IBMPowerVSMachineSpec{ImageRef: infrav1.ImageReference{Name: "capi-image"}},
+				}},
+			}
+			imageScope.Client = fake.NewClientBuilder().WithObjects(cluster, ownedTemplate, labeledTemplate).Build()
+			imageScope.IBMPowerVSImage.Name = "capi-image"
+			imageScope.IBMPowerVSImage.Namespace = "default"
+			imageScope.IBMPowerVSImage.Status.ImageID = "capi-image-id"
+			imageScope.IBMPowerVSImage.Finalizers = []string{infrav1.IBMPowerVSImageFinalizer}
+			mockpowervs.EXPECT().DeleteImage("capi-image-id").Return(nil)
+			result, err := reconciler.reconcileDelete(ctx, imageScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(BeZero())
+			g.Expect(imageScope.IBMPowerVSImage.Finalizers).To(Not(ContainElement(infrav1.IBMPowerVSImageFinalizer)))
+			g.Expect(imageScope.IBMPowerVSImage.Status.Usage.ReferenceCount).To(BeZero())
+		})
 	})
 }
 
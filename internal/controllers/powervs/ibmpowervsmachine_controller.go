@@ -18,6 +18,7 @@ package powervs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/conditions"
 	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
@@ -74,6 +76,8 @@ func init() {
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachines,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=ibmpowervsmachines/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMPowerVSMachine.
 func (r *IBMPowerVSMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) { //nolint:gocyclo
@@ -276,7 +280,7 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 	}
 
 	if machineScope.IBMPowerVSImage != nil {
-		if !machineScope.IBMPowerVSImage.Status.Ready {
+		if !machineScope.IsImageReady() {
 			log.Info("IBMPowerVSImage is not ready yet, skipping reconciliation")
 			deprecatedv1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyV1Beta2Condition, infrav1.InstanceWaitingForImageV1Beta2Reason, clusterv1.ConditionSeverityInfo, "")
 			conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
@@ -311,7 +315,54 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 		return reconcile.Result{}, nil
 	}
 
+	ipAddressAllocated, err := machineScope.ReconcileIPAddress(ctx)
+	if err != nil {
+		log.Error(err, "Unable to claim IP address from IP address pool")
+		deprecatedv1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyV1Beta2Condition, infrav1.InstanceWaitingForIPAddressV1Beta2Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:    infrav1.InstanceReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InstanceWaitingForIPAddressReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, fmt.Errorf("failed to claim IP address: %w", err)
+	}
+	if !ipAddressAllocated {
+		log.Info("Waiting for IP address to be allocated from IP address pool, skipping reconciliation")
+		deprecatedv1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyV1Beta2Condition, infrav1.InstanceWaitingForIPAddressV1Beta2Reason, clusterv1.ConditionSeverityInfo, "")
+		conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:   infrav1.InstanceReadyCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.InstanceWaitingForIPAddressReason,
+		})
+		// the machine is reconciled again once the IPAddressClaim is updated with the allocated IP address.
+		return ctrl.Result{}, nil
+	}
+
 	machine, err := machineScope.CreateMachine(ctx)
+	if errors.Is(err, powervsscope.ErrUnsupportedByZone) {
+		log.Info("The instance is not supported by the PowerVS zone, requeuing", "reason", err.Error())
+		deprecatedv1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyV1Beta2Condition, infrav1.InstanceUnsupportedByZoneV1Beta2Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
+		conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:    infrav1.InstanceReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InstanceUnsupportedByZoneReason,
+			Message: err.Error(),
+		})
+		// retrying only helps once the zone capabilities in the cluster status change, the machine spec changes trigger the reconciliation.
+		return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	}
+	if errors.Is(err, powervsscope.ErrInsufficientWorkspaceQuota) {
+		log.Info("Creating the instance would exceed the PowerVS workspace quota, requeuing", "reason", err.Error())
+		deprecatedv1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyV1Beta2Condition, infrav1.InstanceWaitingForWorkspaceQuotaV1Beta2Reason, clusterv1.ConditionSeverityWarning, "%s", err.Error())
+		conditions.Set(machineScope.IBMPowerVSMachine, metav1.Condition{
+			Type:    infrav1.InstanceReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.InstanceWaitingForWorkspaceQuotaReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{RequeueAfter: time.Minute}, nil
+	}
 	if err != nil {
 		log.Error(err, "Unable to create PowerVS machine")
 		deprecatedv1beta1conditions.MarkFalse(machineScope.IBMPowerVSMachine, infrav1.InstanceReadyV1Beta2Condition, infrav1.InstanceProvisionFailedV1Beta2Reason, clusterv1.ConditionSeverityError, "%s", err.Error())
@@ -346,6 +397,10 @@ func (r *IBMPowerVSMachineReconciler) reconcileNormal(ctx context.Context, machi
 	machineScope.SetAddresses(ctx, instance)
 	machineScope.SetHealth(instance.Health)
 	machineScope.SetInstanceState(instance.Status)
+	machineScope.SetBootVolume(instance)
+	if err := machineScope.ReconcileTags(ctx, instance); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile user tags: %w", err)
+	}
 
 	switch machineScope.GetInstanceState() {
 	case infrav1.PowerVSInstanceStateBUILD:
@@ -513,6 +568,12 @@ func (r *IBMPowerVSMachineReconciler) SetupWithManager(ctx context.Context, mgr
 				predicates.ClusterPausedTransitionsOrInfrastructureProvisioned(r.Scheme, predicateLog),
 			)),
 		).
+		// the IPAddressClaim of the machine is updated with the reference to the IPAddress once it is allocated by the IPAM provider.
+		Watches(
+			&ipamv1.IPAddressClaim{},
+			handler.EnqueueRequestForOwner(mgr.GetScheme(), mgr.GetRESTMapper(), &infrav1.IBMPowerVSMachine{}),
+			builder.WithPredicates(predicates.ResourceIsChanged(r.Scheme, predicateLog)),
+		).
 		Complete(r)
 	if err != nil {
 		return fmt.Errorf("could not set up controller for IBMPowerVSMachine: %w", err)
@@ -29,6 +29,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -42,6 +43,7 @@ import (
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/options"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 	deprecatedv1beta1conditions "sigs.k8s.io/cluster-api/util/conditions/deprecated/v1beta1"
 
@@ -477,6 +479,131 @@ func TestIBMPowerVSMachineReconciler_ReconcileOperations(t *testing.T) {
 			expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceProvisionFailedReason}})
 		})
 
+		t.Run("Should wait for the IPAddressClaim to be updated if IP address is not yet allocated from IP address pool", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machine := newMachine()
+			pvsMachine := newIBMPowerVSMachine()
+			pvsMachine.Labels = map[string]string{clusterv1.WatchLabel: "watch-filter"}
+			pvsMachine.Spec.IPAddressPool = &ipamv1.IPPoolReference{
+				APIGroup: "ipam.cluster.x-k8s.io",
+				Kind:     "InClusterIPPool",
+				Name:     "pool",
+			}
+			mockClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects().Build()
+			machineScope = &powervsscope.MachineScope{
+				Client: mockClient,
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-test"},
+					Status: clusterv1.ClusterStatus{
+						Initialization: clusterv1.ClusterInitializationStatus{
+							InfrastructureProvisioned: ptr.To(true),
+						},
+					},
+				},
+				Machine:           machine,
+				IBMPowerVSMachine: pvsMachine,
+				IBMPowerVSClient:  mockpowervs,
+			}
+
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(BeZero())
+			expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityInfo, infrav1.InstanceWaitingForIPAddressReason}})
+			// the claim carries the watch label of the machine for its update to trigger the reconciliation of the machine.
+			claim := &ipamv1.IPAddressClaim{}
+			g.Expect(mockClient.Get(ctx, client.ObjectKey{Namespace: pvsMachine.Namespace, Name: pvsMachine.Name}, claim)).To(Succeed())
+			g.Expect(claim.Labels).To(HaveKeyWithValue(clusterv1.WatchLabel, "watch-filter"))
+		})
+
+		t.Run("Should requeue if the system type of the instance is not available in the zone", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			var instances = &models.PVMInstances{}
+			machine := newMachine()
+			pvsMachine := newIBMPowerVSMachine()
+			pvsMachine.Spec.SystemType = "e1080"
+			mockClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects().Build()
+			machineScope = &powervsscope.MachineScope{
+				Client: mockClient,
+				Cluster: &clusterv1.Cluster{
+					Status: clusterv1.ClusterStatus{
+						Initialization: clusterv1.ClusterInitializationStatus{
+							InfrastructureProvisioned: ptr.To(true),
+						},
+					},
+				},
+				Machine:           machine,
+				IBMPowerVSMachine: pvsMachine,
+				IBMPowerVSImage: &infrav1.IBMPowerVSImage{
+					Status: infrav1.IBMPowerVSImageStatus{
+						Ready: true,
+					},
+				},
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						ZoneCapabilities: &infrav1.ZoneCapabilitiesStatus{
+							SystemTypes: []infrav1.SystemTypeCapabilities{
+								{Name: "s922"},
+							},
+						},
+					},
+				},
+				IBMPowerVSClient: mockpowervs,
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(instances, nil)
+
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(5 * time.Minute))
+			expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityError, infrav1.InstanceUnsupportedByZoneReason}})
+		})
+
+		t.Run("Should requeue if creating the instance would exceed the workspace quota", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			var instances = &models.PVMInstances{}
+			machine := newMachine()
+			pvsMachine := newIBMPowerVSMachine()
+			mockClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects().Build()
+			machineScope = &powervsscope.MachineScope{
+				Client: mockClient,
+				Cluster: &clusterv1.Cluster{
+					Status: clusterv1.ClusterStatus{
+						Initialization: clusterv1.ClusterInitializationStatus{
+							InfrastructureProvisioned: ptr.To(true),
+						},
+					},
+				},
+				Machine:           machine,
+				IBMPowerVSMachine: pvsMachine,
+				IBMPowerVSImage: &infrav1.IBMPowerVSImage{
+					Status: infrav1.IBMPowerVSImageStatus{
+						Ready: true,
+					},
+				},
+				IBMPowerVSCluster: &infrav1.IBMPowerVSCluster{
+					Status: infrav1.IBMPowerVSClusterStatus{
+						WorkspaceQuota: &infrav1.WorkspaceQuotaStatus{
+							Available: infrav1.WorkspaceCapacity{
+								Processors: ptr.To(resource.MustParse("0.25")),
+							},
+						},
+					},
+				},
+				IBMPowerVSClient: mockpowervs,
+			}
+			mockpowervs.EXPECT().GetAllInstance().Return(instances, nil)
+
+			result, err := reconciler.reconcileNormal(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+			expectConditions(g, machineScope.IBMPowerVSMachine, []conditionAssertion{{infrav1.InstanceReadyCondition, corev1.ConditionFalse, clusterv1.ConditionSeverityWarning, infrav1.InstanceWaitingForWorkspaceQuotaReason}})
+		})
+
 		t.Run("Should fail reconcile if creation of the load balancer pool member is unsuccessful", func(t *testing.T) {
 			g := NewWithT(t)
 			setup(t)
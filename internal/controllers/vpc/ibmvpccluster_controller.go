@@ -19,6 +19,7 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -31,6 +32,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -198,7 +200,7 @@ func (r *IBMVPCClusterReconciler) reconcileV2(ctx context.Context, req ctrl.Requ
 
 	// Handle deleted clusters.
 	if !ibmVPCCluster.DeletionTimestamp.IsZero() {
-		return r.reconcileDeleteV2(clusterScope)
+		return r.reconcileDeleteV2(ctx, clusterScope)
 	}
 
 	return r.reconcileCluster(ctx, clusterScope)
@@ -308,6 +310,26 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		return ctrl.Result{}, nil
 	}
 
+	// Stop before any resource is reconciled when the resource name template fails to render,
+	// rather than creating the resources under names the user did not ask for.
+	if err := r.checkResourceNameTemplate(ctx, clusterScope); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// Verify the resources referenced in the Network Status once the cluster is ready, as they may have been removed or changed out-of-band.
+	if clusterScope.IBMVPCCluster.Status.Ready && resourceVerificationDue(clusterScope.IBMVPCCluster, clusterScope.ResourceVerificationInterval()) {
+		if res, err := r.verifyResources(ctx, clusterScope); err != nil || !res.IsZero() {
+			return res, err
+		}
+	}
+
+	// Attach the user tags and the cluster UID tag to the resources created in the previous reconciliations,
+	// so the resources of a cluster can be found even when its provisioning never completes.
+	if err := clusterScope.ReconcileTags(ctx); err != nil {
+		log.Error(err, "failed to reconcile user tags")
+		return reconcile.Result{}, err
+	}
+
 	// Reconcile the cluster's VPC.
 	log.Info("Reconciling VPC")
 	if requeue, err := clusterScope.ReconcileVPC(ctx); err != nil {
@@ -404,6 +426,58 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		Reason: infrav1.VPCSecurityGroupReadyV1Beta2Reason,
 	})
 
+	// Reconcile the VPC Endpoint Gateways, if requested, providing private access to IBM Cloud services.
+	if len(clusterScope.NetworkSpec().EndpointGateways) != 0 {
+		log.Info("Reconciling VPC Endpoint Gateways")
+		if requeue, err := clusterScope.ReconcileEndpointGateways(ctx); err != nil {
+			log.Error(err, "failed to reconcile VPC Endpoint Gateways")
+			v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.VPCEndpointGatewayReadyCondition, infrav1.VPCEndpointGatewayReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.VPCEndpointGatewayReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.VPCEndpointGatewayNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		} else if requeue {
+			log.Info("VPC Endpoint Gateways creation is pending, requeueing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		log.Info("Reconciliation of VPC Endpoint Gateways complete")
+		v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.VPCEndpointGatewayReadyCondition)
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.VPCEndpointGatewayReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.VPCEndpointGatewayReadyV1Beta2Reason,
+		})
+	}
+
+	// Reconcile the Transit Gateway connecting the cluster's VPC to other VPCs, if requested.
+	if clusterScope.NetworkSpec().TransitGateway != nil {
+		log.Info("Reconciling Transit Gateway")
+		if requeue, err := clusterScope.ReconcileTransitGateway(ctx); err != nil {
+			log.Error(err, "failed to reconcile Transit Gateway")
+			v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.TransitGatewayReadyCondition, infrav1.TransitGatewayReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.TransitGatewayReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.TransitGatewayNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		} else if requeue {
+			log.Info("Transit Gateway creation is pending, requeueing")
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		log.Info("Reconciliation of Transit Gateway complete")
+		v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.TransitGatewayReadyCondition)
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.TransitGatewayReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.TransitGatewayReadyV1Beta2Reason,
+		})
+	}
+
 	// Reconcile the cluster's Load Balancers
 	log.Info("Reconciling Load Balancers")
 	if requeue, err := clusterScope.ReconcileLoadBalancers(ctx); err != nil {
@@ -437,11 +511,141 @@ func (r *IBMVPCClusterReconciler) reconcileCluster(ctx context.Context, clusterS
 		return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 	}
 
+	// Reconcile the DNS record for the Load Balancer hostname, if requested, and use it for the control plane endpoint.
+	if clusterScope.IBMVPCCluster.Spec.DNS != nil {
+		log.Info("Reconciling DNS record")
+		if err := clusterScope.ReconcileDNSRecord(ctx, *hostName); err != nil {
+			log.Error(err, "failed to reconcile DNS record")
+			v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.DNSRecordReadyCondition, infrav1.DNSRecordReconciliationFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+			v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+				Type:    infrav1.DNSRecordReadyV1Beta2Condition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.DNSRecordNotReadyV1Beta2Reason,
+				Message: err.Error(),
+			})
+			return reconcile.Result{}, err
+		}
+		log.Info("Reconciliation of DNS record complete")
+		v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.DNSRecordReadyCondition)
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.DNSRecordReadyV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.DNSRecordReadyV1Beta2Reason,
+		})
+		hostName = ptr.To(clusterScope.DNSRecordHostName())
+	}
+
 	// Mark cluster as ready.
 	clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Host = *hostName
 	clusterScope.IBMVPCCluster.Spec.ControlPlaneEndpoint.Port = clusterScope.GetAPIServerPort()
 	clusterScope.IBMVPCCluster.Status.Ready = true
 	log.Info("cluster infrastructure is now ready for cluster", "clusterName", clusterScope.IBMVPCCluster.Name)
+
+	// Requeue to verify the resources periodically.
+	return ctrl.Result{RequeueAfter: clusterScope.ResourceVerificationInterval()}, nil
+}
+
+// resourceVerificationDue returns whether the resources referenced in the Network Status are to be verified.
+// They are verified once the verification interval has passed since the last verification, and on every reconciliation while resources are missing.
+func resourceVerificationDue(cluster *infrav1.IBMVPCCluster, interval time.Duration) bool {
+	lastVerification := cluster.Status.LastResourceVerificationTime
+	if lastVerification == nil || time.Since(lastVerification.Time) >= interval {
+		return true
+	}
+	condition := v1beta2conditions.Get(cluster, infrav1.ResourcesVerifiedV1Beta2Condition)
+	return condition != nil && condition.Reason == infrav1.ResourcesMissingV1Beta2Reason
+}
+
+// checkResourceNameTemplate checks the resource name template renders the names of all the resources created by the controller,
+// and reports the outcome in the ResourceNameTemplateRendered condition.
+func (r *IBMVPCClusterReconciler) checkResourceNameTemplate(ctx context.Context, clusterScope *vpcscope.ClusterScopeV2) error {
+	log := ctrl.LoggerFrom(ctx)
+	if clusterScope.IBMVPCCluster.Spec.ResourceNameTemplate == nil {
+		v1beta1conditions.Delete(clusterScope.IBMVPCCluster, infrav1.ResourceNameTemplateRenderedCondition)
+		v1beta2conditions.Delete(clusterScope.IBMVPCCluster, infrav1.ResourceNameTemplateRenderedV1Beta2Condition)
+		return nil
+	}
+	if err := clusterScope.CheckResourceNameTemplate(); err != nil {
+		log.Error(err, "failed to check resource name template")
+		v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.ResourceNameTemplateRenderedCondition, infrav1.ResourceNameTemplateRenderFailedReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:    infrav1.ResourceNameTemplateRenderedV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.ResourceNameTemplateRenderFailedV1Beta2Reason,
+			Message: err.Error(),
+		})
+		return err
+	}
+	v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.ResourceNameTemplateRenderedCondition)
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:   infrav1.ResourceNameTemplateRenderedV1Beta2Condition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ResourceNameTemplateRenderedV1Beta2Reason,
+	})
+	return nil
+}
+
+// verifyResources verifies the resources referenced in the Network Status still exist and match spec, and reports the outcome in the ResourcesVerified condition.
+// The reconciliation is stopped and requeued after the verification interval when resources are missing.
+func (r *IBMVPCClusterReconciler) verifyResources(ctx context.Context, clusterScope *vpcscope.ClusterScopeV2) (ctrl.Result, error) {
+	log := ctrl.LoggerFrom(ctx)
+	log.Info("Verifying resources")
+	result, err := clusterScope.VerifyResources(ctx)
+	if err != nil {
+		log.Error(err, "failed to verify resources")
+		v1beta1conditions.MarkUnknown(clusterScope.IBMVPCCluster, infrav1.ResourcesVerifiedCondition, infrav1.ResourcesVerificationFailedReason, "%s", err.Error())
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:    infrav1.ResourcesVerifiedV1Beta2Condition,
+			Status:  metav1.ConditionUnknown,
+			Reason:  infrav1.ResourcesVerificationFailedV1Beta2Reason,
+			Message: err.Error(),
+		})
+		return reconcile.Result{}, err
+	}
+	clusterScope.IBMVPCCluster.Status.LastResourceVerificationTime = ptr.To(metav1.Now())
+
+	var messages []string
+	if len(result.Missing) != 0 {
+		messages = append(messages, fmt.Sprintf("Missing resources: %s", strings.Join(result.Missing, ", ")))
+	}
+	if len(result.Recreating) != 0 {
+		messages = append(messages, fmt.Sprintf("Recreating resources: %s", strings.Join(result.Recreating, ", ")))
+	}
+	if len(result.Drifted) != 0 {
+		messages = append(messages, fmt.Sprintf("Drifted resources: %s", strings.Join(result.Drifted, ", ")))
+	}
+	message := strings.Join(messages, "; ")
+
+	var reason, v1beta2Reason string
+	switch {
+	case len(result.Missing) != 0:
+		reason, v1beta2Reason = infrav1.ResourcesMissingReason, infrav1.ResourcesMissingV1Beta2Reason
+	case len(result.Recreating) != 0:
+		reason, v1beta2Reason = infrav1.ResourcesRecreatingReason, infrav1.ResourcesRecreatingV1Beta2Reason
+	case len(result.Drifted) != 0:
+		reason, v1beta2Reason = infrav1.ResourcesDriftedReason, infrav1.ResourcesDriftedV1Beta2Reason
+	default:
+		log.Info("Verification of resources complete")
+		v1beta1conditions.MarkTrue(clusterScope.IBMVPCCluster, infrav1.ResourcesVerifiedCondition)
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.ResourcesVerifiedV1Beta2Condition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ResourcesVerifiedV1Beta2Reason,
+		})
+		return ctrl.Result{}, nil
+	}
+
+	v1beta1conditions.MarkFalse(clusterScope.IBMVPCCluster, infrav1.ResourcesVerifiedCondition, reason, clusterv1beta1.ConditionSeverityWarning, "%s", message)
+	v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+		Type:    infrav1.ResourcesVerifiedV1Beta2Condition,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1beta2Reason,
+		Message: message,
+	})
+	if len(result.Missing) != 0 {
+		log.Info("Resources are missing, requeueing", "resources", result.Missing)
+		return ctrl.Result{RequeueAfter: clusterScope.ResourceVerificationInterval()}, nil
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -521,7 +725,49 @@ func (r *IBMVPCClusterReconciler) reconcileDelete(ctx context.Context, clusterSc
 	return handleFinalizerRemoval(clusterScope)
 }
 
-func (r *IBMVPCClusterReconciler) reconcileDeleteV2(clusterScope *vpcscope.ClusterScopeV2) (ctrl.Result, error) { //nolint:unparam
+func (r *IBMVPCClusterReconciler) reconcileDeleteV2(ctx context.Context, clusterScope *vpcscope.ClusterScopeV2) (ctrl.Result, error) {
+	// The DNS record lives outside of the cluster's VPC, so remove it even though deleting the remaining resources is not implemented yet.
+	if clusterScope.IBMVPCCluster.Spec.DNS != nil {
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.DNSRecordReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.DNSRecordDeletingV1Beta2Reason,
+		})
+		if err := clusterScope.DeleteDNSRecord(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete DNS record: %w", err)
+		}
+	}
+
+	// The Transit Gateway lives outside of the cluster's VPC as well, and its connections must be removed before the VPC can be deleted.
+	if clusterScope.TransitGatewayStatus() != nil {
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.TransitGatewayReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.TransitGatewayDeletingV1Beta2Reason,
+		})
+		if requeue, err := clusterScope.DeleteTransitGateway(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete Transit Gateway: %w", err)
+		} else if requeue {
+			clusterScope.Info("Transit Gateway deletion is pending, requeueing")
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
+	// The endpoint gateways hold reserved IPs in the cluster's subnets, so they must be removed before the subnets can be deleted.
+	if clusterScope.NetworkStatus() != nil && len(clusterScope.NetworkStatus().EndpointGateways) != 0 {
+		v1beta2conditions.Set(clusterScope.IBMVPCCluster, metav1.Condition{
+			Type:   infrav1.VPCEndpointGatewayReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.VPCEndpointGatewayDeletingV1Beta2Reason,
+		})
+		if requeue, err := clusterScope.DeleteEndpointGateways(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to delete VPC endpoint gateways: %w", err)
+		} else if requeue {
+			clusterScope.Info("VPC endpoint gateway deletion is pending, requeueing")
+			return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+	}
+
 	clusterScope.Info("Delete cluster is not implemented for reconcile v2")
 	controllerutil.RemoveFinalizer(clusterScope.IBMVPCCluster, infrav1.ClusterFinalizer)
 	return ctrl.Result{}, nil
@@ -602,6 +848,9 @@ func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, i
 		v1beta2conditions.IgnoreTypesIfMissing{
 			infrav1.VPCSecurityGroupReadyV1Beta2Condition,
 			infrav1.VPCImageReadyV1Beta2Condition,
+			infrav1.DNSRecordReadyV1Beta2Condition,
+			infrav1.TransitGatewayReadyV1Beta2Condition,
+			infrav1.VPCEndpointGatewayReadyV1Beta2Condition,
 		},
 		// Using a custom merge strategy to override reasons applied during merge.
 		v1beta2conditions.CustomMergeStrategy{
@@ -627,5 +876,10 @@ func patchIBMVPCCluster(ctx context.Context, patchHelper *v1beta1patch.Helper, i
 		infrav1.VPCSecurityGroupReadyV1Beta2Condition,
 		infrav1.VPCLoadBalancerReadyV1Beta2Condition,
 		infrav1.VPCImageReadyV1Beta2Condition,
+		infrav1.DNSRecordReadyV1Beta2Condition,
+		infrav1.TransitGatewayReadyV1Beta2Condition,
+		infrav1.VPCEndpointGatewayReadyV1Beta2Condition,
+		infrav1.ResourcesVerifiedV1Beta2Condition,
+		infrav1.ResourceNameTemplateRenderedV1Beta2Condition,
 	}})
 }
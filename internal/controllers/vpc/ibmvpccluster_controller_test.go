@@ -676,3 +676,113 @@ func cleanupObject(g *WithT, obj client.Object) {
 		g.Expect(testEnv.Cleanup(ctx, obj)).To(Succeed())
 	}
 }
+
+func TestResourceVerificationDue(t *testing.T) {
+	testCases := []struct {
+		name             string
+		lastVerification *metav1.Time
+		conditions       []metav1.Condition
+		expectedDue      bool
+	}{
+		{
+			name:        "Should verify resources when they were never verified",
+			expectedDue: true,
+		},
+		{
+			name:             "Should verify resources when the interval has passed since the last verification",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-11 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedV1Beta2Condition, Status: metav1.ConditionTrue, Reason: infrav1.ResourcesVerifiedV1Beta2Reason}},
+			expectedDue:      true,
+		},
+		{
+			name:             "Should not verify resources within the interval since the last verification",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-1 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedV1Beta2Condition, Status: metav1.ConditionTrue, Reason: infrav1.ResourcesVerifiedV1Beta2Reason}},
+			expectedDue:      false,
+		},
+		{
+			name:             "Should not verify drifted resources within the interval since the last verification",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-1 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedV1Beta2Condition, Status: metav1.ConditionFalse, Reason: infrav1.ResourcesDriftedV1Beta2Reason}},
+			expectedDue:      false,
+		},
+		{
+			name:             "Should verify resources within the interval while resources are missing",
+			lastVerification: ptr.To(metav1.NewTime(time.Now().Add(-1 * time.Minute))),
+			conditions:       []metav1.Condition{{Type: infrav1.ResourcesVerifiedV1Beta2Condition, Status: metav1.ConditionFalse, Reason: infrav1.ResourcesMissingV1Beta2Reason}},
+			expectedDue:      true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			cluster := &infrav1.IBMVPCCluster{
+				Status: infrav1.IBMVPCClusterStatus{
+					LastResourceVerificationTime: tc.lastVerification,
+					V1Beta2:                      &infrav1.IBMVPCClusterV1Beta2Status{Conditions: tc.conditions},
+				},
+			}
+			g.Expect(resourceVerificationDue(cluster, 10*time.Minute)).To(Equal(tc.expectedDue))
+		})
+	}
+}
+
+func TestCheckResourceNameTemplate(t *testing.T) {
+	testCases := []struct {
+		name            string
+		nameTemplate    *string
+		expectedErr     bool
+		expectedStatus  metav1.ConditionStatus
+		expectedReason  string
+		expectCondition bool
+	}{
+		{
+			name: "Should not set the condition when the resource name template is not set",
+		},
+		{
+			name:            "Should set the condition to true when the resource name template renders",
+			nameTemplate:    ptr.To("fin-{{ .ClusterName }}-{{ lower .ResourceType }}"),
+			expectedStatus:  metav1.ConditionTrue,
+			expectedReason:  infrav1.ResourceNameTemplateRenderedV1Beta2Reason,
+			expectCondition: true,
+		},
+		{
+			name:            "Should set the condition to false and return error when the resource name template fails to render",
+			nameTemplate:    ptr.To("fin-{{ index .ClusterName 20 }}-{{ lower .ResourceType }}"),
+			expectedErr:     true,
+			expectedStatus:  metav1.ConditionFalse,
+			expectedReason:  infrav1.ResourceNameTemplateRenderFailedV1Beta2Reason,
+			expectCondition: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			clusterScope := &vpc.ClusterScopeV2{
+				IBMVPCCluster: &infrav1.IBMVPCCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "capi-cluster"},
+					Spec:       infrav1.IBMVPCClusterSpec{ResourceNameTemplate: tc.nameTemplate},
+				},
+			}
+			reconciler := &IBMVPCClusterReconciler{}
+			err := reconciler.checkResourceNameTemplate(ctx, clusterScope)
+			if tc.expectedErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			if !tc.expectCondition {
+				g.Expect(clusterScope.IBMVPCCluster.Status.Conditions).To(BeEmpty())
+				g.Expect(clusterScope.IBMVPCCluster.GetV1Beta2Conditions()).To(BeEmpty())
+				return
+			}
+			g.Expect(clusterScope.IBMVPCCluster.Status.Conditions).To(HaveLen(1))
+			g.Expect(clusterScope.IBMVPCCluster.Status.Conditions[0].Type).To(Equal(infrav1.ResourceNameTemplateRenderedCondition))
+			g.Expect(clusterScope.IBMVPCCluster.Status.V1Beta2.Conditions).To(HaveLen(1))
+			condition := clusterScope.IBMVPCCluster.Status.V1Beta2.Conditions[0]
+			g.Expect(condition.Type).To(Equal(infrav1.ResourceNameTemplateRenderedV1Beta2Condition))
+			g.Expect(condition.Status).To(Equal(tc.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tc.expectedReason))
+		})
+	}
+}
@@ -68,6 +68,8 @@ type IBMVPCMachineReconciler struct {
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses,verbs=get;list;watch
 
 // Reconcile implements controller runtime Reconciler interface and handles reconcileation logic for IBMVPCMachine.
 func (r *IBMVPCMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -183,10 +185,33 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 
 	if machineScope.IBMVPCCluster.Status.Subnet.ID != nil {
 		machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
-			Subnet: *machineScope.IBMVPCCluster.Status.Subnet.ID,
+			Subnet:        *machineScope.IBMVPCCluster.Status.Subnet.ID,
+			IPAddressPool: machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface.IPAddressPool,
 		}
 	}
 
+	ipAddressReserved, err := machineScope.ReconcileIPAddress(ctx)
+	if err != nil {
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForIPAddressReason, clusterv1beta1.ConditionSeverityError, "%s", err.Error())
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:    infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.WaitingForIPAddressReason,
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile IP address for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
+	}
+	if !ipAddressReserved {
+		log.Info("Waiting for IP address to be allocated from IP address pool")
+		v1beta1conditions.MarkFalse(machineScope.IBMVPCMachine, infrav1.InstanceReadyCondition, infrav1.WaitingForIPAddressReason, clusterv1beta1.ConditionSeverityInfo, "")
+		v1beta2conditions.Set(machineScope.IBMVPCMachine, metav1.Condition{
+			Type:   infrav1.IBMVPCMachineInstanceReadyV1Beta2Condition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.WaitingForIPAddressReason,
+		})
+		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+	}
+
 	instance, err := r.getOrCreate(ctx, machineScope)
 	if err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile VSI for IBMVPCMachine %s/%s: %w", machineScope.IBMVPCMachine.Namespace, machineScope.IBMVPCMachine.Name, err)
@@ -198,6 +223,9 @@ func (r *IBMVPCMachineReconciler) reconcileNormal(ctx context.Context, machineSc
 		if err := machineScope.TagResource(machineScope.IBMVPCCluster.Name, *instance.CRN); err != nil {
 			return ctrl.Result{}, fmt.Errorf("error failed to tag machine: %w", err)
 		}
+		if err := machineScope.ReconcileTags(ctx, instance); err != nil {
+			return ctrl.Result{}, fmt.Errorf("error failed to reconcile user tags of machine: %w", err)
+		}
 
 		// Set available status' for Machine.
 		machineScope.SetInstanceID(*instance.ID)
@@ -364,6 +392,15 @@ func (r *IBMVPCMachineReconciler) reconcileDelete(ctx context.Context, scope *vp
 		return ctrl.Result{}, fmt.Errorf("error deleting IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Spec.Name, err)
 	}
 
+	ipAddressReleased, err := scope.ReleaseIPAddress(ctx)
+	if err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to release IP address of IBMVPCMachine %s/%s: %w", scope.IBMVPCMachine.Namespace, scope.IBMVPCMachine.Name, err)
+	}
+	if !ipAddressReleased {
+		log.Info("Waiting for the instance to be deleted to release its IP address")
+		return ctrl.Result{RequeueAfter: 15 * time.Second}, nil
+	}
+
 	defer func() {
 		if reterr == nil {
 			// VSI is deleted so remove the finalizer.
@@ -36,6 +36,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api/api/core/v1beta1" //nolint:staticcheck
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
 	"sigs.k8s.io/cluster-api/util"
 	v1beta2conditions "sigs.k8s.io/cluster-api/util/deprecated/v1beta1/conditions/v1beta2" //nolint:staticcheck
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -634,6 +635,24 @@ func TestIBMVPCMachineReconciler_Delete(t *testing.T) {
 			g.Expect(err).To(BeNil())
 			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(Not(ContainElement(infrav1.MachineFinalizer)))
 		})
+		t.Run("Should requeue while the reserved IP of the VPC machine is bound", func(t *testing.T) {
+			g := NewWithT(t)
+			setup(t)
+			t.Cleanup(teardown)
+			machineScope.IBMVPCMachine.Spec.PrimaryNetworkInterface = infrav1.NetworkInterface{
+				Subnet:        "subnet-id",
+				IPAddressPool: &ipamv1.IPPoolReference{APIGroup: "ipam.cluster.x-k8s.io", Kind: "InClusterIPPool", Name: "pool"},
+			}
+			machineScope.IBMVPCMachine.Status.PrimaryReservedIP = &infrav1.ResourceStatus{ID: "reserved-ip-id", Ready: true}
+			machineScope.IBMVPCCluster = &infrav1.IBMVPCCluster{}
+			mockvpc.EXPECT().DeleteInstance(gomock.AssignableToTypeOf(options)).Return(&core.DetailedResponse{}, nil)
+			mockvpc.EXPECT().GetVPCSubnetByName("subnet-id").Return(nil, nil)
+			mockvpc.EXPECT().GetSubnetReservedIP(gomock.AssignableToTypeOf(&vpcv1.GetSubnetReservedIPOptions{})).Return(&vpcv1.ReservedIP{ID: ptr.To("reserved-ip-id"), Target: &vpcv1.ReservedIPTarget{}}, &core.DetailedResponse{}, nil)
+			result, err := reconciler.reconcileDelete(ctx, machineScope)
+			g.Expect(err).To(BeNil())
+			g.Expect(result.RequeueAfter).To(Not(BeZero()))
+			g.Expect(machineScope.IBMVPCMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+		})
 	})
 }
 
@@ -18,9 +18,16 @@ package genutil
 
 import (
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
 
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
 
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/endpoints"
@@ -47,3 +54,162 @@ func GetTransitGatewayLocationAndRouting(powerVSZone *string, vpcRegion *string)
 	// since VPC region is not set and used PowerVS region to calculate the transit gateway location, hence returning local routing as default.
 	return &location, ptr.To(false), nil
 }
+
+// GetVPCTransitGatewayLocationAndRouting returns appropriate location and routing suitable for transit gateway connecting VPCs.
+// the transit gateway is located in the region of the cluster's VPC.
+// routing indicates whether to enable global routing on transit gateway or not.
+// returns true when any of the connected VPCs is not in the same region as the cluster's VPC otherwise false.
+func GetVPCTransitGatewayLocationAndRouting(vpcRegion *string, connectedVPCRegions []string) (*string, *bool, error) {
+	if vpcRegion == nil || *vpcRegion == "" {
+		return nil, nil, fmt.Errorf("vpc region is not set")
+	}
+	for _, region := range connectedVPCRegions {
+		if region != *vpcRegion {
+			return vpcRegion, ptr.To(true), nil
+		}
+	}
+	return vpcRegion, ptr.To(false), nil
+}
+
+// GetEndpointGatewayTarget returns the target of a Virtual Private Endpoint gateway for a service CRN.
+// CRNs of Private Path service gateways target the Private Path service, any other CRN targets an IBM Cloud service.
+func GetEndpointGatewayTarget(serviceCRN string) *vpcv1.EndpointGatewayTargetPrototype {
+	resourceType := vpcv1.EndpointGatewayTargetPrototypeResourceTypeProviderCloudServiceConst
+	// CRN format: crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource.
+	if segments := strings.Split(serviceCRN, ":"); len(segments) == 10 && segments[4] == "is" && segments[8] == "private-path-service-gateway" {
+		resourceType = vpcv1.EndpointGatewayTargetPrototypeResourceTypePrivatePathServiceGatewayConst
+	}
+	return &vpcv1.EndpointGatewayTargetPrototype{
+		ResourceType: ptr.To(resourceType),
+		CRN:          ptr.To(serviceCRN),
+	}
+}
+
+// ResourceNameTemplateData is the data a resource name template is rendered with.
+type ResourceNameTemplateData struct {
+	ClusterName  string
+	Namespace    string
+	ResourceType string
+	Zone         string
+}
+
+// MaxVPCResourceNameLength is the maximum length of the name of an IBM Cloud VPC resource.
+const MaxVPCResourceNameLength = 63
+
+// VPCResourceNameRegex matches the names allowed for IBM Cloud VPC resources.
+var VPCResourceNameRegex = regexp.MustCompile(`^([a-z]|[a-z][-a-z0-9]*[a-z0-9])$`)
+
+// ValidateResourceNameTemplate checks that a resource name template references .ResourceType.
+// the names are otherwise the same for every resource type, e.g. the VPC and the load balancer pool would share a name.
+func ValidateResourceNameTemplate(nameTemplate string) error {
+	data := ResourceNameTemplateData{ClusterName: "cluster", Namespace: "namespace", Zone: "zone-1"}
+	data.ResourceType = "resource-type-a"
+	nameA, err := RenderResourceName(nameTemplate, data)
+	if err != nil {
+		return err
+	}
+	data.ResourceType = "resource-type-b"
+	nameB, err := RenderResourceName(nameTemplate, data)
+	if err != nil {
+		return err
+	}
+	if nameA == nameB {
+		return fmt.Errorf("resource name template must reference {{ .ResourceType }} to keep the names of the resources unique")
+	}
+	return nil
+}
+
+// RenderResourceName renders the name of a resource from a Go template.
+// the lower function is available to the template, as some resources like COS buckets only allow lowercase names.
+func RenderResourceName(nameTemplate string, data ResourceNameTemplateData) (string, error) {
+	tmpl, err := template.New("resourceName").Funcs(template.FuncMap{"lower": strings.ToLower}).Parse(nameTemplate)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse resource name template: %w", err)
+	}
+	var name strings.Builder
+	if err := tmpl.Execute(&name, data); err != nil {
+		return "", fmt.Errorf("failed to render resource name template: %w", err)
+	}
+	return name.String(), nil
+}
+
+// RenderZonalResourceName renders the name of a resource created in each zone from a Go template.
+// the zone is appended to the name when the template does not reference the zone, to keep the names unique across zones.
+func RenderZonalResourceName(nameTemplate string, data ResourceNameTemplateData) (string, error) {
+	name, err := RenderResourceName(nameTemplate, data)
+	if err != nil {
+		return "", err
+	}
+	zone := data.Zone
+	data.Zone = ""
+	nameWithoutZone, err := RenderResourceName(nameTemplate, data)
+	if err != nil {
+		return "", err
+	}
+	if name == nameWithoutZone {
+		name = fmt.Sprintf("%s-%s", name, zone)
+	}
+	return name, nil
+}
+
+// ClusterUIDTagKey is the key of the user tag attached to every resource created for a cluster, its value is the UID of the
+// IBMVPCCluster or IBMPowerVSCluster. It allows to find the resources of a cluster whose object no longer exists.
+const ClusterUIDTagKey = "capibm-cluster-uid"
+
+// maxUserTagLength is the maximum length of an IBM Cloud user tag.
+const maxUserTagLength = 128
+
+// userTagKeyRegex matches the characters allowed in the key of an IBM Cloud user tag, the colon separates the key from the value.
+var userTagKeyRegex = regexp.MustCompile(`^[A-Za-z0-9 _.-]+$`)
+
+// userTagValueRegex matches the characters allowed in the value of an IBM Cloud user tag.
+var userTagValueRegex = regexp.MustCompile(`^[A-Za-z0-9 _.:-]*$`)
+
+// UserTags returns the IBM Cloud user tags, in the form key:value, of the given tags sorted by name.
+// the tags of a later map override the tags with the same key of an earlier map.
+func UserTags(tags ...map[string]string) []string {
+	merged := map[string]string{}
+	for _, t := range tags {
+		maps.Copy(merged, t)
+	}
+	userTags := make([]string, 0, len(merged))
+	for _, key := range slices.Sorted(maps.Keys(merged)) {
+		userTags = append(userTags, fmt.Sprintf("%s:%s", key, merged[key]))
+	}
+	return userTags
+}
+
+// ClusterUserTags returns the IBM Cloud user tags to attach to the resources of the cluster with the given UID,
+// the given tags together with the cluster UID tag, sorted by name.
+func ClusterUserTags(clusterUID types.UID, tags ...map[string]string) []string {
+	if clusterUID != "" {
+		tags = append(tags, map[string]string{ClusterUIDTagKey: string(clusterUID)})
+	}
+	return UserTags(tags...)
+}
+
+// ClusterUIDFromUserTag returns the cluster UID of the cluster UID user tag, false when the tag is not a cluster UID tag.
+func ClusterUIDFromUserTag(tag string) (types.UID, bool) {
+	uid, found := strings.CutPrefix(tag, ClusterUIDTagKey+":")
+	if !found || uid == "" {
+		return "", false
+	}
+	return types.UID(uid), true
+}
+
+// ValidateUserTag returns an error when the key:value pair is not a valid IBM Cloud user tag.
+func ValidateUserTag(key, value string) error {
+	if key == ClusterUIDTagKey {
+		return fmt.Errorf("tag key %q is reserved for the tag of the cluster UID", key)
+	}
+	if !userTagKeyRegex.MatchString(key) {
+		return fmt.Errorf("tag key %q must only contain letters, digits, spaces and the characters _ . -", key)
+	}
+	if !userTagValueRegex.MatchString(value) {
+		return fmt.Errorf("tag value %q must only contain letters, digits, spaces and the characters _ . - :", value)
+	}
+	if length := len(key) + len(value) + 1; length > maxUserTagLength {
+		return fmt.Errorf("tag %s:%s is %d characters long, the maximum is %d", key, value, length, maxUserTagLength)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package genutil
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetVPCTransitGatewayLocationAndRouting(t *testing.T) {
+	testcases := []struct {
+		name                string
+		vpcRegion           *string
+		connectedVPCRegions []string
+		expectedLocation    *string
+		expectedRouting     *bool
+		expectError         bool
+	}{
+		{
+			name:        "Returns error when VPC region is not set",
+			expectError: true,
+		},
+		{
+			name:        "Returns error when VPC region is empty",
+			vpcRegion:   ptr.To(""),
+			expectError: true,
+		},
+		{
+			name:             "Returns local routing when there are no connected VPCs",
+			vpcRegion:        ptr.To("us-south"),
+			expectedLocation: ptr.To("us-south"),
+			expectedRouting:  ptr.To(false),
+		},
+		{
+			name:                "Returns local routing when connected VPCs are in the same region",
+			vpcRegion:           ptr.To("us-south"),
+			connectedVPCRegions: []string{"us-south", "us-south"},
+			expectedLocation:    ptr.To("us-south"),
+			expectedRouting:     ptr.To(false),
+		},
+		{
+			name:                "Returns global routing when a connected VPC is in a different region",
+			vpcRegion:           ptr.To("us-south"),
+			connectedVPCRegions: []string{"us-south", "eu-de"},
+			expectedLocation:    ptr.To("us-south"),
+			expectedRouting:     ptr.To(true),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			location, routing, err := GetVPCTransitGatewayLocationAndRouting(tc.vpcRegion, tc.connectedVPCRegions)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(location).To(Equal(tc.expectedLocation))
+			g.Expect(routing).To(Equal(tc.expectedRouting))
+		})
+	}
+}
+
+func TestValidateResourceNameTemplate(t *testing.T) {
+	testcases := []struct {
+		name         string
+		nameTemplate string
+		expectError  bool
+	}{
+		{
+			name:         "Accepts a template referencing the resource type",
+			nameTemplate: "{{ .ClusterName }}-{{ .ResourceType }}",
+		},
+		{
+			name:         "Accepts a template referencing the lowercase resource type",
+			nameTemplate: "fin-{{ .Namespace }}-{{ lower .ResourceType }}",
+		},
+		{
+			name:         "Returns error when the template does not reference the resource type",
+			nameTemplate: "fin-{{ .Namespace }}-{{ .ClusterName }}-{{ .Zone }}",
+			expectError:  true,
+		},
+		{
+			name:         "Returns error when the template is a constant name",
+			nameTemplate: "capi",
+			expectError:  true,
+		},
+		{
+			name:         "Returns error when the template cannot be parsed",
+			nameTemplate: "{{ .ResourceType ",
+			expectError:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateResourceNameTemplate(tc.nameTemplate)
+			if tc.expectError {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+		})
+	}
+}
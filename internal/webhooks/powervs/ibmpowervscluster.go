@@ -19,7 +19,9 @@ package powervs
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
+	"regexp"
 	"strconv"
 
 	regionUtil "github.com/ppc64le-cloud/powervs-utils"
@@ -88,6 +90,38 @@ func validateIBMPowerVSCluster(oldCluster, newCluster *infrav1.IBMPowerVSCluster
 	if err := validateIBMPowerVSClusterCreateInfraPrereq(newCluster); err != nil {
 		allErrs = append(allErrs, err...)
 	}
+
+	if err := validateIBMPowerVSClusterDHCPNetworks(newCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateIBMPowerVSClusterDNS(newCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := validateIBMPowerVSClusterLoadBalancerProfiles(newCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateIBMPowerVSClusterLoadBalancerListeners(newCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateIBMPowerVSClusterTransitGatewayConnections(newCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateIBMPowerVSClusterVPCEndpointGateways(newCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateIBMPowerVSClusterResourceNameTemplate(oldCluster, newCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+
+	if err := validateTags(newCluster.Spec.Tags, field.NewPath("spec", "tags")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
 	// Need not validate for create operation
 	if oldCluster != nil {
 		if err := validateAdditionalListenerSelector(newCluster, oldCluster); err != nil {
@@ -117,6 +151,25 @@ func validateIBMPowerVSClusterNetwork(cluster *infrav1.IBMPowerVSCluster) *field
 	return nil
 }
 
+func validateIBMPowerVSClusterDHCPNetworks(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
+	for i, dhcpNetwork := range cluster.Spec.DHCPNetworks {
+		if dhcpNetwork.DHCPServer != nil && dhcpNetwork.DHCPServer.ID != nil && dhcpNetwork.DHCPServer.Name != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "dhcpNetworks").Index(i).Child("dhcpServer"), dhcpNetwork.DHCPServer, "only one of dhcpServer - id or name can be specified"))
+		}
+	}
+	return allErrs
+}
+
+func validateIBMPowerVSClusterDNS(cluster *infrav1.IBMPowerVSCluster) *field.Error {
+	if cluster.Spec.DNS == nil {
+		return nil
+	}
+	if createInfra, err := strconv.ParseBool(cluster.GetAnnotations()[infrav1.CreateInfrastructureAnnotation]); err != nil || !createInfra {
+		return field.Forbidden(field.NewPath("spec.dns"), "dns is only supported when powervs.cluster.x-k8s.io/create-infra annotation is set to true")
+	}
+	return nil
+}
+
 func validateIBMPowerVSClusterLoadBalancers(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
 	if err := validateIBMPowerVSClusterLoadBalancerNames(cluster); err != nil {
 		allErrs = append(allErrs, err...)
@@ -135,6 +188,49 @@ func validateIBMPowerVSClusterLoadBalancers(cluster *infrav1.IBMPowerVSCluster)
 	return append(allErrs, field.Invalid(field.NewPath("spec.LoadBalancers"), cluster.Spec.LoadBalancers, "Expect atleast one of the load balancer to be public"))
 }
 
+// validateIBMPowerVSClusterLoadBalancerProfiles validates that the load balancers do not use the network load balancer profile,
+// as the PowerVS instances are added to the load balancer pools as IP targets and a network load balancer requires instance targets.
+func validateIBMPowerVSClusterLoadBalancerProfiles(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
+	for i, loadBalancer := range cluster.Spec.LoadBalancers {
+		if loadBalancer.Profile == infrav1.VPCLoadBalancerProfileNetworkFixed {
+			allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "loadBalancers").Index(i).Child("profile"), loadBalancer.Profile, []string{string(infrav1.VPCLoadBalancerProfileApplication)}))
+		}
+	}
+	return allErrs
+}
+
+// validateIBMPowerVSClusterLoadBalancerListeners validates the certificate and HTTPS redirect of the load balancer listeners.
+func validateIBMPowerVSClusterLoadBalancerListeners(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
+	for i, loadBalancer := range cluster.Spec.LoadBalancers {
+		httpsPorts := make(map[int64]bool)
+		for _, listener := range loadBalancer.AdditionalListeners {
+			if listener.Protocol != nil && *listener.Protocol == infrav1.VPCLoadBalancerListenerProtocolHTTPS {
+				httpsPorts[listener.Port] = true
+			}
+		}
+		for j, listener := range loadBalancer.AdditionalListeners {
+			listenerPath := field.NewPath("spec", "loadBalancers").Index(i).Child("additionalListeners").Index(j)
+			isHTTPS := listener.Protocol != nil && *listener.Protocol == infrav1.VPCLoadBalancerListenerProtocolHTTPS
+			if isHTTPS && listener.CertificateCRN == nil {
+				allErrs = append(allErrs, field.Required(listenerPath.Child("certificateCRN"), "certificateCRN is required for https listener"))
+			}
+			if !isHTTPS && listener.CertificateCRN != nil {
+				allErrs = append(allErrs, field.Forbidden(listenerPath.Child("certificateCRN"), "certificateCRN is only supported for https listener"))
+			}
+			if listener.HTTPSRedirect == nil {
+				continue
+			}
+			if listener.Protocol == nil || *listener.Protocol != infrav1.VPCLoadBalancerListenerProtocolHTTP {
+				allErrs = append(allErrs, field.Forbidden(listenerPath.Child("httpsRedirect"), "httpsRedirect is only supported for http listener"))
+			}
+			if !httpsPorts[listener.HTTPSRedirect.ListenerPort] {
+				allErrs = append(allErrs, field.Invalid(listenerPath.Child("httpsRedirect", "listenerPort"), listener.HTTPSRedirect.ListenerPort, "httpsRedirect must reference an https listener of the load balancer"))
+			}
+		}
+	}
+	return allErrs
+}
+
 func validateIBMPowerVSClusterLoadBalancerNames(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
 	found := make(map[string]bool)
 	for i, loadbalancer := range cluster.Spec.LoadBalancers {
@@ -181,6 +277,122 @@ func validateIBMPowerVSClusterTransitGateway(cluster *infrav1.IBMPowerVSCluster)
 	return nil
 }
 
+// validateIBMPowerVSClusterTransitGatewayConnections validates the network CRN and prefix filters of the additional transit gateway connections.
+func validateIBMPowerVSClusterTransitGatewayConnections(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
+	if cluster.Spec.TransitGateway == nil {
+		return nil
+	}
+	for i, connection := range cluster.Spec.TransitGateway.Connections {
+		connectionPath := field.NewPath("spec", "transitGateway", "connections").Index(i)
+		if connection.NetworkType == infrav1.TransitGatewayConnectionNetworkTypeClassic && connection.NetworkCRN != nil {
+			allErrs = append(allErrs, field.Forbidden(connectionPath.Child("networkCRN"), "networkCRN is not supported for classic connection"))
+		}
+		if connection.NetworkType != infrav1.TransitGatewayConnectionNetworkTypeClassic && connection.NetworkCRN == nil {
+			allErrs = append(allErrs, field.Required(connectionPath.Child("networkCRN"), fmt.Sprintf("networkCRN is required for %s connection", connection.NetworkType)))
+		}
+		allErrs = append(allErrs, validateTransitGatewayPrefixFilters(connection.PrefixFilters, connectionPath.Child("prefixFilters"))...)
+	}
+	if cluster.Spec.TransitGateway.PowerVSConnection != nil {
+		allErrs = append(allErrs, validateTransitGatewayPrefixFilters(cluster.Spec.TransitGateway.PowerVSConnection.PrefixFilters, field.NewPath("spec", "transitGateway", "powerVSConnection", "prefixFilters"))...)
+	}
+	if cluster.Spec.TransitGateway.VPCConnection != nil {
+		allErrs = append(allErrs, validateTransitGatewayPrefixFilters(cluster.Spec.TransitGateway.VPCConnection.PrefixFilters, field.NewPath("spec", "transitGateway", "vpcConnection", "prefixFilters"))...)
+	}
+	return allErrs
+}
+
+// validateIBMPowerVSClusterResourceNameTemplate validates the names rendered from the resource name template against the IBM Cloud naming rules of each resource.
+// the template cannot be changed once set, as the resources created with the previous names would no longer be found.
+func validateIBMPowerVSClusterResourceNameTemplate(oldCluster, cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
+	templatePath := field.NewPath("spec", "resourceNameTemplate")
+	if oldCluster != nil && !reflect.DeepEqual(oldCluster.Spec.ResourceNameTemplate, cluster.Spec.ResourceNameTemplate) {
+		allErrs = append(allErrs, field.Forbidden(templatePath, "resourceNameTemplate is immutable"))
+	}
+	if cluster.Spec.ResourceNameTemplate == nil {
+		return allErrs
+	}
+
+	nameTemplate := *cluster.Spec.ResourceNameTemplate
+	if err := genutil.ValidateResourceNameTemplate(nameTemplate); err != nil {
+		return append(allErrs, field.Invalid(templatePath, nameTemplate, err.Error()))
+	}
+	// render the zonal names with the first zone of the region, the zone names of a region only differ in their suffix.
+	zone := "zone-1"
+	if cluster.Spec.VPC != nil && cluster.Spec.VPC.Region != nil {
+		zone = fmt.Sprintf("%s-1", *cluster.Spec.VPC.Region)
+	}
+	resourceNameRegexes := []struct {
+		resourceType infrav1.ResourceType
+		regex        *regexp.Regexp
+		message      string
+	}{
+		{infrav1.ResourceTypeServiceInstance, resourceInstanceNameRegex, resourceInstanceNameMessage},
+		{infrav1.ResourceTypeDHCPServer, resourceInstanceNameRegex, resourceInstanceNameMessage},
+		{infrav1.ResourceTypeCOSInstance, resourceInstanceNameRegex, resourceInstanceNameMessage},
+		{infrav1.ResourceTypeVPC, genutil.VPCResourceNameRegex, vpcResourceNameMessage},
+		{infrav1.ResourceTypeSubnet, genutil.VPCResourceNameRegex, vpcResourceNameMessage},
+		{infrav1.ResourceTypeLoadBalancer, genutil.VPCResourceNameRegex, vpcResourceNameMessage},
+		{infrav1.ResourceTypeTransitGateway, transitGatewayNameRegex, transitGatewayNameMessage},
+		{infrav1.ResourceTypeCOSBucket, cosBucketNameRegex, cosBucketNameMessage},
+	}
+	for _, resourceName := range resourceNameRegexes {
+		data := genutil.ResourceNameTemplateData{
+			ClusterName:  cluster.Name,
+			Namespace:    cluster.Namespace,
+			ResourceType: string(resourceName.resourceType),
+		}
+		var name string
+		var err error
+		if resourceName.resourceType == infrav1.ResourceTypeSubnet {
+			data.Zone = zone
+			name, err = genutil.RenderZonalResourceName(nameTemplate, data)
+		} else {
+			name, err = genutil.RenderResourceName(nameTemplate, data)
+		}
+		if err != nil {
+			// a template which fails to parse or render fails for every resource type.
+			return append(allErrs, field.Invalid(templatePath, nameTemplate, err.Error()))
+		}
+		if len(name) > genutil.MaxVPCResourceNameLength || !resourceName.regex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(templatePath, nameTemplate, fmt.Sprintf("rendered %s name %q must be at most %d characters, %s", resourceName.resourceType, name, genutil.MaxVPCResourceNameLength, resourceName.message)))
+		}
+	}
+	return allErrs
+}
+
+// validateIBMPowerVSClusterVPCEndpointGateways validates the service CRNs targeted by the VPC endpoint gateways.
+func validateIBMPowerVSClusterVPCEndpointGateways(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
+	for i, endpointGateway := range cluster.Spec.VPCEndpointGateways {
+		if !isValidCRN(endpointGateway.ServiceCRN) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "vpcEndpointGateways").Index(i).Child("serviceCRN"), endpointGateway.ServiceCRN, "serviceCRN must be a valid IBM Cloud CRN"))
+		}
+	}
+	return allErrs
+}
+
+// validateTransitGatewayPrefixFilters validates the prefix and the prefix length range of the transit gateway prefix filters.
+func validateTransitGatewayPrefixFilters(prefixFilters []infrav1.TransitGatewayPrefixFilter, path *field.Path) (allErrs field.ErrorList) {
+	for i, prefixFilter := range prefixFilters {
+		filterPath := path.Index(i)
+		_, prefix, err := net.ParseCIDR(prefixFilter.Prefix)
+		if err != nil || prefix.IP.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(filterPath.Child("prefix"), prefixFilter.Prefix, "prefix must be an IPv4 CIDR"))
+			continue
+		}
+		prefixLength, _ := prefix.Mask.Size()
+		if prefixFilter.Ge != nil && *prefixFilter.Ge < int64(prefixLength) {
+			allErrs = append(allErrs, field.Invalid(filterPath.Child("ge"), *prefixFilter.Ge, "ge must be greater than or equal to the prefix length"))
+		}
+		if prefixFilter.Le != nil && *prefixFilter.Le < int64(prefixLength) {
+			allErrs = append(allErrs, field.Invalid(filterPath.Child("le"), *prefixFilter.Le, "le must be greater than or equal to the prefix length"))
+		}
+		if prefixFilter.Ge != nil && prefixFilter.Le != nil && *prefixFilter.Le < *prefixFilter.Ge {
+			allErrs = append(allErrs, field.Invalid(filterPath.Child("le"), *prefixFilter.Le, "le must be greater than or equal to ge"))
+		}
+	}
+	return allErrs
+}
+
 func validateIBMPowerVSClusterCreateInfraPrereq(cluster *infrav1.IBMPowerVSCluster) (allErrs field.ErrorList) {
 	annotations := cluster.GetAnnotations()
 	if len(annotations) == 0 {
@@ -130,6 +130,422 @@ func TestIBMPowerVSCluster_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should error if both DHCP id and name are set for a DHCP network",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					DHCPNetworks: []infrav1.DHCPNetwork{
+						{
+							Name: "storage",
+							DHCPServer: &infrav1.DHCPServer{
+								ID:   ptr.To("capi-dhcp-id"),
+								Name: ptr.To("capi-dhcp"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if DNS is set without create infra annotation",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com",
+						RecordName: "api.capi",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if DNS record name is invalid",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{infrav1.CreateInfrastructureAnnotation: "true"},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					Zone:          ptr.To("dal10"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("us-south")},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{Name: ptr.To("default")},
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com",
+						RecordName: "API_capi",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow DNS with create infra annotation",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{infrav1.CreateInfrastructureAnnotation: "true"},
+				},
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					Zone:          ptr.To("dal10"),
+					VPC:           &infrav1.VPCResourceReference{Region: ptr.To("us-south")},
+					ResourceGroup: &infrav1.IBMPowerVSResourceReference{Name: ptr.To("default")},
+					DNS: &infrav1.DNSRecordSpec{
+						InstanceID: "dns-instance-id",
+						Zone:       "example.com",
+						RecordName: "api.capi",
+						TTL:        ptr.To(int64(300)),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if load balancer uses the network load balancer profile",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name:    "capi-lb",
+							Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+							AdditionalListeners: []infrav1.AdditionalListenerSpec{
+								{
+									Port:     22,
+									Protocol: &infrav1.VPCLoadBalancerListenerProtocolTCP,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if network load balancer has HTTP listener",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name:    "capi-lb",
+							Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+							AdditionalListeners: []infrav1.AdditionalListenerSpec{
+								{
+									Port:     80,
+									Protocol: &infrav1.VPCLoadBalancerListenerProtocolHTTP,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow https listener with certificate and http listener redirect",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name: "capi-lb",
+							AdditionalListeners: []infrav1.AdditionalListenerSpec{
+								{
+									Port:     80,
+									Protocol: &infrav1.VPCLoadBalancerListenerProtocolHTTP,
+									HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{
+										ListenerPort: 443,
+									},
+								},
+								{
+									Port:                  443,
+									Protocol:              &infrav1.VPCLoadBalancerListenerProtocolHTTPS,
+									CertificateCRN:        ptr.To("crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:cert"),
+									IdleConnectionTimeout: ptr.To(int64(300)),
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if https listener has no certificate",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name: "capi-lb",
+							AdditionalListeners: []infrav1.AdditionalListenerSpec{
+								{
+									Port:     443,
+									Protocol: &infrav1.VPCLoadBalancerListenerProtocolHTTPS,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if https redirect does not reference an https listener",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name: "capi-lb",
+							AdditionalListeners: []infrav1.AdditionalListenerSpec{
+								{
+									Port:     80,
+									Protocol: &infrav1.VPCLoadBalancerListenerProtocolHTTP,
+									HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{
+										ListenerPort: 8443,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if network load balancer has multiple subnets",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name:    "capi-lb",
+							Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+							Subnets: []infrav1.VPCResource{
+								{ID: ptr.To("subnet-id-1")},
+								{ID: ptr.To("subnet-id-2")},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow additional transit gateway connections",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					TransitGateway: &infrav1.TransitGateway{
+						Connections: []infrav1.TransitGatewayConnection{
+							{
+								Name:        "on-prem",
+								NetworkType: infrav1.TransitGatewayConnectionNetworkTypeDirectLink,
+								NetworkCRN:  ptr.To("crn:v1:bluemix:public:directlink:global:a/account::dedicated:dl-id"),
+								PrefixFilters: []infrav1.TransitGatewayPrefixFilter{
+									{Action: infrav1.TransitGatewayPrefixFilterActionPermit, Prefix: "10.0.0.0/8", Le: ptr.To(int64(24))},
+								},
+								PrefixFiltersDefault: &infrav1.TransitGatewayPrefixFilterActionDeny,
+							},
+							{
+								Name:        "classic",
+								NetworkType: infrav1.TransitGatewayConnectionNetworkTypeClassic,
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if additional transit gateway connection has no network CRN",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					TransitGateway: &infrav1.TransitGateway{
+						Connections: []infrav1.TransitGatewayConnection{
+							{
+								Name:        "shared-services",
+								NetworkType: infrav1.TransitGatewayConnectionNetworkTypeVPC,
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if transit gateway prefix filter le is less than the prefix length",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					TransitGateway: &infrav1.TransitGateway{
+						Connections: []infrav1.TransitGatewayConnection{
+							{
+								Name:        "shared-services",
+								NetworkType: infrav1.TransitGatewayConnectionNetworkTypeVPC,
+								NetworkCRN:  ptr.To("crn:v1:bluemix:public:is:us-south:a/account::vpc:vpc-id"),
+								PrefixFilters: []infrav1.TransitGatewayPrefixFilter{
+									{Action: infrav1.TransitGatewayPrefixFilterActionDeny, Prefix: "10.240.0.0/16", Le: ptr.To(int64(8))},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if transit gateway VPC connection prefix filter prefix is not a CIDR",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					TransitGateway: &infrav1.TransitGateway{
+						VPCConnection: &infrav1.TransitGatewayConnectionPrefixFilters{
+							PrefixFilters: []infrav1.TransitGatewayPrefixFilter{
+								{Action: infrav1.TransitGatewayPrefixFilterActionDeny, Prefix: "10.240.0.0"},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow VPC endpoint gateway with a valid service CRN",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					VPCEndpointGateways: []infrav1.VPCEndpointGateway{
+						{
+							Name:       "vpe-cos",
+							ServiceCRN: "crn:v1:bluemix:public:cloud-object-storage:global:::endpoint:s3.direct.us-south.cloud-object-storage.appdomain.cloud",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if VPC endpoint gateway service CRN is invalid",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					VPCEndpointGateways: []infrav1.VPCEndpointGateway{
+						{
+							Name:       "vpe-cos",
+							ServiceCRN: "cloud-object-storage",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should allow resource name template rendering valid names",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Should error if resource name template renders an invalid COS bucket name",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ .ResourceType }}"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if resource name template cannot be parsed",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ResourceNameTemplate: ptr.To("fin-{{ .Namespace "),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should error if resource name template does not reference the resource type",
+			powervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ .ClusterName }}"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -138,6 +554,7 @@ func TestIBMPowerVSCluster_create(t *testing.T) {
 			cluster.ObjectMeta = metav1.ObjectMeta{
 				GenerateName: "capi-cluster-",
 				Namespace:    "default",
+				Annotations:  tc.powervsCluster.Annotations,
 			}
 
 			if err := testEnv.Create(ctx, cluster); (err != nil) != tc.wantErr {
@@ -454,6 +871,28 @@ func TestIBMPowerVSCluster_update(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Should error if the resource name template is changed",
+			oldPowervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+				},
+			},
+			newPowervsCluster: &infrav1.IBMPowerVSCluster{
+				Spec: infrav1.IBMPowerVSClusterSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					Network: infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-net-id"),
+					},
+					ResourceNameTemplate: ptr.To("hr-{{ .Namespace }}-{{ lower .ResourceType }}"),
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -18,6 +18,13 @@ package powervs
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -50,16 +57,109 @@ func (r *IBMPowerVSImage) Default(_ context.Context, _ *infrav1.IBMPowerVSImage)
 }
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMPowerVSImage) ValidateCreate(_ context.Context, _ *infrav1.IBMPowerVSImage) (admission.Warnings, error) {
-	return nil, nil
+func (r *IBMPowerVSImage) ValidateCreate(_ context.Context, obj *infrav1.IBMPowerVSImage) (admission.Warnings, error) {
+	return validateIBMPowerVSImage(obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMPowerVSImage) ValidateUpdate(_ context.Context, _, _ *infrav1.IBMPowerVSImage) (warnings admission.Warnings, err error) {
-	return nil, nil
+func (r *IBMPowerVSImage) ValidateUpdate(_ context.Context, _, newObj *infrav1.IBMPowerVSImage) (warnings admission.Warnings, err error) {
+	return validateIBMPowerVSImage(newObj)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMPowerVSImage) ValidateDelete(_ context.Context, _ *infrav1.IBMPowerVSImage) (admission.Warnings, error) {
 	return nil, nil
 }
+
+func validateIBMPowerVSImage(image *infrav1.IBMPowerVSImage) (admission.Warnings, error) {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, validateIBMPowerVSImageSource(image)...)
+	allErrs = append(allErrs, validateIBMPowerVSImageTargets(image)...)
+
+	if image.Spec.Retention != nil && image.Labels[infrav1.IBMPowerVSImageFamilyLabel] == "" {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "retention"), image.Spec.Retention,
+			fmt.Sprintf("retention requires the image to be labeled with %s", infrav1.IBMPowerVSImageFamilyLabel)))
+	}
+
+	if len(allErrs) == 0 {
+		return nil, nil
+	}
+
+	return nil, apierrors.NewInvalid(
+		schema.GroupKind{Group: infrastructureGroup, Kind: "IBMPowerVSImage"},
+		image.Name, allErrs)
+}
+
+func validateIBMPowerVSImageSource(image *infrav1.IBMPowerVSImage) (allErrs field.ErrorList) {
+	spec := image.Spec
+	if spec.StockImage == nil {
+		if spec.Bucket == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "bucket"), "bucket is required when stockImage is not set"))
+		}
+		if spec.Object == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "object"), "object is required when stockImage is not set"))
+		}
+		if spec.Region == nil {
+			allErrs = append(allErrs, field.Required(field.NewPath("spec", "region"), "region is required when stockImage is not set"))
+		}
+		return append(allErrs, validateIBMPowerVSImageChecksum(spec.ExpectedChecksum)...)
+	}
+
+	path := field.NewPath("spec", "stockImage")
+	if spec.ExpectedChecksum != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "expectedChecksum"), "expectedChecksum is only supported for images imported from Cloud Object Storage"))
+	}
+	if spec.Bucket != nil || spec.Object != nil || spec.Region != nil {
+		allErrs = append(allErrs, field.Invalid(path, spec.StockImage, "bucket, object and region must not be set when stockImage is set"))
+	}
+	if spec.StockImage.ID == nil && spec.StockImage.Name == nil {
+		allErrs = append(allErrs, field.Required(path, "either id or name of the stock image must be specified"))
+	}
+	if spec.StockImage.ID != nil && spec.StockImage.Name != nil {
+		allErrs = append(allErrs, field.Invalid(path, spec.StockImage, "only one of id or name of the stock image may be specified"))
+	}
+	if spec.StockImage.RegEx != nil {
+		allErrs = append(allErrs, field.Forbidden(path.Child("regex"), "regex is not supported for stock image"))
+	}
+	return allErrs
+}
+
+func validateIBMPowerVSImageChecksum(checksum *infrav1.IBMPowerVSImageChecksum) (allErrs field.ErrorList) {
+	if checksum == nil {
+		return nil
+	}
+	// Length of the hex encoded checksums.
+	length := 64
+	if checksum.Algorithm == infrav1.PowerVSImageChecksumAlgorithmMD5 {
+		length = 32
+	}
+	if _, err := hex.DecodeString(checksum.Value); err != nil || len(checksum.Value) != length {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "expectedChecksum", "value"), checksum.Value,
+			fmt.Sprintf("value must be a hex encoded %s checksum of %d characters", checksum.Algorithm, length)))
+	}
+	return allErrs
+}
+
+func validateIBMPowerVSImageTargets(image *infrav1.IBMPowerVSImage) (allErrs field.ErrorList) {
+	for i, target := range image.Spec.Targets {
+		path := field.NewPath("spec", "targets").Index(i).Child("serviceInstance")
+		if target.ServiceInstance.ID == nil && target.ServiceInstance.Name == nil {
+			allErrs = append(allErrs, field.Required(path, "either id or name of the service instance must be specified"))
+			continue
+		}
+		if target.ServiceInstance.ID != nil && target.ServiceInstance.Name != nil {
+			allErrs = append(allErrs, field.Invalid(path, target.ServiceInstance, "only one of id or name of the service instance may be specified"))
+		}
+		if target.ServiceInstance.RegEx != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("regex"), "regex is not supported for service instance"))
+		}
+		if image.Spec.ServiceInstance != nil && image.Spec.ServiceInstance.ID != nil && target.ServiceInstance.ID != nil && *image.Spec.ServiceInstance.ID == *target.ServiceInstance.ID {
+			allErrs = append(allErrs, field.Invalid(path.Child("id"), *target.ServiceInstance.ID, fmt.Sprintf("service instance %s is already the service instance of the image", *target.ServiceInstance.ID)))
+		}
+	}
+
+	if image.Spec.ClusterSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(image.Spec.ClusterSelector, metav1validation.LabelSelectorValidationOptions{}, field.NewPath("spec", "clusterSelector"))...)
+	}
+	return allErrs
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powervs
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+)
+
+func TestIBMPowerVSImage_create(t *testing.T) {
+	tests := []struct {
+		name         string
+		powervsImage *infrav1.IBMPowerVSImage
+		wantErr      bool
+	}{
+		{
+			name:         "Should allow image without targets",
+			powervsImage: powervsImageWithTargets(),
+			wantErr:      false,
+		},
+		{
+			name: "Should allow targets referenced by ID or name",
+			powervsImage: powervsImageWithTargets(
+				infrav1.IBMPowerVSImageTarget{ServiceInstance: infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-target-si-id")}},
+				infrav1.IBMPowerVSImageTarget{ServiceInstance: infrav1.IBMPowerVSResourceReference{Name: ptr.To("capi-target-si")}, Zone: ptr.To("dal10")},
+			),
+			wantErr: false,
+		},
+		{
+			name: "Should error if neither target ID nor name is set",
+			powervsImage: powervsImageWithTargets(
+				infrav1.IBMPowerVSImageTarget{ServiceInstance: infrav1.IBMPowerVSResourceReference{}},
+			),
+			wantErr: true,
+		},
+		{
+			name: "Should error if both target ID and name are set",
+			powervsImage: powervsImageWithTargets(
+				infrav1.IBMPowerVSImageTarget{ServiceInstance: infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-target-si-id"), Name: ptr.To("capi-target-si")}},
+			),
+			wantErr: true,
+		},
+		{
+			name: "Should error if target regex is set",
+			powervsImage: powervsImageWithTargets(
+				infrav1.IBMPowerVSImageTarget{ServiceInstance: infrav1.IBMPowerVSResourceReference{Name: ptr.To("capi-target-si"), RegEx: ptr.To("^capi$")}},
+			),
+			wantErr: true,
+		},
+		{
+			name: "Should error if target is the image service instance",
+			powervsImage: powervsImageWithTargets(
+				infrav1.IBMPowerVSImageTarget{ServiceInstance: infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")}},
+			),
+			wantErr: true,
+		},
+		{
+			name:         "Should allow stock image referenced by name",
+			powervsImage: powervsStockImage(&infrav1.IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")}),
+			wantErr:      false,
+		},
+		{
+			name:         "Should error if both stock image ID and name are set",
+			powervsImage: powervsStockImage(&infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-stock-image-id"), Name: ptr.To("CentOS-Stream-9")}),
+			wantErr:      true,
+		},
+		{
+			name:         "Should error if stock image regex is set",
+			powervsImage: powervsStockImage(&infrav1.IBMPowerVSResourceReference{RegEx: ptr.To("^CentOS")}),
+			wantErr:      true,
+		},
+		{
+			name: "Should error if both stock image and Cloud Object Storage object are set",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsImageWithTargets()
+				image.Spec.StockImage = &infrav1.IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")}
+				return image
+			}(),
+			wantErr: true,
+		},
+		{
+			name:         "Should error if neither stock image nor Cloud Object Storage object are set",
+			powervsImage: powervsStockImage(nil),
+			wantErr:      true,
+		},
+		{
+			name: "Should allow retention of an image with family label",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsImageWithTargets()
+				image.Labels = map[string]string{infrav1.IBMPowerVSImageFamilyLabel: "rhcos"}
+				image.Spec.Retention = &infrav1.IBMPowerVSImageRetention{KeepLastVersions: 2}
+				return image
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "Should error if retention is set without family label",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsImageWithTargets()
+				image.Spec.Retention = &infrav1.IBMPowerVSImageRetention{KeepLastVersions: 2}
+				return image
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "Should allow expected MD5 checksum",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsImageWithTargets()
+				image.Spec.ExpectedChecksum = &infrav1.IBMPowerVSImageChecksum{Algorithm: infrav1.PowerVSImageChecksumAlgorithmMD5, Value: "d41d8cd98f00b204e9800998ecf8427e"}
+				return image
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "Should error if expected SHA256 checksum is not valid",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsImageWithTargets()
+				image.Spec.ExpectedChecksum = &infrav1.IBMPowerVSImageChecksum{Algorithm: infrav1.PowerVSImageChecksumAlgorithmSHA256, Value: "d41d8cd98f00b204e9800998ecf8427e"}
+				return image
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "Should error if expected checksum is set for stock image",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsStockImage(&infrav1.IBMPowerVSResourceReference{Name: ptr.To("CentOS-Stream-9")})
+				image.Spec.ExpectedChecksum = &infrav1.IBMPowerVSImageChecksum{Algorithm: infrav1.PowerVSImageChecksumAlgorithmMD5, Value: "d41d8cd98f00b204e9800998ecf8427e"}
+				return image
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "Should error if cluster selector is invalid",
+			powervsImage: func() *infrav1.IBMPowerVSImage {
+				image := powervsImageWithTargets()
+				image.Spec.ClusterSelector = &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: "Unknown"}},
+				}
+				return image
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			image := tc.powervsImage.DeepCopy()
+			image.ObjectMeta = metav1.ObjectMeta{
+				GenerateName: "capi-image-",
+				Namespace:    "default",
+				Labels:       tc.powervsImage.Labels,
+			}
+
+			if err := testEnv.Create(ctx, image); (err != nil) != tc.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func powervsImageWithTargets(targets ...infrav1.IBMPowerVSImageTarget) *infrav1.IBMPowerVSImage {
+	return &infrav1.IBMPowerVSImage{
+		Spec: infrav1.IBMPowerVSImageSpec{
+			ClusterName:     "capi-cluster",
+			ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+			Bucket:          ptr.To("capi-bucket"),
+			Object:          ptr.To("capi-image.ova.gz"),
+			Region:          ptr.To("us-south"),
+			Targets:         targets,
+		},
+	}
+}
+
+func powervsStockImage(stockImage *infrav1.IBMPowerVSResourceReference) *infrav1.IBMPowerVSImage {
+	return &infrav1.IBMPowerVSImage{
+		Spec: infrav1.IBMPowerVSImageSpec{
+			ClusterName:     "capi-cluster",
+			ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+			StockImage:      stockImage,
+		},
+	}
+}
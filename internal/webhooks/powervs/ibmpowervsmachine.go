@@ -18,6 +18,7 @@ package powervs
 
 import (
 	"context"
+	"reflect"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -56,12 +57,12 @@ func (r *IBMPowerVSMachine) Default(_ context.Context, obj *infrav1.IBMPowerVSMa
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMPowerVSMachine) ValidateCreate(_ context.Context, obj *infrav1.IBMPowerVSMachine) (admission.Warnings, error) {
-	return validateIBMPowerVSMachine(obj)
+	return validateIBMPowerVSMachine(nil, obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMPowerVSMachine) ValidateUpdate(_ context.Context, _, newObj *infrav1.IBMPowerVSMachine) (warnings admission.Warnings, err error) {
-	return validateIBMPowerVSMachine(newObj)
+func (r *IBMPowerVSMachine) ValidateUpdate(_ context.Context, oldObj, newObj *infrav1.IBMPowerVSMachine) (warnings admission.Warnings, err error) {
+	return validateIBMPowerVSMachine(oldObj, newObj)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -69,7 +70,7 @@ func (r *IBMPowerVSMachine) ValidateDelete(_ context.Context, _ *infrav1.IBMPowe
 	return nil, nil
 }
 
-func validateIBMPowerVSMachine(machine *infrav1.IBMPowerVSMachine) (admission.Warnings, error) {
+func validateIBMPowerVSMachine(oldMachine, machine *infrav1.IBMPowerVSMachine) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 	if err := validateIBMPowerVSMachineNetwork(machine); err != nil {
 		allErrs = append(allErrs, err)
@@ -83,6 +84,15 @@ func validateIBMPowerVSMachine(machine *infrav1.IBMPowerVSMachine) (admission.Wa
 	if err := validateIBMPowerVSMachineProcessors(machine); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := validateIBMPowerVSBootVolume(machine.Spec.BootVolume, field.NewPath("spec", "bootVolume")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMPowerVSMachineBootVolumeUpdate(oldMachine, machine); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateTags(machine.Spec.Tags, field.NewPath("spec", "tags")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -92,11 +102,23 @@ func validateIBMPowerVSMachine(machine *infrav1.IBMPowerVSMachine) (admission.Wa
 		machine.Name, allErrs)
 }
 
+// validateIBMPowerVSMachineBootVolumeUpdate validates that the boot volume is not changed,
+// as the boot volume of an existing instance is not moved to another storage tier or pool.
+func validateIBMPowerVSMachineBootVolumeUpdate(oldMachine, machine *infrav1.IBMPowerVSMachine) *field.Error {
+	if oldMachine == nil {
+		return nil
+	}
+	if !reflect.DeepEqual(oldMachine.Spec.BootVolume, machine.Spec.BootVolume) {
+		return field.Forbidden(field.NewPath("spec", "bootVolume"), "bootVolume is immutable")
+	}
+	return nil
+}
+
 func validateIBMPowerVSMachineNetwork(machine *infrav1.IBMPowerVSMachine) *field.Error {
 	if res, err := validateIBMPowerVSNetworkReference(machine.Spec.Network); !res {
 		return err
 	}
-	return nil
+	return validateIBMPowerVSDHCPNetworkReference(machine.Spec.Network, machine.Spec.DHCPNetwork, field.NewPath("spec", "dhcpNetwork"))
 }
 
 func validateIBMPowerVSMachineImage(machine *infrav1.IBMPowerVSMachine) *field.Error {
@@ -70,6 +70,24 @@ func TestIBMPowerVSMachine_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to validate IBMPowerVSMachine - both Network and DHCPNetwork specified in Spec",
+			powerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					DHCPNetwork: "storage",
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should fail to validate IBMPowerVSMachine - no Image or Imagref in Spec",
 			powerVSMachine: &infrav1.IBMPowerVSMachine{
@@ -139,6 +157,32 @@ func TestIBMPowerVSMachine_create(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to validate IBMPowerVSMachine - both StoragePool and StorageAffinity specified in Spec",
+			powerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					Processors: intstr.FromString("0.25"),
+					MemoryGiB:  4,
+					BootVolume: &infrav1.PowerVSBootVolume{
+						StoragePool: "Tier1-Flash-1",
+						StorageAffinity: &infrav1.PowerVSStorageAffinity{
+							Policy:  infrav1.PowerVSStorageAffinityPolicyAffinity,
+							Volumes: []string{"capi-volume"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Should successfully validate IBMPowerVSMachine - valid spec",
 			powerVSMachine: &infrav1.IBMPowerVSMachine{
@@ -353,6 +397,113 @@ func TestIBMPowerVSMachine_update(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Should fail to update IBMPowerVSMachine with a different BootVolume",
+			oldPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:       4,
+					Processors:      intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier1},
+				},
+			},
+			newPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:       4,
+					Processors:      intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier3},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should fail to update IBMPowerVSMachine by setting BootVolume",
+			oldPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:       4,
+					Processors:      intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+				},
+			},
+			newPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:       4,
+					Processors:      intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier3},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Should successfully update IBMPowerVSMachine with an unchanged BootVolume",
+			oldPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:       4,
+					Processors:      intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier1},
+				},
+			},
+			newPowerVSMachine: &infrav1.IBMPowerVSMachine{
+				Spec: infrav1.IBMPowerVSMachineSpec{
+					ServiceInstance: &infrav1.IBMPowerVSResourceReference{ID: ptr.To("capi-si-id")},
+					SystemType:      defaultSystemType,
+					ProcessorType:   infrav1.PowerVSProcessorTypeShared,
+					MemoryGiB:       8,
+					Processors:      intstr.FromString("0.25"),
+					Network: infrav1.IBMPowerVSResourceReference{
+						Name: ptr.To("capi-net"),
+					},
+					Image: &infrav1.IBMPowerVSResourceReference{
+						ID: ptr.To("capi-image-id"),
+					},
+					BootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier1},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "Should successfully update IBMPowerVSMachine",
 			oldPowerVSMachine: &infrav1.IBMPowerVSMachine{
@@ -83,6 +83,12 @@ func validateIBMPowerVSMachineTemplate(machineTemplate *infrav1.IBMPowerVSMachin
 	if err := validateIBMPowerVSMachineTemplateProcessors(machineTemplate); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := validateIBMPowerVSBootVolume(machineTemplate.Spec.Template.Spec.BootVolume, field.NewPath("spec", "template", "spec", "bootVolume")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateTags(machineTemplate.Spec.Template.Spec.Tags, field.NewPath("spec", "template", "spec", "tags")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -96,7 +102,7 @@ func validateIBMPowerVSMachineTemplateNetwork(machineTemplate *infrav1.IBMPowerV
 	if res, err := validateIBMPowerVSNetworkReference(machineTemplate.Spec.Template.Spec.Network); !res {
 		return err
 	}
-	return nil
+	return validateIBMPowerVSDHCPNetworkReference(machineTemplate.Spec.Template.Spec.Network, machineTemplate.Spec.Template.Spec.DHCPNetwork, field.NewPath("spec", "template", "spec", "dhcpNetwork"))
 }
 
 func validateIBMPowerVSMachineTemplateImage(machineTemplate *infrav1.IBMPowerVSMachineTemplate) *field.Error {
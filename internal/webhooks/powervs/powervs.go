@@ -17,18 +17,33 @@ limitations under the License.
 package powervs
 
 import (
+	"regexp"
 	"strconv"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
 )
 
 const (
 	defaultSystemType = "s922"
 )
 
+// naming rules of the IBM Cloud resources created for the cluster.
+var (
+	resourceInstanceNameRegex   = regexp.MustCompile(`^[a-zA-Z0-9][-_.a-zA-Z0-9 ]*$`)
+	resourceInstanceNameMessage = "consist of alphanumeric characters, ' ', '-', '_' or '.', and start with an alphanumeric character"
+	vpcResourceNameMessage      = "consist of lowercase alphanumeric characters or '-', start with a letter and end with an alphanumeric character"
+	transitGatewayNameRegex     = regexp.MustCompile(`^([a-zA-Z]|[a-zA-Z][-_a-zA-Z0-9]*[a-zA-Z0-9])$`)
+	transitGatewayNameMessage   = "consist of alphanumeric characters, '-' or '_', start with a letter and end with an alphanumeric character"
+	cosBucketNameRegex          = regexp.MustCompile(`^[a-z0-9][-.a-z0-9]{1,61}[a-z0-9]$`)
+	cosBucketNameMessage        = "be at least 3 characters, consist of lowercase alphanumeric characters, '-' or '.', and start and end with an alphanumeric character"
+)
+
+var crnRegex = regexp.MustCompile(`^crn:v[0-9]+:[a-z0-9-]+:[a-z0-9-]+:[a-z0-9-]+:[a-z0-9-]*:([a-z]\/[a-z0-9-]+)?:[a-z0-9-]*:[a-z0-9-]*:[a-zA-Z0-9-_\.\/]*$`)
+
 func defaultIBMPowerVSMachineSpec(spec *infrav1.IBMPowerVSMachineSpec) {
 	if spec.MemoryGiB == 0 {
 		spec.MemoryGiB = 2
@@ -58,6 +73,31 @@ func validateIBMPowerVSNetworkReference(res infrav1.IBMPowerVSResourceReference)
 	return true, nil
 }
 
+func validateIBMPowerVSDHCPNetworkReference(network infrav1.IBMPowerVSResourceReference, dhcpNetwork string, fldPath *field.Path) *field.Error {
+	if dhcpNetwork != "" && (network.ID != nil || network.Name != nil || network.RegEx != nil) {
+		return field.Invalid(fldPath, dhcpNetwork, "either one of network or dhcpNetwork can be provided")
+	}
+	return nil
+}
+
+func validateIBMPowerVSBootVolume(bootVolume *infrav1.PowerVSBootVolume, fldPath *field.Path) (allErrs field.ErrorList) {
+	if bootVolume == nil || bootVolume.StorageAffinity == nil {
+		return nil
+	}
+	affinity := bootVolume.StorageAffinity
+	affinityPath := fldPath.Child("storageAffinity")
+	if bootVolume.StoragePool != "" {
+		allErrs = append(allErrs, field.Invalid(affinityPath, affinity.Policy, "either one of storagePool or storageAffinity can be provided"))
+	}
+	switch references := len(affinity.Volumes) + len(affinity.Instances); {
+	case affinity.Policy == infrav1.PowerVSStorageAffinityPolicyAffinity && references != 1:
+		allErrs = append(allErrs, field.Invalid(affinityPath, affinity.Policy, "exactly one volume or instance must be provided for Affinity policy"))
+	case affinity.Policy == infrav1.PowerVSStorageAffinityPolicyAntiAffinity && references == 0:
+		allErrs = append(allErrs, field.Invalid(affinityPath, affinity.Policy, "at least one volume or instance must be provided for AntiAffinity policy"))
+	}
+	return allErrs
+}
+
 func validateIBMPowerVSMemoryValues(resValue int32) bool {
 	if val := float64(resValue); val < 2 {
 		return false
@@ -79,3 +119,18 @@ func validateIBMPowerVSProcessorValues(resValue intstr.IntOrString) bool {
 
 	return true
 }
+
+// validateTags validates that the tags are valid IBM Cloud user tags.
+func validateTags(tags map[string]string, tagsPath *field.Path) (allErrs field.ErrorList) {
+	for key, value := range tags {
+		if err := genutil.ValidateUserTag(key, value); err != nil {
+			allErrs = append(allErrs, field.Invalid(tagsPath.Key(key), value, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// isValidCRN checks whether the provided string is a valid IBM Cloud CRN.
+func isValidCRN(crn string) bool {
+	return crnRegex.MatchString(crn)
+}
@@ -20,6 +20,9 @@ import (
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/powervs/v1beta3"
 )
 
 func TestValidateIBMPowerVSMemoryValues(t *testing.T) {
@@ -109,3 +112,65 @@ func TestValidateIBMPowerVSProcessorValues(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIBMPowerVSBootVolume(t *testing.T) {
+	tests := []struct {
+		name       string
+		bootVolume *infrav1.PowerVSBootVolume
+		wantErrs   int
+	}{
+		{
+			name: "Boot volume is not set",
+		},
+		{
+			name:       "Storage tier and storage pool are set",
+			bootVolume: &infrav1.PowerVSBootVolume{StorageTier: infrav1.PowerVSStorageTierTier1, StoragePool: "Tier1-Flash-1"},
+		},
+		{
+			name: "Affinity policy with a single volume",
+			bootVolume: &infrav1.PowerVSBootVolume{StorageAffinity: &infrav1.PowerVSStorageAffinity{
+				Policy:  infrav1.PowerVSStorageAffinityPolicyAffinity,
+				Volumes: []string{"volume-1"},
+			}},
+		},
+		{
+			name: "AntiAffinity policy with volumes and instances",
+			bootVolume: &infrav1.PowerVSBootVolume{StorageAffinity: &infrav1.PowerVSStorageAffinity{
+				Policy:    infrav1.PowerVSStorageAffinityPolicyAntiAffinity,
+				Volumes:   []string{"volume-1"},
+				Instances: []string{"instance-1", "instance-2"},
+			}},
+		},
+		{
+			name: "Storage pool and storage affinity are set",
+			bootVolume: &infrav1.PowerVSBootVolume{StoragePool: "Tier1-Flash-1", StorageAffinity: &infrav1.PowerVSStorageAffinity{
+				Policy:  infrav1.PowerVSStorageAffinityPolicyAffinity,
+				Volumes: []string{"volume-1"},
+			}},
+			wantErrs: 1,
+		},
+		{
+			name: "Affinity policy with a volume and an instance",
+			bootVolume: &infrav1.PowerVSBootVolume{StorageAffinity: &infrav1.PowerVSStorageAffinity{
+				Policy:    infrav1.PowerVSStorageAffinityPolicyAffinity,
+				Volumes:   []string{"volume-1"},
+				Instances: []string{"instance-1"},
+			}},
+			wantErrs: 1,
+		},
+		{
+			name: "AntiAffinity policy without volumes or instances",
+			bootVolume: &infrav1.PowerVSBootVolume{StorageAffinity: &infrav1.PowerVSStorageAffinity{
+				Policy: infrav1.PowerVSStorageAffinityPolicyAntiAffinity,
+			}},
+			wantErrs: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateIBMPowerVSBootVolume(tt.bootVolume, field.NewPath("spec", "bootVolume")); len(got) != tt.wantErrs {
+				t.Errorf("validateIBMPowerVSBootVolume() = %v, want %d errors", got, tt.wantErrs)
+			}
+		})
+	}
+}
@@ -18,15 +18,22 @@ package vpc
 
 import (
 	"context"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
 )
 
 // Ensure IBMVPCCluster implements the typed webhook interfaces.
@@ -55,12 +62,12 @@ func (r *IBMVPCCluster) Default(_ context.Context, _ *infrav1.IBMVPCCluster) err
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMVPCCluster) ValidateCreate(_ context.Context, obj *infrav1.IBMVPCCluster) (admission.Warnings, error) {
-	return validateIBMVPCCluster(obj)
+	return validateIBMVPCCluster(nil, obj)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCCluster) ValidateUpdate(_ context.Context, _, newObj *infrav1.IBMVPCCluster) (warnings admission.Warnings, err error) {
-	return validateIBMVPCCluster(newObj)
+func (r *IBMVPCCluster) ValidateUpdate(_ context.Context, oldObj, newObj *infrav1.IBMVPCCluster) (warnings admission.Warnings, err error) {
+	return validateIBMVPCCluster(oldObj, newObj)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
@@ -68,11 +75,38 @@ func (r *IBMVPCCluster) ValidateDelete(_ context.Context, _ *infrav1.IBMVPCClust
 	return nil, nil
 }
 
-func validateIBMVPCCluster(vpcCluster *infrav1.IBMVPCCluster) (admission.Warnings, error) {
+func validateIBMVPCCluster(oldCluster, vpcCluster *infrav1.IBMVPCCluster) (admission.Warnings, error) {
 	var allErrs field.ErrorList
 	if err := validateIBMVPCClusterControlPlane(vpcCluster); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if err := validateIBMVPCClusterDNS(vpcCluster); err != nil {
+		allErrs = append(allErrs, err)
+	}
+	if err := validateIBMVPCClusterLoadBalancerProfiles(vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMVPCClusterLoadBalancerListeners(vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMVPCClusterTransitGateway(vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMVPCClusterAddressPrefixes(vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMVPCClusterEgress(vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMVPCClusterEndpointGateways(vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateIBMVPCClusterResourceNameTemplate(oldCluster, vpcCluster); err != nil {
+		allErrs = append(allErrs, err...)
+	}
+	if err := validateTags(vpcCluster.Spec.Tags, field.NewPath("spec", "tags")); err != nil {
+		allErrs = append(allErrs, err...)
+	}
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -88,3 +122,280 @@ func validateIBMVPCClusterControlPlane(vpcCluster *infrav1.IBMVPCCluster) *field
 	}
 	return nil
 }
+
+func validateIBMVPCClusterDNS(vpcCluster *infrav1.IBMVPCCluster) *field.Error {
+	if vpcCluster.Spec.DNS != nil && vpcCluster.Spec.Network == nil {
+		return field.Forbidden(field.NewPath("spec", "dns"), "DNS is only supported along with Network")
+	}
+	return nil
+}
+
+// validateIBMVPCClusterTransitGateway validates the VPCs connected to the cluster's VPC through the transit gateway.
+func validateIBMVPCClusterTransitGateway(vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	if vpcCluster.Spec.Network == nil || vpcCluster.Spec.Network.TransitGateway == nil {
+		return nil
+	}
+	transitGateway := vpcCluster.Spec.Network.TransitGateway
+	tgPath := field.NewPath("spec", "network", "transitGateway")
+	for i, connection := range transitGateway.VPCConnections {
+		connectionPath := tgPath.Child("vpcConnections").Index(i).Child("vpcCRN")
+		if !isValidCRN(connection.VPCCRN) {
+			allErrs = append(allErrs, field.Invalid(connectionPath, connection.VPCCRN, "vpcCRN must be a valid IBM Cloud CRN"))
+			continue
+		}
+		// The region is the sixth segment of a CRN, a VPC in another region can only be connected with global routing.
+		if region := strings.Split(connection.VPCCRN, ":")[5]; transitGateway.GlobalRouting != nil && !*transitGateway.GlobalRouting && region != vpcCluster.Spec.Region {
+			allErrs = append(allErrs, field.Invalid(connectionPath, connection.VPCCRN, fmt.Sprintf("VPC in region %s cannot be connected to the cluster in region %s when globalRouting is false", region, vpcCluster.Spec.Region)))
+		}
+	}
+	return allErrs
+}
+
+// validateIBMVPCClusterResourceNameTemplate validates the names rendered from the resource name template against the IBM Cloud naming rules of each resource.
+// The template cannot be changed once set, as the resources created with the previous names would no longer be found.
+func validateIBMVPCClusterResourceNameTemplate(oldCluster, vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	templatePath := field.NewPath("spec", "resourceNameTemplate")
+	if oldCluster != nil && !ptr.Equal(oldCluster.Spec.ResourceNameTemplate, vpcCluster.Spec.ResourceNameTemplate) {
+		allErrs = append(allErrs, field.Forbidden(templatePath, "resourceNameTemplate is immutable"))
+	}
+	if vpcCluster.Spec.ResourceNameTemplate == nil {
+		return allErrs
+	}
+	if vpcCluster.Spec.Network == nil {
+		return append(allErrs, field.Forbidden(templatePath, "resourceNameTemplate is only supported along with network"))
+	}
+
+	nameTemplate := *vpcCluster.Spec.ResourceNameTemplate
+	if err := genutil.ValidateResourceNameTemplate(nameTemplate); err != nil {
+		return append(allErrs, field.Invalid(templatePath, nameTemplate, err.Error()))
+	}
+	// Render the zonal names with the first zone of the region, the zone names of a region only differ in their suffix.
+	zone := fmt.Sprintf("%s-1", vpcCluster.Spec.Region)
+	resourceTypes := []infrav1.ResourceType{
+		infrav1.ResourceTypeVPC,
+		infrav1.ResourceTypeSubnet,
+		infrav1.ResourceTypePublicGateway,
+		infrav1.ResourceTypeLoadBalancer,
+		infrav1.ResourceTypeLoadBalancerPool,
+		infrav1.ResourceTypeTransitGateway,
+	}
+	for _, resourceType := range resourceTypes {
+		data := genutil.ResourceNameTemplateData{
+			ClusterName:  vpcCluster.Name,
+			Namespace:    vpcCluster.Namespace,
+			ResourceType: string(resourceType),
+		}
+		var name string
+		var err error
+		if resourceType == infrav1.ResourceTypeSubnet || resourceType == infrav1.ResourceTypePublicGateway {
+			data.Zone = zone
+			name, err = genutil.RenderZonalResourceName(nameTemplate, data)
+		} else {
+			name, err = genutil.RenderResourceName(nameTemplate, data)
+		}
+		if err != nil {
+			// A template which fails to parse or render fails for every resource type.
+			return append(allErrs, field.Invalid(templatePath, nameTemplate, err.Error()))
+		}
+		if len(name) > genutil.MaxVPCResourceNameLength || !genutil.VPCResourceNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(templatePath, nameTemplate, fmt.Sprintf("rendered %s name %q must be at most %d characters, consist of lowercase alphanumeric characters or '-', start with a letter and end with an alphanumeric character", resourceType, name, genutil.MaxVPCResourceNameLength)))
+		}
+	}
+	return allErrs
+}
+
+// validateIBMVPCClusterEndpointGateways validates the service CRNs targeted by the VPC endpoint gateways.
+func validateIBMVPCClusterEndpointGateways(vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	if vpcCluster.Spec.Network == nil {
+		return nil
+	}
+	for i, endpointGateway := range vpcCluster.Spec.Network.EndpointGateways {
+		if !isValidCRN(endpointGateway.ServiceCRN) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "endpointGateways").Index(i).Child("serviceCRN"), endpointGateway.ServiceCRN, "serviceCRN must be a valid IBM Cloud CRN"))
+		}
+	}
+	return allErrs
+}
+
+// validateIBMVPCClusterAddressPrefixes validates the VPC address prefixes, and that the subnet cidrs fall within the address prefixes of their zone.
+func validateIBMVPCClusterAddressPrefixes(vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	network := vpcCluster.Spec.Network
+	if network == nil {
+		return nil
+	}
+	networkPath := field.NewPath("spec", "network")
+	manual := network.AddressPrefixManagement == infrav1.VPCAddressPrefixManagementManual
+	if manual && len(network.AddressPrefixes) == 0 {
+		allErrs = append(allErrs, field.Required(networkPath.Child("addressPrefixes"), "addressPrefixes must be specified when addressPrefixManagement is manual"))
+	}
+
+	zonePrefixes := make(map[string][]netip.Prefix)
+	var prefixes []netip.Prefix
+	for i, addressPrefix := range network.AddressPrefixes {
+		cidrPath := networkPath.Child("addressPrefixes").Index(i).Child("cidr")
+		prefix, err := netip.ParsePrefix(addressPrefix.CIDR)
+		if err != nil || !prefix.Addr().Is4() || prefix.Masked() != prefix {
+			allErrs = append(allErrs, field.Invalid(cidrPath, addressPrefix.CIDR, "cidr must be a valid IPv4 network cidr"))
+			continue
+		}
+		if slices.ContainsFunc(prefixes, prefix.Overlaps) {
+			allErrs = append(allErrs, field.Invalid(cidrPath, addressPrefix.CIDR, "cidr overlaps another address prefix"))
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+		zonePrefixes[addressPrefix.Zone] = append(zonePrefixes[addressPrefix.Zone], prefix)
+	}
+
+	validateSubnets := func(subnets []infrav1.Subnet, subnetsPath *field.Path) {
+		for i, subnet := range subnets {
+			if subnet.Ipv4CidrBlock == nil {
+				continue
+			}
+			cidrPath := subnetsPath.Index(i).Child("cidr")
+			cidr, err := netip.ParsePrefix(*subnet.Ipv4CidrBlock)
+			if err != nil || !cidr.Addr().Is4() || cidr.Masked() != cidr {
+				allErrs = append(allErrs, field.Invalid(cidrPath, *subnet.Ipv4CidrBlock, "cidr must be a valid IPv4 network cidr"))
+				continue
+			}
+			// Subnets in zones without an address prefix defined can only be checked once the default address prefixes are created.
+			zone := ptr.Deref(subnet.Zone, "")
+			if _, ok := zonePrefixes[zone]; !ok && !manual {
+				continue
+			}
+			if !slices.ContainsFunc(zonePrefixes[zone], func(prefix netip.Prefix) bool {
+				return prefix.Bits() <= cidr.Bits() && prefix.Contains(cidr.Addr())
+			}) {
+				allErrs = append(allErrs, field.Invalid(cidrPath, *subnet.Ipv4CidrBlock, fmt.Sprintf("cidr must be within one of the address prefixes of zone %q", zone)))
+			}
+		}
+	}
+	validateSubnets(network.ControlPlaneSubnets, networkPath.Child("controlPlaneSubnets"))
+	validateSubnets(network.WorkerSubnets, networkPath.Child("workerSubnets"))
+	return allErrs
+}
+
+// validateIBMVPCClusterEgress validates the egress of the network and subnets, and the proxy urls.
+func validateIBMVPCClusterEgress(vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	network := vpcCluster.Spec.Network
+	if network == nil {
+		return nil
+	}
+	networkPath := field.NewPath("spec", "network")
+	allErrs = append(allErrs, validateVPCEgress(network.Egress, networkPath.Child("egress"))...)
+	for i, subnet := range network.ControlPlaneSubnets {
+		allErrs = append(allErrs, validateVPCEgress(subnet.Egress, networkPath.Child("controlPlaneSubnets").Index(i).Child("egress"))...)
+	}
+	for i, subnet := range network.WorkerSubnets {
+		allErrs = append(allErrs, validateVPCEgress(subnet.Egress, networkPath.Child("workerSubnets").Index(i).Child("egress"))...)
+	}
+
+	if network.Proxy == nil {
+		return allErrs
+	}
+	proxyPath := networkPath.Child("proxy")
+	if network.Proxy.HTTPProxy == nil && network.Proxy.HTTPSProxy == nil {
+		allErrs = append(allErrs, field.Required(proxyPath, "one of httpProxy or httpsProxy must be specified"))
+	}
+	validateProxyURL := func(proxyURL *string, urlPath *field.Path) {
+		if proxyURL == nil {
+			return
+		}
+		if u, err := url.Parse(*proxyURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(urlPath, *proxyURL, "must be a valid http or https url"))
+		}
+	}
+	validateProxyURL(network.Proxy.HTTPProxy, proxyPath.Child("httpProxy"))
+	validateProxyURL(network.Proxy.HTTPSProxy, proxyPath.Child("httpsProxy"))
+	return allErrs
+}
+
+// validateVPCEgress validates an existing public gateway is only referenced for the PublicGateway egress mode.
+func validateVPCEgress(egress *infrav1.VPCEgress, egressPath *field.Path) (allErrs field.ErrorList) {
+	if egress == nil || egress.PublicGateway == nil {
+		return nil
+	}
+	if egress.Mode == infrav1.VPCEgressModeNone {
+		allErrs = append(allErrs, field.Forbidden(egressPath.Child("publicGateway"), "publicGateway is only supported when mode is PublicGateway"))
+	}
+	if egress.PublicGateway.ID == nil && egress.PublicGateway.Name == nil {
+		allErrs = append(allErrs, field.Required(egressPath.Child("publicGateway"), "one of id or name must be specified"))
+	}
+	return allErrs
+}
+
+// validateIBMVPCClusterLoadBalancerProfiles validates the network load balancer constraints of the cluster load balancers.
+func validateIBMVPCClusterLoadBalancerProfiles(vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	if vpcCluster.Spec.ControlPlaneLoadBalancer != nil {
+		allErrs = append(allErrs, validateVPCLoadBalancerProfile(*vpcCluster.Spec.ControlPlaneLoadBalancer, field.NewPath("spec", "controlPlaneLoadBalancer"))...)
+	}
+	if vpcCluster.Spec.Network != nil {
+		for i, loadBalancer := range vpcCluster.Spec.Network.LoadBalancers {
+			allErrs = append(allErrs, validateVPCLoadBalancerProfile(loadBalancer, field.NewPath("spec", "network", "loadBalancers").Index(i))...)
+		}
+	}
+	return allErrs
+}
+
+// validateVPCLoadBalancerProfile validates the constraints of a load balancer using the network load balancer profile.
+func validateVPCLoadBalancerProfile(loadBalancer infrav1.VPCLoadBalancerSpec, lbPath *field.Path) (allErrs field.ErrorList) {
+	if loadBalancer.Profile != infrav1.VPCLoadBalancerProfileNetworkFixed {
+		return nil
+	}
+	if len(loadBalancer.Subnets) > 1 {
+		allErrs = append(allErrs, field.Invalid(lbPath.Child("subnets"), loadBalancer.Subnets, "network load balancer supports only a single subnet"))
+	}
+	for i, listener := range loadBalancer.AdditionalListeners {
+		if listener.Protocol != nil && *listener.Protocol != infrav1.VPCLoadBalancerListenerProtocolTCP && *listener.Protocol != infrav1.VPCLoadBalancerListenerProtocolUDP {
+			allErrs = append(allErrs, field.NotSupported(lbPath.Child("additionalListeners").Index(i).Child("protocol"), *listener.Protocol, []string{string(infrav1.VPCLoadBalancerListenerProtocolTCP), string(infrav1.VPCLoadBalancerListenerProtocolUDP)}))
+		}
+	}
+	for i, pool := range loadBalancer.BackendPools {
+		if pool.Protocol != infrav1.VPCLoadBalancerBackendPoolProtocolTCP && pool.Protocol != infrav1.VPCLoadBalancerBackendPoolProtocolUDP {
+			allErrs = append(allErrs, field.NotSupported(lbPath.Child("backendPools").Index(i).Child("protocol"), pool.Protocol, []string{string(infrav1.VPCLoadBalancerBackendPoolProtocolTCP), string(infrav1.VPCLoadBalancerBackendPoolProtocolUDP)}))
+		}
+	}
+	return allErrs
+}
+
+// validateIBMVPCClusterLoadBalancerListeners validates the certificate and HTTPS redirect of the cluster load balancer listeners.
+func validateIBMVPCClusterLoadBalancerListeners(vpcCluster *infrav1.IBMVPCCluster) (allErrs field.ErrorList) {
+	if vpcCluster.Spec.ControlPlaneLoadBalancer != nil {
+		allErrs = append(allErrs, validateVPCLoadBalancerListeners(*vpcCluster.Spec.ControlPlaneLoadBalancer, field.NewPath("spec", "controlPlaneLoadBalancer"))...)
+	}
+	if vpcCluster.Spec.Network != nil {
+		for i, loadBalancer := range vpcCluster.Spec.Network.LoadBalancers {
+			allErrs = append(allErrs, validateVPCLoadBalancerListeners(loadBalancer, field.NewPath("spec", "network", "loadBalancers").Index(i))...)
+		}
+	}
+	return allErrs
+}
+
+// validateVPCLoadBalancerListeners validates the certificate and HTTPS redirect of the load balancer listeners.
+func validateVPCLoadBalancerListeners(loadBalancer infrav1.VPCLoadBalancerSpec, lbPath *field.Path) (allErrs field.ErrorList) {
+	httpsPorts := make(map[int64]bool)
+	for _, listener := range loadBalancer.AdditionalListeners {
+		if listener.Protocol != nil && *listener.Protocol == infrav1.VPCLoadBalancerListenerProtocolHTTPS {
+			httpsPorts[listener.Port] = true
+		}
+	}
+	for i, listener := range loadBalancer.AdditionalListeners {
+		listenerPath := lbPath.Child("additionalListeners").Index(i)
+		isHTTPS := listener.Protocol != nil && *listener.Protocol == infrav1.VPCLoadBalancerListenerProtocolHTTPS
+		if isHTTPS && listener.CertificateCRN == nil {
+			allErrs = append(allErrs, field.Required(listenerPath.Child("certificateCRN"), "certificateCRN is required for https listener"))
+		}
+		if !isHTTPS && listener.CertificateCRN != nil {
+			allErrs = append(allErrs, field.Forbidden(listenerPath.Child("certificateCRN"), "certificateCRN is only supported for https listener"))
+		}
+		if listener.HTTPSRedirect == nil {
+			continue
+		}
+		if listener.Protocol == nil || *listener.Protocol != infrav1.VPCLoadBalancerListenerProtocolHTTP {
+			allErrs = append(allErrs, field.Forbidden(listenerPath.Child("httpsRedirect"), "httpsRedirect is only supported for http listener"))
+		}
+		if !httpsPorts[listener.HTTPSRedirect.ListenerPort] {
+			allErrs = append(allErrs, field.Invalid(listenerPath.Child("httpsRedirect", "listenerPort"), listener.HTTPSRedirect.ListenerPort, "httpsRedirect must reference an https listener of the load balancer"))
+		}
+	}
+	return allErrs
+}
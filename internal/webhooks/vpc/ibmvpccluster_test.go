@@ -0,0 +1,602 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpc
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+)
+
+func Test_validateIBMVPCClusterDNS(t *testing.T) {
+	dns := &infrav1.DNSRecordSpec{
+		InstanceID: "dns-instance-id",
+		Zone:       "example.com",
+		RecordName: "api.capi",
+	}
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCClusterSpec
+		wantError bool
+	}{
+		{
+			name:      "DNS is not set",
+			spec:      infrav1.IBMVPCClusterSpec{},
+			wantError: false,
+		},
+		{
+			name: "DNS is set along with Network",
+			spec: infrav1.IBMVPCClusterSpec{
+				DNS:     dns,
+				Network: &infrav1.VPCNetworkSpec{},
+			},
+			wantError: false,
+		},
+		{
+			name: "DNS is set without Network",
+			spec: infrav1.IBMVPCClusterSpec{
+				DNS: dns,
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateIBMVPCClusterDNS(&infrav1.IBMVPCCluster{Spec: tt.spec})
+			if (err != nil) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterDNS() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterLoadBalancerProfiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      infrav1.IBMVPCClusterSpec
+		wantError bool
+	}{
+		{
+			name: "Application load balancer with multiple subnets and HTTP listener",
+			spec: infrav1.IBMVPCClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.VPCLoadBalancerSpec{
+					Name:                "capi-lb",
+					Subnets:             []infrav1.VPCResource{{ID: ptr.To("subnet-id-1")}, {ID: ptr.To("subnet-id-2")}},
+					AdditionalListeners: []infrav1.AdditionalListenerSpec{{Port: 80, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTP)}},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Network load balancer with TCP and UDP listeners and pools",
+			spec: infrav1.IBMVPCClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.VPCLoadBalancerSpec{
+					Name:    "capi-lb",
+					Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+					Subnets: []infrav1.VPCResource{{ID: ptr.To("subnet-id-1")}},
+					AdditionalListeners: []infrav1.AdditionalListenerSpec{
+						{Port: 22, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolTCP)},
+						{Port: 53, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolUDP)},
+					},
+					BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{
+						{Protocol: infrav1.VPCLoadBalancerBackendPoolProtocolTCP},
+						{Protocol: infrav1.VPCLoadBalancerBackendPoolProtocolUDP},
+					},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name: "Network load balancer with multiple subnets",
+			spec: infrav1.IBMVPCClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.VPCLoadBalancerSpec{
+					Name:    "capi-lb",
+					Profile: infrav1.VPCLoadBalancerProfileNetworkFixed,
+					Subnets: []infrav1.VPCResource{{ID: ptr.To("subnet-id-1")}, {ID: ptr.To("subnet-id-2")}},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Network load balancer with HTTP listener",
+			spec: infrav1.IBMVPCClusterSpec{
+				ControlPlaneLoadBalancer: &infrav1.VPCLoadBalancerSpec{
+					Name:                "capi-lb",
+					Profile:             infrav1.VPCLoadBalancerProfileNetworkFixed,
+					AdditionalListeners: []infrav1.AdditionalListenerSpec{{Port: 80, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTP)}},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Network load balancer in network with HTTP backend pool",
+			spec: infrav1.IBMVPCClusterSpec{
+				Network: &infrav1.VPCNetworkSpec{
+					LoadBalancers: []infrav1.VPCLoadBalancerSpec{
+						{
+							Name:         "capi-lb",
+							Profile:      infrav1.VPCLoadBalancerProfileNetworkFixed,
+							BackendPools: []infrav1.VPCLoadBalancerBackendPoolSpec{{Protocol: infrav1.VPCLoadBalancerBackendPoolProtocolHTTP}},
+						},
+					},
+				},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateIBMVPCClusterLoadBalancerProfiles(&infrav1.IBMVPCCluster{Spec: tt.spec})
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterLoadBalancerProfiles() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterLoadBalancerListeners(t *testing.T) {
+	certificateCRN := "crn:v1:bluemix:public:secrets-manager:us-south:a/account:instance:secret:cert"
+	tests := []struct {
+		name      string
+		listeners []infrav1.AdditionalListenerSpec
+		wantError bool
+	}{
+		{
+			name: "HTTPS listener with certificate and HTTP listener redirect",
+			listeners: []infrav1.AdditionalListenerSpec{
+				{Port: 80, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTP), HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{ListenerPort: 443}},
+				{Port: 443, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTPS), CertificateCRN: ptr.To(certificateCRN)},
+			},
+			wantError: false,
+		},
+		{
+			name: "HTTPS listener without certificate",
+			listeners: []infrav1.AdditionalListenerSpec{
+				{Port: 443, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTPS)},
+			},
+			wantError: true,
+		},
+		{
+			name: "TCP listener with certificate",
+			listeners: []infrav1.AdditionalListenerSpec{
+				{Port: 22, CertificateCRN: ptr.To(certificateCRN)},
+			},
+			wantError: true,
+		},
+		{
+			name: "TCP listener with HTTPS redirect",
+			listeners: []infrav1.AdditionalListenerSpec{
+				{Port: 22, HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{ListenerPort: 443}},
+				{Port: 443, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTPS), CertificateCRN: ptr.To(certificateCRN)},
+			},
+			wantError: true,
+		},
+		{
+			name: "HTTP listener redirect to a port without HTTPS listener",
+			listeners: []infrav1.AdditionalListenerSpec{
+				{Port: 80, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTP), HTTPSRedirect: &infrav1.VPCLoadBalancerListenerHTTPSRedirect{ListenerPort: 8443}},
+				{Port: 443, Protocol: ptr.To(infrav1.VPCLoadBalancerListenerProtocolHTTPS), CertificateCRN: ptr.To(certificateCRN)},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpcCluster := &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: &infrav1.VPCNetworkSpec{
+						LoadBalancers: []infrav1.VPCLoadBalancerSpec{{Name: "capi-lb", AdditionalListeners: tt.listeners}},
+					},
+				},
+			}
+			errs := validateIBMVPCClusterLoadBalancerListeners(vpcCluster)
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterLoadBalancerListeners() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterTransitGateway(t *testing.T) {
+	localVPCCRN := "crn:v1:bluemix:public:is:us-south:a/account::vpc:r006-local"
+	remoteVPCCRN := "crn:v1:bluemix:public:is:eu-de:a/account::vpc:r010-remote"
+	tests := []struct {
+		name           string
+		transitGateway *infrav1.VPCTransitGateway
+		wantError      bool
+	}{
+		{
+			name:      "Transit gateway is not set",
+			wantError: false,
+		},
+		{
+			name: "Connection to a VPC in the same region",
+			transitGateway: &infrav1.VPCTransitGateway{
+				VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "local", VPCCRN: localVPCCRN}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Connection to a VPC in the same region with local routing",
+			transitGateway: &infrav1.VPCTransitGateway{
+				GlobalRouting:  ptr.To(false),
+				VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "local", VPCCRN: localVPCCRN}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Connection to a VPC in another region with default routing",
+			transitGateway: &infrav1.VPCTransitGateway{
+				VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Connection to a VPC in another region with global routing",
+			transitGateway: &infrav1.VPCTransitGateway{
+				GlobalRouting:  ptr.To(true),
+				VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "remote", VPCCRN: remoteVPCCRN}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Connection to a VPC in another region with local routing",
+			transitGateway: &infrav1.VPCTransitGateway{
+				GlobalRouting: ptr.To(false),
+				VPCConnections: []infrav1.VPCTransitGatewayConnection{
+					{Name: "local", VPCCRN: localVPCCRN},
+					{Name: "remote", VPCCRN: remoteVPCCRN},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Connection with an invalid VPC CRN",
+			transitGateway: &infrav1.VPCTransitGateway{
+				VPCConnections: []infrav1.VPCTransitGatewayConnection{{Name: "invalid", VPCCRN: "r006-vpc-id"}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpcCluster := &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region: "us-south",
+				},
+			}
+			if tt.transitGateway != nil {
+				vpcCluster.Spec.Network = &infrav1.VPCNetworkSpec{TransitGateway: tt.transitGateway}
+			}
+			errs := validateIBMVPCClusterTransitGateway(vpcCluster)
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterTransitGateway() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterAddressPrefixes(t *testing.T) {
+	addressPrefixes := []infrav1.VPCAddressPrefix{
+		{CIDR: "10.240.0.0/18", Zone: "us-south-1"},
+		{CIDR: "10.240.64.0/18", Zone: "us-south-2"},
+	}
+	tests := []struct {
+		name      string
+		network   *infrav1.VPCNetworkSpec
+		wantError bool
+	}{
+		{
+			name:      "Network is not set",
+			wantError: false,
+		},
+		{
+			name: "Address prefixes with subnets within them",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes:     addressPrefixes,
+				ControlPlaneSubnets: []infrav1.Subnet{{Zone: ptr.To("us-south-1"), Ipv4CidrBlock: ptr.To("10.240.0.0/24")}},
+				WorkerSubnets:       []infrav1.Subnet{{Zone: ptr.To("us-south-2"), Ipv4CidrBlock: ptr.To("10.240.64.0/24")}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Manual address prefix management without address prefixes",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixManagement: infrav1.VPCAddressPrefixManagementManual,
+			},
+			wantError: true,
+		},
+		{
+			name: "Manual address prefix management with address prefixes",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixManagement: infrav1.VPCAddressPrefixManagementManual,
+				AddressPrefixes:         addressPrefixes,
+			},
+			wantError: false,
+		},
+		{
+			name: "Address prefix with an invalid cidr",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes: []infrav1.VPCAddressPrefix{{CIDR: "10.240.0.0", Zone: "us-south-1"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Address prefix with host bits set",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes: []infrav1.VPCAddressPrefix{{CIDR: "10.240.0.1/18", Zone: "us-south-1"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Address prefix with an IPv6 cidr",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes: []infrav1.VPCAddressPrefix{{CIDR: "fd00::/64", Zone: "us-south-1"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Overlapping address prefixes",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes: []infrav1.VPCAddressPrefix{
+					{CIDR: "10.240.0.0/16", Zone: "us-south-1"},
+					{CIDR: "10.240.64.0/18", Zone: "us-south-2"},
+				},
+			},
+			wantError: true,
+		},
+		{
+			name: "Subnet outside of the address prefixes of its zone",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes:     addressPrefixes,
+				ControlPlaneSubnets: []infrav1.Subnet{{Zone: ptr.To("us-south-1"), Ipv4CidrBlock: ptr.To("10.240.64.0/24")}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Subnet larger than the address prefix of its zone",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes: addressPrefixes,
+				WorkerSubnets:   []infrav1.Subnet{{Zone: ptr.To("us-south-1"), Ipv4CidrBlock: ptr.To("10.240.0.0/16")}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Subnet with an invalid cidr",
+			network: &infrav1.VPCNetworkSpec{
+				WorkerSubnets: []infrav1.Subnet{{Zone: ptr.To("us-south-1"), Ipv4CidrBlock: ptr.To("10.240.0.1/24")}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Subnet in a zone without address prefix and automatic address prefix management",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixes: addressPrefixes,
+				WorkerSubnets:   []infrav1.Subnet{{Zone: ptr.To("us-south-3"), Ipv4CidrBlock: ptr.To("10.240.128.0/24")}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Subnet in a zone without address prefix and manual address prefix management",
+			network: &infrav1.VPCNetworkSpec{
+				AddressPrefixManagement: infrav1.VPCAddressPrefixManagementManual,
+				AddressPrefixes:         addressPrefixes,
+				WorkerSubnets:           []infrav1.Subnet{{Zone: ptr.To("us-south-3"), Ipv4CidrBlock: ptr.To("10.240.128.0/24")}},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpcCluster := &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region:  "us-south",
+					Network: tt.network,
+				},
+			}
+			errs := validateIBMVPCClusterAddressPrefixes(vpcCluster)
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterAddressPrefixes() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterEgress(t *testing.T) {
+	tests := []struct {
+		name      string
+		network   *infrav1.VPCNetworkSpec
+		wantError bool
+	}{
+		{
+			name:      "Network is not set",
+			wantError: false,
+		},
+		{
+			name: "Network egress without public gateway",
+			network: &infrav1.VPCNetworkSpec{
+				Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone},
+			},
+			wantError: false,
+		},
+		{
+			name: "Subnet egress with an existing public gateway",
+			network: &infrav1.VPCNetworkSpec{
+				ControlPlaneSubnets: []infrav1.Subnet{{Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModePublicGateway, PublicGateway: &infrav1.VPCResource{Name: ptr.To("existing-pgw")}}}},
+			},
+			wantError: false,
+		},
+		{
+			name: "Network egress with an existing public gateway and mode None",
+			network: &infrav1.VPCNetworkSpec{
+				Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone, PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Worker subnet egress with an existing public gateway and mode None",
+			network: &infrav1.VPCNetworkSpec{
+				WorkerSubnets: []infrav1.Subnet{{Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone, PublicGateway: &infrav1.VPCResource{ID: ptr.To("pgw-id")}}}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Control plane subnet egress with an existing public gateway without id nor name",
+			network: &infrav1.VPCNetworkSpec{
+				ControlPlaneSubnets: []infrav1.Subnet{{Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModePublicGateway, PublicGateway: &infrav1.VPCResource{}}}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Proxy with http and https urls",
+			network: &infrav1.VPCNetworkSpec{
+				Egress: &infrav1.VPCEgress{Mode: infrav1.VPCEgressModeNone},
+				Proxy:  &infrav1.VPCProxy{HTTPProxy: ptr.To("http://proxy.example.com:3128"), HTTPSProxy: ptr.To("https://proxy.example.com:3129")},
+			},
+			wantError: false,
+		},
+		{
+			name: "Proxy without url",
+			network: &infrav1.VPCNetworkSpec{
+				Proxy: &infrav1.VPCProxy{NoProxy: []string{".example.com"}},
+			},
+			wantError: true,
+		},
+		{
+			name: "Proxy with an unsupported url scheme",
+			network: &infrav1.VPCNetworkSpec{
+				Proxy: &infrav1.VPCProxy{HTTPSProxy: ptr.To("socks5://proxy.example.com:1080")},
+			},
+			wantError: true,
+		},
+		{
+			name: "Proxy with an url without host",
+			network: &infrav1.VPCNetworkSpec{
+				Proxy: &infrav1.VPCProxy{HTTPProxy: ptr.To("proxy.example.com:3128")},
+			},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpcCluster := &infrav1.IBMVPCCluster{
+				Spec: infrav1.IBMVPCClusterSpec{
+					Network: tt.network,
+				},
+			}
+			errs := validateIBMVPCClusterEgress(vpcCluster)
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterEgress() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
+
+func Test_validateIBMVPCClusterResourceNameTemplate(t *testing.T) {
+	tests := []struct {
+		name                    string
+		oldResourceNameTemplate *string
+		resourceNameTemplate    *string
+		network                 *infrav1.VPCNetworkSpec
+		wantError               bool
+	}{
+		{
+			name:      "Resource name template is not set",
+			network:   &infrav1.VPCNetworkSpec{},
+			wantError: false,
+		},
+		{
+			name:                 "Resource name template rendering valid names",
+			resourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+			network:              &infrav1.VPCNetworkSpec{},
+			wantError:            false,
+		},
+		{
+			name:                 "Resource name template ending with the zone, which is empty for the regional resources",
+			resourceNameTemplate: ptr.To("{{ .ClusterName }}-{{ lower .ResourceType }}-{{ .Zone }}"),
+			network:              &infrav1.VPCNetworkSpec{},
+			wantError:            true,
+		},
+		{
+			name:                 "Resource name template without network",
+			resourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+			wantError:            true,
+		},
+		{
+			name:                 "Resource name template not referencing the resource type",
+			resourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ .ClusterName }}"),
+			network:              &infrav1.VPCNetworkSpec{},
+			wantError:            true,
+		},
+		{
+			name:                 "Resource name template rendering uppercase names",
+			resourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ .ResourceType }}"),
+			network:              &infrav1.VPCNetworkSpec{},
+			wantError:            true,
+		},
+		{
+			name:                 "Resource name template rendering names longer than 63 characters",
+			resourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}-aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+			network:              &infrav1.VPCNetworkSpec{},
+			wantError:            true,
+		},
+		{
+			name:                 "Resource name template which cannot be parsed",
+			resourceNameTemplate: ptr.To("fin-{{ .Namespace "),
+			network:              &infrav1.VPCNetworkSpec{},
+			wantError:            true,
+		},
+		{
+			name:                    "Resource name template is changed",
+			oldResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+			resourceNameTemplate:    ptr.To("hr-{{ .Namespace }}-{{ lower .ResourceType }}"),
+			network:                 &infrav1.VPCNetworkSpec{},
+			wantError:               true,
+		},
+		{
+			name:                    "Resource name template is unchanged",
+			oldResourceNameTemplate: ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+			resourceNameTemplate:    ptr.To("fin-{{ .Namespace }}-{{ lower .ResourceType }}"),
+			network:                 &infrav1.VPCNetworkSpec{},
+			wantError:               false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vpcCluster := &infrav1.IBMVPCCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "capi", Namespace: "default"},
+				Spec: infrav1.IBMVPCClusterSpec{
+					Region:               "us-south",
+					Network:              tt.network,
+					ResourceNameTemplate: tt.resourceNameTemplate,
+				},
+			}
+			var oldCluster *infrav1.IBMVPCCluster
+			if tt.oldResourceNameTemplate != nil {
+				oldCluster = vpcCluster.DeepCopy()
+				oldCluster.Spec.ResourceNameTemplate = tt.oldResourceNameTemplate
+			}
+			errs := validateIBMVPCClusterResourceNameTemplate(oldCluster, vpcCluster)
+			if (len(errs) != 0) != tt.wantError {
+				t.Errorf("validateIBMVPCClusterResourceNameTemplate() errors = %v, wantError %v", errs, tt.wantError)
+			}
+		})
+	}
+}
@@ -55,12 +55,14 @@ func (r *IBMVPCMachine) Default(_ context.Context, obj *infrav1.IBMVPCMachine) e
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMVPCMachine) ValidateCreate(_ context.Context, obj *infrav1.IBMVPCMachine) (admission.Warnings, error) {
 	allErrs := validateIBMVPCMachineVolume(obj.Spec)
+	allErrs = append(allErrs, validateTags(obj.Spec.Tags, field.NewPath("spec", "tags"))...)
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
-func (r *IBMVPCMachine) ValidateUpdate(_ context.Context, _, _ *infrav1.IBMVPCMachine) (warnings admission.Warnings, err error) {
-	return nil, nil
+func (r *IBMVPCMachine) ValidateUpdate(_ context.Context, _, newObj *infrav1.IBMVPCMachine) (warnings admission.Warnings, err error) {
+	allErrs := validateTags(newObj.Spec.Tags, field.NewPath("spec", "tags"))
+	return nil, aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type.
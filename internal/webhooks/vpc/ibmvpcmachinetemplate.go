@@ -19,6 +19,8 @@ package vpc
 import (
 	"context"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -53,6 +55,7 @@ func (r *IBMVPCMachineTemplate) Default(_ context.Context, obj *infrav1.IBMVPCMa
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type.
 func (r *IBMVPCMachineTemplate) ValidateCreate(_ context.Context, obj *infrav1.IBMVPCMachineTemplate) (admission.Warnings, error) {
 	allErrs := validateIBMVPCMachineVolume(obj.Spec.Template.Spec)
+	allErrs = append(allErrs, validateTags(obj.Spec.Template.Spec.Tags, field.NewPath("spec", "template", "spec", "tags"))...)
 	return nil, aggregateObjErrors(obj.GroupVersionKind().GroupKind(), obj.Name, allErrs)
 }
 
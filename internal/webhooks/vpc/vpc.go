@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/internal/genutil"
 )
 
 // IBM Cloud CRN validation regex.
@@ -79,3 +80,13 @@ func validateVolumes(spec infrav1.IBMVPCMachineSpec) field.ErrorList {
 func isValidCRN(crn string) bool {
 	return crnRegex.MatchString(crn)
 }
+
+// validateTags validates that the tags are valid IBM Cloud user tags.
+func validateTags(tags map[string]string, tagsPath *field.Path) (allErrs field.ErrorList) {
+	for key, value := range tags {
+		if err := genutil.ValidateUserTag(key, value); err != nil {
+			allErrs = append(allErrs, field.Invalid(tagsPath.Key(key), value, err.Error()))
+		}
+	}
+	return allErrs
+}
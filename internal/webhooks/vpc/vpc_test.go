@@ -17,8 +17,11 @@ limitations under the License.
 package vpc
 
 import (
+	"strings"
 	"testing"
 
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
 	infrav1 "sigs.k8s.io/cluster-api-provider-ibmcloud/api/vpc/v1beta2"
 )
 
@@ -93,3 +96,49 @@ func Test_validateVolumes(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateTags(t *testing.T) {
+	tests := []struct {
+		name      string
+		tags      map[string]string
+		wantError bool
+	}{
+		{
+			name:      "No tags",
+			tags:      nil,
+			wantError: false,
+		},
+		{
+			name:      "Valid tags",
+			tags:      map[string]string{"env": "prod", "team": "capi:ibm"},
+			wantError: false,
+		},
+		{
+			name:      "Invalid character in tag key",
+			tags:      map[string]string{"env/name": "prod"},
+			wantError: true,
+		},
+		{
+			name:      "Invalid character in tag value",
+			tags:      map[string]string{"env": "prod,dev"},
+			wantError: true,
+		},
+		{
+			name:      "Reserved cluster UID tag key",
+			tags:      map[string]string{"capibm-cluster-uid": "uid"},
+			wantError: true,
+		},
+		{
+			name:      "Tag too long",
+			tags:      map[string]string{"env": strings.Repeat("a", 128)},
+			wantError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateTags(tt.tags, field.NewPath("spec", "tags")); (err != nil) != tt.wantError {
+				t.Errorf("validateTags() = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+}
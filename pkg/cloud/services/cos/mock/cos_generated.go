@@ -137,6 +137,21 @@ func (mr *MockCosMockRecorder) GetObjectRequest(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectRequest", reflect.TypeOf((*MockCos)(nil).GetObjectRequest), arg0)
 }
 
+// HeadObject mocks base method.
+func (m *MockCos) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HeadObject", input)
+	ret0, _ := ret[0].(*s3.HeadObjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HeadObject indicates an expected call of HeadObject.
+func (mr *MockCosMockRecorder) HeadObject(input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HeadObject", reflect.TypeOf((*MockCos)(nil).HeadObject), input)
+}
+
 // ListObjects mocks base method.
 func (m *MockCos) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
 	m.ctrl.T.Helper()
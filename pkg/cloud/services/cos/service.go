@@ -25,6 +25,7 @@ import (
 	"golang.org/x/net/http/httpproxy"
 
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	cosSession "github.com/IBM/ibm-cos-sdk-go/aws/session"
@@ -75,6 +76,11 @@ func (s *Service) GetObjectRequest(input *s3.GetObjectInput) (*request.Request,
 	return s.client.GetObjectRequest(input)
 }
 
+// HeadObject returns the metadata of the object.
+func (s *Service) HeadObject(input *s3.HeadObjectInput) (*s3.HeadObjectOutput, error) {
+	return s.client.HeadObject(input)
+}
+
 // ListObjects returns the list of objects in a bucket.
 func (s *Service) ListObjects(input *s3.ListObjectsInput) (*s3.ListObjectsOutput, error) {
 	return s.client.ListObjects(input)
@@ -100,6 +106,16 @@ func NewServiceWrapper(options ServiceOptions, apikey, serviceInstance string) (
 
 // NewService returns a new service for the IBM Cloud Resource Controller api client.
 func NewService(options ServiceOptions, apikey, serviceInstance string) (Cos, error) {
+	return newService(options, ibmiam.NewStaticCredentials(aws.NewConfig(), iamEndpoint, apikey, serviceInstance))
+}
+
+// NewAnonymousService returns a new service for the IBM Cloud COS api client with anonymous credentials,
+// which only allows to read objects from public buckets.
+func NewAnonymousService(options ServiceOptions) (Cos, error) {
+	return newService(options, credentials.AnonymousCredentials)
+}
+
+func newService(options ServiceOptions, creds *credentials.Credentials) (Cos, error) {
 	if options.Options == nil {
 		options.Options = &cosSession.Options{}
 	}
@@ -121,7 +137,7 @@ func NewService(options ServiceOptions, apikey, serviceInstance string) (Cos, er
 			ExpectContinueTimeout: 1 * time.Second,
 		},
 	}
-	options.Config.Credentials = ibmiam.NewStaticCredentials(aws.NewConfig(), iamEndpoint, apikey, serviceInstance)
+	options.Config.Credentials = creds
 
 	sess, err := cosSession.NewSessionWithOptions(*options.Options)
 	if err != nil {
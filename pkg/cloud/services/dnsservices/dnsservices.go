@@ -0,0 +1,35 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsservices
+
+import (
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+//go:generate ../../../../hack/tools/bin/mockgen -source=./dnsservices.go -destination=./mock/dnsservices_generated.go -package=mock
+//go:generate /usr/bin/env bash -c "cat ../../../../hack/boilerplate/boilerplate.generatego.txt ./mock/dnsservices_generated.go > ./mock/_dnsservices_generated.go && mv ./mock/_dnsservices_generated.go ./mock/dnsservices_generated.go"
+
+// DNSServices interface defines a method that a IBMCLOUD service object should implement in order to
+// use the dnsservices package for managing DNS zones and resource records.
+type DNSServices interface {
+	GetDNSZoneByName(instanceID, zoneName string) (*dnssvcsv1.Dnszone, error)
+	GetResourceRecordByName(instanceID, zoneID, recordName string) (*dnssvcsv1.ResourceRecord, error)
+	CreateResourceRecord(*dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	UpdateResourceRecord(*dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error)
+	DeleteResourceRecord(*dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsservices
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// RecordType returns the resource record type used to resolve to target, A for an IPv4 address and CNAME otherwise.
+func RecordType(target string) string {
+	if ip := net.ParseIP(target); ip != nil && ip.To4() != nil {
+		return dnssvcsv1.ResourceRecord_Type_A
+	}
+	return dnssvcsv1.ResourceRecord_Type_Cname
+}
+
+// InputRdata returns the record data used while creating a resource record of recordType resolving to target.
+func InputRdata(recordType, target string) dnssvcsv1.ResourceRecordInputRdataIntf {
+	if recordType == dnssvcsv1.ResourceRecord_Type_A {
+		return &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: ptr.To(target)}
+	}
+	return &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: ptr.To(target)}
+}
+
+// UpdateInputRdata returns the record data used while updating a resource record of recordType to resolve to target.
+func UpdateInputRdata(recordType, target string) dnssvcsv1.ResourceRecordUpdateInputRdataIntf {
+	if recordType == dnssvcsv1.ResourceRecord_Type_A {
+		return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: ptr.To(target)}
+	}
+	return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord{Cname: ptr.To(target)}
+}
+
+// RecordTarget returns the IP address or canonical name an A or CNAME resource record resolves to.
+func RecordTarget(record *dnssvcsv1.ResourceRecord) string {
+	if record == nil || record.Rdata == nil {
+		return ""
+	}
+	for _, key := range []string{"ip", "cname"} {
+		if value, ok := record.Rdata[key].(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// RecordSpec defines the resource record to be reconciled in a DNS Services instance.
+// It matches the DNSRecordSpec of the cluster APIs, so it can be converted from them directly.
+type RecordSpec struct {
+	InstanceID string
+	Zone       string
+	RecordName string
+	TTL        *int64
+}
+
+// RecordStatus defines the observed state of a resource record reconciled in a DNS Services instance.
+// It matches the DNSRecordStatus of the cluster APIs, so it can be converted to and from them directly.
+type RecordStatus struct {
+	ZoneID            *string
+	ID                *string
+	Hostname          *string
+	Target            *string
+	ControllerCreated *bool
+}
+
+// HostName returns the fully qualified name of the record, RECORD_NAME.ZONE.
+func (r RecordSpec) HostName() string {
+	return fmt.Sprintf("%s.%s", r.RecordName, strings.TrimSuffix(r.Zone, "."))
+}
+
+// ReconcileRecord reconciles the record defined by spec to resolve to target, using a CNAME record for a hostname
+// and an A record for an IPv4 address. A record previously reconciled under a different name is deleted first.
+// The returned status is the one to be persisted, even when an error is returned.
+func ReconcileRecord(ctx context.Context, client DNSServices, spec RecordSpec, status *RecordStatus, target string) (*RecordStatus, error) {
+	log := ctrl.LoggerFrom(ctx)
+	hostName := spec.HostName()
+
+	// remove the previously created record when the record name or zone has changed.
+	if status != nil && ptr.Deref(status.Hostname, "") != hostName {
+		if err := DeleteRecord(ctx, client, spec.InstanceID, status); err != nil {
+			return status, err
+		}
+		status = nil
+	}
+
+	zoneID, err := getZoneID(client, spec, status)
+	if err != nil {
+		return status, err
+	}
+
+	recordType := RecordType(target)
+	record, err := client.GetResourceRecordByName(spec.InstanceID, zoneID, hostName)
+	if err != nil {
+		return status, fmt.Errorf("failed to fetch DNS record %s: %w", hostName, err)
+	}
+
+	if record == nil {
+		log.Info("Creating DNS record", "hostname", hostName, "type", recordType, "target", target)
+		record, _, err = client.CreateResourceRecord(&dnssvcsv1.CreateResourceRecordOptions{
+			InstanceID: ptr.To(spec.InstanceID),
+			DnszoneID:  ptr.To(zoneID),
+			Type:       ptr.To(recordType),
+			Name:       ptr.To(hostName),
+			Rdata:      InputRdata(recordType, target),
+			TTL:        spec.TTL,
+		})
+		if err != nil {
+			return status, fmt.Errorf("failed to create DNS record %s: %w", hostName, err)
+		}
+		return recordStatus(status, hostName, zoneID, record, target, true), nil
+	}
+
+	if record.Type == nil || *record.Type != recordType {
+		return status, fmt.Errorf("DNS record %s already exists with type %s, expected type %s", hostName, ptr.Deref(record.Type, ""), recordType)
+	}
+
+	// update the record when it no longer resolves to the target, such as after a load balancer replacement.
+	if RecordTarget(record) != target || (spec.TTL != nil && ptr.Deref(record.TTL, 0) != *spec.TTL) {
+		log.Info("Updating DNS record", "hostname", hostName, "type", recordType, "target", target)
+		record, _, err = client.UpdateResourceRecord(&dnssvcsv1.UpdateResourceRecordOptions{
+			InstanceID: ptr.To(spec.InstanceID),
+			DnszoneID:  ptr.To(zoneID),
+			RecordID:   record.ID,
+			Name:       ptr.To(hostName),
+			Rdata:      UpdateInputRdata(recordType, target),
+			TTL:        spec.TTL,
+		})
+		if err != nil {
+			return status, fmt.Errorf("failed to update DNS record %s: %w", hostName, err)
+		}
+	}
+	return recordStatus(status, hostName, zoneID, record, target, false), nil
+}
+
+// DeleteRecord deletes the record tracked in status, if it is created by the controller.
+func DeleteRecord(ctx context.Context, client DNSServices, instanceID string, status *RecordStatus) error {
+	log := ctrl.LoggerFrom(ctx)
+	if status == nil {
+		return nil
+	}
+	if status.ID == nil || status.ZoneID == nil || !ptr.Deref(status.ControllerCreated, false) {
+		log.Info("Skipping DNS record deletion as resource is not created by controller")
+		return nil
+	}
+
+	log.Info("Deleting DNS record", "hostname", ptr.Deref(status.Hostname, ""))
+	if resp, err := client.DeleteResourceRecord(&dnssvcsv1.DeleteResourceRecordOptions{
+		InstanceID: ptr.To(instanceID),
+		DnszoneID:  status.ZoneID,
+		RecordID:   status.ID,
+	}); err != nil && (resp == nil || resp.StatusCode != http.StatusNotFound) {
+		return fmt.Errorf("failed to delete DNS record %s: %w", ptr.Deref(status.Hostname, ""), err)
+	}
+	return nil
+}
+
+// getZoneID returns the id of the zone defined in spec, reusing the id in status when the record is already reconciled.
+func getZoneID(client DNSServices, spec RecordSpec, status *RecordStatus) (string, error) {
+	if status != nil && status.ZoneID != nil && ptr.Deref(status.Hostname, "") == spec.HostName() {
+		return *status.ZoneID, nil
+	}
+	zone, err := client.GetDNSZoneByName(spec.InstanceID, spec.Zone)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch DNS zone %s: %w", spec.Zone, err)
+	}
+	if zone == nil || zone.ID == nil {
+		return "", fmt.Errorf("DNS zone %s not found in DNS Services instance %s", spec.Zone, spec.InstanceID)
+	}
+	return *zone.ID, nil
+}
+
+// recordStatus returns the status of the reconciled record, retaining whether the controller created it
+// when the record is already tracked in status.
+func recordStatus(status *RecordStatus, hostName, zoneID string, record *dnssvcsv1.ResourceRecord, target string, controllerCreated bool) *RecordStatus {
+	if status != nil && status.ID != nil && record.ID != nil && *status.ID == *record.ID {
+		controllerCreated = controllerCreated || ptr.Deref(status.ControllerCreated, false)
+	}
+	return &RecordStatus{
+		ZoneID:            ptr.To(zoneID),
+		ID:                record.ID,
+		Hostname:          ptr.To(hostName),
+		Target:            ptr.To(target),
+		ControllerCreated: ptr.To(controllerCreated),
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsservices
+
+import (
+	"fmt"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-ibmcloud/pkg/cloud/services/authenticator"
+)
+
+// listLimit is the page size used while listing DNS zones and resource records.
+const listLimit int64 = 100
+
+// Service holds the IBM Cloud DNS Services specific information.
+type Service struct {
+	client *dnssvcsv1.DnsSvcsV1
+}
+
+// NewService returns a new service for the IBM Cloud DNS Services api client.
+func NewService(options *dnssvcsv1.DnsSvcsV1Options) (DNSServices, error) {
+	if options == nil {
+		options = &dnssvcsv1.DnsSvcsV1Options{}
+	}
+	if options.Authenticator == nil {
+		auth, err := authenticator.GetAuthenticator()
+		if err != nil {
+			return nil, err
+		}
+		options.Authenticator = auth
+	}
+	client, err := dnssvcsv1.NewDnsSvcsV1(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		client: client,
+	}, nil
+}
+
+// GetDNSZoneByName returns the DNS zone with given name in the DNS Services instance. If not found, returns nil.
+func (s *Service) GetDNSZoneByName(instanceID, zoneName string) (*dnssvcsv1.Dnszone, error) {
+	var offset int64
+	for {
+		zones, _, err := s.client.ListDnszones(&dnssvcsv1.ListDnszonesOptions{
+			InstanceID: ptr.To(instanceID),
+			Offset:     ptr.To(offset),
+			Limit:      ptr.To(listLimit),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list DNS zones: %w", err)
+		}
+		for _, zone := range zones.Dnszones {
+			if zone.Name != nil && *zone.Name == zoneName {
+				return &zone, nil
+			}
+		}
+		offset += int64(len(zones.Dnszones))
+		if len(zones.Dnszones) == 0 || zones.TotalCount == nil || offset >= *zones.TotalCount {
+			return nil, nil
+		}
+	}
+}
+
+// GetResourceRecordByName returns the resource record with given fully qualified name in the DNS zone. If not found, returns nil.
+func (s *Service) GetResourceRecordByName(instanceID, zoneID, recordName string) (*dnssvcsv1.ResourceRecord, error) {
+	var offset int64
+	for {
+		records, _, err := s.client.ListResourceRecords(&dnssvcsv1.ListResourceRecordsOptions{
+			InstanceID: ptr.To(instanceID),
+			DnszoneID:  ptr.To(zoneID),
+			Name:       ptr.To(recordName),
+			Offset:     ptr.To(offset),
+			Limit:      ptr.To(listLimit),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resource records: %w", err)
+		}
+		for _, record := range records.ResourceRecords {
+			if record.Name != nil && *record.Name == recordName {
+				return &record, nil
+			}
+		}
+		offset += int64(len(records.ResourceRecords))
+		if len(records.ResourceRecords) == 0 || records.TotalCount == nil || offset >= *records.TotalCount {
+			return nil, nil
+		}
+	}
+}
+
+// CreateResourceRecord creates a resource record in the DNS zone.
+func (s *Service) CreateResourceRecord(options *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	return s.client.CreateResourceRecord(options)
+}
+
+// UpdateResourceRecord updates a resource record in the DNS zone.
+func (s *Service) UpdateResourceRecord(options *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	return s.client.UpdateResourceRecord(options)
+}
+
+// DeleteResourceRecord deletes a resource record from the DNS zone.
+func (s *Service) DeleteResourceRecord(options *dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error) {
+	return s.client.DeleteResourceRecord(options)
+}
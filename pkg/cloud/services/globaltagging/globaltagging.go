@@ -30,4 +30,7 @@ type GlobalTagging interface {
 	CreateTag(*globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error)
 	AttachTag(*globaltaggingv1.AttachTagOptions) (*globaltaggingv1.TagResults, *core.DetailedResponse, error)
 	GetTagByName(string) (*globaltaggingv1.Tag, error)
+	AttachUserTags(tagNames []string, resourceCRNs []string) error
+	DetachUserTags(tagNames []string, resourceCRNs []string) error
+	GetAttachedUserTags(resourceCRN string) ([]string, error)
 }
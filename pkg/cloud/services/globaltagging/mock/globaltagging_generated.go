@@ -72,6 +72,20 @@ func (mr *MockGlobalTaggingMockRecorder) AttachTag(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachTag", reflect.TypeOf((*MockGlobalTagging)(nil).AttachTag), arg0)
 }
 
+// AttachUserTags mocks base method.
+func (m *MockGlobalTagging) AttachUserTags(tagNames, resourceCRNs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachUserTags", tagNames, resourceCRNs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AttachUserTags indicates an expected call of AttachUserTags.
+func (mr *MockGlobalTaggingMockRecorder) AttachUserTags(tagNames, resourceCRNs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachUserTags", reflect.TypeOf((*MockGlobalTagging)(nil).AttachUserTags), tagNames, resourceCRNs)
+}
+
 // CreateTag mocks base method.
 func (m *MockGlobalTagging) CreateTag(arg0 *globaltaggingv1.CreateTagOptions) (*globaltaggingv1.CreateTagResults, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +102,35 @@ func (mr *MockGlobalTaggingMockRecorder) CreateTag(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockGlobalTagging)(nil).CreateTag), arg0)
 }
 
+// DetachUserTags mocks base method.
+func (m *MockGlobalTagging) DetachUserTags(tagNames, resourceCRNs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachUserTags", tagNames, resourceCRNs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DetachUserTags indicates an expected call of DetachUserTags.
+func (mr *MockGlobalTaggingMockRecorder) DetachUserTags(tagNames, resourceCRNs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachUserTags", reflect.TypeOf((*MockGlobalTagging)(nil).DetachUserTags), tagNames, resourceCRNs)
+}
+
+// GetAttachedUserTags mocks base method.
+func (m *MockGlobalTagging) GetAttachedUserTags(resourceCRN string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttachedUserTags", resourceCRN)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttachedUserTags indicates an expected call of GetAttachedUserTags.
+func (mr *MockGlobalTaggingMockRecorder) GetAttachedUserTags(resourceCRN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttachedUserTags", reflect.TypeOf((*MockGlobalTagging)(nil).GetAttachedUserTags), resourceCRN)
+}
+
 // GetTagByName mocks base method.
 func (m *MockGlobalTagging) GetTagByName(arg0 string) (*globaltaggingv1.Tag, error) {
 	m.ctrl.T.Helper()
@@ -17,6 +17,7 @@ limitations under the License.
 package globaltagging
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -75,6 +76,87 @@ func (s *Service) GetTagByName(tagName string) (*globaltaggingv1.Tag, error) {
 	return nil, nil
 }
 
+// AttachUserTags attaches the user tags to the resources, the tags that do not exist are created.
+func (s *Service) AttachUserTags(tagNames []string, resourceCRNs []string) error {
+	if len(tagNames) == 0 || len(resourceCRNs) == 0 {
+		return nil
+	}
+	options := s.client.NewAttachTagOptions()
+	options.SetResources(tagResources(resourceCRNs))
+	options.SetTagNames(tagNames)
+	options.SetTagType(globaltaggingv1.AttachTagOptionsTagTypeUserConst)
+
+	result, _, err := s.client.AttachTag(options)
+	if err != nil {
+		return fmt.Errorf("failed attaching user tags: %w", err)
+	}
+	return tagResultsError(result)
+}
+
+// DetachUserTags detaches the user tags from the resources.
+func (s *Service) DetachUserTags(tagNames []string, resourceCRNs []string) error {
+	if len(tagNames) == 0 || len(resourceCRNs) == 0 {
+		return nil
+	}
+	options := s.client.NewDetachTagOptions()
+	options.SetResources(tagResources(resourceCRNs))
+	options.SetTagNames(tagNames)
+	options.SetTagType(globaltaggingv1.DetachTagOptionsTagTypeUserConst)
+
+	result, _, err := s.client.DetachTag(options)
+	if err != nil {
+		return fmt.Errorf("failed detaching user tags: %w", err)
+	}
+	return tagResultsError(result)
+}
+
+// GetAttachedUserTags returns the names of the user tags attached to the resource.
+func (s *Service) GetAttachedUserTags(resourceCRN string) ([]string, error) {
+	options := s.client.NewListTagsOptions()
+	options.SetTagType(globaltaggingv1.ListTagsOptionsTagTypeUserConst)
+	options.SetProviders([]string{globaltaggingv1.ListTagsOptionsProvidersGhostConst})
+	options.SetAttachedTo(resourceCRN)
+
+	result, _, err := s.client.ListTags(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed listing user tags attached to resource %s: %w", resourceCRN, err)
+	}
+	if result == nil {
+		return nil, nil
+	}
+	tagNames := make([]string, 0, len(result.Items))
+	for _, tag := range result.Items {
+		if tag.Name != nil {
+			tagNames = append(tagNames, *tag.Name)
+		}
+	}
+	return tagNames, nil
+}
+
+func tagResources(resourceCRNs []string) []globaltaggingv1.Resource {
+	resources := make([]globaltaggingv1.Resource, 0, len(resourceCRNs))
+	for _, crn := range resourceCRNs {
+		resources = append(resources, globaltaggingv1.Resource{
+			ResourceID: ptr.To(crn),
+		})
+	}
+	return resources
+}
+
+// tagResultsError returns the errors reported for the resources of an attach or detach operation.
+func tagResultsError(result *globaltaggingv1.TagResults) error {
+	if result == nil {
+		return nil
+	}
+	var errs []error
+	for _, item := range result.Results {
+		if item.IsError != nil && *item.IsError {
+			errs = append(errs, fmt.Errorf("resource %s: %s", ptr.Deref(item.ResourceID, ""), ptr.Deref(item.Message, "")))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // NewService returns a new service for the IBM Cloud Global Tagging api client.
 func NewService(options ServiceOptions) (*Service, error) {
 	if options.GlobalTaggingV1Options == nil {
@@ -86,6 +86,21 @@ func (mr *MockPowerVSMockRecorder) CreateDHCPServer(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDHCPServer", reflect.TypeOf((*MockPowerVS)(nil).CreateDHCPServer), arg0)
 }
 
+// CreateImage mocks base method.
+func (m *MockPowerVS) CreateImage(body *models.CreateImage) (*models.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateImage", body)
+	ret0, _ := ret[0].(*models.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateImage indicates an expected call of CreateImage.
+func (mr *MockPowerVSMockRecorder) CreateImage(body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateImage", reflect.TypeOf((*MockPowerVS)(nil).CreateImage), body)
+}
+
 // CreateInstance mocks base method.
 func (m *MockPowerVS) CreateInstance(body *models.PVMInstanceCreate) (*models.PVMInstanceList, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +232,66 @@ func (mr *MockPowerVSMockRecorder) GetAllNetwork() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllNetwork", reflect.TypeOf((*MockPowerVS)(nil).GetAllNetwork))
 }
 
+// GetAllStockImages mocks base method.
+func (m *MockPowerVS) GetAllStockImages() (*models.Images, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStockImages")
+	ret0, _ := ret[0].(*models.Images)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStockImages indicates an expected call of GetAllStockImages.
+func (mr *MockPowerVSMockRecorder) GetAllStockImages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStockImages", reflect.TypeOf((*MockPowerVS)(nil).GetAllStockImages))
+}
+
+// GetAllStoragePoolsCapacity mocks base method.
+func (m *MockPowerVS) GetAllStoragePoolsCapacity() (*models.StoragePoolsCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStoragePoolsCapacity")
+	ret0, _ := ret[0].(*models.StoragePoolsCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStoragePoolsCapacity indicates an expected call of GetAllStoragePoolsCapacity.
+func (mr *MockPowerVSMockRecorder) GetAllStoragePoolsCapacity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStoragePoolsCapacity", reflect.TypeOf((*MockPowerVS)(nil).GetAllStoragePoolsCapacity))
+}
+
+// GetAllStorageTypesCapacity mocks base method.
+func (m *MockPowerVS) GetAllStorageTypesCapacity() (*models.StorageTypesCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllStorageTypesCapacity")
+	ret0, _ := ret[0].(*models.StorageTypesCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllStorageTypesCapacity indicates an expected call of GetAllStorageTypesCapacity.
+func (mr *MockPowerVSMockRecorder) GetAllStorageTypesCapacity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllStorageTypesCapacity", reflect.TypeOf((*MockPowerVS)(nil).GetAllStorageTypesCapacity))
+}
+
+// GetCloudInstance mocks base method.
+func (m *MockPowerVS) GetCloudInstance(id string) (*models.CloudInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloudInstance", id)
+	ret0, _ := ret[0].(*models.CloudInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloudInstance indicates an expected call of GetCloudInstance.
+func (mr *MockPowerVSMockRecorder) GetCloudInstance(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudInstance", reflect.TypeOf((*MockPowerVS)(nil).GetCloudInstance), id)
+}
+
 // GetCosImages mocks base method.
 func (m *MockPowerVS) GetCosImages(id string) (*models.Job, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +322,21 @@ func (mr *MockPowerVSMockRecorder) GetDHCPServer(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDHCPServer", reflect.TypeOf((*MockPowerVS)(nil).GetDHCPServer), id)
 }
 
+// GetDatacenter mocks base method.
+func (m *MockPowerVS) GetDatacenter(zone string) (*models.Datacenter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatacenter", zone)
+	ret0, _ := ret[0].(*models.Datacenter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatacenter indicates an expected call of GetDatacenter.
+func (mr *MockPowerVSMockRecorder) GetDatacenter(zone any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatacenter", reflect.TypeOf((*MockPowerVS)(nil).GetDatacenter), zone)
+}
+
 // GetDatacenterCapabilities mocks base method.
 func (m *MockPowerVS) GetDatacenterCapabilities(zone string) (map[string]bool, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +427,21 @@ func (mr *MockPowerVSMockRecorder) GetNetworkByName(networkName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByName", reflect.TypeOf((*MockPowerVS)(nil).GetNetworkByName), networkName)
 }
 
+// GetVolume mocks base method.
+func (m *MockPowerVS) GetVolume(id string) (*models.Volume, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolume", id)
+	ret0, _ := ret[0].(*models.Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolume indicates an expected call of GetVolume.
+func (mr *MockPowerVSMockRecorder) GetVolume(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolume", reflect.TypeOf((*MockPowerVS)(nil).GetVolume), id)
+}
+
 // WithClients mocks base method.
 func (m *MockPowerVS) WithClients(options powervs.ServiceOptions) *powervs.Service {
 	m.ctrl.T.Helper()
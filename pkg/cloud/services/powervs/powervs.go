@@ -32,9 +32,12 @@ type PowerVS interface {
 	GetAllNetwork() (*models.Networks, error)
 	GetNetworkByID(id string) (*models.Network, error)
 	GetInstance(id string) (*models.PVMInstance, error)
+	GetVolume(id string) (*models.Volume, error)
 	GetImage(id string) (*models.Image, error)
 	DeleteImage(id string) error
 	CreateCosImage(body *models.CreateCosImageImportJob) (*models.JobReference, error)
+	CreateImage(body *models.CreateImage) (*models.Image, error)
+	GetAllStockImages() (*models.Images, error)
 	GetCosImages(id string) (*models.Job, error)
 	GetJob(id string) (*models.Job, error)
 	DeleteJob(id string) error
@@ -45,4 +48,8 @@ type PowerVS interface {
 	WithClients(options ServiceOptions) *Service
 	GetNetworkByName(networkName string) (*models.NetworkReference, error)
 	GetDatacenterCapabilities(zone string) (map[string]bool, error)
+	GetCloudInstance(id string) (*models.CloudInstance, error)
+	GetAllStorageTypesCapacity() (*models.StorageTypesCapacity, error)
+	GetAllStoragePoolsCapacity() (*models.StoragePoolsCapacity, error)
+	GetDatacenter(zone string) (*models.Datacenter, error)
 }
@@ -40,6 +40,10 @@ type Service struct {
 	imageClient    *instance.IBMPIImageClient
 	jobClient      *instance.IBMPIJobClient
 	dhcpClient     *instance.IBMPIDhcpClient
+	volumeClient   *instance.IBMPIVolumeClient
+
+	cloudInstanceClient   *instance.IBMPICloudInstanceClient
+	storageCapacityClient *instance.IBMPIStorageCapacityClient
 }
 
 // ServiceOptions holds the PowerVS Service Options specific information.
@@ -84,6 +88,9 @@ func (s *Service) WithClients(options ServiceOptions) *Service {
 	s.imageClient = instance.NewIBMPIImageClient(ctx, s.session, options.CloudInstanceID)
 	s.jobClient = instance.NewIBMPIJobClient(ctx, s.session, options.CloudInstanceID)
 	s.dhcpClient = instance.NewIBMPIDhcpClient(ctx, s.session, options.CloudInstanceID)
+	s.volumeClient = instance.NewIBMPIVolumeClient(ctx, s.session, options.CloudInstanceID)
+	s.cloudInstanceClient = instance.NewIBMPICloudInstanceClient(ctx, s.session, options.CloudInstanceID)
+	s.storageCapacityClient = instance.NewIBMPIStorageCapacityClient(ctx, s.session, options.CloudInstanceID)
 	return s
 }
 
@@ -107,6 +114,11 @@ func (s *Service) GetInstance(id string) (*models.PVMInstance, error) {
 	return s.instanceClient.Get(id)
 }
 
+// GetVolume returns the volume in the Power VS service instance.
+func (s *Service) GetVolume(id string) (*models.Volume, error) {
+	return s.volumeClient.Get(id)
+}
+
 // GetImage returns the image in the Power VS service instance.
 func (s *Service) GetImage(id string) (*models.Image, error) {
 	return s.imageClient.Get(id)
@@ -127,6 +139,16 @@ func (s *Service) CreateCosImage(body *models.CreateCosImageImportJob) (*models.
 	return s.imageClient.CreateCosImage(body)
 }
 
+// CreateImage creates the image in the Power VS service instance, used to copy a stock image into the service instance.
+func (s *Service) CreateImage(body *models.CreateImage) (*models.Image, error) {
+	return s.imageClient.Create(body)
+}
+
+// GetAllStockImages returns all the stock images in the catalog of the Power VS zone.
+func (s *Service) GetAllStockImages() (*models.Images, error) {
+	return s.imageClient.GetAllStockImages(false, false)
+}
+
 // GetCosImages returns the last import job in the Power VS service instance.
 func (s *Service) GetCosImages(id string) (*models.Job, error) {
 	params := p_cloud_images.NewPcloudV1CloudinstancesCosimagesGetParams().WithCloudInstanceID(id)
@@ -193,6 +215,34 @@ func (s *Service) GetNetworkByName(networkName string) (*models.NetworkReference
 	return network, nil
 }
 
+// GetCloudInstance returns the Power VS service instance along with its usage and limits.
+func (s *Service) GetCloudInstance(id string) (*models.CloudInstance, error) {
+	return s.cloudInstanceClient.Get(id)
+}
+
+// GetAllStorageTypesCapacity returns the capacity of the storage types available in the Power VS service instance.
+func (s *Service) GetAllStorageTypesCapacity() (*models.StorageTypesCapacity, error) {
+	return s.storageCapacityClient.GetAllStorageTypesCapacity()
+}
+
+// GetAllStoragePoolsCapacity returns the capacity of the storage pools available in the Power VS service instance.
+func (s *Service) GetAllStoragePoolsCapacity() (*models.StoragePoolsCapacity, error) {
+	return s.storageCapacityClient.GetAllStoragePoolsCapacity()
+}
+
+// GetDatacenter fetches the datacenter details for the given zone.
+func (s *Service) GetDatacenter(zone string) (*models.Datacenter, error) {
+	params := datacenters.NewV1DatacentersGetParamsWithContext(context.TODO()).WithDatacenterRegion(zone)
+	datacenter, err := s.session.Power.Datacenters.V1DatacentersGet(params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datacenter details for zone: %s err:%w", zone, err)
+	}
+	if datacenter == nil || datacenter.Payload == nil {
+		return nil, fmt.Errorf("failed to get datacenter details for zone: %s", zone)
+	}
+	return datacenter.Payload, nil
+}
+
 // GetDatacenterCapabilities fetches the datacenter capabilities for the given zone.
 func (s *Service) GetDatacenterCapabilities(zone string) (map[string]bool, error) {
 	// though the function name is WithDatacenterRegion it takes zone as parameter
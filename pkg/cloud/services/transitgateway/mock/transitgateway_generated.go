@@ -88,6 +88,38 @@ func (mr *MockTransitGatewayMockRecorder) CreateTransitGatewayConnection(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitGatewayConnection", reflect.TypeOf((*MockTransitGateway)(nil).CreateTransitGatewayConnection), arg0)
 }
 
+// CreateTransitGatewayConnectionPrefixFilter mocks base method.
+func (m *MockTransitGateway) CreateTransitGatewayConnectionPrefixFilter(arg0 *transitgatewayapisv1.CreateTransitGatewayConnectionPrefixFilterOptions) (*transitgatewayapisv1.PrefixFilterCust, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitGatewayConnectionPrefixFilter", arg0)
+	ret0, _ := ret[0].(*transitgatewayapisv1.PrefixFilterCust)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTransitGatewayConnectionPrefixFilter indicates an expected call of CreateTransitGatewayConnectionPrefixFilter.
+func (mr *MockTransitGatewayMockRecorder) CreateTransitGatewayConnectionPrefixFilter(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitGatewayConnectionPrefixFilter", reflect.TypeOf((*MockTransitGateway)(nil).CreateTransitGatewayConnectionPrefixFilter), arg0)
+}
+
+// CreateTransitGatewayRouteReport mocks base method.
+func (m *MockTransitGateway) CreateTransitGatewayRouteReport(arg0 *transitgatewayapisv1.CreateTransitGatewayRouteReportOptions) (*transitgatewayapisv1.RouteReport, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransitGatewayRouteReport", arg0)
+	ret0, _ := ret[0].(*transitgatewayapisv1.RouteReport)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateTransitGatewayRouteReport indicates an expected call of CreateTransitGatewayRouteReport.
+func (mr *MockTransitGatewayMockRecorder) CreateTransitGatewayRouteReport(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransitGatewayRouteReport", reflect.TypeOf((*MockTransitGateway)(nil).CreateTransitGatewayRouteReport), arg0)
+}
+
 // DeleteTransitGateway mocks base method.
 func (m *MockTransitGateway) DeleteTransitGateway(deleteTransitGatewayOptions *transitgatewayapisv1.DeleteTransitGatewayOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +150,36 @@ func (mr *MockTransitGatewayMockRecorder) DeleteTransitGatewayConnection(deleteT
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayConnection", reflect.TypeOf((*MockTransitGateway)(nil).DeleteTransitGatewayConnection), deleteTransitGatewayConnectionOptions)
 }
 
+// DeleteTransitGatewayConnectionPrefixFilter mocks base method.
+func (m *MockTransitGateway) DeleteTransitGatewayConnectionPrefixFilter(arg0 *transitgatewayapisv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayConnectionPrefixFilter", arg0)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransitGatewayConnectionPrefixFilter indicates an expected call of DeleteTransitGatewayConnectionPrefixFilter.
+func (mr *MockTransitGatewayMockRecorder) DeleteTransitGatewayConnectionPrefixFilter(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayConnectionPrefixFilter", reflect.TypeOf((*MockTransitGateway)(nil).DeleteTransitGatewayConnectionPrefixFilter), arg0)
+}
+
+// DeleteTransitGatewayRouteReport mocks base method.
+func (m *MockTransitGateway) DeleteTransitGatewayRouteReport(arg0 *transitgatewayapisv1.DeleteTransitGatewayRouteReportOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransitGatewayRouteReport", arg0)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransitGatewayRouteReport indicates an expected call of DeleteTransitGatewayRouteReport.
+func (mr *MockTransitGatewayMockRecorder) DeleteTransitGatewayRouteReport(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransitGatewayRouteReport", reflect.TypeOf((*MockTransitGateway)(nil).DeleteTransitGatewayRouteReport), arg0)
+}
+
 // GetTransitGateway mocks base method.
 func (m *MockTransitGateway) GetTransitGateway(arg0 *transitgatewayapisv1.GetTransitGatewayOptions) (*transitgatewayapisv1.TransitGateway, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -165,6 +227,22 @@ func (mr *MockTransitGatewayMockRecorder) GetTransitGatewayConnection(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitGatewayConnection", reflect.TypeOf((*MockTransitGateway)(nil).GetTransitGatewayConnection), arg0)
 }
 
+// GetTransitGatewayRouteReport mocks base method.
+func (m *MockTransitGateway) GetTransitGatewayRouteReport(arg0 *transitgatewayapisv1.GetTransitGatewayRouteReportOptions) (*transitgatewayapisv1.RouteReport, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransitGatewayRouteReport", arg0)
+	ret0, _ := ret[0].(*transitgatewayapisv1.RouteReport)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTransitGatewayRouteReport indicates an expected call of GetTransitGatewayRouteReport.
+func (mr *MockTransitGatewayMockRecorder) GetTransitGatewayRouteReport(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransitGatewayRouteReport", reflect.TypeOf((*MockTransitGateway)(nil).GetTransitGatewayRouteReport), arg0)
+}
+
 // ListTransitGatewayConnections mocks base method.
 func (m *MockTransitGateway) ListTransitGatewayConnections(arg0 *transitgatewayapisv1.ListTransitGatewayConnectionsOptions) (*transitgatewayapisv1.TransitGatewayConnectionCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -180,3 +258,19 @@ func (mr *MockTransitGatewayMockRecorder) ListTransitGatewayConnections(arg0 any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransitGatewayConnections", reflect.TypeOf((*MockTransitGateway)(nil).ListTransitGatewayConnections), arg0)
 }
+
+// UpdateTransitGatewayConnection mocks base method.
+func (m *MockTransitGateway) UpdateTransitGatewayConnection(arg0 *transitgatewayapisv1.UpdateTransitGatewayConnectionOptions) (*transitgatewayapisv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransitGatewayConnection", arg0)
+	ret0, _ := ret[0].(*transitgatewayapisv1.TransitGatewayConnectionCust)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateTransitGatewayConnection indicates an expected call of UpdateTransitGatewayConnection.
+func (mr *MockTransitGatewayMockRecorder) UpdateTransitGatewayConnection(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransitGatewayConnection", reflect.TypeOf((*MockTransitGateway)(nil).UpdateTransitGatewayConnection), arg0)
+}
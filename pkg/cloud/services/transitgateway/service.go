@@ -129,3 +129,33 @@ func (s *Service) DeleteTransitGateway(options *tgapiv1.DeleteTransitGatewayOpti
 func (s *Service) DeleteTransitGatewayConnection(options *tgapiv1.DeleteTransitGatewayConnectionOptions) (*core.DetailedResponse, error) {
 	return s.tgClient.DeleteTransitGatewayConnection(options)
 }
+
+// UpdateTransitGatewayConnection updates a transit gateway connection.
+func (s *Service) UpdateTransitGatewayConnection(options *tgapiv1.UpdateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error) {
+	return s.tgClient.UpdateTransitGatewayConnection(options)
+}
+
+// CreateTransitGatewayConnectionPrefixFilter creates a prefix filter of a transit gateway connection.
+func (s *Service) CreateTransitGatewayConnectionPrefixFilter(options *tgapiv1.CreateTransitGatewayConnectionPrefixFilterOptions) (*tgapiv1.PrefixFilterCust, *core.DetailedResponse, error) {
+	return s.tgClient.CreateTransitGatewayConnectionPrefixFilter(options)
+}
+
+// DeleteTransitGatewayConnectionPrefixFilter deletes a prefix filter of a transit gateway connection.
+func (s *Service) DeleteTransitGatewayConnectionPrefixFilter(options *tgapiv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (*core.DetailedResponse, error) {
+	return s.tgClient.DeleteTransitGatewayConnectionPrefixFilter(options)
+}
+
+// CreateTransitGatewayRouteReport requests the generation of a route report of a transit gateway.
+func (s *Service) CreateTransitGatewayRouteReport(options *tgapiv1.CreateTransitGatewayRouteReportOptions) (*tgapiv1.RouteReport, *core.DetailedResponse, error) {
+	return s.tgClient.CreateTransitGatewayRouteReport(options)
+}
+
+// GetTransitGatewayRouteReport returns a route report of a transit gateway.
+func (s *Service) GetTransitGatewayRouteReport(options *tgapiv1.GetTransitGatewayRouteReportOptions) (*tgapiv1.RouteReport, *core.DetailedResponse, error) {
+	return s.tgClient.GetTransitGatewayRouteReport(options)
+}
+
+// DeleteTransitGatewayRouteReport deletes a route report of a transit gateway.
+func (s *Service) DeleteTransitGatewayRouteReport(options *tgapiv1.DeleteTransitGatewayRouteReportOptions) (*core.DetailedResponse, error) {
+	return s.tgClient.DeleteTransitGatewayRouteReport(options)
+}
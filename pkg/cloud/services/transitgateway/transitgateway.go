@@ -35,4 +35,10 @@ type TransitGateway interface {
 	GetTransitGatewayConnection(*tgapiv1.GetTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
 	DeleteTransitGateway(deleteTransitGatewayOptions *tgapiv1.DeleteTransitGatewayOptions) (response *core.DetailedResponse, err error)
 	DeleteTransitGatewayConnection(deleteTransitGatewayConnectionOptions *tgapiv1.DeleteTransitGatewayConnectionOptions) (response *core.DetailedResponse, err error)
+	UpdateTransitGatewayConnection(*tgapiv1.UpdateTransitGatewayConnectionOptions) (*tgapiv1.TransitGatewayConnectionCust, *core.DetailedResponse, error)
+	CreateTransitGatewayConnectionPrefixFilter(*tgapiv1.CreateTransitGatewayConnectionPrefixFilterOptions) (*tgapiv1.PrefixFilterCust, *core.DetailedResponse, error)
+	DeleteTransitGatewayConnectionPrefixFilter(*tgapiv1.DeleteTransitGatewayConnectionPrefixFilterOptions) (*core.DetailedResponse, error)
+	CreateTransitGatewayRouteReport(*tgapiv1.CreateTransitGatewayRouteReportOptions) (*tgapiv1.RouteReport, *core.DetailedResponse, error)
+	GetTransitGatewayRouteReport(*tgapiv1.GetTransitGatewayRouteReportOptions) (*tgapiv1.RouteReport, *core.DetailedResponse, error)
+	DeleteTransitGatewayRouteReport(*tgapiv1.DeleteTransitGatewayRouteReportOptions) (*core.DetailedResponse, error)
 }
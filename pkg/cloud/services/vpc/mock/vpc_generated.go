@@ -72,6 +72,22 @@ func (mr *MockVpcMockRecorder) AttachVolumeToInstance(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeToInstance", reflect.TypeOf((*MockVpc)(nil).AttachVolumeToInstance), options)
 }
 
+// CreateEndpointGateway mocks base method.
+func (m *MockVpc) CreateEndpointGateway(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpointGateway", options)
+	ret0, _ := ret[0].(*vpcv1.EndpointGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateEndpointGateway indicates an expected call of CreateEndpointGateway.
+func (mr *MockVpcMockRecorder) CreateEndpointGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpointGateway", reflect.TypeOf((*MockVpc)(nil).CreateEndpointGateway), options)
+}
+
 // CreateImage mocks base method.
 func (m *MockVpc) CreateImage(options *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -120,6 +136,38 @@ func (mr *MockVpcMockRecorder) CreateLoadBalancer(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockVpc)(nil).CreateLoadBalancer), options)
 }
 
+// CreateLoadBalancerListener mocks base method.
+func (m *MockVpc) CreateLoadBalancerListener(options *vpcv1.CreateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancerListener", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerListener)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateLoadBalancerListener indicates an expected call of CreateLoadBalancerListener.
+func (mr *MockVpcMockRecorder) CreateLoadBalancerListener(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerListener", reflect.TypeOf((*MockVpc)(nil).CreateLoadBalancerListener), options)
+}
+
+// CreateLoadBalancerPool mocks base method.
+func (m *MockVpc) CreateLoadBalancerPool(options *vpcv1.CreateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancerPool", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerPool)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateLoadBalancerPool indicates an expected call of CreateLoadBalancerPool.
+func (mr *MockVpcMockRecorder) CreateLoadBalancerPool(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerPool", reflect.TypeOf((*MockVpc)(nil).CreateLoadBalancerPool), options)
+}
+
 // CreateLoadBalancerPoolMember mocks base method.
 func (m *MockVpc) CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +248,22 @@ func (mr *MockVpcMockRecorder) CreateSubnet(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnet", reflect.TypeOf((*MockVpc)(nil).CreateSubnet), options)
 }
 
+// CreateSubnetReservedIP mocks base method.
+func (m *MockVpc) CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubnetReservedIP", options)
+	ret0, _ := ret[0].(*vpcv1.ReservedIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateSubnetReservedIP indicates an expected call of CreateSubnetReservedIP.
+func (mr *MockVpcMockRecorder) CreateSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).CreateSubnetReservedIP), options)
+}
+
 // CreateVPC mocks base method.
 func (m *MockVpc) CreateVPC(options *vpcv1.CreateVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +280,22 @@ func (mr *MockVpcMockRecorder) CreateVPC(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockVpc)(nil).CreateVPC), options)
 }
 
+// CreateVPCAddressPrefix mocks base method.
+func (m *MockVpc) CreateVPCAddressPrefix(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCAddressPrefix", options)
+	ret0, _ := ret[0].(*vpcv1.AddressPrefix)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateVPCAddressPrefix indicates an expected call of CreateVPCAddressPrefix.
+func (mr *MockVpcMockRecorder) CreateVPCAddressPrefix(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCAddressPrefix", reflect.TypeOf((*MockVpc)(nil).CreateVPCAddressPrefix), options)
+}
+
 // CreateVolume mocks base method.
 func (m *MockVpc) CreateVolume(options *vpcv1.CreateVolumeOptions) (*vpcv1.Volume, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +312,21 @@ func (mr *MockVpcMockRecorder) CreateVolume(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVpc)(nil).CreateVolume), options)
 }
 
+// DeleteEndpointGateway mocks base method.
+func (m *MockVpc) DeleteEndpointGateway(options *vpcv1.DeleteEndpointGatewayOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpointGateway", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpointGateway indicates an expected call of DeleteEndpointGateway.
+func (mr *MockVpcMockRecorder) DeleteEndpointGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpointGateway", reflect.TypeOf((*MockVpc)(nil).DeleteEndpointGateway), options)
+}
+
 // DeleteInstance mocks base method.
 func (m *MockVpc) DeleteInstance(options *vpcv1.DeleteInstanceOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +357,36 @@ func (mr *MockVpcMockRecorder) DeleteLoadBalancer(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockVpc)(nil).DeleteLoadBalancer), options)
 }
 
+// DeleteLoadBalancerListener mocks base method.
+func (m *MockVpc) DeleteLoadBalancerListener(options *vpcv1.DeleteLoadBalancerListenerOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerListener", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancerListener indicates an expected call of DeleteLoadBalancerListener.
+func (mr *MockVpcMockRecorder) DeleteLoadBalancerListener(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerListener", reflect.TypeOf((*MockVpc)(nil).DeleteLoadBalancerListener), options)
+}
+
+// DeleteLoadBalancerPool mocks base method.
+func (m *MockVpc) DeleteLoadBalancerPool(options *vpcv1.DeleteLoadBalancerPoolOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerPool", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancerPool indicates an expected call of DeleteLoadBalancerPool.
+func (mr *MockVpcMockRecorder) DeleteLoadBalancerPool(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerPool", reflect.TypeOf((*MockVpc)(nil).DeleteLoadBalancerPool), options)
+}
+
 // DeleteLoadBalancerPoolMember mocks base method.
 func (m *MockVpc) DeleteLoadBalancerPoolMember(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +447,21 @@ func (mr *MockVpcMockRecorder) DeleteSubnet(options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnet", reflect.TypeOf((*MockVpc)(nil).DeleteSubnet), options)
 }
 
+// DeleteSubnetReservedIP mocks base method.
+func (m *MockVpc) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubnetReservedIP", options)
+	ret0, _ := ret[0].(*core.DetailedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSubnetReservedIP indicates an expected call of DeleteSubnetReservedIP.
+func (mr *MockVpcMockRecorder) DeleteSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).DeleteSubnetReservedIP), options)
+}
+
 // DeleteVPC mocks base method.
 func (m *MockVpc) DeleteVPC(options *vpcv1.DeleteVPCOptions) (*core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +492,37 @@ func (mr *MockVpcMockRecorder) GetDedicatedHostByName(dHostName any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedHostByName", reflect.TypeOf((*MockVpc)(nil).GetDedicatedHostByName), dHostName)
 }
 
+// GetEndpointGateway mocks base method.
+func (m *MockVpc) GetEndpointGateway(options *vpcv1.GetEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpointGateway", options)
+	ret0, _ := ret[0].(*vpcv1.EndpointGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEndpointGateway indicates an expected call of GetEndpointGateway.
+func (mr *MockVpcMockRecorder) GetEndpointGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpointGateway", reflect.TypeOf((*MockVpc)(nil).GetEndpointGateway), options)
+}
+
+// GetEndpointGatewayByName mocks base method.
+func (m *MockVpc) GetEndpointGatewayByName(vpcID, name string) (*vpcv1.EndpointGateway, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEndpointGatewayByName", vpcID, name)
+	ret0, _ := ret[0].(*vpcv1.EndpointGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEndpointGatewayByName indicates an expected call of GetEndpointGatewayByName.
+func (mr *MockVpcMockRecorder) GetEndpointGatewayByName(vpcID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEndpointGatewayByName", reflect.TypeOf((*MockVpc)(nil).GetEndpointGatewayByName), vpcID, name)
+}
+
 // GetImage mocks base method.
 func (m *MockVpc) GetImage(options *vpcv1.GetImageOptions) (*vpcv1.Image, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -477,6 +648,38 @@ func (mr *MockVpcMockRecorder) GetLoadBalancerPoolByName(loadBalancerID, poolNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerPoolByName", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerPoolByName), loadBalancerID, poolName)
 }
 
+// GetLoadBalancerProfile mocks base method.
+func (m *MockVpc) GetLoadBalancerProfile(options *vpcv1.GetLoadBalancerProfileOptions) (*vpcv1.LoadBalancerProfile, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerProfile", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerProfile)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerProfile indicates an expected call of GetLoadBalancerProfile.
+func (mr *MockVpcMockRecorder) GetLoadBalancerProfile(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerProfile", reflect.TypeOf((*MockVpc)(nil).GetLoadBalancerProfile), options)
+}
+
+// GetPublicGateway mocks base method.
+func (m *MockVpc) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicGateway", options)
+	ret0, _ := ret[0].(*vpcv1.PublicGateway)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublicGateway indicates an expected call of GetPublicGateway.
+func (mr *MockVpcMockRecorder) GetPublicGateway(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicGateway", reflect.TypeOf((*MockVpc)(nil).GetPublicGateway), options)
+}
+
 // GetSecurityGroup mocks base method.
 func (m *MockVpc) GetSecurityGroup(options *vpcv1.GetSecurityGroupOptions) (*vpcv1.SecurityGroup, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -556,6 +759,37 @@ func (mr *MockVpcMockRecorder) GetSubnetPublicGateway(options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetPublicGateway", reflect.TypeOf((*MockVpc)(nil).GetSubnetPublicGateway), options)
 }
 
+// GetSubnetReservedIP mocks base method.
+func (m *MockVpc) GetSubnetReservedIP(options *vpcv1.GetSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetReservedIP", options)
+	ret0, _ := ret[0].(*vpcv1.ReservedIP)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSubnetReservedIP indicates an expected call of GetSubnetReservedIP.
+func (mr *MockVpcMockRecorder) GetSubnetReservedIP(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetReservedIP", reflect.TypeOf((*MockVpc)(nil).GetSubnetReservedIP), options)
+}
+
+// GetSubnetReservedIPByAddress mocks base method.
+func (m *MockVpc) GetSubnetReservedIPByAddress(subnetID, address string) (*vpcv1.ReservedIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetReservedIPByAddress", subnetID, address)
+	ret0, _ := ret[0].(*vpcv1.ReservedIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetReservedIPByAddress indicates an expected call of GetSubnetReservedIPByAddress.
+func (mr *MockVpcMockRecorder) GetSubnetReservedIPByAddress(subnetID, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetReservedIPByAddress", reflect.TypeOf((*MockVpc)(nil).GetSubnetReservedIPByAddress), subnetID, address)
+}
+
 // GetVPC mocks base method.
 func (m *MockVpc) GetVPC(arg0 *vpcv1.GetVPCOptions) (*vpcv1.VPC, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -572,6 +806,21 @@ func (mr *MockVpcMockRecorder) GetVPC(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPC", reflect.TypeOf((*MockVpc)(nil).GetVPC), arg0)
 }
 
+// GetVPCAddressPrefixes mocks base method.
+func (m *MockVpc) GetVPCAddressPrefixes(vpcID string) ([]vpcv1.AddressPrefix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVPCAddressPrefixes", vpcID)
+	ret0, _ := ret[0].([]vpcv1.AddressPrefix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVPCAddressPrefixes indicates an expected call of GetVPCAddressPrefixes.
+func (mr *MockVpcMockRecorder) GetVPCAddressPrefixes(vpcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCAddressPrefixes", reflect.TypeOf((*MockVpc)(nil).GetVPCAddressPrefixes), vpcID)
+}
+
 // GetVPCByName mocks base method.
 func (m *MockVpc) GetVPCByName(vpcName string) (*vpcv1.VPC, error) {
 	m.ctrl.T.Helper()
@@ -728,6 +977,22 @@ func (mr *MockVpcMockRecorder) ListLoadBalancerPoolMembers(options any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerPoolMembers", reflect.TypeOf((*MockVpc)(nil).ListLoadBalancerPoolMembers), options)
 }
 
+// ListLoadBalancerPools mocks base method.
+func (m *MockVpc) ListLoadBalancerPools(options *vpcv1.ListLoadBalancerPoolsOptions) (*vpcv1.LoadBalancerPoolCollection, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancerPools", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerPoolCollection)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListLoadBalancerPools indicates an expected call of ListLoadBalancerPools.
+func (mr *MockVpcMockRecorder) ListLoadBalancerPools(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerPools", reflect.TypeOf((*MockVpc)(nil).ListLoadBalancerPools), options)
+}
+
 // ListLoadBalancers mocks base method.
 func (m *MockVpc) ListLoadBalancers(options *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error) {
 	m.ctrl.T.Helper()
@@ -854,3 +1119,35 @@ func (mr *MockVpcMockRecorder) UnsetSubnetPublicGateway(options any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsetSubnetPublicGateway", reflect.TypeOf((*MockVpc)(nil).UnsetSubnetPublicGateway), options)
 }
+
+// UpdateLoadBalancerListener mocks base method.
+func (m *MockVpc) UpdateLoadBalancerListener(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerListener", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerListener)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateLoadBalancerListener indicates an expected call of UpdateLoadBalancerListener.
+func (mr *MockVpcMockRecorder) UpdateLoadBalancerListener(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerListener", reflect.TypeOf((*MockVpc)(nil).UpdateLoadBalancerListener), options)
+}
+
+// UpdateLoadBalancerPool mocks base method.
+func (m *MockVpc) UpdateLoadBalancerPool(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerPool", options)
+	ret0, _ := ret[0].(*vpcv1.LoadBalancerPool)
+	ret1, _ := ret[1].(*core.DetailedResponse)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// UpdateLoadBalancerPool indicates an expected call of UpdateLoadBalancerPool.
+func (mr *MockVpcMockRecorder) UpdateLoadBalancerPool(options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerPool", reflect.TypeOf((*MockVpc)(nil).UpdateLoadBalancerPool), options)
+}
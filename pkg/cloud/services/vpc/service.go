@@ -134,6 +134,11 @@ func (s *Service) GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOp
 	return s.vpcService.GetSubnetPublicGateway(options)
 }
 
+// GetPublicGateway returns the public gateway.
+func (s *Service) GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
+	return s.vpcService.GetPublicGateway(options)
+}
+
 // CreatePublicGateway creates a public gateway for the VPC.
 func (s *Service) CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error) {
 	return s.vpcService.CreatePublicGateway(options)
@@ -159,6 +164,45 @@ func (s *Service) ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOp
 	return s.vpcService.ListVPCAddressPrefixes(options)
 }
 
+// GetVPCAddressPrefixes returns all the address prefixes of a VPC, across all pages.
+func (s *Service) GetVPCAddressPrefixes(vpcID string) ([]vpcv1.AddressPrefix, error) {
+	var addressPrefixes []vpcv1.AddressPrefix
+	f := func(start string) (bool, string, error) {
+		listVPCAddressPrefixesOptions := &vpcv1.ListVPCAddressPrefixesOptions{
+			VPCID: &vpcID,
+		}
+		if start != "" {
+			listVPCAddressPrefixesOptions.Start = &start
+		}
+
+		addressPrefixesList, _, err := s.vpcService.ListVPCAddressPrefixes(listVPCAddressPrefixesOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if addressPrefixesList == nil {
+			return false, "", fmt.Errorf("address prefixes list returned is nil")
+		}
+		addressPrefixes = append(addressPrefixes, addressPrefixesList.AddressPrefixes...)
+
+		if addressPrefixesList.Next != nil && *addressPrefixesList.Next.Href != "" {
+			return false, *addressPrefixesList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := pagingutils.PagingHelper(f); err != nil {
+		return nil, err
+	}
+
+	return addressPrefixes, nil
+}
+
+// CreateVPCAddressPrefix creates an address prefix for a VPC.
+func (s *Service) CreateVPCAddressPrefix(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error) {
+	return s.vpcService.CreateVPCAddressPrefix(options)
+}
+
 // CreateSecurityGroupRule creates a rule for a security group.
 func (s *Service) CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error) {
 	return s.vpcService.CreateSecurityGroupRule(options)
@@ -184,6 +228,11 @@ func (s *Service) GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (*vpcv1
 	return s.vpcService.GetLoadBalancer(options)
 }
 
+// GetLoadBalancerProfile returns a load balancer profile.
+func (s *Service) GetLoadBalancerProfile(options *vpcv1.GetLoadBalancerProfileOptions) (*vpcv1.LoadBalancerProfile, *core.DetailedResponse, error) {
+	return s.vpcService.GetLoadBalancerProfile(options)
+}
+
 // CreateLoadBalancerPoolMember creates a new member and adds the member to the pool.
 func (s *Service) CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error) {
 	return s.vpcService.CreateLoadBalancerPoolMember(options)
@@ -204,6 +253,41 @@ func (s *Service) GetLoadBalancerListener(options *vpcv1.GetLoadBalancerListener
 	return s.vpcService.GetLoadBalancerListener(options)
 }
 
+// UpdateLoadBalancerListener updates a load balancer listener.
+func (s *Service) UpdateLoadBalancerListener(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateLoadBalancerListener(options)
+}
+
+// CreateLoadBalancerListener creates a load balancer listener.
+func (s *Service) CreateLoadBalancerListener(options *vpcv1.CreateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error) {
+	return s.vpcService.CreateLoadBalancerListener(options)
+}
+
+// DeleteLoadBalancerListener deletes a load balancer listener.
+func (s *Service) DeleteLoadBalancerListener(options *vpcv1.DeleteLoadBalancerListenerOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteLoadBalancerListener(options)
+}
+
+// ListLoadBalancerPools returns the pools of a load balancer.
+func (s *Service) ListLoadBalancerPools(options *vpcv1.ListLoadBalancerPoolsOptions) (*vpcv1.LoadBalancerPoolCollection, *core.DetailedResponse, error) {
+	return s.vpcService.ListLoadBalancerPools(options)
+}
+
+// CreateLoadBalancerPool creates a load balancer pool.
+func (s *Service) CreateLoadBalancerPool(options *vpcv1.CreateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	return s.vpcService.CreateLoadBalancerPool(options)
+}
+
+// UpdateLoadBalancerPool updates a load balancer pool.
+func (s *Service) UpdateLoadBalancerPool(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error) {
+	return s.vpcService.UpdateLoadBalancerPool(options)
+}
+
+// DeleteLoadBalancerPool deletes a load balancer pool.
+func (s *Service) DeleteLoadBalancerPool(options *vpcv1.DeleteLoadBalancerPoolOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteLoadBalancerPool(options)
+}
+
 // ListKeys returns list of keys in a region.
 func (s *Service) ListKeys(options *vpcv1.ListKeysOptions) (*vpcv1.KeyCollection, *core.DetailedResponse, error) {
 	return s.vpcService.ListKeys(options)
@@ -546,6 +630,91 @@ func (s *Service) GetVolume(options *vpcv1.GetVolumeOptions) (result *vpcv1.Volu
 	return s.vpcService.GetVolume(options)
 }
 
+// CreateEndpointGateway creates a virtual private endpoint gateway.
+func (s *Service) CreateEndpointGateway(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	return s.vpcService.CreateEndpointGateway(options)
+}
+
+// GetEndpointGateway returns the virtual private endpoint gateway.
+func (s *Service) GetEndpointGateway(options *vpcv1.GetEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error) {
+	return s.vpcService.GetEndpointGateway(options)
+}
+
+// GetEndpointGatewayByName returns the virtual private endpoint gateway with the given name in the VPC, or nil if it does not exist.
+func (s *Service) GetEndpointGatewayByName(vpcID string, name string) (*vpcv1.EndpointGateway, error) {
+	endpointGateways, _, err := s.vpcService.ListEndpointGateways(&vpcv1.ListEndpointGatewaysOptions{
+		Name:  &name,
+		VPCID: &vpcID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if endpointGateways == nil || len(endpointGateways.EndpointGateways) == 0 {
+		return nil, nil
+	}
+	return &endpointGateways.EndpointGateways[0], nil
+}
+
+// DeleteEndpointGateway deletes the virtual private endpoint gateway.
+func (s *Service) DeleteEndpointGateway(options *vpcv1.DeleteEndpointGatewayOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteEndpointGateway(options)
+}
+
+// CreateSubnetReservedIP reserves an IP address on the subnet.
+func (s *Service) CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	return s.vpcService.CreateSubnetReservedIP(options)
+}
+
+// GetSubnetReservedIP returns the reserved IP of the subnet.
+func (s *Service) GetSubnetReservedIP(options *vpcv1.GetSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error) {
+	return s.vpcService.GetSubnetReservedIP(options)
+}
+
+// GetSubnetReservedIPByAddress returns the reserved IP of the subnet with the given address. If not found, returns nil.
+func (s *Service) GetSubnetReservedIPByAddress(subnetID string, address string) (*vpcv1.ReservedIP, error) {
+	var reservedIP *vpcv1.ReservedIP
+	f := func(start string) (bool, string, error) {
+		listSubnetReservedIpsOptions := &vpcv1.ListSubnetReservedIpsOptions{
+			SubnetID: &subnetID,
+		}
+		if start != "" {
+			listSubnetReservedIpsOptions.Start = &start
+		}
+
+		reservedIPList, _, err := s.vpcService.ListSubnetReservedIps(listSubnetReservedIpsOptions)
+		if err != nil {
+			return false, "", err
+		}
+
+		if reservedIPList == nil {
+			return false, "", fmt.Errorf("reserved IP list returned is nil")
+		}
+
+		for i, ip := range reservedIPList.ReservedIps {
+			if ip.Address != nil && *ip.Address == address {
+				reservedIP = &reservedIPList.ReservedIps[i]
+				return true, "", nil
+			}
+		}
+
+		if reservedIPList.Next != nil && *reservedIPList.Next.Href != "" {
+			return false, *reservedIPList.Next.Href, nil
+		}
+		return true, "", nil
+	}
+
+	if err := pagingutils.PagingHelper(f); err != nil {
+		return nil, err
+	}
+
+	return reservedIP, nil
+}
+
+// DeleteSubnetReservedIP releases the reserved IP of the subnet.
+func (s *Service) DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error) {
+	return s.vpcService.DeleteSubnetReservedIP(options)
+}
+
 // NewService returns a new VPC Service.
 func NewService(svcEndpoint string) (Vpc, error) {
 	service := &Service{}
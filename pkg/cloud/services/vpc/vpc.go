@@ -40,18 +40,29 @@ type Vpc interface {
 	GetSubnetPublicGateway(options *vpcv1.GetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	SetSubnetPublicGateway(options *vpcv1.SetSubnetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	UnsetSubnetPublicGateway(options *vpcv1.UnsetSubnetPublicGatewayOptions) (*core.DetailedResponse, error)
+	GetPublicGateway(options *vpcv1.GetPublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	CreatePublicGateway(options *vpcv1.CreatePublicGatewayOptions) (*vpcv1.PublicGateway, *core.DetailedResponse, error)
 	DeletePublicGateway(options *vpcv1.DeletePublicGatewayOptions) (*core.DetailedResponse, error)
 	ListVPCAddressPrefixes(options *vpcv1.ListVPCAddressPrefixesOptions) (*vpcv1.AddressPrefixCollection, *core.DetailedResponse, error)
+	GetVPCAddressPrefixes(vpcID string) ([]vpcv1.AddressPrefix, error)
+	CreateVPCAddressPrefix(options *vpcv1.CreateVPCAddressPrefixOptions) (*vpcv1.AddressPrefix, *core.DetailedResponse, error)
 	CreateSecurityGroupRule(options *vpcv1.CreateSecurityGroupRuleOptions) (vpcv1.SecurityGroupRuleIntf, *core.DetailedResponse, error)
 	CreateLoadBalancer(options *vpcv1.CreateLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error)
 	DeleteLoadBalancer(options *vpcv1.DeleteLoadBalancerOptions) (*core.DetailedResponse, error)
 	ListLoadBalancers(options *vpcv1.ListLoadBalancersOptions) (*vpcv1.LoadBalancerCollection, *core.DetailedResponse, error)
 	GetLoadBalancer(options *vpcv1.GetLoadBalancerOptions) (*vpcv1.LoadBalancer, *core.DetailedResponse, error)
+	GetLoadBalancerProfile(options *vpcv1.GetLoadBalancerProfileOptions) (*vpcv1.LoadBalancerProfile, *core.DetailedResponse, error)
 	CreateLoadBalancerPoolMember(options *vpcv1.CreateLoadBalancerPoolMemberOptions) (*vpcv1.LoadBalancerPoolMember, *core.DetailedResponse, error)
 	DeleteLoadBalancerPoolMember(options *vpcv1.DeleteLoadBalancerPoolMemberOptions) (*core.DetailedResponse, error)
 	ListLoadBalancerPoolMembers(options *vpcv1.ListLoadBalancerPoolMembersOptions) (*vpcv1.LoadBalancerPoolMemberCollection, *core.DetailedResponse, error)
 	GetLoadBalancerListener(options *vpcv1.GetLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error)
+	UpdateLoadBalancerListener(options *vpcv1.UpdateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error)
+	CreateLoadBalancerListener(options *vpcv1.CreateLoadBalancerListenerOptions) (*vpcv1.LoadBalancerListener, *core.DetailedResponse, error)
+	DeleteLoadBalancerListener(options *vpcv1.DeleteLoadBalancerListenerOptions) (*core.DetailedResponse, error)
+	ListLoadBalancerPools(options *vpcv1.ListLoadBalancerPoolsOptions) (*vpcv1.LoadBalancerPoolCollection, *core.DetailedResponse, error)
+	CreateLoadBalancerPool(options *vpcv1.CreateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error)
+	UpdateLoadBalancerPool(options *vpcv1.UpdateLoadBalancerPoolOptions) (*vpcv1.LoadBalancerPool, *core.DetailedResponse, error)
+	DeleteLoadBalancerPool(options *vpcv1.DeleteLoadBalancerPoolOptions) (*core.DetailedResponse, error)
 	ListKeys(options *vpcv1.ListKeysOptions) (*vpcv1.KeyCollection, *core.DetailedResponse, error)
 	CreateImage(options *vpcv1.CreateImageOptions) (*vpcv1.Image, *core.DetailedResponse, error)
 	ListImages(options *vpcv1.ListImagesOptions) (*vpcv1.ImageCollection, *core.DetailedResponse, error)
@@ -77,4 +88,12 @@ type Vpc interface {
 	AttachVolumeToInstance(options *vpcv1.CreateInstanceVolumeAttachmentOptions) (*vpcv1.VolumeAttachment, *core.DetailedResponse, error)
 	GetVolumeAttachments(options *vpcv1.ListInstanceVolumeAttachmentsOptions) (result *vpcv1.VolumeAttachmentCollection, response *core.DetailedResponse, err error)
 	GetVolume(options *vpcv1.GetVolumeOptions) (result *vpcv1.Volume, response *core.DetailedResponse, err error)
+	CreateEndpointGateway(options *vpcv1.CreateEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error)
+	GetEndpointGateway(options *vpcv1.GetEndpointGatewayOptions) (*vpcv1.EndpointGateway, *core.DetailedResponse, error)
+	GetEndpointGatewayByName(vpcID string, name string) (*vpcv1.EndpointGateway, error)
+	DeleteEndpointGateway(options *vpcv1.DeleteEndpointGatewayOptions) (*core.DetailedResponse, error)
+	CreateSubnetReservedIP(options *vpcv1.CreateSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error)
+	GetSubnetReservedIP(options *vpcv1.GetSubnetReservedIPOptions) (*vpcv1.ReservedIP, *core.DetailedResponse, error)
+	GetSubnetReservedIPByAddress(subnetID string, address string) (*vpcv1.ReservedIP, error)
+	DeleteSubnetReservedIP(options *vpcv1.DeleteSubnetReservedIPOptions) (*core.DetailedResponse, error)
 }
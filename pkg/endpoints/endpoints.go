@@ -41,11 +41,13 @@ const (
 	RM serviceID = "rm"
 	// GlobalTagging used to identify the Global Tagging service.
 	GlobalTagging serviceID = "globaltagging"
+	// DNSServices used to identify the DNS Services service.
+	DNSServices serviceID = "dnsservices"
 )
 
 type serviceID string
 
-var serviceIDs = []serviceID{VPC, PowerVS, RC, TransitGateway, COS, RM, GlobalTagging}
+var serviceIDs = []serviceID{VPC, PowerVS, RC, TransitGateway, COS, RM, GlobalTagging, DNSServices}
 
 // ServiceEndpoint holds the Service endpoint specific information.
 type ServiceEndpoint struct {
@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+	clusterv1util "sigs.k8s.io/cluster-api/util"
+)
+
+// Claim holds the details of the IPAddressClaim of a machine.
+type Claim struct {
+	// Name is the name of the IPAddressClaim.
+	Name string
+	// Namespace is the namespace of the IPAddressClaim, the namespace of the machine.
+	Namespace string
+	// ClusterName is the name of the Cluster the machine belongs to.
+	ClusterName string
+	// Owner is the reference to the machine owning the IPAddressClaim, the claim is garbage collected along with the machine.
+	Owner metav1.OwnerReference
+	// PoolRef is the reference to the pool the IP address is allocated from.
+	PoolRef ipamv1.IPPoolReference
+	// Labels are the additional labels of the IPAddressClaim, for example the watch label of the machine
+	// for the changes of the claim to pass the event filter of the controller of the machine.
+	Labels map[string]string
+}
+
+// ClaimIPAddress creates the IPAddressClaim if it does not exist and returns the IPAddress allocated for it.
+// returns nil when the IP address is not yet allocated by the IPAM provider.
+func ClaimIPAddress(ctx context.Context, c client.Client, claim Claim) (*ipamv1.IPAddress, error) {
+	ipAddressClaim := &ipamv1.IPAddressClaim{}
+	key := client.ObjectKey{Namespace: claim.Namespace, Name: claim.Name}
+	if err := c.Get(ctx, key, ipAddressClaim); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to get IPAddressClaim %s: %w", key, err)
+		}
+		ipAddressClaim = &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            claim.Name,
+				Namespace:       claim.Namespace,
+				Labels:          claimLabels(claim),
+				OwnerReferences: clusterv1util.EnsureOwnerRef(nil, claim.Owner),
+			},
+			Spec: ipamv1.IPAddressClaimSpec{
+				ClusterName: claim.ClusterName,
+				PoolRef:     claim.PoolRef,
+			},
+		}
+		if err := c.Create(ctx, ipAddressClaim); err != nil {
+			return nil, fmt.Errorf("failed to create IPAddressClaim %s: %w", key, err)
+		}
+		return nil, nil
+	}
+
+	if ipAddressClaim.Status.AddressRef.Name == "" {
+		return nil, nil
+	}
+	ipAddress := &ipamv1.IPAddress{}
+	addressKey := client.ObjectKey{Namespace: claim.Namespace, Name: ipAddressClaim.Status.AddressRef.Name}
+	if err := c.Get(ctx, addressKey, ipAddress); err != nil {
+		return nil, fmt.Errorf("failed to get IPAddress %s: %w", addressKey, err)
+	}
+	return ipAddress, nil
+}
+
+// claimLabels returns the labels of the IPAddressClaim.
+func claimLabels(claim Claim) map[string]string {
+	labels := map[string]string{}
+	maps.Copy(labels, claim.Labels)
+	labels[clusterv1.ClusterNameLabel] = claim.ClusterName
+	return labels
+}
+
+// ReleaseIPAddress deletes the IPAddressClaim, releasing the IP address allocated for it back to the pool.
+func ReleaseIPAddress(ctx context.Context, c client.Client, key client.ObjectKey) error {
+	ipAddressClaim := &ipamv1.IPAddressClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+		},
+	}
+	if err := c.Delete(ctx, ipAddressClaim); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete IPAddressClaim %s: %w", key, err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ipamv1 "sigs.k8s.io/cluster-api/api/ipam/v1beta2"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestClaimIPAddress(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = ipamv1.AddToScheme(scheme)
+
+	claim := Claim{
+		Name:        "machine",
+		Namespace:   "default",
+		ClusterName: "cluster",
+		Owner: metav1.OwnerReference{
+			APIVersion: "infrastructure.cluster.x-k8s.io/v1beta3",
+			Kind:       "IBMPowerVSMachine",
+			Name:       "machine",
+			UID:        "machine-uid",
+		},
+		PoolRef: ipamv1.IPPoolReference{
+			APIGroup: "ipam.cluster.x-k8s.io",
+			Kind:     "InClusterIPPool",
+			Name:     "pool",
+		},
+		Labels: map[string]string{clusterv1.WatchLabel: "watch-filter"},
+	}
+
+	t.Run("When the IPAddressClaim does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		ipAddress, err := ClaimIPAddress(context.Background(), c, claim)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ipAddress).To(BeNil())
+
+		ipAddressClaim := &ipamv1.IPAddressClaim{}
+		g.Expect(c.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "machine"}, ipAddressClaim)).To(Succeed())
+		g.Expect(ipAddressClaim.Spec.ClusterName).To(Equal("cluster"))
+		g.Expect(ipAddressClaim.Spec.PoolRef).To(Equal(claim.PoolRef))
+		g.Expect(ipAddressClaim.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "cluster"))
+		g.Expect(ipAddressClaim.Labels).To(HaveKeyWithValue(clusterv1.WatchLabel, "watch-filter"))
+		g.Expect(ipAddressClaim.OwnerReferences).To(ConsistOf(claim.Owner))
+	})
+	t.Run("When the IP address is not yet allocated", func(t *testing.T) {
+		g := NewWithT(t)
+		ipAddressClaim := &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ipAddressClaim).Build()
+
+		ipAddress, err := ClaimIPAddress(context.Background(), c, claim)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ipAddress).To(BeNil())
+	})
+	t.Run("When the IP address is allocated", func(t *testing.T) {
+		g := NewWithT(t)
+		ipAddressClaim := &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"},
+			Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "machine-address"}},
+		}
+		address := &ipamv1.IPAddress{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine-address", Namespace: "default"},
+			Spec: ipamv1.IPAddressSpec{
+				Address: "192.168.10.10",
+				Prefix:  ptr.To[int32](24),
+				Gateway: "192.168.10.1",
+			},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ipAddressClaim, address).Build()
+
+		ipAddress, err := ClaimIPAddress(context.Background(), c, claim)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(ipAddress.Spec).To(Equal(address.Spec))
+	})
+	t.Run("When the allocated IPAddress does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		ipAddressClaim := &ipamv1.IPAddressClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"},
+			Status:     ipamv1.IPAddressClaimStatus{AddressRef: ipamv1.IPAddressReference{Name: "machine-address"}},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ipAddressClaim).Build()
+
+		ipAddress, err := ClaimIPAddress(context.Background(), c, claim)
+		g.Expect(err).To(MatchError(ContainSubstring("failed to get IPAddress default/machine-address")))
+		g.Expect(ipAddress).To(BeNil())
+	})
+}
+
+func TestReleaseIPAddress(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = ipamv1.AddToScheme(scheme)
+	key := client.ObjectKey{Namespace: "default", Name: "machine"}
+
+	t.Run("When the IPAddressClaim exists", func(t *testing.T) {
+		g := NewWithT(t)
+		ipAddressClaim := &ipamv1.IPAddressClaim{ObjectMeta: metav1.ObjectMeta{Name: "machine", Namespace: "default"}}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(ipAddressClaim).Build()
+
+		g.Expect(ReleaseIPAddress(context.Background(), c, key)).To(Succeed())
+		err := c.Get(context.Background(), key, &ipamv1.IPAddressClaim{})
+		g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+	t.Run("When the IPAddressClaim does not exist", func(t *testing.T) {
+		g := NewWithT(t)
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		g.Expect(ReleaseIPAddress(context.Background(), c, key)).To(Succeed())
+	})
+}